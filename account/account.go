@@ -95,6 +95,7 @@ type Account struct {
 	baseToPositions  map[uint32][]*Position
 	baseCount        map[uint32]int
 	positions        map[uint64]*Position
+	lpPositions      map[string]*LPPosition
 	balances         map[uint32]int64
 	assets           map[uint32]*xchangerModels.Asset
 	margin           int64
@@ -117,6 +118,7 @@ func NewAccount(account *models.Account, fillCollector *FillCollector, makerFees
 		ordersClID:       make(map[string]*Order),
 		securities:       make(map[uint64]Security),
 		positions:        make(map[uint64]*Position),
+		lpPositions:      make(map[string]*LPPosition),
 		baseToPositions:  make(map[uint32][]*Position),
 		baseCount:        make(map[uint32]int),
 		balances:         make(map[uint32]int64),
@@ -128,7 +130,11 @@ func NewAccount(account *models.Account, fillCollector *FillCollector, makerFees
 		cache:            make(map[int]CacheValue),
 		fillCollector:    fillCollector,
 	}
-	switch account.Exchange.ID {
+	var exchangeID uint32
+	if account.Exchange != nil {
+		exchangeID = account.Exchange.ID
+	}
+	switch exchangeID {
 	case constants.FBINANCE.ID:
 		accnt.MarginCurrency = constants.TETHER
 		if constants.TETHER == nil {
@@ -172,6 +178,14 @@ func NewAccount(account *models.Account, fillCollector *FillCollector, makerFees
 		accnt.MarginCurrency = constants.TETHER
 		accnt.MarginPrecision = 100000000
 	}
+	if account.Chain != nil {
+		// On-chain wallets only hold assets, valued in dollars
+		if constants.DOLLAR == nil {
+			return nil, fmt.Errorf("not loaded")
+		}
+		accnt.MarginCurrency = constants.DOLLAR
+		accnt.MarginPrecision = 100000000
+	}
 	if accnt.MarginCurrency != nil {
 		accnt.assets[accnt.MarginCurrency.ID] = accnt.MarginCurrency
 	}
@@ -1033,6 +1047,7 @@ func (accnt *Account) GetPositions() []*models.Position {
 			positions = append(positions, pos)
 		}
 	}
+	positions = append(positions, accnt.getLPPositionModels()...)
 
 	return positions
 }
@@ -1078,6 +1093,7 @@ func (accnt *Account) GetAllPositions() []*models.Position {
 		}
 		positions = append(positions, p)
 	}
+	positions = append(positions, accnt.getLPPositionModels()...)
 
 	return positions
 }
@@ -1244,6 +1260,7 @@ func (accnt *Account) Clone() *Account {
 		baseToPositions: make(map[uint32][]*Position),
 		baseCount:       make(map[uint32]int),
 		positions:       make(map[uint64]*Position),
+		lpPositions:     make(map[string]*LPPosition),
 		balances:        make(map[uint32]int64),
 		assets:          make(map[uint32]*xchangerModels.Asset),
 		margin:          accnt.margin,
@@ -1269,6 +1286,9 @@ func (accnt *Account) Clone() *Account {
 	for k, v := range accnt.balances {
 		clone.balances[k] = v
 	}
+	for k, v := range accnt.lpPositions {
+		clone.lpPositions[k] = v.Clone()
+	}
 
 	return clone
 }
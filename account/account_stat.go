@@ -93,6 +93,14 @@ func (accnt *Account) GetAssetExposure(asset uint32) float64 {
 		}
 		exposure += pos.Size()
 	}
+	for _, pos := range accnt.lpPositions {
+		if pos.Token0.ID == asset {
+			exposure += pos.Amount0 + pos.Fees0
+		}
+		if pos.Token1.ID == asset {
+			exposure += pos.Amount1 + pos.Fees1
+		}
+	}
 	return exposure
 }
 
@@ -108,6 +116,10 @@ func (accnt *Account) GetAssetExposures() map[uint32]float64 {
 			exposures[k] += p.Size()
 		}
 	}
+	for _, pos := range accnt.lpPositions {
+		exposures[pos.Token0.ID] += pos.Amount0 + pos.Fees0
+		exposures[pos.Token1.ID] += pos.Amount1 + pos.Fees1
+	}
 
 	return exposures
 }
@@ -208,6 +220,9 @@ func (accnt *Account) GetNetMargin(model modeling.Market) (float64, error) {
 			netMargin += unrealizedPnL
 		}
 	}
+	if accnt.MarginCurrency != nil {
+		netMargin += accnt.getLPValue(model)
+	}
 
 	return math.Max(netMargin, 0.), nil
 }
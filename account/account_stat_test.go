@@ -4,6 +4,7 @@ import (
 	"gitlab.com/alphaticks/alpha-connect/account"
 	"gitlab.com/alphaticks/alpha-connect/modeling"
 	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
 	"gitlab.com/alphaticks/alpha-connect/tests"
 	"gitlab.com/alphaticks/xchanger/constants"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -93,6 +94,114 @@ func TestAccount_GetAvailableMargin(t *testing.T) {
 	}
 }
 
+func TestAccount_Wallet(t *testing.T) {
+	InitModel(t)
+	walletAccount := &models.Account{
+		Name:    "wallet",
+		Chain:   constants.EthereumMainnet,
+		Address: "0x90F8bf6A479f320ead074411a4B0e7944Ea8c9C1",
+	}
+	accnt, err := account.NewAccount(walletAccount, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := accnt.Sync(nil, nil, nil, []*models.Balance{{Asset: constants.ETHEREUM, Quantity: 2}}, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := accnt.UpdateBalance(constants.TETHER, 50, messages.AccountMovementType_Unknown); err != nil {
+		t.Fatal(err)
+	}
+
+	p := account.NewPortfolio("wallets", 100)
+	p.AddAccount(accnt)
+	value, err := p.Value(model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(value-70.) > 0.0000001 {
+		t.Fatalf("was expecting 70, got %g", value)
+	}
+	exposures := p.GetAssetExposures()
+	if exposures[constants.ETHEREUM.ID] != 2 || exposures[constants.TETHER.ID] != 50 {
+		t.Fatalf("was expecting the wallet balances in the exposures, got %v", exposures)
+	}
+}
+
+func TestAccount_LPPosition(t *testing.T) {
+	InitModel(t)
+	walletAccount := &models.Account{
+		Name:    "lp",
+		Chain:   constants.EthereumMainnet,
+		Address: "0x90F8bf6A479f320ead074411a4B0e7944Ea8c9C1",
+	}
+	accnt, err := account.NewAccount(walletAccount, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := accnt.Sync(nil, nil, nil, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	// Deposited 1 ETH and 10 USDT at a price of 10, the price moved to 12.1
+	// and the position holds 0.9 ETH, 11 USDT and earned 1 USDT of fees
+	accnt.UpdateLPPosition(&account.LPPosition{
+		ID:         "1",
+		Token0:     constants.ETHEREUM,
+		Token1:     constants.TETHER,
+		TickLower:  -887220,
+		TickUpper:  887220,
+		Liquidity:  1e9,
+		Price:      12.1,
+		Amount0:    0.9,
+		Amount1:    11,
+		Fees1:      1,
+		Deposited0: 1,
+		Deposited1: 10,
+	})
+	pos := accnt.GetLPPositions()
+	if len(pos) != 1 {
+		t.Fatalf("was expecting one lp position, got %d", len(pos))
+	}
+	if math.Abs(pos[0].HoldValue()-22.1) > 1e-9 || math.Abs(pos[0].PnL()-0.79) > 1e-9 {
+		t.Fatalf("wrong lp position value: %g %g", pos[0].HoldValue(), pos[0].PnL())
+	}
+	if math.Abs(pos[0].ImpermanentLoss()-0.21) > 1e-9 {
+		t.Fatalf("wrong impermanent loss: %g", pos[0].ImpermanentLoss())
+	}
+	positions := accnt.GetPositions()
+	if len(positions) != 1 || positions[0].Lp == nil || math.Abs(positions[0].Lp.Pnl-0.79) > 1e-9 {
+		t.Fatalf("was expecting the lp position in the positions, got %v", positions)
+	}
+
+	p := account.NewPortfolio("lp", 100)
+	p.AddAccount(accnt)
+	// The model values ETH at 10 dollars and USDT at 1 dollar
+	value, err := p.Value(model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(value-21.) > 0.0000001 {
+		t.Fatalf("was expecting 21, got %g", value)
+	}
+	exposures := p.GetAssetExposures()
+	if math.Abs(exposures[constants.ETHEREUM.ID]-0.9) > 1e-9 || math.Abs(exposures[constants.TETHER.ID]-12) > 1e-9 {
+		t.Fatalf("was expecting the lp amounts in the exposures, got %v", exposures)
+	}
+
+	gas := modeling.NewGasMap()
+	pos[0].Security = &models.Security{Chain: constants.EthereumMainnet}
+	accnt.UpdateLPPosition(pos[0])
+	if _, ok := accnt.GetLPRebalanceCost(model, gas, constants.ETHEREUM); ok {
+		t.Fatalf("was expecting an unknown rebalance cost without gas stats")
+	}
+	// 500k gas at 20 gwei is 0.01 ETH, 0.1 dollar
+	gas.SetStat(&models.Stat{StatType: models.StatType_BaseFee, Value: 18, ChainID: constants.EthereumMainnet.ID})
+	gas.SetStat(&models.Stat{StatType: models.StatType_PriorityFee, Value: 2, ChainID: constants.EthereumMainnet.ID})
+	cost, ok := accnt.GetLPRebalanceCost(model, gas, constants.ETHEREUM)
+	if !ok || math.Abs(cost-0.1) > 1e-9 {
+		t.Fatalf("was expecting a rebalance cost of 0.1, got %g", cost)
+	}
+}
+
 /*
 func TestAccount_GetAvailableMargin_Inverse(t *testing.T) {
 	InitModel(t)
@@ -0,0 +1,178 @@
+package account
+
+import (
+	"math"
+
+	"gitlab.com/alphaticks/alpha-connect/modeling"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	xchangerModels "gitlab.com/alphaticks/xchanger/models"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Gas used by a rebalance of a liquidity position: decreasing its liquidity,
+// collecting the tokens and minting the new range
+const lpRebalanceGas = 500000
+
+// LPPosition is liquidity provided to a concentrated liquidity pool.
+// Amounts are in token units and the price is the price of token0 in token1.
+type LPPosition struct {
+	ID        string
+	Security  *models.Security
+	Token0    *xchangerModels.Asset
+	Token1    *xchangerModels.Asset
+	TickLower int32
+	TickUpper int32
+	Liquidity float64
+	Price     float64
+	Amount0   float64
+	Amount1   float64
+	// Uncollected fees, and liquidity withdrawn but not collected
+	Fees0 float64
+	Fees1 float64
+	// Net amounts deposited, the amounts added less the amounts collected
+	Deposited0 float64
+	Deposited1 float64
+}
+
+// Value returns the value of the position in token1
+func (pos *LPPosition) Value() float64 {
+	return (pos.Amount0+pos.Fees0)*pos.Price + pos.Amount1 + pos.Fees1
+}
+
+// HoldValue returns the value in token1 of the deposited tokens if they had been held
+func (pos *LPPosition) HoldValue() float64 {
+	return pos.Deposited0*pos.Price + pos.Deposited1
+}
+
+// PnL returns the gain in token1 of providing liquidity versus holding the deposited tokens
+func (pos *LPPosition) PnL() float64 {
+	return pos.Value() - pos.HoldValue()
+}
+
+// ImpermanentLoss returns the loss in token1 of the position versus holding, fees excluded
+func (pos *LPPosition) ImpermanentLoss() float64 {
+	return math.Max(pos.HoldValue()-(pos.Amount0*pos.Price+pos.Amount1), 0.)
+}
+
+func (pos *LPPosition) GetPosition() *models.LPPosition {
+	return &models.LPPosition{
+		Id:         pos.ID,
+		Token0:     pos.Token0,
+		Token1:     pos.Token1,
+		TickLower:  pos.TickLower,
+		TickUpper:  pos.TickUpper,
+		Liquidity:  pos.Liquidity,
+		Price:      pos.Price,
+		Amount0:    pos.Amount0,
+		Amount1:    pos.Amount1,
+		Fees0:      pos.Fees0,
+		Fees1:      pos.Fees1,
+		Deposited0: pos.Deposited0,
+		Deposited1: pos.Deposited1,
+		Pnl:        pos.PnL(),
+	}
+}
+
+func (pos *LPPosition) Clone() *LPPosition {
+	clone := *pos
+	return &clone
+}
+
+// UpdateLPPosition creates or replaces the liquidity position with the same ID
+func (accnt *Account) UpdateLPPosition(pos *LPPosition) {
+	accnt.Lock()
+	defer accnt.Unlock()
+	accnt.lpPositions[pos.ID] = pos.Clone()
+	accnt.assets[pos.Token0.ID] = pos.Token0
+	accnt.assets[pos.Token1.ID] = pos.Token1
+}
+
+func (accnt *Account) RemoveLPPosition(ID string) {
+	accnt.Lock()
+	defer accnt.Unlock()
+	delete(accnt.lpPositions, ID)
+}
+
+func (accnt *Account) GetLPPositions() []*LPPosition {
+	accnt.RLock()
+	defer accnt.RUnlock()
+	positions := make([]*LPPosition, 0, len(accnt.lpPositions))
+	for _, pos := range accnt.lpPositions {
+		positions = append(positions, pos.Clone())
+	}
+	return positions
+}
+
+func (accnt *Account) getLPPositionModels() []*models.Position {
+	var positions []*models.Position
+	for _, pos := range accnt.lpPositions {
+		p := &models.Position{
+			Account:   accnt.Name,
+			Quantity:  pos.Liquidity,
+			MarkPrice: &wrapperspb.DoubleValue{Value: pos.Price},
+			Lp:        pos.GetPosition(),
+		}
+		if pos.Security != nil {
+			p.Instrument = &models.Instrument{
+				SecurityID: &wrapperspb.UInt64Value{Value: pos.Security.SecurityID},
+				Exchange:   pos.Security.Exchange,
+				Symbol:     &wrapperspb.StringValue{Value: pos.Security.Symbol},
+			}
+		}
+		positions = append(positions, p)
+	}
+	return positions
+}
+
+// getLPValue returns the value of the liquidity positions in the margin currency
+func (accnt *Account) getLPValue(model modeling.Market) float64 {
+	value := 0.
+	for _, pos := range accnt.lpPositions {
+		for _, leg := range []struct {
+			asset  *xchangerModels.Asset
+			amount float64
+		}{{pos.Token0, pos.Amount0 + pos.Fees0}, {pos.Token1, pos.Amount1 + pos.Fees1}} {
+			if leg.amount == 0 {
+				continue
+			}
+			if leg.asset.ID == accnt.MarginCurrency.ID {
+				value += leg.amount
+			} else if pp, ok := model.GetPairPrice(leg.asset.ID, accnt.MarginCurrency.ID); ok {
+				value += leg.amount * pp
+			}
+		}
+	}
+	return value
+}
+
+// RebalanceCost returns the cost in the native asset of the chain of rebalancing the position,
+// false when the gas price of the chain is unknown
+func (pos *LPPosition) RebalanceCost(gas *modeling.GasMap) (float64, bool) {
+	if pos.Security == nil || pos.Security.Chain == nil {
+		return 0, false
+	}
+	return gas.GetTxCost(pos.Security.Chain.ID, lpRebalanceGas)
+}
+
+// GetLPRebalanceCost returns the cost in the margin currency of rebalancing
+// the liquidity positions, native is the native asset of the account chain
+func (accnt *Account) GetLPRebalanceCost(model modeling.Market, gas *modeling.GasMap, native *xchangerModels.Asset) (float64, bool) {
+	accnt.RLock()
+	defer accnt.RUnlock()
+	cost := 0.
+	for _, pos := range accnt.lpPositions {
+		c, ok := pos.RebalanceCost(gas)
+		if !ok {
+			return 0, false
+		}
+		cost += c
+	}
+	if cost == 0 || native.ID == accnt.MarginCurrency.ID {
+		return cost, true
+	}
+	pp, ok := model.GetPairPrice(native.ID, accnt.MarginCurrency.ID)
+	if !ok {
+		return 0, false
+	}
+	return cost * pp, true
+}
@@ -68,6 +68,14 @@ func (p *Portfolio) GetAllPositions() []*models.Position {
 	return positions
 }
 
+func (p *Portfolio) GetLPPositions() []*LPPosition {
+	var positions []*LPPosition
+	for _, accnt := range p.accountPortfolios {
+		positions = append(positions, accnt.GetLPPositions()...)
+	}
+	return positions
+}
+
 func (p *Portfolio) GetBalances() []*models.Balance {
 	var balances []*models.Balance
 	for _, accnt := range p.accountPortfolios {
@@ -0,0 +1,291 @@
+package backfill
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/log"
+	"gitlab.com/alphaticks/alpha-connect/config"
+	"gitlab.com/alphaticks/alpha-connect/data"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	tickstore_types "gitlab.com/alphaticks/tickstore-types"
+	"gitlab.com/alphaticks/xchanger/constants"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// The executor spawns the backfill jobs writing historical on-chain data to the
+// web3 store, and keeps their last status so that their progress can be queried.
+// The status of the finished jobs is kept for a day.
+
+const statusRetention = 24 * time.Hour
+
+type Executor struct {
+	cfg      *config.Config
+	store    data.DataClient
+	client   tickstore_types.TickstoreClient
+	db       *gorm.DB
+	jobs     map[uint64]*actor.PID
+	status   map[uint64]*messages.BackfillStatus
+	finished map[uint64]time.Time // Finish time of the jobs
+	logger   *log.Logger
+}
+
+func NewExecutorProducer(cfg *config.Config) actor.Producer {
+	return func() actor.Actor {
+		return NewExecutor(cfg)
+	}
+}
+
+func NewExecutor(cfg *config.Config) actor.Actor {
+	return &Executor{
+		cfg: cfg,
+	}
+}
+
+func (state *Executor) Receive(context actor.Context) {
+	switch context.Message().(type) {
+	case *actor.Started:
+		if err := state.Initialize(context); err != nil {
+			state.logger.Error("error initializing", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor started")
+
+	case *actor.Stopping:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error stopping", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor stopping")
+
+	case *actor.Stopped:
+		state.logger.Info("actor stopped")
+
+	case *actor.Restarting:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error restarting", log.Error(err))
+			// Attention, no panic in restarting or infinite loop
+		}
+		state.logger.Info("actor restarting")
+
+	case *messages.BackfillRequest:
+		if err := state.OnBackfillRequest(context); err != nil {
+			state.logger.Error("error processing OnBackfillRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.BackfillStatusRequest:
+		if err := state.OnBackfillStatusRequest(context); err != nil {
+			state.logger.Error("error processing OnBackfillStatusRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.BackfillStatus:
+		if err := state.OnBackfillStatus(context); err != nil {
+			state.logger.Error("error processing OnBackfillStatus", log.Error(err))
+			panic(err)
+		}
+
+	case *actor.Terminated:
+		if err := state.OnTerminated(context); err != nil {
+			state.logger.Error("error processing OnTerminated", log.Error(err))
+			panic(err)
+		}
+	}
+}
+
+func (state *Executor) Initialize(context actor.Context) error {
+	state.logger = log.New(
+		log.InfoLevel,
+		"",
+		log.String("ID", context.Self().Id),
+		log.String("type", reflect.TypeOf(*state).String()))
+
+	state.jobs = make(map[uint64]*actor.PID)
+	state.status = make(map[uint64]*messages.BackfillStatus)
+	state.finished = make(map[uint64]time.Time)
+
+	if state.cfg.DataServerAddress == "" {
+		// Backfill requests are rejected
+		return nil
+	}
+	store, err := data.NewStorageClient("", state.cfg.DataServerAddress)
+	if err != nil {
+		return fmt.Errorf("error creating storage client: %v", err)
+	}
+	state.store = store
+	client, freq, err := state.store.GetClient(data.DATA_CLIENT_WEB3)
+	if err != nil {
+		return fmt.Errorf("error getting store client: %v", err)
+	}
+	if freq != data.DATA_CLIENT_WEB3 {
+		return fmt.Errorf("store has no web3 frequency")
+	}
+	if err := client.RegisterMeasurement(TransfersMeasurement, "TransferLog"); err != nil {
+		return fmt.Errorf("error registering transfers measurement: %v", err)
+	}
+	if err := client.RegisterMeasurement(UnipoolV3Measurement, "UPV3Log"); err != nil {
+		return fmt.Errorf("error registering unipoolv3 measurement: %v", err)
+	}
+	state.client = client
+
+	if state.cfg.DB != nil {
+		sql, err := gorm.Open(postgres.Open(state.cfg.DB.DSN()), &gorm.Config{})
+		if err != nil {
+			return fmt.Errorf("error connecting to database: %v", err)
+		}
+		if state.cfg.DB.Migrate {
+			if err := sql.AutoMigrate(&Progress{}); err != nil {
+				return fmt.Errorf("error migrating progress type: %v", err)
+			}
+		}
+		state.db = sql
+	}
+
+	return nil
+}
+
+func (state *Executor) Clean(context actor.Context) error {
+	if state.store != nil {
+		if err := state.store.Close(); err != nil {
+			return fmt.Errorf("error closing store: %v", err)
+		}
+		state.store = nil
+	}
+	return nil
+}
+
+func (state *Executor) OnBackfillRequest(context actor.Context) error {
+	req := context.Message().(*messages.BackfillRequest)
+	res := &messages.BackfillResponse{
+		RequestID:  req.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		Success:    false,
+	}
+	if state.client == nil {
+		res.RejectionReason = messages.RejectionReason_UnsupportedRequest
+		context.Respond(res)
+		return nil
+	}
+	chain, ok := constants.GetChainByID(req.ChainID)
+	if !ok {
+		res.RejectionReason = messages.RejectionReason_UnknownChain
+		context.Respond(res)
+		return nil
+	}
+	if req.Instrument == nil && req.ProtocolID == 0 {
+		res.RejectionReason = messages.RejectionReason_InvalidRequest
+		context.Respond(res)
+		return nil
+	}
+	if req.EndBlock == 0 && (req.EndTime.IsZero() || !req.EndTime.After(req.StartTime)) {
+		res.RejectionReason = messages.RejectionReason_InvalidRequest
+		context.Respond(res)
+		return nil
+	}
+	if req.EndBlock != 0 && req.EndBlock < req.StartBlock {
+		res.RejectionReason = messages.RejectionReason_InvalidRequest
+		context.Respond(res)
+		return nil
+	}
+	state.prune(time.Now())
+	// Two jobs can't write the same data
+	key, _ := jobKey(req)
+	for id, s := range state.status {
+		if _, ok := state.jobs[id]; ok && s.Key == key {
+			res.RejectionReason = messages.RejectionReason_InvalidRequest
+			context.Respond(res)
+			return nil
+		}
+	}
+
+	var progress ProgressStore
+	if state.db != nil {
+		progress = DBProgressStore{DB: state.db}
+	}
+	jobID := uint64(time.Now().UnixNano())
+	pid := context.Spawn(actor.PropsFromProducer(NewJobProducer(jobID, req, chain, state.client, progress)))
+	context.Watch(pid)
+	state.jobs[jobID] = pid
+	state.status[jobID] = &messages.BackfillStatus{
+		JobID:      jobID,
+		Key:        key,
+		StartBlock: req.StartBlock,
+		EndBlock:   req.EndBlock,
+	}
+
+	res.Success = true
+	res.JobID = jobID
+	context.Respond(res)
+	return nil
+}
+
+func (state *Executor) OnBackfillStatusRequest(context actor.Context) error {
+	req := context.Message().(*messages.BackfillStatusRequest)
+	res := &messages.BackfillStatusResponse{
+		RequestID:  req.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		Success:    true,
+	}
+	if req.JobID != 0 {
+		s, ok := state.status[req.JobID]
+		if !ok {
+			res.Success = false
+			res.RejectionReason = messages.RejectionReason_InvalidRequest
+			context.Respond(res)
+			return nil
+		}
+		c := *s
+		res.Jobs = append(res.Jobs, &c)
+	} else {
+		for _, s := range state.status {
+			c := *s
+			res.Jobs = append(res.Jobs, &c)
+		}
+		sort.Slice(res.Jobs, func(i, j int) bool {
+			return res.Jobs[i].JobID < res.Jobs[j].JobID
+		})
+	}
+	context.Respond(res)
+	return nil
+}
+
+func (state *Executor) OnBackfillStatus(context actor.Context) error {
+	s := context.Message().(*messages.BackfillStatus)
+	if _, ok := state.status[s.JobID]; !ok {
+		// Pruned
+		return nil
+	}
+	state.status[s.JobID] = s
+	return nil
+}
+
+func (state *Executor) OnTerminated(context actor.Context) error {
+	msg := context.Message().(*actor.Terminated)
+	for k, v := range state.jobs {
+		if v.Id == msg.Who.Id {
+			delete(state.jobs, k)
+			state.finished[k] = time.Now()
+			// The job stopped without sending its final status
+			if s, ok := state.status[k]; ok && !s.Done && s.Error == "" {
+				s.Error = "job terminated"
+			}
+		}
+	}
+	state.prune(time.Now())
+	return nil
+}
+
+// prune removes the status of the jobs finished for longer than the retention
+func (state *Executor) prune(now time.Time) {
+	for k, t := range state.finished {
+		if now.Sub(t) > statusRetention {
+			delete(state.finished, k)
+			delete(state.status, k)
+		}
+	}
+}
@@ -0,0 +1,467 @@
+package backfill
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+	"unsafe"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/log"
+	"github.com/melaurent/gotickfile/v2"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+	tickstore_types "gitlab.com/alphaticks/tickstore-types"
+	"gitlab.com/alphaticks/tickstore-types/tickobjects"
+	models2 "gitlab.com/alphaticks/xchanger/models"
+)
+
+// A job splits its block range in chunks fetched in parallel from the protocols or exchanges
+// executor, and writes them in order. The chunks failing are split in two and fetched again,
+// the next block to write is checkpointed so that a job on the same data and block range
+// resumes from it.
+
+const (
+	chunkSize      = 2000
+	maxParallelism = 4
+	maxRetries     = 5
+)
+
+type chunk struct {
+	start   uint64
+	end     uint64
+	retries int
+}
+
+// chunkData holds the deltas of the chunk by tick
+type chunkData struct {
+	chunk  chunk
+	ticks  []uint64
+	deltas []gotickfile.TickDeltas
+}
+
+type Job struct {
+	req       *messages.BackfillRequest
+	chain     *models2.Chain
+	key       string
+	tags      map[string]string
+	status    *messages.BackfillStatus
+	store     tickstore_types.TickstoreClient
+	writer    tickstore_types.TickstoreWriter
+	progress  ProgressStore
+	executor  *actor.PID
+	pending   []chunk // Chunks to fetch again
+	nextFetch uint64
+	inflight  int
+	fetched   map[uint64]*chunkData // Fetched chunks by start block
+	logger    *log.Logger
+}
+
+func NewJobProducer(jobID uint64, req *messages.BackfillRequest, chain *models2.Chain, store tickstore_types.TickstoreClient, progress ProgressStore) actor.Producer {
+	return func() actor.Actor {
+		return NewJob(jobID, req, chain, store, progress)
+	}
+}
+
+// NewJob creates a job writing to the store, the progress store can be nil,
+// in which case the job doesn't resume
+func NewJob(jobID uint64, req *messages.BackfillRequest, chain *models2.Chain, store tickstore_types.TickstoreClient, progress ProgressStore) actor.Actor {
+	key, tags := jobKey(req)
+	return &Job{
+		req:   req,
+		chain: chain,
+		key:   key,
+		tags:  tags,
+		status: &messages.BackfillStatus{
+			JobID:      jobID,
+			Key:        key,
+			StartBlock: req.StartBlock,
+			EndBlock:   req.EndBlock,
+		},
+		store:    store,
+		progress: progress,
+		logger:   nil,
+	}
+}
+
+// jobKey returns the key of the backfilled data, along with the tags it is written with
+func jobKey(req *messages.BackfillRequest) (string, map[string]string) {
+	var measurement string
+	var tags map[string]string
+	if req.Instrument != nil {
+		measurement = UnipoolV3Measurement
+		tags = map[string]string{
+			"symbol": req.Instrument.Symbol.GetValue(),
+		}
+	} else {
+		measurement = TransfersMeasurement
+		asset := "all"
+		if req.AssetID != nil {
+			asset = fmt.Sprintf("%d", req.AssetID.Value)
+		}
+		tags = map[string]string{
+			"protocol": fmt.Sprintf("%d", req.ProtocolID),
+			"chain":    fmt.Sprintf("%d", req.ChainID),
+			"asset":    asset,
+		}
+	}
+	return fmt.Sprintf("%s%v", measurement, tags), tags
+}
+
+func (state *Job) Receive(context actor.Context) {
+	switch context.Message().(type) {
+	case *actor.Started:
+		if err := state.Initialize(context); err != nil {
+			state.logger.Error("error initializing", log.Error(err))
+			state.fail(context, err)
+			return
+		}
+		state.logger.Info("actor started")
+	case *actor.Stopping:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error stopping", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor stopping")
+	case *actor.Stopped:
+		state.logger.Info("actor stopped")
+	case *actor.Restarting:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error restarting", log.Error(err))
+			// Attention, no panic in restarting or infinite loop
+		}
+		state.logger.Info("actor restarting")
+	}
+}
+
+func (state *Job) Initialize(context actor.Context) error {
+	state.logger = log.New(
+		log.InfoLevel,
+		"",
+		log.String("ID", context.Self().Id),
+		log.String("type", reflect.TypeOf(*state).String()),
+		log.String("key", state.key))
+	state.executor = actor.NewPID(context.ActorSystem().Address(), "executor")
+	state.fetched = make(map[uint64]*chunkData)
+
+	if state.req.EndBlock == 0 {
+		start, err := state.blockAt(context, state.req.StartTime)
+		if err != nil {
+			return fmt.Errorf("error getting start block: %v", err)
+		}
+		end, err := state.blockAt(context, state.req.EndTime)
+		if err != nil {
+			return fmt.Errorf("error getting end block: %v", err)
+		}
+		// The end block is the last block before the end time
+		if end == 0 {
+			return fmt.Errorf("empty block range")
+		}
+		state.status.StartBlock = start
+		state.status.EndBlock = end - 1
+	}
+	if state.status.EndBlock < state.status.StartBlock {
+		return fmt.Errorf("empty block range")
+	}
+	resumed, err := state.resume()
+	if err != nil {
+		return err
+	}
+	if resumed {
+		state.logger.Info("resuming backfill", log.Uint64("block", state.status.NextBlock))
+	}
+	state.nextFetch = state.status.NextBlock
+
+	measurement := TransfersMeasurement
+	if state.req.Instrument != nil {
+		measurement = UnipoolV3Measurement
+	}
+	writer, err := state.store.NewTickWriter(measurement, state.tags, time.Minute)
+	if err != nil {
+		return fmt.Errorf("error creating writer: %v", err)
+	}
+	state.writer = writer
+
+	context.Send(context.Parent(), state.statusCopy())
+	return state.write(context)
+}
+
+// resume sets the next block to write from the progress of a job on the same data and
+// block range, it returns false if none was saved
+func (state *Job) resume() (bool, error) {
+	state.status.NextBlock = state.status.StartBlock
+	if state.progress == nil {
+		return false, nil
+	}
+	next, ok, err := state.progress.LoadProgress(progressKey(state.key, state.status.StartBlock, state.status.EndBlock))
+	if err != nil {
+		return false, err
+	}
+	if !ok || next <= state.status.StartBlock || next > state.status.EndBlock+1 {
+		return false, nil
+	}
+	state.status.NextBlock = next
+	return true, nil
+}
+
+// blockAt returns the first block at or after the time, or the block following
+// the head if the head is before the time
+func (state *Job) blockAt(context actor.Context, t time.Time) (uint64, error) {
+	res, err := context.RequestFuture(state.executor, &messages.BlockNumberRequest{
+		RequestID: uint64(time.Now().UnixNano()),
+		Chain:     state.chain,
+	}, 10*time.Second).Result()
+	if err != nil {
+		return 0, fmt.Errorf("error fetching block number: %v", err)
+	}
+	b, ok := res.(*messages.BlockNumberResponse)
+	if !ok {
+		return 0, fmt.Errorf("was expecting BlockNumberResponse, got %s", reflect.TypeOf(res).String())
+	}
+	if !b.Success {
+		return 0, fmt.Errorf("error fetching block number: %s", b.RejectionReason.String())
+	}
+	lo, hi := uint64(0), b.BlockNumber+1
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		res, err := context.RequestFuture(state.executor, &messages.BlockInfoRequest{
+			RequestID:   uint64(time.Now().UnixNano()),
+			Chain:       state.chain,
+			BlockNumber: mid,
+		}, 10*time.Second).Result()
+		if err != nil {
+			return 0, fmt.Errorf("error fetching block info: %v", err)
+		}
+		info, ok := res.(*messages.BlockInfoResponse)
+		if !ok {
+			return 0, fmt.Errorf("was expecting BlockInfoResponse, got %s", reflect.TypeOf(res).String())
+		}
+		if !info.Success {
+			return 0, fmt.Errorf("error fetching block info: %s", info.RejectionReason.String())
+		}
+		if info.BlockTime.Before(t) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, nil
+}
+
+// schedule fetches the chunks to fetch again, then the next chunks, while under the parallelism
+func (state *Job) schedule(context actor.Context) {
+	for state.inflight < maxParallelism && len(state.pending) > 0 {
+		c := state.pending[0]
+		state.pending = state.pending[1:]
+		state.fetch(context, c)
+	}
+	// Bound the chunks held waiting for a previous chunk to be written
+	for state.inflight < maxParallelism && len(state.fetched) < 2*maxParallelism && state.nextFetch <= state.status.EndBlock {
+		c := chunk{
+			start: state.nextFetch,
+			end:   state.nextFetch + chunkSize - 1,
+		}
+		if c.end > state.status.EndBlock {
+			c.end = state.status.EndBlock
+		}
+		state.nextFetch = c.end + 1
+		state.fetch(context, c)
+	}
+}
+
+func (state *Job) fetch(context actor.Context, c chunk) {
+	var req interface{}
+	if state.req.Instrument != nil {
+		req = &messages.HistoricalUnipoolV3DataRequest{
+			RequestID:  uint64(time.Now().UnixNano()),
+			Instrument: state.req.Instrument,
+			Start:      c.start,
+			End:        c.end,
+		}
+	} else {
+		req = &messages.HistoricalProtocolAssetTransferRequest{
+			RequestID:  uint64(time.Now().UnixNano()),
+			ProtocolID: state.req.ProtocolID,
+			ChainID:    state.req.ChainID,
+			AssetID:    state.req.AssetID,
+			Start:      c.start,
+			Stop:       c.end,
+		}
+	}
+	state.inflight += 1
+	future := context.RequestFuture(state.executor, req, 2*time.Minute)
+	context.ReenterAfter(future, func(res interface{}, err error) {
+		state.inflight -= 1
+		if state.status.Done || state.status.Error != "" {
+			return
+		}
+		var data *chunkData
+		if err == nil {
+			data, err = parseChunk(c, res)
+		}
+		if err != nil {
+			if err := state.retry(c, err); err != nil {
+				state.fail(context, err)
+				return
+			}
+		} else {
+			state.fetched[c.start] = data
+		}
+		if err := state.write(context); err != nil {
+			state.fail(context, err)
+		}
+	})
+}
+
+// retry splits the chunk in two to be fetched again, or fetches it again when of one block
+func (state *Job) retry(c chunk, err error) error {
+	state.logger.Warn("error fetching chunk", log.Uint64("start", c.start), log.Uint64("end", c.end), log.Error(err))
+	if c.end > c.start {
+		mid := c.start + (c.end-c.start)/2
+		state.pending = append([]chunk{{start: c.start, end: mid}, {start: mid + 1, end: c.end}}, state.pending...)
+		return nil
+	}
+	if c.retries >= maxRetries {
+		return fmt.Errorf("error fetching block %d: %v", c.start, err)
+	}
+	c.retries += 1
+	state.pending = append([]chunk{c}, state.pending...)
+	return nil
+}
+
+// write writes the fetched chunks following the last block written, then schedules the next fetches
+func (state *Job) write(context actor.Context) error {
+	written := false
+	for {
+		data, ok := state.fetched[state.status.NextBlock]
+		if !ok {
+			break
+		}
+		delete(state.fetched, state.status.NextBlock)
+		for i, tick := range data.ticks {
+			if state.writer.GetObject() == nil {
+				var obj tickobjects.TickObject = NewTransferLog()
+				if state.req.Instrument != nil {
+					obj = NewUPV3Log()
+				}
+				if err := state.writer.WriteObject(tick, obj); err != nil {
+					return fmt.Errorf("error writing object: %v", err)
+				}
+			}
+			if err := state.writer.WriteDeltas(tick, data.deltas[i]); err != nil {
+				return fmt.Errorf("error writing deltas: %v", err)
+			}
+		}
+		state.status.NextBlock = data.chunk.end + 1
+		written = true
+	}
+	if written {
+		if err := state.writer.Flush(); err != nil {
+			return fmt.Errorf("error flushing writer: %v", err)
+		}
+		if state.progress != nil {
+			if err := state.progress.SaveProgress(progressKey(state.key, state.status.StartBlock, state.status.EndBlock), state.status.NextBlock); err != nil {
+				state.logger.Warn("error saving progress", log.Error(err))
+			}
+		}
+		context.Send(context.Parent(), state.statusCopy())
+	}
+	if state.status.NextBlock > state.status.EndBlock {
+		state.status.Done = true
+		context.Send(context.Parent(), state.statusCopy())
+		state.logger.Info("backfill done")
+		context.Stop(context.Self())
+		return nil
+	}
+	state.schedule(context)
+	return nil
+}
+
+func (state *Job) fail(context actor.Context, err error) {
+	state.status.Error = err.Error()
+	context.Send(context.Parent(), state.statusCopy())
+	context.Stop(context.Self())
+}
+
+func (state *Job) statusCopy() *messages.BackfillStatus {
+	s := *state.status
+	return &s
+}
+
+// parseChunk groups the transfers or pool events of the response by tick
+func parseChunk(c chunk, res interface{}) (*chunkData, error) {
+	data := &chunkData{chunk: c}
+	switch res := res.(type) {
+	case *messages.HistoricalProtocolAssetTransferResponse:
+		if !res.Success {
+			return nil, fmt.Errorf("error fetching transfers: %s", res.RejectionReason.String())
+		}
+		var deltas []TransferDelta
+		var tick uint64
+		for _, u := range res.Update {
+			t := utils.TimestampToMilli(u.BlockTime)
+			if len(deltas) > 0 && t != tick {
+				data.ticks = append(data.ticks, tick)
+				data.deltas = append(data.deltas, transferTickDeltas(deltas))
+				deltas = nil
+			}
+			tick = t
+			deltas = append(deltas, TransferDeltas(u)...)
+		}
+		if len(deltas) > 0 {
+			data.ticks = append(data.ticks, tick)
+			data.deltas = append(data.deltas, transferTickDeltas(deltas))
+		}
+	case *messages.HistoricalUnipoolV3DataResponse:
+		if !res.Success {
+			return nil, fmt.Errorf("error fetching pool events: %s", res.RejectionReason.String())
+		}
+		var deltas []UPV3Delta
+		var tick uint64
+		for _, e := range res.Events {
+			t := utils.TimestampToMilli(e.Timestamp)
+			if len(deltas) > 0 && t != tick {
+				data.ticks = append(data.ticks, tick)
+				data.deltas = append(data.deltas, upv3TickDeltas(deltas))
+				deltas = nil
+			}
+			tick = t
+			d, err := UPV3DeltaFromUpdate(e)
+			if err != nil {
+				return nil, err
+			}
+			deltas = append(deltas, d)
+		}
+		if len(deltas) > 0 {
+			data.ticks = append(data.ticks, tick)
+			data.deltas = append(data.deltas, upv3TickDeltas(deltas))
+		}
+	default:
+		return nil, fmt.Errorf("unexpected response type %s", reflect.TypeOf(res).String())
+	}
+	return data, nil
+}
+
+func transferTickDeltas(deltas []TransferDelta) gotickfile.TickDeltas {
+	return gotickfile.TickDeltas{
+		Pointer: unsafe.Pointer(&deltas[0]),
+		Len:     len(deltas),
+	}
+}
+
+func upv3TickDeltas(deltas []UPV3Delta) gotickfile.TickDeltas {
+	return gotickfile.TickDeltas{
+		Pointer: unsafe.Pointer(&deltas[0]),
+		Len:     len(deltas),
+	}
+}
+
+func (state *Job) Clean(context actor.Context) error {
+	if state.writer != nil {
+		if err := state.writer.Close(); err != nil {
+			state.logger.Warn("error closing writer", log.Error(err))
+		}
+		state.writer = nil
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package backfill
+
+import (
+	"testing"
+
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+)
+
+type memProgressStore map[string]uint64
+
+func (s memProgressStore) LoadProgress(key string) (uint64, bool, error) {
+	n, ok := s[key]
+	return n, ok, nil
+}
+
+func (s memProgressStore) SaveProgress(key string, nextBlock uint64) error {
+	s[key] = nextBlock
+	return nil
+}
+
+func TestJobResume(t *testing.T) {
+	progress := memProgressStore{}
+	job := func(start, end uint64) *Job {
+		return NewJob(1, &messages.BackfillRequest{
+			ProtocolID: 1,
+			ChainID:    1,
+			StartBlock: start,
+			EndBlock:   end,
+		}, nil, nil, progress).(*Job)
+	}
+
+	j := job(2000, 3000)
+	if resumed, err := j.resume(); err != nil || resumed || j.status.NextBlock != 2000 {
+		t.Fatalf("was expecting to start at 2000, got %d", j.status.NextBlock)
+	}
+	// Written up to 3000
+	if err := progress.SaveProgress(progressKey(j.key, 2000, 3000), 3001); err != nil {
+		t.Fatal(err)
+	}
+
+	// An overlapping range starts over, the blocks before 2000 weren't written
+	j = job(0, 5000)
+	if resumed, err := j.resume(); err != nil || resumed || j.status.NextBlock != 0 {
+		t.Fatalf("was expecting to start at 0, got %d", j.status.NextBlock)
+	}
+	if err := progress.SaveProgress(progressKey(j.key, 0, 5000), 1500); err != nil {
+		t.Fatal(err)
+	}
+
+	// The same ranges resume
+	j = job(0, 5000)
+	if resumed, err := j.resume(); err != nil || !resumed || j.status.NextBlock != 1500 {
+		t.Fatalf("was expecting to resume at 1500, got %d", j.status.NextBlock)
+	}
+	j = job(2000, 3000)
+	if resumed, err := j.resume(); err != nil || !resumed || j.status.NextBlock != 3001 {
+		t.Fatalf("was expecting to resume at 3001, got %d", j.status.NextBlock)
+	}
+
+	// A range inside a written one starts over
+	j = job(2500, 2800)
+	if resumed, err := j.resume(); err != nil || resumed || j.status.NextBlock != 2500 {
+		t.Fatalf("was expecting to start at 2500, got %d", j.status.NextBlock)
+	}
+
+	// No progress store
+	j = NewJob(1, &messages.BackfillRequest{ProtocolID: 1, ChainID: 1, StartBlock: 2000, EndBlock: 3000}, nil, nil, nil).(*Job)
+	if resumed, err := j.resume(); err != nil || resumed || j.status.NextBlock != 2000 {
+		t.Fatalf("was expecting to start at 2000, got %d", j.status.NextBlock)
+	}
+}
@@ -0,0 +1,278 @@
+package backfill
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	"github.com/melaurent/gotickfile/v2"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/tickstore-types/tickobjects"
+)
+
+// The backfilled on-chain events are stored in the web3 store as the deltas of log objects,
+// the object itself only holds the last block written. The store must know the same types.
+
+const (
+	TransfersMeasurement = "transfers"
+	UnipoolV3Measurement = "unipoolv3"
+)
+
+func init() {
+	if err := tickobjects.RegisterTickObject("TransferLog", reflect.TypeOf(TransferLog{}), reflect.TypeOf(TransferDelta{})); err != nil {
+		panic(err)
+	}
+	if err := tickobjects.RegisterTickObject("UPV3Log", reflect.TypeOf(UPV3Log{}), reflect.TypeOf(UPV3Delta{})); err != nil {
+		panic(err)
+	}
+}
+
+// TransferDelta is an asset transfer, the token ID is zero for fungible tokens
+type TransferDelta struct {
+	Block    uint64
+	From     [20]byte
+	To       [20]byte
+	Contract [20]byte
+	Value    [32]byte
+	TokenID  [32]byte
+}
+
+const (
+	UPV3Initialize uint8 = iota
+	UPV3Mint
+	UPV3Burn
+	UPV3Swap
+	UPV3Collect
+	UPV3Flash
+	UPV3SetFeeProtocol
+	UPV3CollectProtocol
+)
+
+// UPV3Delta is a Uniswap V3 pool event, the fields set depend on its type. The sqrt price and
+// the tick are those of initialize and swap, the owner, ticks and amount those of positions.
+type UPV3Delta struct {
+	Block        uint64
+	Type         uint8
+	Tick         int32
+	TickLower    int32
+	TickUpper    int32
+	FeesProtocol uint32
+	Owner        [20]byte
+	SqrtPriceX96 [32]byte
+	Liquidity    [32]byte
+	Amount       [32]byte
+	Amount0      [32]byte
+	Amount1      [32]byte
+}
+
+type TransferLog struct {
+	lastBlock uint64
+}
+
+func NewTransferLog() *TransferLog {
+	return &TransferLog{}
+}
+
+func (t *TransferLog) ToSnapshot() []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, t.lastBlock)
+	return b
+}
+
+func (t *TransferLog) FromSnapshot(b []byte) error {
+	if len(b) != 8 {
+		return fmt.Errorf("invalid snapshot length %d", len(b))
+	}
+	t.lastBlock = binary.LittleEndian.Uint64(b)
+	return nil
+}
+
+func (t *TransferLog) DeltasTo(other tickobjects.TickObject) (gotickfile.TickDeltas, error) {
+	// The transfers can't be recovered from the last block
+	return gotickfile.TickDeltas{}, nil
+}
+
+func (t *TransferLog) AggregateDeltas(deltas []gotickfile.TickDeltas) gotickfile.TickDeltas {
+	var ndeltas []TransferDelta
+	size := reflect.TypeOf(TransferDelta{}).Size()
+	for _, delta := range deltas {
+		for i := 0; i < delta.Len; i++ {
+			ndeltas = append(ndeltas, *(*TransferDelta)(unsafe.Pointer(uintptr(delta.Pointer) + uintptr(i)*size)))
+		}
+	}
+	var ptr unsafe.Pointer
+	if len(ndeltas) > 0 {
+		ptr = unsafe.Pointer(&ndeltas[0])
+	}
+	return gotickfile.TickDeltas{
+		Len:     len(ndeltas),
+		Pointer: ptr,
+	}
+}
+
+func (t *TransferLog) ProcessDeltas(delta gotickfile.TickDeltas) error {
+	size := reflect.TypeOf(TransferDelta{}).Size()
+	for i := 0; i < delta.Len; i++ {
+		updt := *(*TransferDelta)(unsafe.Pointer(uintptr(delta.Pointer) + uintptr(i)*size))
+		if updt.Block < t.lastBlock {
+			return fmt.Errorf("out of order block %d, last block %d", updt.Block, t.lastBlock)
+		}
+		t.lastBlock = updt.Block
+	}
+	return nil
+}
+
+func (t *TransferLog) Clone() tickobjects.TickObject {
+	return &TransferLog{lastBlock: t.lastBlock}
+}
+
+func (t *TransferLog) LastBlock() uint64 {
+	return t.lastBlock
+}
+
+type UPV3Log struct {
+	lastBlock uint64
+}
+
+func NewUPV3Log() *UPV3Log {
+	return &UPV3Log{}
+}
+
+func (t *UPV3Log) ToSnapshot() []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, t.lastBlock)
+	return b
+}
+
+func (t *UPV3Log) FromSnapshot(b []byte) error {
+	if len(b) != 8 {
+		return fmt.Errorf("invalid snapshot length %d", len(b))
+	}
+	t.lastBlock = binary.LittleEndian.Uint64(b)
+	return nil
+}
+
+func (t *UPV3Log) DeltasTo(other tickobjects.TickObject) (gotickfile.TickDeltas, error) {
+	// The events can't be recovered from the last block
+	return gotickfile.TickDeltas{}, nil
+}
+
+func (t *UPV3Log) AggregateDeltas(deltas []gotickfile.TickDeltas) gotickfile.TickDeltas {
+	var ndeltas []UPV3Delta
+	size := reflect.TypeOf(UPV3Delta{}).Size()
+	for _, delta := range deltas {
+		for i := 0; i < delta.Len; i++ {
+			ndeltas = append(ndeltas, *(*UPV3Delta)(unsafe.Pointer(uintptr(delta.Pointer) + uintptr(i)*size)))
+		}
+	}
+	var ptr unsafe.Pointer
+	if len(ndeltas) > 0 {
+		ptr = unsafe.Pointer(&ndeltas[0])
+	}
+	return gotickfile.TickDeltas{
+		Len:     len(ndeltas),
+		Pointer: ptr,
+	}
+}
+
+func (t *UPV3Log) ProcessDeltas(delta gotickfile.TickDeltas) error {
+	size := reflect.TypeOf(UPV3Delta{}).Size()
+	for i := 0; i < delta.Len; i++ {
+		updt := *(*UPV3Delta)(unsafe.Pointer(uintptr(delta.Pointer) + uintptr(i)*size))
+		if updt.Block < t.lastBlock {
+			return fmt.Errorf("out of order block %d, last block %d", updt.Block, t.lastBlock)
+		}
+		t.lastBlock = updt.Block
+	}
+	return nil
+}
+
+func (t *UPV3Log) Clone() tickobjects.TickObject {
+	return &UPV3Log{lastBlock: t.lastBlock}
+}
+
+func (t *UPV3Log) LastBlock() uint64 {
+	return t.lastBlock
+}
+
+// fill copies the big endian bytes of a value right aligned
+func fill(dst []byte, b []byte) {
+	if len(b) > len(dst) {
+		b = b[len(b)-len(dst):]
+	}
+	copy(dst[len(dst)-len(b):], b)
+}
+
+// TransferDeltas returns the deltas of the transfers of the update, the token
+// IDs being those of non-fungible and multi-token transfers, if any
+func TransferDeltas(update *models.ProtocolAssetUpdate) []TransferDelta {
+	deltas := make([]TransferDelta, len(update.Transfers))
+	for i, tr := range update.Transfers {
+		d := &deltas[i]
+		d.Block = update.BlockNumber
+		fill(d.From[:], tr.From)
+		fill(d.To[:], tr.To)
+		fill(d.Contract[:], tr.Contract)
+		fill(d.Value[:], tr.Value)
+		if i < len(update.TokenIds) {
+			fill(d.TokenID[:], update.TokenIds[i])
+		}
+	}
+	return deltas
+}
+
+// UPV3DeltaFromUpdate returns the delta of a pool event
+func UPV3DeltaFromUpdate(update *models.UPV3Update) (UPV3Delta, error) {
+	d := UPV3Delta{Block: update.Block}
+	switch {
+	case update.Initialize != nil:
+		d.Type = UPV3Initialize
+		d.Tick = update.Initialize.Tick
+		fill(d.SqrtPriceX96[:], update.Initialize.SqrtPriceX96)
+	case update.Mint != nil:
+		d.Type = UPV3Mint
+		d.TickLower = update.Mint.TickLower
+		d.TickUpper = update.Mint.TickUpper
+		fill(d.Owner[:], update.Mint.Owner)
+		fill(d.Amount[:], update.Mint.Amount)
+		fill(d.Amount0[:], update.Mint.Amount0)
+		fill(d.Amount1[:], update.Mint.Amount1)
+	case update.Burn != nil:
+		d.Type = UPV3Burn
+		d.TickLower = update.Burn.TickLower
+		d.TickUpper = update.Burn.TickUpper
+		fill(d.Owner[:], update.Burn.Owner)
+		fill(d.Amount[:], update.Burn.Amount)
+		fill(d.Amount0[:], update.Burn.Amount0)
+		fill(d.Amount1[:], update.Burn.Amount1)
+	case update.Swap != nil:
+		d.Type = UPV3Swap
+		d.Tick = update.Swap.Tick
+		fill(d.SqrtPriceX96[:], update.Swap.SqrtPriceX96)
+		fill(d.Liquidity[:], update.Swap.Liquidity)
+		fill(d.Amount0[:], update.Swap.Amount0)
+		fill(d.Amount1[:], update.Swap.Amount1)
+	case update.Collect != nil:
+		d.Type = UPV3Collect
+		d.TickLower = update.Collect.TickLower
+		d.TickUpper = update.Collect.TickUpper
+		fill(d.Owner[:], update.Collect.Owner)
+		fill(d.Amount0[:], update.Collect.AmountRequested0)
+		fill(d.Amount1[:], update.Collect.AmountRequested1)
+	case update.Flash != nil:
+		d.Type = UPV3Flash
+		fill(d.Amount0[:], update.Flash.Amount0)
+		fill(d.Amount1[:], update.Flash.Amount1)
+	case update.SetFeeProtocol != nil:
+		d.Type = UPV3SetFeeProtocol
+		d.FeesProtocol = update.SetFeeProtocol.FeesProtocol
+	case update.CollectProtocol != nil:
+		d.Type = UPV3CollectProtocol
+		fill(d.Amount0[:], update.CollectProtocol.AmountRequested0)
+		fill(d.Amount1[:], update.CollectProtocol.AmountRequested1)
+	default:
+		return d, fmt.Errorf("empty pool event")
+	}
+	return d, nil
+}
@@ -0,0 +1,115 @@
+package backfill
+
+import (
+	"math/big"
+	"testing"
+	"unsafe"
+
+	"github.com/melaurent/gotickfile/v2"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+	gorderbook "gitlab.com/alphaticks/gorderbook/gorderbook.models"
+)
+
+func TestTransferDeltas(t *testing.T) {
+	update := &models.ProtocolAssetUpdate{
+		BlockNumber: 10,
+		Transfers: []*gorderbook.AssetTransfer{{
+			From:     []byte{1},
+			To:       []byte{2},
+			Contract: []byte{3},
+			Value:    big.NewInt(1000).Bytes(),
+		}, {
+			From:     []byte{2},
+			To:       []byte{1},
+			Contract: []byte{3},
+			Value:    big.NewInt(1).Bytes(),
+		}},
+		TokenIds: [][]byte{big.NewInt(7).Bytes(), big.NewInt(8).Bytes()},
+	}
+	deltas := TransferDeltas(update)
+	if len(deltas) != 2 {
+		t.Fatalf("was expecting 2 deltas, got %d", len(deltas))
+	}
+	if deltas[0].Block != 10 || deltas[0].From[19] != 1 || deltas[0].To[19] != 2 || deltas[0].Contract[19] != 3 {
+		t.Fatalf("unexpected delta %v", deltas[0])
+	}
+	if big.NewInt(0).SetBytes(deltas[0].Value[:]).Int64() != 1000 {
+		t.Fatalf("unexpected value %v", deltas[0].Value)
+	}
+	if big.NewInt(0).SetBytes(deltas[1].TokenID[:]).Int64() != 8 {
+		t.Fatalf("unexpected token id %v", deltas[1].TokenID)
+	}
+
+	obj := NewTransferLog()
+	if err := obj.ProcessDeltas(gotickfile.TickDeltas{Pointer: unsafe.Pointer(&deltas[0]), Len: len(deltas)}); err != nil {
+		t.Fatal(err)
+	}
+	if obj.LastBlock() != 10 {
+		t.Fatalf("was expecting last block 10, got %d", obj.LastBlock())
+	}
+	cpy := NewTransferLog()
+	if err := cpy.FromSnapshot(obj.ToSnapshot()); err != nil {
+		t.Fatal(err)
+	}
+	if cpy.LastBlock() != 10 {
+		t.Fatalf("was expecting last block 10, got %d", cpy.LastBlock())
+	}
+	old := []TransferDelta{{Block: 9}}
+	if err := obj.ProcessDeltas(gotickfile.TickDeltas{Pointer: unsafe.Pointer(&old[0]), Len: 1}); err == nil {
+		t.Fatalf("was expecting an error on out of order block")
+	}
+}
+
+func TestParseChunk(t *testing.T) {
+	res := &messages.HistoricalUnipoolV3DataResponse{
+		Success: true,
+		Events: []*models.UPV3Update{{
+			Block:     1,
+			Timestamp: utils.MilliToTimestamp(1000),
+			Swap: &gorderbook.UPV3Swap{
+				Tick:         -10,
+				SqrtPriceX96: big.NewInt(42).Bytes(),
+				Amount0:      big.NewInt(5).Bytes(),
+			},
+		}, {
+			Block:     1,
+			Timestamp: utils.MilliToTimestamp(1000),
+			SetFeeProtocol: &gorderbook.UPV3SetFeeProtocol{
+				FeesProtocol: 4,
+			},
+		}, {
+			Block:     2,
+			Timestamp: utils.MilliToTimestamp(2000),
+			Mint: &gorderbook.UPV3Mint{
+				Owner:     []byte{9},
+				TickLower: -20,
+				TickUpper: 20,
+				Amount:    big.NewInt(100).Bytes(),
+			},
+		}},
+	}
+	data, err := parseChunk(chunk{start: 1, end: 2}, res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data.ticks) != 2 || data.ticks[0] != 1000 || data.ticks[1] != 2000 {
+		t.Fatalf("unexpected ticks %v", data.ticks)
+	}
+	if data.deltas[0].Len != 2 || data.deltas[1].Len != 1 {
+		t.Fatalf("unexpected deltas length %d %d", data.deltas[0].Len, data.deltas[1].Len)
+	}
+	swap := *(*UPV3Delta)(data.deltas[0].Pointer)
+	if swap.Type != UPV3Swap || swap.Tick != -10 || big.NewInt(0).SetBytes(swap.SqrtPriceX96[:]).Int64() != 42 {
+		t.Fatalf("unexpected swap delta %v", swap)
+	}
+	mint := *(*UPV3Delta)(data.deltas[1].Pointer)
+	if mint.Type != UPV3Mint || mint.TickLower != -20 || mint.Owner[19] != 9 {
+		t.Fatalf("unexpected mint delta %v", mint)
+	}
+
+	if _, err := parseChunk(chunk{start: 1, end: 2}, &messages.HistoricalUnipoolV3DataResponse{}); err == nil {
+		t.Fatalf("was expecting an error on rejected response")
+	}
+}
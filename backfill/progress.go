@@ -0,0 +1,72 @@
+package backfill
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Progress is the next block to write of the backfilled data over a block range, a job
+// on the same data and range resumes from it instead of starting over
+type Progress struct {
+	Key       string `gorm:"primarykey"`
+	NextBlock uint64
+	UpdatedAt time.Time
+}
+
+// LoadProgress returns the next block to write of the data, false if none was saved
+func LoadProgress(db *gorm.DB, key string) (uint64, bool, error) {
+	var p Progress
+	tx := db.Where("key=?", key).First(&p)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("error loading progress: %v", tx.Error)
+	}
+	return p.NextBlock, true, nil
+}
+
+func SaveProgress(db *gorm.DB, key string, nextBlock uint64) error {
+	tx := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"next_block", "updated_at"}),
+	}).Create(&Progress{
+		Key:       key,
+		NextBlock: nextBlock,
+		UpdatedAt: time.Now(),
+	})
+	if tx.Error != nil {
+		return fmt.Errorf("error saving progress: %v", tx.Error)
+	}
+	return nil
+}
+
+// ProgressStore holds the progress of the backfill jobs
+type ProgressStore interface {
+	LoadProgress(key string) (uint64, bool, error)
+	SaveProgress(key string, nextBlock uint64) error
+}
+
+// DBProgressStore holds the progress in the database
+type DBProgressStore struct {
+	DB *gorm.DB
+}
+
+func (s DBProgressStore) LoadProgress(key string) (uint64, bool, error) {
+	return LoadProgress(s.DB, key)
+}
+
+func (s DBProgressStore) SaveProgress(key string, nextBlock uint64) error {
+	return SaveProgress(s.DB, key, nextBlock)
+}
+
+// progressKey returns the key of the progress of the data over the block range. The progress
+// of a range doesn't tell which blocks of another range were written, so it is only resumed
+// by a job on the same range.
+func progressKey(key string, start, end uint64) string {
+	return fmt.Sprintf("%s[%d,%d]", key, start, end)
+}
@@ -0,0 +1,154 @@
+package bars
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The aggregator builds bars of a single security from its trade stream.
+// Time bars are aligned on multiples of the interval and are closed either by
+// the first trade after their end or by a call to Flush. Tick, volume and dollar
+// bars are closed by the trade that makes them reach the threshold, trades are never split.
+
+type Aggregator struct {
+	securityID uint64
+	barType    models.BarType
+	interval   time.Duration
+	threshold  float64
+	current    *models.Bar
+	progress   float64
+	lastClose  float64
+	lastTs     time.Time
+	nextOpen   time.Time
+}
+
+func NewAggregator(securityID uint64, barType models.BarType, interval time.Duration, threshold float64) (*Aggregator, error) {
+	switch barType {
+	case models.BarType_TimeBar:
+		if interval <= 0 {
+			return nil, fmt.Errorf("time bars require a positive interval")
+		}
+	case models.BarType_TickBar, models.BarType_VolumeBar, models.BarType_DollarBar:
+		if threshold <= 0 {
+			return nil, fmt.Errorf("%s require a positive threshold", barType.String())
+		}
+	default:
+		return nil, fmt.Errorf("unknown bar type %s", barType.String())
+	}
+	return &Aggregator{
+		securityID: securityID,
+		barType:    barType,
+		interval:   interval,
+		threshold:  threshold,
+	}, nil
+}
+
+// Current returns the bar being built, nil if no trade went into it yet
+func (a *Aggregator) Current() *models.Bar {
+	return a.current
+}
+
+// AddTrade adds a trade to the current bar and returns the bars completed by it.
+// A bid trade is a trade initiated by the seller.
+func (a *Aggregator) AddTrade(ts time.Time, price, quantity float64, bid bool) []*models.Bar {
+	var completed []*models.Bar
+	if ts.Before(a.lastTs) {
+		// Keep bars monotonic
+		ts = a.lastTs
+	}
+	a.lastTs = ts
+	if a.barType == models.BarType_TimeBar {
+		completed = a.Flush(ts)
+	}
+	if a.current == nil {
+		a.current = a.newBar(ts, price)
+	}
+	bar := a.current
+	quantity = math.Abs(quantity)
+	bar.High = math.Max(bar.High, price)
+	bar.Low = math.Min(bar.Low, price)
+	bar.Close = price
+	bar.Volume += quantity
+	bar.QuoteVolume += quantity * price
+	if bid {
+		bar.SellVolume += quantity
+		bar.SellCount += 1
+	} else {
+		bar.BuyVolume += quantity
+		bar.BuyCount += 1
+	}
+
+	switch a.barType {
+	case models.BarType_TickBar:
+		a.progress += 1
+	case models.BarType_VolumeBar:
+		a.progress += quantity
+	case models.BarType_DollarBar:
+		a.progress += quantity * price
+	}
+	if a.barType != models.BarType_TimeBar && a.progress >= a.threshold {
+		bar.CloseTime = timestamppb.New(ts)
+		completed = append(completed, a.close())
+	}
+
+	return completed
+}
+
+// Flush closes the time bars ending before now. Intervals without trades
+// produce flat bars at the previous close, once a first bar has been closed.
+func (a *Aggregator) Flush(now time.Time) []*models.Bar {
+	if a.barType != models.BarType_TimeBar {
+		return nil
+	}
+	var completed []*models.Bar
+	if a.current != nil {
+		if now.Before(a.current.CloseTime.AsTime()) {
+			return nil
+		}
+		completed = append(completed, a.close())
+	}
+	if a.nextOpen.IsZero() {
+		return completed
+	}
+	// Fill the gap with empty bars
+	for !now.Before(a.nextOpen.Add(a.interval)) {
+		bar := a.newBar(a.nextOpen, a.lastClose)
+		completed = append(completed, bar)
+		a.nextOpen = a.nextOpen.Add(a.interval)
+	}
+
+	return completed
+}
+
+func (a *Aggregator) newBar(ts time.Time, price float64) *models.Bar {
+	bar := &models.Bar{
+		Open:       price,
+		High:       price,
+		Low:        price,
+		Close:      price,
+		SecurityID: a.securityID,
+	}
+	if a.barType == models.BarType_TimeBar {
+		openTime := ts.Truncate(a.interval)
+		bar.OpenTime = timestamppb.New(openTime)
+		bar.CloseTime = timestamppb.New(openTime.Add(a.interval))
+	} else {
+		bar.OpenTime = timestamppb.New(ts)
+	}
+	return bar
+}
+
+func (a *Aggregator) close() *models.Bar {
+	bar := a.current
+	a.lastClose = bar.Close
+	if a.barType == models.BarType_TimeBar {
+		a.nextOpen = bar.CloseTime.AsTime()
+	}
+	a.current = nil
+	a.progress = 0
+	return bar
+}
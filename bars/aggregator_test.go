@@ -0,0 +1,109 @@
+package bars
+
+import (
+	"testing"
+	"time"
+
+	"gitlab.com/alphaticks/alpha-connect/models"
+)
+
+func TestTimeBars(t *testing.T) {
+	agg, err := NewAggregator(1, models.BarType_TimeBar, time.Minute, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	if bars := agg.AddTrade(start.Add(10*time.Second), 100, 1, false); len(bars) != 0 {
+		t.Fatalf("was expecting no bar, got %d", len(bars))
+	}
+	agg.AddTrade(start.Add(20*time.Second), 105, 2, true)
+	agg.AddTrade(start.Add(30*time.Second), 95, 1, false)
+	agg.AddTrade(start.Add(40*time.Second), 101, 1, true)
+
+	// Next trade is two intervals later, expect the full bar and an empty one
+	bars := agg.AddTrade(start.Add(150*time.Second), 110, 1, false)
+	if len(bars) != 2 {
+		t.Fatalf("was expecting 2 bars, got %d", len(bars))
+	}
+	bar := bars[0]
+	if bar.Open != 100 || bar.High != 105 || bar.Low != 95 || bar.Close != 101 {
+		t.Fatalf("wrong OHLC: %f %f %f %f", bar.Open, bar.High, bar.Low, bar.Close)
+	}
+	if bar.Volume != 5 || bar.BuyVolume != 2 || bar.SellVolume != 3 {
+		t.Fatalf("wrong volumes: %f %f %f", bar.Volume, bar.BuyVolume, bar.SellVolume)
+	}
+	if bar.BuyCount != 2 || bar.SellCount != 2 {
+		t.Fatalf("wrong counts: %d %d", bar.BuyCount, bar.SellCount)
+	}
+	if !bar.OpenTime.AsTime().Equal(start) || !bar.CloseTime.AsTime().Equal(start.Add(time.Minute)) {
+		t.Fatalf("wrong bar times: %s %s", bar.OpenTime.AsTime(), bar.CloseTime.AsTime())
+	}
+	empty := bars[1]
+	if empty.Open != 101 || empty.Close != 101 || empty.Volume != 0 {
+		t.Fatalf("was expecting a flat bar at 101, got %f %f %f", empty.Open, empty.Close, empty.Volume)
+	}
+	if !empty.OpenTime.AsTime().Equal(start.Add(time.Minute)) {
+		t.Fatalf("wrong empty bar open time: %s", empty.OpenTime.AsTime())
+	}
+
+	// Flushing before the end of the bar shouldn't close it
+	if bars := agg.Flush(start.Add(170 * time.Second)); len(bars) != 0 {
+		t.Fatalf("was expecting no bar, got %d", len(bars))
+	}
+	bars = agg.Flush(start.Add(245 * time.Second))
+	if len(bars) != 2 {
+		t.Fatalf("was expecting 2 bars, got %d", len(bars))
+	}
+	if bars[0].Close != 110 || bars[1].Open != 110 || bars[1].Volume != 0 {
+		t.Fatalf("wrong flushed bars")
+	}
+	if agg.Current() != nil {
+		t.Fatalf("was expecting no current bar")
+	}
+}
+
+func TestThresholdBars(t *testing.T) {
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tick, err := NewAggregator(1, models.BarType_TickBar, 0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var count int
+	for i := 0; i < 10; i++ {
+		count += len(tick.AddTrade(ts.Add(time.Duration(i)*time.Second), 100, 1, false))
+	}
+	if count != 3 {
+		t.Fatalf("was expecting 3 tick bars, got %d", count)
+	}
+
+	volume, err := NewAggregator(1, models.BarType_VolumeBar, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bars := volume.AddTrade(ts, 100, 6, false); len(bars) != 0 {
+		t.Fatalf("was expecting no bar, got %d", len(bars))
+	}
+	bars := volume.AddTrade(ts.Add(time.Second), 102, 7, true)
+	if len(bars) != 1 {
+		t.Fatalf("was expecting 1 bar, got %d", len(bars))
+	}
+	if bars[0].Volume != 13 || bars[0].Close != 102 {
+		t.Fatalf("wrong volume bar: %f %f", bars[0].Volume, bars[0].Close)
+	}
+
+	dollar, err := NewAggregator(1, models.BarType_DollarBar, 0, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bars := dollar.AddTrade(ts, 100, 9, false); len(bars) != 0 {
+		t.Fatalf("was expecting no bar, got %d", len(bars))
+	}
+	if bars := dollar.AddTrade(ts, 100, 1, false); len(bars) != 1 {
+		t.Fatalf("was expecting 1 bar, got %d", len(bars))
+	}
+
+	if _, err := NewAggregator(1, models.BarType_VolumeBar, 0, 0); err == nil {
+		t.Fatalf("was expecting an error on zero threshold")
+	}
+}
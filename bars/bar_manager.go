@@ -0,0 +1,335 @@
+package bars
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/log"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+	tickstore_types "gitlab.com/alphaticks/tickstore-types"
+	"gitlab.com/alphaticks/tickstore-types/tickobjects"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// The bar manager subscribes to the market data of one security, aggregates
+// its trades into bars and multiplex them to the actors who subscribed.
+// When given a writer, the completed bars are also written to the store.
+
+type flushBars struct{}
+
+type BarManager struct {
+	securityID  uint64
+	barType     models.BarType
+	interval    time.Duration
+	threshold   float64
+	aggregator  *Aggregator
+	subscribers map[uint64]*actor.PID
+	writer      tickstore_types.TickstoreWriter
+	persistent  bool
+	executor    *actor.PID
+	seqNum      uint64
+	mdSeqNum    uint64
+	flushTicker *time.Ticker
+	logger      *log.Logger
+}
+
+func NewBarManagerProducer(securityID uint64, barType models.BarType, interval time.Duration, threshold float64, writer tickstore_types.TickstoreWriter) actor.Producer {
+	return func() actor.Actor {
+		return NewBarManager(securityID, barType, interval, threshold, writer)
+	}
+}
+
+func NewBarManager(securityID uint64, barType models.BarType, interval time.Duration, threshold float64, writer tickstore_types.TickstoreWriter) actor.Actor {
+	return &BarManager{
+		securityID: securityID,
+		barType:    barType,
+		interval:   interval,
+		threshold:  threshold,
+		writer:     writer,
+		persistent: writer != nil,
+	}
+}
+
+func (state *BarManager) Receive(context actor.Context) {
+	switch context.Message().(type) {
+	case *actor.Started:
+		if err := state.Initialize(context); err != nil {
+			state.logger.Error("error initializing", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor started")
+
+	case *actor.Stopping:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error stopping", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor stopping")
+
+	case *actor.Stopped:
+		state.logger.Info("actor stopped")
+
+	case *actor.Restarting:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error restarting", log.Error(err))
+			// Attention, no panic in restarting or infinite loop
+		}
+		state.logger.Info("actor restarting")
+
+	case *messages.BarDataRequest:
+		if err := state.OnBarDataRequest(context); err != nil {
+			state.logger.Error("error processing OnBarDataRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.MarketDataResponse:
+		if err := state.OnMarketDataResponse(context); err != nil {
+			state.logger.Error("error processing OnMarketDataResponse", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.MarketDataIncrementalRefresh:
+		if err := state.OnMarketDataIncrementalRefresh(context); err != nil {
+			state.logger.Error("error processing OnMarketDataIncrementalRefresh", log.Error(err))
+			panic(err)
+		}
+
+	case *flushBars:
+		if err := state.onFlushBars(context); err != nil {
+			state.logger.Error("error flushing bars", log.Error(err))
+			panic(err)
+		}
+
+	case *actor.Terminated:
+		if err := state.OnTerminated(context); err != nil {
+			state.logger.Error("error processing OnTerminated", log.Error(err))
+			panic(err)
+		}
+	}
+}
+
+func (state *BarManager) Initialize(context actor.Context) error {
+	state.logger = log.New(
+		log.InfoLevel,
+		"",
+		log.String("ID", context.Self().Id),
+		log.String("type", reflect.TypeOf(*state).String()),
+		log.String("security-id", fmt.Sprintf("%d", state.securityID)),
+		log.String("bar-type", state.barType.String()))
+
+	if state.subscribers == nil {
+		state.subscribers = make(map[uint64]*actor.PID)
+	}
+	agg, err := NewAggregator(state.securityID, state.barType, state.interval, state.threshold)
+	if err != nil {
+		return err
+	}
+	state.aggregator = agg
+	state.executor = actor.NewPID(context.ActorSystem().Address(), "executor/exchanges")
+
+	res, err := context.RequestFuture(state.executor, &messages.MarketDataRequest{
+		RequestID:  uint64(time.Now().UnixNano()),
+		Subscribe:  true,
+		Subscriber: context.Self(),
+		Instrument: &models.Instrument{
+			SecurityID: wrapperspb.UInt64(state.securityID),
+		},
+		Aggregation: models.OrderBookAggregation_L2,
+	}, 20*time.Second).Result()
+	if err != nil {
+		return fmt.Errorf("error subscribing to market data: %v", err)
+	}
+	mdres, ok := res.(*messages.MarketDataResponse)
+	if !ok {
+		return fmt.Errorf("was expecting MarketDataResponse, got %s", reflect.TypeOf(res).String())
+	}
+	if !mdres.Success {
+		return fmt.Errorf("error subscribing to market data: %s", mdres.RejectionReason.String())
+	}
+	state.mdSeqNum = mdres.SeqNum
+	state.seqNum = uint64(time.Now().UnixNano())
+
+	if state.barType == models.BarType_TimeBar {
+		flushTicker := time.NewTicker(time.Second)
+		state.flushTicker = flushTicker
+		go func(pid *actor.PID) {
+			for {
+				select {
+				case <-flushTicker.C:
+					context.Send(pid, &flushBars{})
+				case <-time.After(2 * time.Second):
+					if state.flushTicker != flushTicker {
+						return
+					}
+				}
+			}
+		}(context.Self())
+	}
+
+	return nil
+}
+
+func (state *BarManager) Clean(context actor.Context) error {
+	if state.flushTicker != nil {
+		state.flushTicker.Stop()
+		state.flushTicker = nil
+	}
+	if state.writer != nil {
+		if err := state.writer.Flush(); err != nil {
+			state.logger.Warn("error flushing bar writer", log.Error(err))
+		}
+	}
+
+	return nil
+}
+
+func (state *BarManager) OnBarDataRequest(context actor.Context) error {
+	request := context.Message().(*messages.BarDataRequest)
+	if request.Unsubscribe {
+		if sub, ok := state.subscribers[request.RequestID]; ok {
+			delete(state.subscribers, request.RequestID)
+			state.unwatch(context, sub)
+		}
+		context.Respond(&messages.BarDataResponse{
+			RequestID:  request.RequestID,
+			ResponseID: uint64(time.Now().UnixNano()),
+			SeqNum:     state.seqNum,
+			Success:    true,
+		})
+		if len(state.subscribers) == 0 && !state.persistent {
+			context.Stop(context.Self())
+		}
+		return nil
+	}
+	if request.Subscribe {
+		state.subscribers[request.RequestID] = request.Subscriber
+		context.Watch(request.Subscriber)
+	}
+
+	context.Respond(&messages.BarDataResponse{
+		RequestID:  request.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		Current:    state.aggregator.Current(),
+		SeqNum:     state.seqNum,
+		Success:    true,
+	})
+
+	return nil
+}
+
+// unwatch stops watching the subscriber if it has no other subscription
+func (state *BarManager) unwatch(context actor.Context, sub *actor.PID) {
+	for _, v := range state.subscribers {
+		if v.String() == sub.String() {
+			return
+		}
+	}
+	context.Unwatch(sub)
+}
+
+func (state *BarManager) OnMarketDataResponse(context actor.Context) error {
+	// The data manager forwards the snapshots it receives to its subscribers,
+	// the refreshes following a snapshot continue its sequence number
+	res := context.Message().(*messages.MarketDataResponse)
+	state.mdSeqNum = res.SeqNum
+	return nil
+}
+
+func (state *BarManager) OnMarketDataIncrementalRefresh(context actor.Context) error {
+	refresh := context.Message().(*messages.MarketDataIncrementalRefresh)
+	if refresh.SeqNum <= state.mdSeqNum {
+		return nil
+	}
+	if state.mdSeqNum+1 != refresh.SeqNum {
+		// The restarted listeners start a new sequence, without sending a snapshot
+		state.logger.Warn(fmt.Sprintf("market data sequence gap: %d -> %d, trades might be missing", state.mdSeqNum, refresh.SeqNum))
+	}
+	state.mdSeqNum = refresh.SeqNum
+
+	var completed []*models.Bar
+	for _, aggTrade := range refresh.Trades {
+		ts := aggTrade.Timestamp.AsTime()
+		for _, trd := range aggTrade.Trades {
+			completed = append(completed, state.aggregator.AddTrade(ts, trd.Price, trd.Quantity, aggTrade.Bid)...)
+		}
+	}
+
+	return state.publish(context, completed)
+}
+
+func (state *BarManager) onFlushBars(context actor.Context) error {
+	return state.publish(context, state.aggregator.Flush(time.Now()))
+}
+
+func (state *BarManager) publish(context actor.Context, bars []*models.Bar) error {
+	if len(bars) == 0 {
+		return nil
+	}
+	if state.writer != nil {
+		for _, b := range bars {
+			obj, err := BarToOHLCV(b)
+			if err != nil {
+				return fmt.Errorf("error converting bar: %v", err)
+			}
+			if err := state.writer.WriteObject(utils.TimestampToMilli(b.CloseTime), obj); err != nil {
+				return fmt.Errorf("error writing bar: %v", err)
+			}
+		}
+	}
+	state.seqNum += 1
+	for k, v := range state.subscribers {
+		context.Send(v, &messages.BarDataIncrementalRefresh{
+			RequestID:  k,
+			ResponseID: uint64(time.Now().UnixNano()),
+			SeqNum:     state.seqNum,
+			Bars:       bars,
+		})
+	}
+
+	return nil
+}
+
+func (state *BarManager) OnTerminated(context actor.Context) error {
+	msg := context.Message().(*actor.Terminated)
+	for k, v := range state.subscribers {
+		if v.String() == msg.Who.String() {
+			delete(state.subscribers, k)
+		}
+	}
+	if len(state.subscribers) == 0 && !state.persistent {
+		context.Stop(context.Self())
+	}
+
+	return nil
+}
+
+// BarToOHLCV converts a bar to the tick object used by the store
+func BarToOHLCV(bar *models.Bar) (*tickobjects.OHLCV, error) {
+	var price float32
+	if bar.Volume > 0 {
+		price = float32(bar.QuoteVolume / bar.Volume)
+	} else {
+		price = float32(bar.Close)
+	}
+	w := &bytes.Buffer{}
+	_ = binary.Write(w, binary.LittleEndian, float32(bar.Open))
+	_ = binary.Write(w, binary.LittleEndian, float32(bar.High))
+	_ = binary.Write(w, binary.LittleEndian, float32(bar.Low))
+	_ = binary.Write(w, binary.LittleEndian, float32(bar.Close))
+	_ = binary.Write(w, binary.LittleEndian, uint32(bar.BuyCount))
+	_ = binary.Write(w, binary.LittleEndian, uint32(bar.SellCount))
+	_ = binary.Write(w, binary.LittleEndian, float32(bar.BuyVolume))
+	_ = binary.Write(w, binary.LittleEndian, float32(bar.SellVolume))
+	_ = binary.Write(w, binary.LittleEndian, price)
+	obj := &tickobjects.OHLCV{}
+	if err := obj.FromSnapshot(w.Bytes()); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
@@ -0,0 +1,223 @@
+package bars
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/log"
+	"gitlab.com/alphaticks/alpha-connect/config"
+	"gitlab.com/alphaticks/alpha-connect/data"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+)
+
+// The executor routes the bar requests to a bar manager per security and bar specification.
+// It also spawns the persistent 1m and 1h bar managers writing to the data store.
+
+const BarsMeasurement = "ohlcv"
+
+type barKey struct {
+	securityID uint64
+	barType    models.BarType
+	interval   time.Duration
+	threshold  float64
+}
+
+type Executor struct {
+	cfg      *config.Config
+	store    data.DataClient
+	managers map[barKey]*actor.PID
+	logger   *log.Logger
+}
+
+func NewExecutorProducer(cfg *config.Config) actor.Producer {
+	return func() actor.Actor {
+		return NewExecutor(cfg)
+	}
+}
+
+func NewExecutor(cfg *config.Config) actor.Actor {
+	return &Executor{
+		cfg: cfg,
+	}
+}
+
+func (state *Executor) Receive(context actor.Context) {
+	switch context.Message().(type) {
+	case *actor.Started:
+		if err := state.Initialize(context); err != nil {
+			state.logger.Error("error initializing", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor started")
+
+	case *actor.Stopping:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error stopping", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor stopping")
+
+	case *actor.Stopped:
+		state.logger.Info("actor stopped")
+
+	case *actor.Restarting:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error restarting", log.Error(err))
+			// Attention, no panic in restarting or infinite loop
+		}
+		state.logger.Info("actor restarting")
+
+	case *messages.BarDataRequest:
+		if err := state.OnBarDataRequest(context); err != nil {
+			state.logger.Error("error processing OnBarDataRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *actor.Terminated:
+		if err := state.OnTerminated(context); err != nil {
+			state.logger.Error("error processing OnTerminated", log.Error(err))
+			panic(err)
+		}
+	}
+}
+
+func (state *Executor) Initialize(context actor.Context) error {
+	state.logger = log.New(
+		log.InfoLevel,
+		"",
+		log.String("ID", context.Self().Id),
+		log.String("type", reflect.TypeOf(*state).String()))
+
+	state.managers = make(map[barKey]*actor.PID)
+
+	if len(state.cfg.PersistedBars) == 0 {
+		return nil
+	}
+	if state.cfg.DataServerAddress == "" {
+		return fmt.Errorf("persisted bars require a DataServerAddress")
+	}
+	store, err := data.NewStorageClient("", state.cfg.DataServerAddress)
+	if err != nil {
+		return fmt.Errorf("error creating storage client: %v", err)
+	}
+	state.store = store
+
+	// The store frequencies bars are persisted at
+	freqs := []int64{data.DATA_CLIENT_1M, data.DATA_CLIENT_1H}
+	for _, secID := range state.cfg.PersistedBars {
+		for _, freq := range freqs {
+			client, cfreq, err := state.store.GetClient(freq)
+			if err != nil {
+				return fmt.Errorf("error getting store client: %v", err)
+			}
+			if cfreq != freq {
+				return fmt.Errorf("store has no %dms frequency", freq)
+			}
+			if err := client.RegisterMeasurement(BarsMeasurement, "OHLCV"); err != nil {
+				return fmt.Errorf("error registering bars measurement: %v", err)
+			}
+			tags := map[string]string{
+				"ID": fmt.Sprintf("%d", secID),
+			}
+			writer, err := client.NewTickWriter(BarsMeasurement, tags, time.Minute)
+			if err != nil {
+				return fmt.Errorf("error creating bars writer: %v", err)
+			}
+			key := barKey{
+				securityID: secID,
+				barType:    models.BarType_TimeBar,
+				interval:   time.Duration(freq) * time.Millisecond,
+			}
+			props := actor.PropsFromProducer(NewBarManagerProducer(key.securityID, key.barType, key.interval, key.threshold, writer), actor.WithSupervisor(
+				utils.NewExponentialBackoffStrategy(100*time.Second, time.Second, time.Second)))
+			pid := context.Spawn(props)
+			context.Watch(pid)
+			state.managers[key] = pid
+		}
+	}
+
+	return nil
+}
+
+func (state *Executor) Clean(context actor.Context) error {
+	if state.store != nil {
+		if err := state.store.Close(); err != nil {
+			return fmt.Errorf("error closing store: %v", err)
+		}
+		state.store = nil
+	}
+	return nil
+}
+
+func (state *Executor) OnBarDataRequest(context actor.Context) error {
+	request := context.Message().(*messages.BarDataRequest)
+	if request.Instrument == nil || request.Instrument.SecurityID == nil {
+		context.Respond(&messages.BarDataResponse{
+			RequestID:       request.RequestID,
+			Success:         false,
+			RejectionReason: messages.RejectionReason_MissingInstrument,
+		})
+		return nil
+	}
+	key := barKey{
+		securityID: request.Instrument.SecurityID.Value,
+		barType:    request.BarType,
+	}
+	if request.BarType == models.BarType_TimeBar {
+		if request.Interval == nil || request.Interval.AsDuration() <= 0 {
+			context.Respond(&messages.BarDataResponse{
+				RequestID:       request.RequestID,
+				Success:         false,
+				RejectionReason: messages.RejectionReason_InvalidRequest,
+			})
+			return nil
+		}
+		key.interval = request.Interval.AsDuration()
+	} else {
+		if request.Threshold <= 0 {
+			context.Respond(&messages.BarDataResponse{
+				RequestID:       request.RequestID,
+				Success:         false,
+				RejectionReason: messages.RejectionReason_InvalidRequest,
+			})
+			return nil
+		}
+		key.threshold = request.Threshold
+	}
+
+	pid, ok := state.managers[key]
+	if !ok && request.Unsubscribe {
+		// Nothing to unsubscribe from
+		context.Respond(&messages.BarDataResponse{
+			RequestID:  request.RequestID,
+			ResponseID: uint64(time.Now().UnixNano()),
+			Success:    true,
+		})
+		return nil
+	}
+	if !ok {
+		props := actor.PropsFromProducer(NewBarManagerProducer(key.securityID, key.barType, key.interval, key.threshold, nil), actor.WithSupervisor(
+			utils.NewExponentialBackoffStrategy(100*time.Second, time.Second, time.Second)))
+		pid = context.Spawn(props)
+		context.Watch(pid)
+		state.managers[key] = pid
+	}
+	context.Forward(pid)
+
+	return nil
+}
+
+func (state *Executor) OnTerminated(context actor.Context) error {
+	msg := context.Message().(*actor.Terminated)
+	for k, v := range state.managers {
+		if v.Id == msg.Who.Id {
+			delete(state.managers, k)
+		}
+	}
+
+	return nil
+}
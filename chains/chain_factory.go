@@ -5,23 +5,29 @@ import (
 	"gitlab.com/alphaticks/alpha-connect/chains/evm"
 	"gitlab.com/alphaticks/alpha-connect/chains/svm"
 	"gitlab.com/alphaticks/alpha-connect/chains/zkevm"
+	"gitlab.com/alphaticks/alpha-connect/config"
 	registry "gitlab.com/alphaticks/alpha-public-registry-grpc"
 	models2 "gitlab.com/alphaticks/xchanger/models"
 )
 
-func NewChainExecutorProducer(chain *models2.Chain, rpc string, registry registry.StaticClient) actor.Producer {
+// NewChainExecutorProducer returns the executor of the chain, only
+// the EVM executor balances its requests over multiple endpoints
+func NewChainExecutorProducer(chain *models2.Chain, rpcs []config.ChainRPC, signers []evm.Signer, registry registry.StaticClient) actor.Producer {
+	if len(rpcs) == 0 {
+		return nil
+	}
 	switch chain.Type {
 	case "EVM":
 		return func() actor.Actor {
-			return evm.NewExecutor(registry, rpc)
+			return evm.NewExecutor(registry, rpcs, signers)
 		}
 	case "SVM":
 		return func() actor.Actor {
-			return svm.NewExecutor(registry, rpc)
+			return svm.NewExecutor(registry, rpcs[0].Endpoint)
 		}
 	case "ZKEVM":
 		return func() actor.Actor {
-			return zkevm.NewExecutor(registry, rpc)
+			return zkevm.NewExecutor(registry, rpcs[0].Endpoint)
 		}
 	default:
 		return nil
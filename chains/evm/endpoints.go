@@ -0,0 +1,367 @@
+package evm
+
+import (
+	goContext "context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"gitlab.com/alphaticks/alpha-connect/config"
+	"gitlab.com/alphaticks/xchanger/exchanges"
+)
+
+// The endpoint pool spreads the requests of a chain over its RPC endpoints. Endpoints
+// are ranked on their latency, and pushed back while they fail or lag behind the highest
+// head of the endpoints not failing. An endpoint that exhausted its rate budget is skipped.
+// A request fails over to the next endpoint when an endpoint can't be reached, an answered
+// error is returned as is. Transactions are only sent to one endpoint, a timed out send
+// might have been broadcast already.
+
+const (
+	maxEndpointFailures = 3 // Consecutive failures before an endpoint is unhealthy
+	maxHeadLag          = 2 // Blocks behind the highest head before an endpoint is unhealthy
+	minHedgeDelay       = 50 * time.Millisecond
+)
+
+var errRateLimited = errors.New("endpoint rate limited")
+
+type endpoint struct {
+	url       string
+	rpc       *rpc.Client // The raw client, for the methods the eth client doesn't expose
+	client    *ethclient.Client
+	rateLimit *exchanges.RateLimit
+	latency   time.Duration // Moving average of the request latency
+	head      uint64
+	failures  int
+}
+
+type endpointPool struct {
+	sync.Mutex
+	endpoints []*endpoint
+	maxHead   uint64
+	closed    bool
+}
+
+func newEndpointPool(rpcs []config.ChainRPC) (*endpointPool, error) {
+	if len(rpcs) == 0 {
+		return nil, fmt.Errorf("no rpc endpoint")
+	}
+	p := &endpointPool{}
+	for _, r := range rpcs {
+		e := &endpoint{
+			url: r.Endpoint,
+		}
+		if r.RateLimit > 0 {
+			e.rateLimit = exchanges.NewRateLimit(r.RateLimit, time.Second)
+		}
+		p.endpoints = append(p.endpoints, e)
+	}
+	return p, nil
+}
+
+// isEndpointError returns whether the error comes from the endpoint
+// and not from the request, in which case the request can be retried
+func isEndpointError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return false
+	}
+	if errors.Is(err, ethereum.NotFound) || errors.Is(err, rpc.ErrNotificationsUnsupported) {
+		return false
+	}
+	return true
+}
+
+// isKnownTxError returns whether the error is the one of a node that already has the transaction
+func isKnownTxError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "already known") || strings.Contains(msg, "known transaction") ||
+		strings.Contains(msg, "already imported")
+}
+
+func (p *endpointPool) healthy(e *endpoint) bool {
+	return e.client != nil && e.failures < maxEndpointFailures && e.head+maxHeadLag >= p.maxHead
+}
+
+// ordered returns the connected endpoints, best first
+func (p *endpointPool) ordered() []*endpoint {
+	p.Lock()
+	defer p.Unlock()
+	type ranked struct {
+		e           *endpoint
+		healthy     bool
+		rateLimited bool
+	}
+	var rs []ranked
+	for _, e := range p.endpoints {
+		if e.client == nil {
+			continue
+		}
+		rs = append(rs, ranked{
+			e:           e,
+			healthy:     p.healthy(e),
+			rateLimited: e.rateLimit != nil && e.rateLimit.IsRateLimited(),
+		})
+	}
+	sort.SliceStable(rs, func(i, j int) bool {
+		if rs[i].healthy != rs[j].healthy {
+			return rs[i].healthy
+		}
+		if rs[i].rateLimited != rs[j].rateLimited {
+			return !rs[i].rateLimited
+		}
+		return rs[i].e.latency < rs[j].e.latency
+	})
+	endpoints := make([]*endpoint, len(rs))
+	for i, r := range rs {
+		endpoints[i] = r.e
+	}
+	return endpoints
+}
+
+func (p *endpointPool) report(e *endpoint, latency time.Duration, err error) {
+	p.Lock()
+	defer p.Unlock()
+	if isEndpointError(err) {
+		e.failures += 1
+		return
+	}
+	e.failures = 0
+	if e.latency == 0 {
+		e.latency = latency
+	} else {
+		e.latency = (4*e.latency + latency) / 5
+	}
+}
+
+func (p *endpointPool) call(ctx goContext.Context, e *endpoint, fn func(goContext.Context, *ethclient.Client) (interface{}, error)) (interface{}, error) {
+	p.Lock()
+	client := e.client
+	if client != nil && e.rateLimit != nil {
+		if e.rateLimit.IsRateLimited() {
+			p.Unlock()
+			// Not a failure of the endpoint, the request fails over
+			return nil, errRateLimited
+		}
+		e.rateLimit.Request(1)
+	}
+	p.Unlock()
+	if client == nil {
+		return nil, fmt.Errorf("endpoint closed")
+	}
+	start := time.Now()
+	res, err := fn(ctx, client)
+	// A request cancelled by the caller doesn't tell anything about the endpoint
+	if !errors.Is(err, goContext.Canceled) {
+		p.report(e, time.Since(start), err)
+	}
+	return res, err
+}
+
+// do runs the request on the best endpoint, failing over to the next ones
+func (p *endpointPool) do(timeout time.Duration, fn func(goContext.Context, *ethclient.Client) (interface{}, error)) (interface{}, error) {
+	err := fmt.Errorf("no connected endpoint")
+	for _, e := range p.ordered() {
+		ctx, cancel := goContext.WithTimeout(goContext.Background(), timeout)
+		var res interface{}
+		res, err = p.call(ctx, e, fn)
+		cancel()
+		if !isEndpointError(err) {
+			return res, err
+		}
+	}
+	return nil, err
+}
+
+// once runs the request on the best endpoint only, for the requests that can't be retried
+func (p *endpointPool) once(timeout time.Duration, fn func(goContext.Context, *ethclient.Client) (interface{}, error)) (interface{}, error) {
+	endpoints := p.ordered()
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no connected endpoint")
+	}
+	ctx, cancel := goContext.WithTimeout(goContext.Background(), timeout)
+	defer cancel()
+	return p.call(ctx, endpoints[0], fn)
+}
+
+// doRPC runs a raw request on the best endpoint, failing over to the next ones
+func (p *endpointPool) doRPC(timeout time.Duration, fn func(goContext.Context, *rpc.Client) (interface{}, error)) (interface{}, error) {
+	err := fmt.Errorf("no connected endpoint")
+	for _, e := range p.ordered() {
+		r := p.rpcClient(e)
+		if r == nil {
+			continue
+		}
+		ctx, cancel := goContext.WithTimeout(goContext.Background(), timeout)
+		var res interface{}
+		res, err = p.call(ctx, e, func(ctx goContext.Context, _ *ethclient.Client) (interface{}, error) {
+			return fn(ctx, r)
+		})
+		cancel()
+		if !isEndpointError(err) {
+			return res, err
+		}
+	}
+	return nil, err
+}
+
+func (p *endpointPool) rpcClient(e *endpoint) *rpc.Client {
+	p.Lock()
+	defer p.Unlock()
+	return e.rpc
+}
+
+// hedge runs the request on the best endpoint, and on the next one each time the
+// request takes longer than twice the latency of the best endpoint. The first answer wins.
+func (p *endpointPool) hedge(timeout time.Duration, fn func(goContext.Context, *ethclient.Client) (interface{}, error)) (interface{}, error) {
+	endpoints := p.ordered()
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no connected endpoint")
+	}
+	p.Lock()
+	delay := 2 * endpoints[0].latency
+	p.Unlock()
+	if delay < minHedgeDelay {
+		delay = minHedgeDelay
+	}
+
+	type result struct {
+		res interface{}
+		err error
+	}
+	ctx, cancel := goContext.WithTimeout(goContext.Background(), timeout)
+	defer cancel()
+	results := make(chan result, len(endpoints))
+	launched := 0
+	launch := func() {
+		e := endpoints[launched]
+		launched += 1
+		go func() {
+			res, err := p.call(ctx, e, fn)
+			results <- result{res: res, err: err}
+		}()
+	}
+	launch()
+	pending := 1
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	var err error
+	for pending > 0 {
+		select {
+		case r := <-results:
+			pending -= 1
+			if !isEndpointError(r.err) {
+				return r.res, r.err
+			}
+			err = r.err
+			if launched < len(endpoints) {
+				launch()
+				pending += 1
+			}
+		case <-timer.C:
+			if launched < len(endpoints) {
+				launch()
+				pending += 1
+				timer.Reset(delay)
+			}
+		}
+	}
+	return nil, err
+}
+
+// check dials the disconnected endpoints and probes the head of the others.
+// It can take as long as the slowest endpoint, it is run outside of the actor.
+func (p *endpointPool) check() {
+	var wg sync.WaitGroup
+	for _, e := range p.endpoints {
+		wg.Add(1)
+		go func(e *endpoint) {
+			defer wg.Done()
+			p.Lock()
+			client := e.client
+			p.Unlock()
+			ctx, cancel := goContext.WithTimeout(goContext.Background(), 5*time.Second)
+			defer cancel()
+			if client == nil {
+				r, err := rpc.DialContext(ctx, e.url)
+				if err != nil {
+					return
+				}
+				c := ethclient.NewClient(r)
+				p.Lock()
+				if p.closed {
+					p.Unlock()
+					c.Close()
+					return
+				}
+				e.rpc = r
+				e.client = c
+				e.failures = 0
+				p.Unlock()
+				client = c
+			}
+			start := time.Now()
+			head, err := client.BlockNumber(ctx)
+			p.report(e, time.Since(start), err)
+			if err == nil {
+				p.Lock()
+				e.head = head
+				p.Unlock()
+			}
+		}(e)
+	}
+	wg.Wait()
+	p.updateMaxHead()
+}
+
+// updateMaxHead sets the highest head to the one of the endpoints not failing, so an
+// endpoint that reported a wrong head doesn't hold the others back once it fails
+func (p *endpointPool) updateMaxHead() {
+	p.Lock()
+	defer p.Unlock()
+	var maxHead uint64
+	for _, e := range p.endpoints {
+		if e.client != nil && e.failures < maxEndpointFailures && e.head > maxHead {
+			maxHead = e.head
+		}
+	}
+	p.maxHead = maxHead
+}
+
+// highestHead returns the highest head of the endpoints not failing
+func (p *endpointPool) highestHead() uint64 {
+	p.Lock()
+	defer p.Unlock()
+	return p.maxHead
+}
+
+func (p *endpointPool) isHealthy(e *endpoint) bool {
+	p.Lock()
+	defer p.Unlock()
+	return p.healthy(e)
+}
+
+func (p *endpointPool) close() {
+	p.Lock()
+	defer p.Unlock()
+	p.closed = true
+	for _, e := range p.endpoints {
+		if e.client != nil {
+			e.client.Close()
+			e.client = nil
+			e.rpc = nil
+		}
+	}
+}
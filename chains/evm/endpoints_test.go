@@ -0,0 +1,162 @@
+package evm
+
+import (
+	goContext "context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"gitlab.com/alphaticks/alpha-connect/config"
+	"gitlab.com/alphaticks/xchanger/exchanges"
+)
+
+type answeredError struct{}
+
+func (e answeredError) Error() string  { return "execution reverted" }
+func (e answeredError) ErrorCode() int { return 3 }
+
+var _ rpc.Error = answeredError{}
+
+func newTestPool(t *testing.T, n int) (*endpointPool, map[*ethclient.Client]int) {
+	var rpcs []config.ChainRPC
+	for i := 0; i < n; i++ {
+		rpcs = append(rpcs, config.ChainRPC{Chain: 1, Endpoint: fmt.Sprintf("ws://%d", i)})
+	}
+	p, err := newEndpointPool(rpcs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clients := make(map[*ethclient.Client]int)
+	for i, e := range p.endpoints {
+		e.client = ethclient.NewClient(nil)
+		e.latency = time.Duration(i+1) * time.Millisecond
+		e.head = 100
+		clients[e.client] = i
+	}
+	p.maxHead = 100
+	return p, clients
+}
+
+func TestEndpointPoolOrder(t *testing.T) {
+	p, _ := newTestPool(t, 3)
+	if p.ordered()[0] != p.endpoints[0] {
+		t.Fatalf("was expecting the fastest endpoint first")
+	}
+	p.endpoints[0].head = 97
+	if p.ordered()[2] != p.endpoints[0] {
+		t.Fatalf("was expecting the lagging endpoint last")
+	}
+	p.endpoints[0].head = 100
+	p.endpoints[1].failures = maxEndpointFailures
+	if order := p.ordered(); order[0] != p.endpoints[0] || order[1] != p.endpoints[2] {
+		t.Fatalf("was expecting the failing endpoint last")
+	}
+	p.endpoints[2].client = nil
+	if len(p.ordered()) != 2 {
+		t.Fatalf("was expecting disconnected endpoints to be skipped")
+	}
+}
+
+func TestEndpointPoolFailover(t *testing.T) {
+	p, clients := newTestPool(t, 2)
+	var calls []int
+	out, err := p.do(time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+		calls = append(calls, clients[client])
+		if clients[client] == 0 {
+			return nil, fmt.Errorf("connection refused")
+		}
+		return uint64(1), nil
+	})
+	if err != nil || out.(uint64) != 1 || len(calls) != 2 {
+		t.Fatalf("was expecting to fail over the second endpoint")
+	}
+	if p.endpoints[0].failures != 1 {
+		t.Fatalf("was expecting the failure to be reported")
+	}
+
+	calls = nil
+	_, err = p.do(time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+		calls = append(calls, clients[client])
+		return nil, answeredError{}
+	})
+	if err == nil || len(calls) != 1 {
+		t.Fatalf("was expecting the answered error to be returned without failover")
+	}
+}
+
+func TestEndpointPoolOnce(t *testing.T) {
+	p, clients := newTestPool(t, 2)
+	var calls []int
+	_, err := p.once(time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+		calls = append(calls, clients[client])
+		return nil, goContext.DeadlineExceeded
+	})
+	if err == nil || len(calls) != 1 || calls[0] != 0 {
+		t.Fatalf("was expecting a single request on the best endpoint")
+	}
+	if !isKnownTxError(fmt.Errorf("already known")) || isKnownTxError(fmt.Errorf("nonce too low")) {
+		t.Fatalf("wrong known transaction error")
+	}
+}
+
+func TestEndpointPoolHedge(t *testing.T) {
+	p, clients := newTestPool(t, 2)
+	var calls int32
+	out, err := p.hedge(time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		if clients[client] == 0 {
+			select {
+			case <-time.After(time.Second):
+				return 0, nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		return 1, nil
+	})
+	if err != nil || out.(int) != 1 || atomic.LoadInt32(&calls) != 2 {
+		t.Fatalf("was expecting the hedged request to win")
+	}
+}
+
+func TestEndpointPoolRateLimit(t *testing.T) {
+	p, clients := newTestPool(t, 2)
+	p.endpoints[0].rateLimit = exchanges.NewRateLimit(1, time.Minute)
+	var calls []int
+	fn := func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+		calls = append(calls, clients[client])
+		return nil, nil
+	}
+	if _, err := p.call(goContext.Background(), p.endpoints[0], fn); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.call(goContext.Background(), p.endpoints[0], fn); err != errRateLimited {
+		t.Fatalf("was expecting the rate limited endpoint to be skipped, got %v", err)
+	}
+	if len(calls) != 1 || p.endpoints[0].failures != 0 {
+		t.Fatalf("was expecting no request and no failure on the rate limited endpoint")
+	}
+	// The request fails over to the next endpoint
+	calls = nil
+	if _, err := p.do(time.Second, fn); err != nil || len(calls) != 1 || calls[0] != 1 {
+		t.Fatalf("was expecting the request on the second endpoint, got %v", calls)
+	}
+}
+
+func TestEndpointPoolMaxHead(t *testing.T) {
+	p, _ := newTestPool(t, 3)
+	p.endpoints[2].head = 1000000
+	p.updateMaxHead()
+	if p.highestHead() != 1000000 || p.isHealthy(p.endpoints[0]) {
+		t.Fatalf("was expecting the endpoints behind to be unhealthy")
+	}
+	// Once failing, the wrong head doesn't hold the others back
+	p.endpoints[2].failures = maxEndpointFailures
+	p.updateMaxHead()
+	if p.highestHead() != 100 || !p.isHealthy(p.endpoints[0]) {
+		t.Fatalf("was expecting a head of 100, got %d", p.highestHead())
+	}
+}
@@ -1,8 +1,8 @@
 package evm
 
 import (
-	"container/list"
 	goContext "context"
+	"errors"
 	"fmt"
 	registry "gitlab.com/alphaticks/alpha-public-registry-grpc"
 
@@ -14,24 +14,46 @@ import (
 	"github.com/asynkron/protoactor-go/actor"
 	"github.com/asynkron/protoactor-go/log"
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	chtypes "gitlab.com/alphaticks/alpha-connect/chains/types"
+	"gitlab.com/alphaticks/alpha-connect/config"
 	"gitlab.com/alphaticks/alpha-connect/models"
 	"gitlab.com/alphaticks/alpha-connect/models/messages"
-	"gitlab.com/alphaticks/xchanger/exchanges"
 )
 
 type flushLogs struct{}
 
+var (
+	errBuildTx = errors.New("error building transaction")
+	errSignTx  = errors.New("error signing transaction")
+)
+
+// asyncResult is the result of a function run outside of the actor
+type asyncResult struct {
+	out interface{}
+	err error
+}
+
+// A logs subscription publishes the logs of the confirmed blocks in order. The
+// subscription is renewed on error, and the logs missed in between are backfilled
+// outside of the actor, by chunks. The subscription isn't flushed while backfilling.
+
+// Number of blocks of a backfill logs query
+const logsChunkSize = 2000
+
 type logsSubscription struct {
-	sync.RWMutex
-	logs         *list.List
+	sync.Mutex
+	query        ethereum.FilterQuery
+	tracker      *logTracker
+	endpoint     *endpoint
 	subscription ethereum.Subscription
 	subscriber   *actor.PID
 	seqNum       uint64
 	lastPingTime time.Time
 	ch           chan types.Log
+	backfilling  bool
 }
 
 type EVMLog struct {
@@ -43,18 +65,26 @@ type Executor struct {
 	chtypes.BaseExecutor
 	protocolAssets map[uint64]*models.ProtocolAsset
 	logger         *log.Logger
-	client         *ethclient.Client
-	rateLimit      *exchanges.RateLimit
+	endpoints      *endpointPool
 	subscriptions  map[uint64]*logsSubscription
+	mempool        *mempool
+	pendingSubs    map[uint64]*pendingSubscription
+	gas            *messages.EVMGasStats
+	gasSubs        map[uint64]*gasSubscription
 	flushTicker    *time.Ticker
-	rpc            string
+	rpcs           []config.ChainRPC
+	signers        []Signer
+	accounts       map[common.Address]*account
+	tracking       bool // Whether the status of the transactions is being fetched
+	chainID        *big.Int
 }
 
-func NewExecutor(registry registry.StaticClient, rpc string) actor.Actor {
+func NewExecutor(registry registry.StaticClient, rpcs []config.ChainRPC, signers []Signer) actor.Actor {
 	e := &Executor{
 		protocolAssets: nil,
 		logger:         nil,
-		rpc:            rpc,
+		rpcs:           rpcs,
+		signers:        signers,
 	}
 	e.Registry = registry
 	return e
@@ -66,6 +96,10 @@ func (state *Executor) Receive(context actor.Context) {
 		if err := state.onFlushLogs(context); err != nil {
 			panic(err)
 		}
+	case *pendingTx:
+		if err := state.onPendingTx(context); err != nil {
+			panic(err)
+		}
 	case *actor.Terminated:
 		if err := state.OnTerminated(context); err != nil {
 			panic(err)
@@ -82,13 +116,38 @@ func (state *Executor) Initialize(context actor.Context) error {
 		log.String("ID", context.Self().Id),
 		log.String("type", reflect.TypeOf(*state).String()))
 
-	client, err := ethclient.Dial(state.rpc)
+	endpoints, err := newEndpointPool(state.rpcs)
 	if err != nil {
-		return fmt.Errorf("error while dialing eth rpc client %v", err)
+		return fmt.Errorf("error creating endpoint pool: %v", err)
+	}
+	endpoints.check()
+	if len(endpoints.ordered()) == 0 {
+		return fmt.Errorf("error while dialing eth rpc clients")
 	}
-	state.client = client
-	//state.rateLimit =
+	state.endpoints = endpoints
 	state.subscriptions = make(map[uint64]*logsSubscription)
+	state.pendingSubs = make(map[uint64]*pendingSubscription)
+	state.gasSubs = make(map[uint64]*gasSubscription)
+	state.mempool = &mempool{
+		watched: make(map[common.Address]int),
+	}
+
+	state.accounts = make(map[common.Address]*account)
+	for _, s := range state.signers {
+		state.accounts[s.Address()] = &account{
+			signer: s,
+			txs:    make(map[uint64]*trackedTx),
+		}
+	}
+	if len(state.accounts) > 0 {
+		out, err := endpoints.do(10*time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+			return client.ChainID(ctx)
+		})
+		if err != nil {
+			return fmt.Errorf("error fetching chain ID: %v", err)
+		}
+		state.chainID = out.(*big.Int)
+	}
 
 	flushTicker := time.NewTicker(5 * time.Second)
 	state.flushTicker = flushTicker
@@ -96,6 +155,9 @@ func (state *Executor) Initialize(context actor.Context) error {
 		for {
 			select {
 			case <-flushTicker.C:
+				// Probe the endpoints here, slow endpoints would block the actor.
+				// Their highest head is the chain head.
+				endpoints.check()
 				context.Send(pid, &flushLogs{})
 			case <-time.After(15 * time.Second):
 				if state.flushTicker != flushTicker {
@@ -117,14 +179,16 @@ func (state *Executor) OnBlockNumberRequest(context actor.Context) error {
 			ResponseID: uint64(time.Now().UnixNano()),
 		}
 
-		current, err := state.client.BlockNumber(goContext.Background())
+		out, err := state.endpoints.do(10*time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+			return client.BlockNumber(ctx)
+		})
 		if err != nil {
 			state.logger.Warn("error filtering logs", log.Error(err))
 			res.RejectionReason = messages.RejectionReason_RPCError
 			context.Send(sender, res)
 			return
 		}
-		res.BlockNumber = current
+		res.BlockNumber = out.(uint64)
 		res.Success = true
 		context.Send(sender, res)
 	}(context.Sender())
@@ -140,7 +204,7 @@ func (state *Executor) OnBlockInfoRequest(context actor.Context) error {
 			ResponseID: uint64(time.Now().UnixNano()),
 		}
 
-		header, err := state.client.HeaderByNumber(goContext.Background(), big.NewInt(int64(req.BlockNumber)))
+		header, err := state.headerByNumber(req.BlockNumber)
 		if err != nil {
 			state.logger.Warn("error getting header", log.Error(err))
 			res.RejectionReason = messages.RejectionReason_RPCError
@@ -164,7 +228,10 @@ func (state *Executor) OnEVMContractCallRequest(context actor.Context) error {
 			Success:    false,
 		}
 
-		out, err := state.client.CallContract(goContext.Background(), req.Msg, big.NewInt(int64(req.BlockNumber)))
+		// Contract calls are latency sensitive, hedge them over the endpoints
+		out, err := state.endpoints.hedge(10*time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+			return client.CallContract(ctx, req.Msg, big.NewInt(int64(req.BlockNumber)))
+		})
 		if err != nil {
 			state.logger.Warn("error with contract call", log.Error(err))
 			res.RejectionReason = messages.RejectionReason_RPCError
@@ -175,7 +242,31 @@ func (state *Executor) OnEVMContractCallRequest(context actor.Context) error {
 			context.Send(sender, res)
 			return
 		}
-		res.Out = out
+		res.Out = out.([]byte)
+		res.Success = true
+		context.Send(sender, res)
+	}(context.Sender())
+	return nil
+}
+
+func (state *Executor) OnEVMBalanceRequest(context actor.Context) error {
+	req := context.Message().(*messages.EVMBalanceRequest)
+	go func(sender *actor.PID) {
+		res := &messages.EVMBalanceResponse{
+			RequestID:  req.RequestID,
+			ResponseID: uint64(time.Now().UnixNano()),
+			Success:    false,
+		}
+		out, err := state.endpoints.do(10*time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+			return client.BalanceAt(ctx, req.Address, big.NewInt(int64(req.BlockNumber)))
+		})
+		if err != nil {
+			state.logger.Warn("error fetching balance", log.Error(err))
+			res.RejectionReason = messages.RejectionReason_RPCError
+			context.Send(sender, res)
+			return
+		}
+		res.Balance = out.(*big.Int)
 		res.Success = true
 		context.Send(sender, res)
 	}(context.Sender())
@@ -237,7 +328,7 @@ func (state *Executor) OnEVMLogsQueryRequest(context actor.Context) error {
 			ResponseID: uint64(time.Now().UnixNano()),
 		}
 
-		logs, err := state.client.FilterLogs(goContext.Background(), req.Query)
+		logs, err := state.filterLogs(req.Query, time.Minute)
 		if err != nil {
 			state.logger.Warn("error filtering logs", log.Error(err))
 			res.RejectionReason = messages.RejectionReason_RPCError
@@ -250,7 +341,7 @@ func (state *Executor) OnEVMLogsQueryRequest(context actor.Context) error {
 		var lastTime uint64 = 0
 		for _, l := range logs {
 			if lastBlock != l.BlockNumber {
-				block, err := state.client.HeaderByNumber(goContext.Background(), big.NewInt(int64(l.BlockNumber)))
+				block, err := state.headerByNumber(l.BlockNumber)
 				if err != nil {
 					state.logger.Warn("error getting header", log.Error(err))
 					res.RejectionReason = messages.RejectionReason_RPCError
@@ -277,146 +368,296 @@ func (state *Executor) OnEVMLogsSubscribeRequest(context actor.Context) error {
 		ResponseID: uint64(time.Now().UnixNano()),
 	}
 
-	ch := make(chan types.Log)
-	sub, err := state.client.SubscribeFilterLogs(goContext.Background(), req.Query, ch)
-	if err != nil {
+	if subs, ok := state.subscriptions[req.RequestID]; ok {
+		res.Success = true
+		res.SeqNum = subs.seqNum
+		context.Respond(res)
+		return nil
+	}
+
+	head := state.endpoints.highestHead()
+	if head == 0 {
+		state.logger.Warn("no endpoint head")
+		res.RejectionReason = messages.RejectionReason_RPCError
+		context.Respond(res)
+		return nil
+	}
+	confirmations := req.Confirmations
+	if confirmations == 0 {
+		confirmations = defaultConfirmations
+	}
+	start := head + 1
+	if req.Query.FromBlock != nil {
+		start = req.Query.FromBlock.Uint64()
+	}
+	// The block range is handled by the tracker
+	query := req.Query
+	query.FromBlock = nil
+	query.ToBlock = nil
+	subs := &logsSubscription{
+		query:      query,
+		tracker:    newLogTracker(confirmations, start),
+		seqNum:     uint64(time.Now().UnixNano()),
+		subscriber: req.Subscriber,
+	}
+	if err := state.subscribe(subs); err != nil {
 		state.logger.Warn("error subscribing to logs", log.Error(err))
 		res.RejectionReason = messages.RejectionReason_RPCError
 		context.Respond(res)
 		return nil
 	}
-	subs, ok := state.subscriptions[req.RequestID]
-	if !ok {
-		subs = &logsSubscription{
-			logs:         list.New(),
-			subscription: sub,
-			seqNum:       uint64(time.Now().UnixNano()),
-			subscriber:   req.Subscriber,
-			ch:           ch,
-		}
-		go func() {
-			for {
-				l, ok := <-ch
-				if !ok {
-					return
-				}
-				subs.Lock()
-				if l.Removed {
-					el := subs.logs.Front()
-					removed := false
-					for ; el != nil; el = el.Next() {
-						update := el.Value.(*types.Log)
-						if update.TxHash == l.TxHash {
-							subs.logs.Remove(el)
-							removed = true
-							break
-						}
-					}
-					if !removed {
-						// TODO restart subscription
-						fmt.Println("COULD NOT FIND REMOVED TX")
-					}
-				} else {
-					subs.logs.PushBack(&l)
-				}
-				subs.Unlock()
-			}
-		}()
-		state.subscriptions[req.RequestID] = subs
-		fmt.Println("NEW SUBSCRIPTION")
-	} else {
-		fmt.Println("REUSING SUB")
+	state.subscriptions[req.RequestID] = subs
+	context.Watch(req.Subscriber)
+
+	// Replay the logs from the requested block, then respond
+	sender := context.Sender()
+	state.backfill(context, req.RequestID, subs, start, head, func(err error) {
+		if err != nil {
+			state.logger.Warn("error replaying logs", log.Error(err))
+			state.unsubscribe(subs)
+			delete(state.subscriptions, req.RequestID)
+			res.RejectionReason = messages.RejectionReason_RPCError
+			context.Send(sender, res)
+			return
+		}
+		res.Success = true
+		res.SeqNum = subs.seqNum
+		context.Send(sender, res)
+	})
+
+	return nil
+}
+
+// subscribe subscribes to the logs on the best endpoint supporting subscriptions
+func (state *Executor) subscribe(subs *logsSubscription) error {
+	ch := make(chan types.Log)
+	err := fmt.Errorf("no connected endpoint")
+	for _, e := range state.endpoints.ordered() {
+		var sub interface{}
+		sub, err = state.endpoints.call(goContext.Background(), e, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+			return client.SubscribeFilterLogs(ctx, subs.query, ch)
+		})
+		if err == nil {
+			subs.subscription = sub.(ethereum.Subscription)
+			subs.endpoint = e
+			break
+		}
+	}
+	if err != nil {
+		return err
 	}
+	subs.ch = ch
+	go func() {
+		for {
+			l, ok := <-ch
+			if !ok {
+				return
+			}
+			subs.Lock()
+			subs.tracker.add(l)
+			subs.Unlock()
+		}
+	}()
+	return nil
+}
 
-	res.Success = true
-	res.SeqNum = subs.seqNum
-	context.Respond(res)
-	context.Watch(req.Subscriber)
+func (state *Executor) unsubscribe(subs *logsSubscription) {
+	if subs.subscription != nil {
+		subs.subscription.Unsubscribe()
+		close(subs.ch)
+		subs.subscription = nil
+	}
+}
 
+// resubscribe replaces a failed subscription and backfills the logs missed in between.
+// On a backfill error, the subscription is dropped and renewed on the next flush.
+func (state *Executor) resubscribe(context actor.Context, requestID uint64, subs *logsSubscription, head uint64) error {
+	state.unsubscribe(subs)
+	if err := state.subscribe(subs); err != nil {
+		return fmt.Errorf("error subscribing to logs: %v", err)
+	}
+	subs.Lock()
+	from := subs.tracker.resumeBlock()
+	subs.Unlock()
+	state.backfill(context, requestID, subs, from, head, func(err error) {
+		if err != nil {
+			state.logger.Warn("error backfilling logs", log.Error(err))
+			state.unsubscribe(subs)
+		}
+	})
 	return nil
 }
 
+// backfill adds the logs of the block range to the tracker outside of the actor, by chunks,
+// then calls cont in the actor, unless the subscription was removed meanwhile
+func (state *Executor) backfill(context actor.Context, requestID uint64, subs *logsSubscription, from, to uint64, cont func(error)) {
+	if from > to {
+		cont(nil)
+		return
+	}
+	chunks := (to-from)/logsChunkSize + 1
+	subs.backfilling = true
+	state.async(context, time.Duration(chunks)*time.Minute, func() (interface{}, error) {
+		for start := from; start <= to; start += logsChunkSize {
+			end := start + logsChunkSize - 1
+			if end > to {
+				end = to
+			}
+			query := subs.query
+			query.FromBlock = big.NewInt(int64(start))
+			query.ToBlock = big.NewInt(int64(end))
+			logs, err := state.filterLogs(query, time.Minute)
+			if err != nil {
+				return nil, fmt.Errorf("error filtering logs from %d to %d: %v", start, end, err)
+			}
+			subs.Lock()
+			for _, l := range logs {
+				subs.tracker.add(l)
+			}
+			subs.Unlock()
+		}
+		return nil, nil
+	}, func(_ interface{}, err error) {
+		subs.backfilling = false
+		if state.subscriptions[requestID] != subs {
+			return
+		}
+		cont(err)
+	})
+}
+
 func (state *Executor) onFlushLogs(context actor.Context) error {
-	ctx, canc := goContext.WithTimeout(goContext.Background(), 10*time.Second)
-	defer canc()
-	current, err := state.client.BlockNumber(ctx)
-	if err != nil {
-		state.logger.Warn("error fetching block number", log.Error(err))
+	current := state.endpoints.highestHead()
+	if current == 0 {
+		state.logger.Warn("no endpoint head")
 		return nil
 	}
 	for k, subs := range state.subscriptions {
-		subs.RLock()
-		select {
-		case err := <-subs.subscription.Err():
-			// TODO restart subscription
-			fmt.Println("Error on subscription", err)
-		default:
-		}
-		var logs *messages.EVMLogs
-		for el := subs.logs.Front(); el != nil; el = subs.logs.Front() {
-			updt := el.Value.(*types.Log)
-			if updt.BlockNumber > current-3 {
-				// we are done
-				break
-			}
-			if logs == nil || logs.BlockNumber != updt.BlockNumber {
-				// No update or new block, publish
-				if logs != nil {
-					context.Send(subs.subscriber, &messages.EVMLogsSubscribeRefresh{
-						RequestID: k,
-						SeqNum:    subs.seqNum + 1,
-						Update:    logs,
-					})
-					subs.seqNum += 1
-				}
-				ctx, _ := goContext.WithTimeout(goContext.Background(), 10*time.Second)
-				h, err := state.client.HeaderByNumber(ctx, big.NewInt(int64(updt.BlockNumber)))
-				if err != nil {
-					state.logger.Warn("error getting block header", log.Error(err))
-					subs.RUnlock()
-					return nil
-				}
-				logs = &messages.EVMLogs{
-					BlockNumber: updt.BlockNumber,
-					BlockTime:   time.Unix(int64(h.Time), 0),
+		if subs.backfilling {
+			continue
+		}
+		failed := subs.subscription == nil
+		if !failed {
+			select {
+			case err := <-subs.subscription.Err():
+				state.logger.Warn("error on logs subscription", log.Error(err))
+				failed = true
+			default:
+				if !state.endpoints.isHealthy(subs.endpoint) {
+					// Fail over to a healthy endpoint
+					state.logger.Warn("unhealthy logs subscription endpoint", log.String("endpoint", subs.endpoint.url))
+					failed = true
 				}
 			}
-			logs.Logs = append(logs.Logs, *updt)
-			subs.logs.Remove(el)
-		}
-		// Publish it
-		if logs != nil {
-			context.Send(subs.subscriber, &messages.EVMLogsSubscribeRefresh{
-				RequestID: k,
-				SeqNum:    subs.seqNum + 1,
-				Update:    logs,
-			})
-			subs.seqNum += 1
-			subs.lastPingTime = time.Now()
-		} else if time.Since(subs.lastPingTime) > 10*time.Second {
-			context.Send(subs.subscriber, &messages.EVMLogsSubscribeRefresh{
-				RequestID: k,
-				SeqNum:    subs.seqNum + 1,
+		}
+		if failed {
+			// The confirmed logs are held back until the backfill is done
+			if err := state.resubscribe(context, k, subs, current); err != nil {
+				// Retry on next flush
+				state.logger.Warn("error resubscribing to logs", log.Error(err))
+			}
+			continue
+		}
+		if err := state.flushSubscription(context, k, subs, current); err != nil {
+			state.logger.Warn("error flushing logs", log.Error(err))
+		}
+	}
+	state.trackTransactions(context, current)
+	state.trackPendingTxs(context, current)
+	if err := state.trackGas(context, current); err != nil {
+		state.logger.Warn("error tracking gas", log.Error(err))
+	}
+	return nil
+}
+
+// flushSubscription publishes the reverted logs and the logs of the confirmed blocks
+func (state *Executor) flushSubscription(context actor.Context, requestID uint64, subs *logsSubscription, head uint64) error {
+	subs.Lock()
+	defer subs.Unlock()
+
+	publish := func(update *messages.EVMLogs) {
+		context.Send(subs.subscriber, &messages.EVMLogsSubscribeRefresh{
+			RequestID: requestID,
+			SeqNum:    subs.seqNum + 1,
+			Update:    update,
+		})
+		subs.seqNum += 1
+		subs.lastPingTime = time.Now()
+	}
+
+	for _, b := range subs.tracker.takeReverted() {
+		state.logger.Warn("logs reverted by a reorg", log.Uint64("block", b.number))
+		publish(&messages.EVMLogs{
+			BlockNumber: b.number,
+			BlockHash:   b.hash,
+			BlockTime:   b.time,
+			Logs:        b.logs,
+			Removed:     true,
+		})
+	}
+
+	for _, b := range subs.tracker.confirmed(head) {
+		h, err := state.headerByNumber(b.number)
+		if err != nil {
+			return fmt.Errorf("error getting block header: %v", err)
+		}
+		if hash := h.Hash(); hash != b.hash {
+			// The block was orphaned, fetch the logs of the canonical one
+			query := subs.query
+			query.BlockHash = &hash
+			logs, err := state.filterLogs(query, 10*time.Second)
+			if err != nil {
+				return fmt.Errorf("error filtering logs: %v", err)
+			}
+			b = &trackedBlock{
+				number: b.number,
+				hash:   hash,
+				logs:   logs,
+			}
+		}
+		b.time = time.Unix(int64(h.Time), 0)
+		subs.tracker.publish(b)
+		if len(b.logs) > 0 {
+			publish(&messages.EVMLogs{
+				BlockNumber: b.number,
+				BlockHash:   b.hash,
+				BlockTime:   b.time,
+				Logs:        b.logs,
 			})
-			subs.seqNum += 1
-			subs.lastPingTime = time.Now()
 		}
-		subs.RUnlock()
+	}
+	subs.tracker.setHead(head)
+
+	if time.Since(subs.lastPingTime) > 10*time.Second {
+		publish(nil)
 	}
 	return nil
 }
 
 func (state *Executor) Clean(context actor.Context) error {
 	for _, sub := range state.subscriptions {
-		sub.subscription.Unsubscribe()
-		close(sub.ch)
+		state.unsubscribe(sub)
 		context.Unwatch(sub.subscriber)
 	}
 	state.subscriptions = nil
-	if state.flushTicker == nil {
+	for _, sub := range state.pendingSubs {
+		context.Unwatch(sub.subscriber)
+	}
+	state.pendingSubs = nil
+	for _, sub := range state.gasSubs {
+		context.Unwatch(sub.subscriber)
+	}
+	state.gasSubs = nil
+	if state.mempool != nil {
+		state.unsubscribeMempool()
+	}
+	if state.flushTicker != nil {
 		state.flushTicker.Stop()
 		state.flushTicker = nil
 	}
+	if state.endpoints != nil {
+		state.endpoints.close()
+	}
 	return nil
 }
 
@@ -424,14 +665,431 @@ func (state *Executor) OnTerminated(context actor.Context) error {
 	msg := context.Message().(*actor.Terminated)
 	for k, sub := range state.subscriptions {
 		if sub.subscriber.String() == msg.Who.String() {
-			sub.subscription.Unsubscribe()
-			close(sub.ch)
+			state.unsubscribe(sub)
 			delete(state.subscriptions, k)
 		}
 	}
+	for k, sub := range state.pendingSubs {
+		if sub.subscriber.String() == msg.Who.String() {
+			state.removePendingSubscription(k)
+		}
+	}
+	for k, sub := range state.gasSubs {
+		if sub.subscriber.String() == msg.Who.String() {
+			delete(state.gasSubs, k)
+		}
+	}
 	return nil
 }
 
+func (state *Executor) OnEVMTransactionRequest(context actor.Context) error {
+	req := context.Message().(*messages.EVMTransactionRequest)
+	res := &messages.EVMTransactionResponse{
+		RequestID:  req.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+	}
+	acc, ok := state.accounts[req.From]
+	if !ok {
+		res.RejectionReason = messages.RejectionReason_UnknownAccount
+		context.Respond(res)
+		return nil
+	}
+	if acc.synced {
+		state.submitTx(context, acc, req, res)
+		return nil
+	}
+	state.async(context, 20*time.Second, func() (interface{}, error) {
+		return state.endpoints.do(10*time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+			return client.PendingNonceAt(ctx, req.From)
+		})
+	}, func(out interface{}, err error) {
+		if err != nil {
+			state.logger.Warn("error fetching account nonce", log.Error(err))
+			res.RejectionReason = messages.RejectionReason_RPCError
+			context.Respond(res)
+			return
+		}
+		// Another request might have synced the account meanwhile
+		if !acc.synced {
+			acc.nonce = out.(uint64)
+			acc.synced = true
+		}
+		state.submitTx(context, acc, req, res)
+	})
+
+	return nil
+}
+
+// submitTx reserves the next nonce of the account, then builds, signs and sends
+// the transaction outside of the actor, and responds once it is sent
+func (state *Executor) submitTx(context actor.Context, acc *account, req *messages.EVMTransactionRequest, res *messages.EVMTransactionResponse) {
+	nonce := acc.nonce
+	acc.nonce += 1
+	state.async(context, time.Minute, func() (interface{}, error) {
+		tx, err := state.buildTx(req, nonce)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errBuildTx, err)
+		}
+		signed, err := acc.signer.SignTx(tx, state.chainID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errSignTx, err)
+		}
+		if err := state.sendTx(signed); err != nil {
+			return nil, fmt.Errorf("error sending transaction: %v", err)
+		}
+		return signed, nil
+	}, func(out interface{}, err error) {
+		if err != nil {
+			state.logger.Warn("error submitting transaction", log.Error(err))
+			unused := errors.Is(err, errBuildTx) || errors.Is(err, errSignTx)
+			if unused && acc.synced && acc.nonce == nonce+1 {
+				// The nonce wasn't used and no other transaction took the next one
+				acc.nonce = nonce
+			} else {
+				// The nonce might have been used outside of the executor
+				acc.synced = false
+			}
+			res.RejectionReason = messages.RejectionReason_RPCError
+			if errors.Is(err, errSignTx) {
+				res.RejectionReason = messages.RejectionReason_InvalidRequest
+			}
+			context.Respond(res)
+			return
+		}
+		signed := out.(*types.Transaction)
+		confirmations := req.Confirmations
+		if confirmations == 0 {
+			confirmations = defaultConfirmations
+		}
+		acc.txs[nonce] = &trackedTx{
+			requestID:     req.RequestID,
+			subscriber:    req.Subscriber,
+			confirmations: confirmations,
+			txs:           []*types.Transaction{signed},
+			status:        messages.EVMTransactionPending,
+		}
+		res.TxHash = signed.Hash()
+		res.Nonce = nonce
+		res.Success = true
+		context.Respond(res)
+	})
+}
+
+func (state *Executor) OnEVMTransactionReplaceRequest(context actor.Context) error {
+	req := context.Message().(*messages.EVMTransactionReplaceRequest)
+	res := &messages.EVMTransactionReplaceResponse{
+		RequestID:  req.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+	}
+	acc, ok := state.accounts[req.From]
+	if !ok {
+		res.RejectionReason = messages.RejectionReason_UnknownAccount
+		context.Respond(res)
+		return nil
+	}
+	tracked, ok := acc.txs[req.Nonce]
+	if !ok || tracked.status != messages.EVMTransactionPending {
+		// Unknown or already mined
+		res.RejectionReason = messages.RejectionReason_InvalidRequest
+		context.Respond(res)
+		return nil
+	}
+	last := tracked.last()
+	state.async(context, time.Minute, func() (interface{}, error) {
+		tip, feeCap, err := state.suggestFees()
+		if err != nil {
+			return nil, fmt.Errorf("error suggesting fees: %v", err)
+		}
+		signed, err := acc.signer.SignTx(replacement(last, req.From, req.Cancel, tip, feeCap), state.chainID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errSignTx, err)
+		}
+		if err := state.sendTx(signed); err != nil {
+			return nil, fmt.Errorf("error sending transaction: %v", err)
+		}
+		return signed, nil
+	}, func(out interface{}, err error) {
+		if err != nil {
+			state.logger.Warn("error replacing transaction", log.Error(err))
+			res.RejectionReason = messages.RejectionReason_RPCError
+			if errors.Is(err, errSignTx) {
+				res.RejectionReason = messages.RejectionReason_InvalidRequest
+			}
+			context.Respond(res)
+			return
+		}
+		signed := out.(*types.Transaction)
+		// The transaction is no longer tracked if it was confirmed meanwhile
+		if tracked, ok := acc.txs[req.Nonce]; ok {
+			tracked.txs = append(tracked.txs, signed)
+		}
+		res.TxHash = signed.Hash()
+		res.Success = true
+		context.Respond(res)
+	})
+
+	return nil
+}
+
+func (state *Executor) buildTx(req *messages.EVMTransactionRequest, nonce uint64) (*types.Transaction, error) {
+	value := req.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	gas := req.Gas
+	if gas == 0 {
+		out, err := state.endpoints.do(10*time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+			return client.EstimateGas(ctx, ethereum.CallMsg{
+				From:  req.From,
+				To:    req.To,
+				Value: value,
+				Data:  req.Data,
+			})
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error estimating gas: %v", err)
+		}
+		// Margin for state changes between the estimation and the execution
+		gas = out.(uint64) * 6 / 5
+	}
+	tip, feeCap := req.MaxPriorityFeePerGas, req.MaxFeePerGas
+	if tip == nil || feeCap == nil {
+		suggestedTip, suggestedFeeCap, err := state.suggestFees()
+		if err != nil {
+			return nil, fmt.Errorf("error suggesting fees: %v", err)
+		}
+		if tip == nil {
+			tip = suggestedTip
+		}
+		if feeCap == nil {
+			feeCap = suggestedFeeCap
+		}
+	}
+	if tip == nil {
+		// The chain doesn't support EIP-1559
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: feeCap,
+			Gas:      gas,
+			To:       req.To,
+			Value:    value,
+			Data:     req.Data,
+		}), nil
+	}
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   state.chainID,
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       gas,
+		To:        req.To,
+		Value:     value,
+		Data:      req.Data,
+	}), nil
+}
+
+// suggestFees returns the suggested tip and fee cap, the fee cap leaving room for
+// the base fee to double. The tip is nil for chains without EIP-1559, the fee cap
+// being the suggested gas price.
+func (state *Executor) suggestFees() (*big.Int, *big.Int, error) {
+	out, err := state.endpoints.do(10*time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+		return client.HeaderByNumber(ctx, nil)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if baseFee := out.(*types.Header).BaseFee; baseFee != nil {
+		out, err := state.endpoints.do(10*time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+			return client.SuggestGasTipCap(ctx)
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		tip := out.(*big.Int)
+		feeCap := new(big.Int).Mul(baseFee, big.NewInt(2))
+		feeCap.Add(feeCap, tip)
+		return tip, feeCap, nil
+	}
+	out, err = state.endpoints.do(10*time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+		return client.SuggestGasPrice(ctx)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return nil, out.(*big.Int), nil
+}
+
+// sendTx sends the transaction on one endpoint, the node gossips it to the others.
+// A transaction the node already has was sent before, it isn't an error.
+func (state *Executor) sendTx(tx *types.Transaction) error {
+	_, err := state.endpoints.once(10*time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+		return nil, client.SendTransaction(ctx, tx)
+	})
+	if isKnownTxError(err) {
+		return nil
+	}
+	return err
+}
+
+// txQuery is the state of a tracked transaction when its status is fetched
+type txQuery struct {
+	addr          common.Address
+	nonce         uint64
+	hashes        []common.Hash // The transaction followed by its replacements
+	confirmations uint64
+}
+
+type txStatus struct {
+	receipt        *types.Receipt
+	confirmedNonce uint64
+}
+
+// trackTransactions fetches the receipts of the submitted transactions outside of
+// the actor, then updates their status. A transaction replaced meanwhile is
+// updated on the next flush.
+func (state *Executor) trackTransactions(context actor.Context, head uint64) {
+	if state.tracking {
+		return
+	}
+	var queries []*txQuery
+	for addr, acc := range state.accounts {
+		for nonce, tracked := range acc.txs {
+			q := &txQuery{
+				addr:          addr,
+				nonce:         nonce,
+				confirmations: tracked.confirmations,
+			}
+			for _, tx := range tracked.txs {
+				q.hashes = append(q.hashes, tx.Hash())
+			}
+			queries = append(queries, q)
+		}
+	}
+	if len(queries) == 0 {
+		return
+	}
+	state.tracking = true
+	state.async(context, 2*time.Minute, func() (interface{}, error) {
+		return state.fetchTxStatuses(queries, head)
+	}, func(out interface{}, err error) {
+		state.tracking = false
+		if err != nil {
+			state.logger.Warn("error tracking transactions", log.Error(err))
+			return
+		}
+		for i, status := range out.([]txStatus) {
+			q := queries[i]
+			acc := state.accounts[q.addr]
+			tracked, ok := acc.txs[q.nonce]
+			if !ok || len(tracked.txs) != len(q.hashes) {
+				continue
+			}
+			if !tracked.update(status.receipt, head, status.confirmedNonce) {
+				continue
+			}
+			update := &messages.EVMTransactionUpdate{
+				RequestID: tracked.requestID,
+				SeqNum:    tracked.seqNum + 1,
+				TxHash:    tracked.last().Hash(),
+				Nonce:     q.nonce,
+				Status:    tracked.status,
+				Receipt:   status.receipt,
+			}
+			if status.receipt != nil {
+				update.TxHash = status.receipt.TxHash
+			}
+			tracked.seqNum += 1
+			if tracked.subscriber != nil {
+				context.Send(tracked.subscriber, update)
+			}
+			if tracked.done() {
+				delete(acc.txs, q.nonce)
+			}
+		}
+	})
+}
+
+// fetchTxStatuses returns the receipt of each queried transaction, and the nonce of its
+// account at the confirmation depth when not mined
+func (state *Executor) fetchTxStatuses(queries []*txQuery, head uint64) ([]txStatus, error) {
+	statuses := make([]txStatus, len(queries))
+	type key struct {
+		addr  common.Address
+		block uint64
+	}
+	confirmedNonces := make(map[key]uint64)
+	for i, q := range queries {
+		// The replacements first
+		for j := len(q.hashes) - 1; j >= 0; j-- {
+			hash := q.hashes[j]
+			out, err := state.endpoints.do(10*time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+				return client.TransactionReceipt(ctx, hash)
+			})
+			if errors.Is(err, ethereum.NotFound) {
+				continue
+			} else if err != nil {
+				return nil, fmt.Errorf("error fetching receipt: %v", err)
+			}
+			statuses[i].receipt = out.(*types.Receipt)
+			break
+		}
+		if statuses[i].receipt == nil && head >= q.confirmations {
+			k := key{q.addr, head - q.confirmations}
+			n, ok := confirmedNonces[k]
+			if !ok {
+				out, err := state.endpoints.do(10*time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+					return client.NonceAt(ctx, k.addr, big.NewInt(int64(k.block)))
+				})
+				if err != nil {
+					return nil, fmt.Errorf("error fetching account nonce: %v", err)
+				}
+				n = out.(uint64)
+				confirmedNonces[k] = n
+			}
+			statuses[i].confirmedNonce = n
+		}
+	}
+	return statuses, nil
+}
+
+// async runs fn outside of the actor, then cont in the actor with its result,
+// or with the timeout error
+func (state *Executor) async(context actor.Context, timeout time.Duration, fn func() (interface{}, error), cont func(interface{}, error)) {
+	future := actor.NewFuture(context.ActorSystem(), timeout)
+	go func(pid *actor.PID) {
+		out, err := fn()
+		context.Send(pid, &asyncResult{out: out, err: err})
+	}(future.PID())
+	context.ReenterAfter(future, func(res interface{}, err error) {
+		if err != nil {
+			cont(nil, err)
+			return
+		}
+		r := res.(*asyncResult)
+		cont(r.out, r.err)
+	})
+}
+
+func (state *Executor) headerByNumber(number uint64) (*types.Header, error) {
+	out, err := state.endpoints.do(10*time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+		return client.HeaderByNumber(ctx, big.NewInt(int64(number)))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.(*types.Header), nil
+}
+
+func (state *Executor) filterLogs(query ethereum.FilterQuery, timeout time.Duration) ([]types.Log, error) {
+	out, err := state.endpoints.do(timeout, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+		return client.FilterLogs(ctx, query)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.([]types.Log), nil
+}
+
 func (state *Executor) GetLogger() *log.Logger {
 	return state.logger
 }
@@ -0,0 +1,115 @@
+package evm
+
+import (
+	goContext "context"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"gitlab.com/alphaticks/alpha-connect/config"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+)
+
+// The transactions are sent to the Ganache endpoint of the default config, started
+// with ganache --deterministic, or with the key of a funded account in GANACHE_KEY
+
+const (
+	ganacheEndpoint = "http://127.0.0.1:7545"
+	ganacheKey      = "4f3edf983ac636a65a842ce7c78d9aa706d3b113bce9c46f30d7d21715b23b1d"
+)
+
+func dialGanache(t *testing.T) *rpc.Client {
+	ctx, cancel := goContext.WithTimeout(goContext.Background(), 2*time.Second)
+	defer cancel()
+	r, err := rpc.DialContext(ctx, ganacheEndpoint)
+	if err != nil {
+		t.Skipf("ganache not reachable: %v", err)
+	}
+	var head string
+	if err := r.CallContext(ctx, &head, "eth_blockNumber"); err != nil {
+		r.Close()
+		t.Skipf("ganache not reachable: %v", err)
+	}
+	return r
+}
+
+func TestExecutorTransaction(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+	}
+	r := dialGanache(t)
+	defer r.Close()
+	key := ganacheKey
+	if os.Getenv("GANACHE_KEY") != "" {
+		key = os.Getenv("GANACHE_KEY")
+	}
+	signer, err := NewKeySigner(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	as := actor.NewActorSystem()
+	executor, err := as.Root.SpawnNamed(actor.PropsFromProducer(func() actor.Actor {
+		return NewExecutor(nil, []config.ChainRPC{{Chain: 1337, Endpoint: ganacheEndpoint}}, []Signer{signer})
+	}), "executor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = as.Root.PoisonFuture(executor).Wait() }()
+	updates := make(chan *messages.EVMTransactionUpdate, 16)
+	subscriber := as.Root.Spawn(actor.PropsFromFunc(func(context actor.Context) {
+		if update, ok := context.Message().(*messages.EVMTransactionUpdate); ok {
+			updates <- update
+		}
+	}))
+	defer as.Root.Stop(subscriber)
+
+	to := common.HexToAddress("0xFFcf8FDEE72ac11b5c542428B35EEF5769C409f0")
+	res, err := as.Root.RequestFuture(executor, &messages.EVMTransactionRequest{
+		RequestID:     1,
+		From:          signer.Address(),
+		To:            &to,
+		Value:         big.NewInt(1000),
+		Confirmations: 2,
+		Subscriber:    subscriber,
+	}, 30*time.Second).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	txRes, ok := res.(*messages.EVMTransactionResponse)
+	if !ok || !txRes.Success {
+		t.Fatalf("error sending transaction: %v", res)
+	}
+
+	// Ganache mines the transaction right away, the next blocks confirm it
+	for i := 0; i < 2; i++ {
+		if err := r.Call(nil, "evm_mine"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	timeout := time.After(time.Minute)
+	var statuses []messages.EVMTransactionStatus
+	for {
+		select {
+		case update := <-updates:
+			if update.RequestID != 1 || update.Nonce != txRes.Nonce {
+				t.Fatalf("unexpected update %v", update)
+			}
+			statuses = append(statuses, update.Status)
+			if update.Status != messages.EVMTransactionConfirmed {
+				continue
+			}
+			if update.TxHash != txRes.TxHash || update.Receipt == nil || update.Receipt.Status != types.ReceiptStatusSuccessful {
+				t.Fatalf("was expecting a successful receipt of %s", txRes.TxHash)
+			}
+			return
+		case <-timeout:
+			t.Fatalf("transaction not confirmed, got statuses %v", statuses)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package evm
+
+import (
+	goContext "context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/log"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+)
+
+// The gas oracle follows the fee history of the chain over a window of blocks. The stats
+// are refreshed on each new head while subscribed to, and published to the subscribers.
+
+const gasWindow = 20
+
+var gasPercentiles = []float64{10, 25, 50, 75, 90}
+
+type feeHistory struct {
+	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+	BaseFee      []*hexutil.Big   `json:"baseFeePerGas"`
+	GasUsedRatio []float64        `json:"gasUsedRatio"`
+	Reward       [][]*hexutil.Big `json:"reward"`
+}
+
+type gasSubscription struct {
+	subscriber   *actor.PID
+	seqNum       uint64
+	lastPingTime time.Time
+}
+
+// gasStats returns the stats of the fee history, the base fees holding
+// the base fee of the block following the window
+func gasStats(history *feeHistory, percentiles []float64) (*messages.EVMGasStats, error) {
+	n := len(history.GasUsedRatio)
+	if n == 0 || len(history.BaseFee) != n+1 || history.OldestBlock == nil {
+		return nil, fmt.Errorf("incomplete fee history")
+	}
+	stats := &messages.EVMGasStats{
+		BlockNumber:  history.OldestBlock.ToInt().Uint64() + uint64(n) - 1,
+		BaseFee:      history.BaseFee[n-1].ToInt(),
+		NextBaseFee:  history.BaseFee[n].ToInt(),
+		Percentiles:  percentiles,
+		PriorityFees: make([]*big.Int, len(percentiles)),
+	}
+	for _, r := range history.GasUsedRatio {
+		stats.Utilization += r
+	}
+	stats.Utilization /= float64(n)
+	for i := range percentiles {
+		var fees []*big.Int
+		for _, reward := range history.Reward {
+			if len(reward) != len(percentiles) {
+				continue
+			}
+			fees = append(fees, reward[i].ToInt())
+		}
+		if len(fees) == 0 {
+			stats.PriorityFees[i] = new(big.Int)
+			continue
+		}
+		sort.Slice(fees, func(a, b int) bool {
+			return fees[a].Cmp(fees[b]) < 0
+		})
+		stats.PriorityFees[i] = fees[len(fees)/2]
+	}
+	return stats, nil
+}
+
+// gasStatsToStats returns the base fee, median priority fee and block utilization stats of the chain
+func gasStatsToStats(stats *messages.EVMGasStats, chainID uint32, ts time.Time) []*models.Stat {
+	gwei := func(v *big.Int) float64 {
+		f, _ := new(big.Float).Quo(new(big.Float).SetInt(v), big.NewFloat(params.GWei)).Float64()
+		return f
+	}
+	timestamp := utils.MilliToTimestamp(uint64(ts.UnixNano() / 1000000))
+	res := []*models.Stat{{
+		Timestamp: timestamp,
+		StatType:  models.StatType_BaseFee,
+		Value:     gwei(stats.BaseFee),
+		ChainID:   chainID,
+	}, {
+		Timestamp: timestamp,
+		StatType:  models.StatType_BlockUtilization,
+		Value:     stats.Utilization,
+		ChainID:   chainID,
+	}}
+	for i, p := range stats.Percentiles {
+		if p == 50 {
+			res = append(res, &models.Stat{
+				Timestamp: timestamp,
+				StatType:  models.StatType_PriorityFee,
+				Value:     gwei(stats.PriorityFees[i]),
+				ChainID:   chainID,
+			})
+		}
+	}
+	return res
+}
+
+func (state *Executor) fetchGasStats(head uint64) (*messages.EVMGasStats, error) {
+	out, err := state.endpoints.doRPC(10*time.Second, func(ctx goContext.Context, client *rpc.Client) (interface{}, error) {
+		history := &feeHistory{}
+		err := client.CallContext(ctx, history, "eth_feeHistory", hexutil.Uint(gasWindow), hexutil.EncodeBig(new(big.Int).SetUint64(head)), gasPercentiles)
+		return history, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching fee history: %v", err)
+	}
+	return gasStats(out.(*feeHistory), gasPercentiles)
+}
+
+func (state *Executor) OnEVMGasSubscribeRequest(context actor.Context) error {
+	req := context.Message().(*messages.EVMGasSubscribeRequest)
+	res := &messages.EVMGasSubscribeResponse{
+		RequestID:  req.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+	}
+	if state.gas == nil {
+		head := state.endpoints.highestHead()
+		if head == 0 {
+			state.logger.Warn("no endpoint head")
+			res.RejectionReason = messages.RejectionReason_RPCError
+			context.Respond(res)
+			return nil
+		}
+		gas, err := state.fetchGasStats(head)
+		if err != nil {
+			state.logger.Warn("error fetching gas stats", log.Error(err))
+			res.RejectionReason = messages.RejectionReason_RPCError
+			context.Respond(res)
+			return nil
+		}
+		state.gas = gas
+	}
+	subs, ok := state.gasSubs[req.RequestID]
+	if !ok {
+		subs = &gasSubscription{
+			subscriber:   req.Subscriber,
+			seqNum:       uint64(time.Now().UnixNano()),
+			lastPingTime: time.Now(),
+		}
+		state.gasSubs[req.RequestID] = subs
+	}
+	res.Success = true
+	res.SeqNum = subs.seqNum
+	res.Gas = state.gas
+	context.Respond(res)
+	context.Watch(req.Subscriber)
+
+	return nil
+}
+
+// trackGas refreshes the gas stats on a new head and publishes them
+func (state *Executor) trackGas(context actor.Context, head uint64) error {
+	if len(state.gasSubs) == 0 {
+		// Not followed, the stats would be stale
+		state.gas = nil
+		return nil
+	}
+	var stats []*models.Stat
+	if state.gas == nil || head > state.gas.BlockNumber {
+		gas, err := state.fetchGasStats(head)
+		if err != nil {
+			return err
+		}
+		state.gas = gas
+		// The endpoints are all of the same chain
+		stats = gasStatsToStats(gas, state.rpcs[0].Chain, time.Now())
+	}
+	for k, subs := range state.gasSubs {
+		if stats == nil && time.Since(subs.lastPingTime) < 10*time.Second {
+			continue
+		}
+		refresh := &messages.EVMGasSubscribeRefresh{
+			RequestID: k,
+			SeqNum:    subs.seqNum + 1,
+		}
+		if stats != nil {
+			refresh.Gas = state.gas
+			refresh.Stats = stats
+		}
+		context.Send(subs.subscriber, refresh)
+		subs.seqNum += 1
+		subs.lastPingTime = time.Now()
+	}
+	return nil
+}
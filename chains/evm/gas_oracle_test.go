@@ -0,0 +1,62 @@
+package evm
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"gitlab.com/alphaticks/alpha-connect/models"
+)
+
+func TestGasStats(t *testing.T) {
+	raw := `{
+		"oldestBlock": "0x64",
+		"baseFeePerGas": ["0x3b9aca00", "0x77359400", "0xb2d05e00", "0xee6b2800"],
+		"gasUsedRatio": [0.2, 0.5, 0.8],
+		"reward": [["0x1", "0x3b9aca00"], ["0x2", "0x77359400"], ["0x3", "0xb2d05e00"]]
+	}`
+	history := &feeHistory{}
+	if err := json.Unmarshal([]byte(raw), history); err != nil {
+		t.Fatal(err)
+	}
+	gas, err := gasStats(history, []float64{10, 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gas.BlockNumber != 102 {
+		t.Fatalf("was expecting block 102, got %d", gas.BlockNumber)
+	}
+	if gas.BaseFee.Uint64() != 3000000000 || gas.NextBaseFee.Uint64() != 4000000000 {
+		t.Fatalf("unexpected base fees %s %s", gas.BaseFee, gas.NextBaseFee)
+	}
+	if gas.PriorityFees[0].Uint64() != 2 || gas.PriorityFees[1].Uint64() != 2000000000 {
+		t.Fatalf("unexpected priority fees %s %s", gas.PriorityFees[0], gas.PriorityFees[1])
+	}
+	if gas.Utilization < 0.499 || gas.Utilization > 0.501 {
+		t.Fatalf("was expecting utilization of 0.5, got %f", gas.Utilization)
+	}
+
+	stats := gasStatsToStats(gas, 1, time.Now())
+	if len(stats) != 3 {
+		t.Fatalf("was expecting 3 stats, got %d", len(stats))
+	}
+	for _, s := range stats {
+		if s.ChainID != 1 || s.SecurityID != 0 {
+			t.Fatalf("was expecting the chain ID and no security ID, got %d %d", s.ChainID, s.SecurityID)
+		}
+		switch s.StatType {
+		case models.StatType_BaseFee:
+			if s.Value != 3 {
+				t.Fatalf("was expecting base fee of 3 gwei, got %f", s.Value)
+			}
+		case models.StatType_PriorityFee:
+			if s.Value != 2 {
+				t.Fatalf("was expecting priority fee of 2 gwei, got %f", s.Value)
+			}
+		}
+	}
+
+	if _, err := gasStats(&feeHistory{}, []float64{50}); err == nil {
+		t.Fatalf("was expecting an error on empty fee history")
+	}
+}
@@ -0,0 +1,168 @@
+package evm
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// The log tracker holds the logs of a subscription until their block is confirmed.
+// Logs are grouped by block hash, so logs of an orphaned block are replaced when the
+// canonical block is seen. Published blocks are kept for a window of blocks, a removed
+// log in one of them is a reorg deeper than the confirmation depth and is reverted.
+
+const defaultConfirmations = 3
+
+// Number of published blocks in which removed logs are still matched
+const reorgWindow = 128
+
+// isConfirmed returns whether a block is at the confirmation depth of the head,
+// the rule shared by the logs and the transactions
+func isConfirmed(block, head, confirmations uint64) bool {
+	return block+confirmations <= head
+}
+
+type trackedBlock struct {
+	number uint64
+	hash   common.Hash
+	time   time.Time
+	logs   []types.Log
+}
+
+func (b *trackedBlock) index(l types.Log) int {
+	for i, bl := range b.logs {
+		if bl.TxHash == l.TxHash && bl.Index == l.Index {
+			return i
+		}
+	}
+	return -1
+}
+
+type logTracker struct {
+	confirmations uint64
+	pending       map[uint64]*trackedBlock
+	published     map[common.Hash]*trackedBlock
+	lastPublished uint64
+	lastConfirmed uint64
+	reverted      map[common.Hash]*trackedBlock
+}
+
+// newLogTracker returns a tracker of logs starting at the given block
+func newLogTracker(confirmations uint64, start uint64) *logTracker {
+	t := &logTracker{
+		confirmations: confirmations,
+		pending:       make(map[uint64]*trackedBlock),
+		published:     make(map[common.Hash]*trackedBlock),
+		reverted:      make(map[common.Hash]*trackedBlock),
+	}
+	if start > 0 {
+		t.lastConfirmed = start - 1
+	}
+	return t
+}
+
+func (t *logTracker) add(l types.Log) {
+	if l.Removed {
+		if b, ok := t.pending[l.BlockNumber]; ok && b.hash == l.BlockHash {
+			if i := b.index(l); i >= 0 {
+				b.logs = append(b.logs[:i], b.logs[i+1:]...)
+			}
+			if len(b.logs) == 0 {
+				delete(t.pending, l.BlockNumber)
+			}
+		} else if b, ok := t.published[l.BlockHash]; ok {
+			if i := b.index(l); i >= 0 {
+				b.logs = append(b.logs[:i], b.logs[i+1:]...)
+				rb, ok := t.reverted[l.BlockHash]
+				if !ok {
+					rb = &trackedBlock{
+						number: b.number,
+						hash:   b.hash,
+						time:   b.time,
+					}
+					t.reverted[l.BlockHash] = rb
+				}
+				rb.logs = append(rb.logs, l)
+			}
+		}
+		// Otherwise the log was already replaced by the canonical block
+		return
+	}
+	if b, ok := t.published[l.BlockHash]; ok && b.index(l) >= 0 {
+		// Already published, from a backfill
+		return
+	}
+	b, ok := t.pending[l.BlockNumber]
+	if !ok || b.hash != l.BlockHash {
+		// New block or a block replacing an orphaned one
+		b = &trackedBlock{
+			number: l.BlockNumber,
+			hash:   l.BlockHash,
+		}
+		t.pending[l.BlockNumber] = b
+	}
+	if b.index(l) < 0 {
+		b.logs = append(b.logs, l)
+	}
+}
+
+// takeReverted returns the logs of the published blocks removed by a reorg
+func (t *logTracker) takeReverted() []*trackedBlock {
+	var blocks []*trackedBlock
+	for _, b := range t.reverted {
+		blocks = append(blocks, b)
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].number < blocks[j].number
+	})
+	t.reverted = make(map[common.Hash]*trackedBlock)
+	return blocks
+}
+
+// confirmed returns the pending blocks confirmed at the given head, in order
+func (t *logTracker) confirmed(head uint64) []*trackedBlock {
+	var blocks []*trackedBlock
+	for n, b := range t.pending {
+		if isConfirmed(n, head, t.confirmations) {
+			blocks = append(blocks, b)
+		}
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].number < blocks[j].number
+	})
+	return blocks
+}
+
+// publish removes the block at the number of b from the pending blocks,
+// b being either the pending block or the canonical block replacing it
+func (t *logTracker) publish(b *trackedBlock) {
+	delete(t.pending, b.number)
+	sort.Slice(b.logs, func(i, j int) bool {
+		return b.logs[i].Index < b.logs[j].Index
+	})
+	if len(b.logs) > 0 {
+		t.published[b.hash] = b
+	}
+	if b.number > t.lastPublished {
+		t.lastPublished = b.number
+	}
+	for h, pb := range t.published {
+		if pb.number+reorgWindow < t.lastPublished {
+			delete(t.published, h)
+		}
+	}
+}
+
+// setHead marks the blocks confirmed at the given head as processed
+func (t *logTracker) setHead(head uint64) {
+	if head >= t.confirmations && head-t.confirmations > t.lastConfirmed {
+		t.lastConfirmed = head - t.confirmations
+	}
+}
+
+// resumeBlock returns the first block whose logs may not all have been received
+func (t *logTracker) resumeBlock() uint64 {
+	return t.lastConfirmed + 1
+}
@@ -0,0 +1,86 @@
+package evm
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func newLog(number uint64, hash byte, tx byte, index uint) types.Log {
+	return types.Log{
+		BlockNumber: number,
+		BlockHash:   common.Hash{hash},
+		TxHash:      common.Hash{tx},
+		Index:       index,
+	}
+}
+
+func TestLogTrackerConfirmations(t *testing.T) {
+	tracker := newLogTracker(3, 10)
+	tracker.add(newLog(10, 1, 1, 1))
+	tracker.add(newLog(10, 1, 1, 0))
+	tracker.add(newLog(10, 1, 1, 0))
+	tracker.add(newLog(11, 2, 2, 2))
+
+	if blocks := tracker.confirmed(12); len(blocks) != 0 {
+		t.Fatalf("was expecting no confirmed block")
+	}
+	blocks := tracker.confirmed(13)
+	if len(blocks) != 1 || blocks[0].number != 10 {
+		t.Fatalf("was expecting block 10 to be confirmed")
+	}
+	tracker.publish(blocks[0])
+	tracker.setHead(13)
+	if len(blocks[0].logs) != 2 || blocks[0].logs[0].Index != 0 {
+		t.Fatalf("was expecting 2 deduplicated logs in order")
+	}
+	if tracker.resumeBlock() != 11 {
+		t.Fatalf("was expecting to resume at block 11, got %d", tracker.resumeBlock())
+	}
+
+	// Replayed logs of a published block are ignored
+	tracker.add(newLog(10, 1, 1, 0))
+	if blocks := tracker.confirmed(14); len(blocks) != 1 || blocks[0].number != 11 {
+		t.Fatalf("was expecting block 11 to be confirmed")
+	}
+}
+
+func TestLogTrackerReorg(t *testing.T) {
+	tracker := newLogTracker(3, 10)
+
+	// Shallow reorg, the orphaned log is removed before confirmation
+	tracker.add(newLog(10, 1, 1, 0))
+	removed := newLog(10, 1, 1, 0)
+	removed.Removed = true
+	tracker.add(removed)
+	tracker.add(newLog(10, 2, 3, 0))
+	blocks := tracker.confirmed(13)
+	if len(blocks) != 1 || blocks[0].hash != (common.Hash{2}) || len(blocks[0].logs) != 1 {
+		t.Fatalf("was expecting the canonical block")
+	}
+	tracker.publish(blocks[0])
+
+	// Deep reorg, the published log is reverted
+	removed = newLog(10, 2, 3, 0)
+	removed.Removed = true
+	tracker.add(removed)
+	reverted := tracker.takeReverted()
+	if len(reverted) != 1 || reverted[0].number != 10 || len(reverted[0].logs) != 1 {
+		t.Fatalf("was expecting the log to be reverted")
+	}
+	if len(tracker.takeReverted()) != 0 {
+		t.Fatalf("was expecting the reverted logs to be taken")
+	}
+
+	// Orphaned block replaced at confirmation
+	tracker.add(newLog(12, 4, 4, 0))
+	blocks = tracker.confirmed(15)
+	if len(blocks) != 1 {
+		t.Fatalf("was expecting block 12 to be confirmed")
+	}
+	tracker.publish(&trackedBlock{number: 12, hash: common.Hash{5}})
+	if len(tracker.confirmed(15)) != 0 {
+		t.Fatalf("was expecting the orphaned block to be dropped")
+	}
+}
@@ -0,0 +1,402 @@
+package evm
+
+import (
+	goContext "context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/log"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+)
+
+// A pending transactions subscription follows the transactions of the mempool sent to a set
+// of contracts. A transaction is published as an intent, decoded with the ABI of its contract,
+// when first seen. It is published again once included in a block, or dropped when another
+// transaction of its sender used its nonce, or when it stayed too long in the mempool.
+// The transactions of the mempool are streamed once for all the subscriptions.
+
+const (
+	maxPendingTime       = 30 * time.Minute
+	maxInclusionCatchUp  = 10 // Blocks scanned for inclusions at most, on a flush
+	pendingStreamBufSize = 1024
+)
+
+type pendingTx struct {
+	tx   *types.Transaction
+	time time.Time
+}
+
+type pendingSubscription struct {
+	contracts    map[common.Address]*abi.ABI
+	subscriber   *actor.PID
+	seqNum       uint64
+	lastPingTime time.Time
+	intents      map[common.Hash]*messages.EVMTxIntent
+}
+
+type mempool struct {
+	sync.Mutex
+	watched      map[common.Address]int // Number of subscriptions by contract
+	subscription *rpc.ClientSubscription
+	endpoint     *endpoint
+	ch           chan *types.Transaction
+	lastBlock    uint64
+	fetching     bool // Whether blocks are being fetched for inclusions
+}
+
+func (m *mempool) isWatched(to *common.Address) bool {
+	if to == nil {
+		return false
+	}
+	m.Lock()
+	defer m.Unlock()
+	return m.watched[*to] > 0
+}
+
+func (m *mempool) watch(contracts map[common.Address]*abi.ABI, delta int) {
+	m.Lock()
+	defer m.Unlock()
+	for c := range contracts {
+		m.watched[c] += delta
+		if m.watched[c] <= 0 {
+			delete(m.watched, c)
+		}
+	}
+}
+
+// decodeIntent decodes the call of a transaction to a contract. The method
+// is left empty when the call data doesn't match a method of the ABI.
+func decodeIntent(tx *types.Transaction, cabi *abi.ABI, seen time.Time) (*messages.EVMTxIntent, error) {
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting sender: %v", err)
+	}
+	intent := &messages.EVMTxIntent{
+		TxHash:    tx.Hash(),
+		From:      from,
+		To:        *tx.To(),
+		Nonce:     tx.Nonce(),
+		Value:     tx.Value(),
+		GasTipCap: tx.GasTipCap(),
+		GasFeeCap: tx.GasFeeCap(),
+		Status:    messages.EVMTransactionPending,
+		SeenTime:  seen,
+	}
+	data := tx.Data()
+	if len(data) < 4 {
+		return intent, nil
+	}
+	method, err := cabi.MethodById(data[:4])
+	if err != nil {
+		return intent, nil
+	}
+	args := make(map[string]interface{})
+	if err := method.Inputs.UnpackIntoMap(args, data[4:]); err != nil {
+		return intent, nil
+	}
+	intent.Method = method.Name
+	intent.Args = args
+	return intent, nil
+}
+
+// add adds the transaction to the pending intents if sent to one of the contracts,
+// it returns the new intent
+func (subs *pendingSubscription) add(tx *types.Transaction, seen time.Time) (*messages.EVMTxIntent, error) {
+	if tx.To() == nil {
+		return nil, nil
+	}
+	cabi, ok := subs.contracts[*tx.To()]
+	if !ok {
+		return nil, nil
+	}
+	if _, ok := subs.intents[tx.Hash()]; ok {
+		return nil, nil
+	}
+	intent, err := decodeIntent(tx, cabi, seen)
+	if err != nil {
+		return nil, err
+	}
+	subs.intents[intent.TxHash] = intent
+	return intent, nil
+}
+
+// block marks the intents included in the block, and the ones whose nonce was used by
+// another transaction of their sender as dropped. It returns the intents updated.
+func (subs *pendingSubscription) block(number uint64, txs []*types.Transaction) []*messages.EVMTxIntent {
+	if len(subs.intents) == 0 {
+		return nil
+	}
+	byNonce := make(map[uint64][]*messages.EVMTxIntent)
+	for _, intent := range subs.intents {
+		byNonce[intent.Nonce] = append(byNonce[intent.Nonce], intent)
+	}
+	var updated []*messages.EVMTxIntent
+	for _, tx := range txs {
+		if intent, ok := subs.intents[tx.Hash()]; ok {
+			intent.Status = messages.EVMTransactionMined
+			intent.BlockNumber = number
+			updated = append(updated, intent)
+			delete(subs.intents, intent.TxHash)
+			continue
+		}
+		intents, ok := byNonce[tx.Nonce()]
+		if !ok {
+			continue
+		}
+		// Only recover the sender of the transactions sharing a pending nonce
+		from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			continue
+		}
+		for _, intent := range intents {
+			if _, ok := subs.intents[intent.TxHash]; ok && intent.From == from {
+				intent.Status = messages.EVMTransactionDropped
+				intent.BlockNumber = number
+				updated = append(updated, intent)
+				delete(subs.intents, intent.TxHash)
+			}
+		}
+	}
+	return updated
+}
+
+// expire drops the intents pending for too long
+func (subs *pendingSubscription) expire(now time.Time) []*messages.EVMTxIntent {
+	var updated []*messages.EVMTxIntent
+	for h, intent := range subs.intents {
+		if now.Sub(intent.SeenTime) > maxPendingTime {
+			intent.Status = messages.EVMTransactionDropped
+			updated = append(updated, intent)
+			delete(subs.intents, h)
+		}
+	}
+	return updated
+}
+
+func (state *Executor) OnEVMPendingTxSubscribeRequest(context actor.Context) error {
+	req := context.Message().(*messages.EVMPendingTxSubscribeRequest)
+	res := &messages.EVMPendingTxSubscribeResponse{
+		RequestID:  req.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+	}
+	if len(req.Contracts) == 0 {
+		res.RejectionReason = messages.RejectionReason_InvalidRequest
+		context.Respond(res)
+		return nil
+	}
+	subs, ok := state.pendingSubs[req.RequestID]
+	if !ok {
+		if state.mempool.subscription == nil {
+			if err := state.subscribeMempool(context); err != nil {
+				state.logger.Warn("error subscribing to pending transactions", log.Error(err))
+				res.RejectionReason = messages.RejectionReason_RPCError
+				context.Respond(res)
+				return nil
+			}
+		}
+		subs = &pendingSubscription{
+			contracts:    req.Contracts,
+			subscriber:   req.Subscriber,
+			seqNum:       uint64(time.Now().UnixNano()),
+			lastPingTime: time.Now(),
+			intents:      make(map[common.Hash]*messages.EVMTxIntent),
+		}
+		state.mempool.watch(subs.contracts, 1)
+		state.pendingSubs[req.RequestID] = subs
+	}
+
+	res.Success = true
+	res.SeqNum = subs.seqNum
+	context.Respond(res)
+	context.Watch(req.Subscriber)
+
+	return nil
+}
+
+// subscribeMempool streams the full pending transactions on the best endpoint supporting
+// it, so that no transaction has to be fetched by hash. The transactions sent to the
+// watched contracts are sent to the executor.
+func (state *Executor) subscribeMempool(context actor.Context) error {
+	ch := make(chan *types.Transaction, pendingStreamBufSize)
+	err := fmt.Errorf("no connected endpoint")
+	for _, e := range state.endpoints.ordered() {
+		r := state.endpoints.rpcClient(e)
+		if r == nil {
+			continue
+		}
+		var sub interface{}
+		sub, err = state.endpoints.call(goContext.Background(), e, func(ctx goContext.Context, _ *ethclient.Client) (interface{}, error) {
+			return r.EthSubscribe(ctx, ch, "newPendingTransactions", true)
+		})
+		if err == nil {
+			state.mempool.subscription = sub.(*rpc.ClientSubscription)
+			state.mempool.endpoint = e
+			break
+		}
+	}
+	if err != nil {
+		return err
+	}
+	state.mempool.ch = ch
+	m := state.mempool
+	go func(pid *actor.PID) {
+		for tx := range ch {
+			if m.isWatched(tx.To()) {
+				context.Send(pid, &pendingTx{tx: tx, time: time.Now()})
+			}
+		}
+	}(context.Self())
+	return nil
+}
+
+func (state *Executor) unsubscribeMempool() {
+	if state.mempool.subscription != nil {
+		state.mempool.subscription.Unsubscribe()
+		close(state.mempool.ch)
+		state.mempool.subscription = nil
+	}
+}
+
+func (state *Executor) onPendingTx(context actor.Context) error {
+	msg := context.Message().(*pendingTx)
+	for k, subs := range state.pendingSubs {
+		intent, err := subs.add(msg.tx, msg.time)
+		if err != nil {
+			state.logger.Warn("error decoding pending transaction", log.Error(err))
+			continue
+		}
+		if intent != nil {
+			state.publishIntents(context, k, subs, []*messages.EVMTxIntent{intent})
+		}
+	}
+	return nil
+}
+
+func (state *Executor) publishIntents(context actor.Context, requestID uint64, subs *pendingSubscription, intents []*messages.EVMTxIntent) {
+	context.Send(subs.subscriber, &messages.EVMPendingTxSubscribeRefresh{
+		RequestID: requestID,
+		SeqNum:    subs.seqNum + 1,
+		Intents:   intents,
+	})
+	subs.seqNum += 1
+	subs.lastPingTime = time.Now()
+}
+
+// inclusionRange returns the blocks to scan for inclusions after the last scanned block.
+// No block is skipped, the scan catching up with the head over the next flushes.
+func inclusionRange(last, head uint64) (uint64, uint64) {
+	from, to := last+1, head
+	if to >= from+maxInclusionCatchUp {
+		to = from + maxInclusionCatchUp - 1
+	}
+	return from, to
+}
+
+// trackPendingTxs publishes the intents included in the blocks up to the head, and the
+// ones dropped. The blocks are fetched outside of the actor, then scanned in the actor.
+// The intents only expire once the blocks are scanned up to the head, so that the ones
+// included in blocks not yet scanned aren't dropped. The mempool stream is renewed on error.
+func (state *Executor) trackPendingTxs(context actor.Context, head uint64) {
+	if len(state.pendingSubs) == 0 {
+		state.mempool.lastBlock = head
+		return
+	}
+	failed := state.mempool.subscription == nil
+	if !failed {
+		select {
+		case err := <-state.mempool.subscription.Err():
+			state.logger.Warn("error on pending transactions subscription", log.Error(err))
+			failed = true
+		default:
+			failed = !state.endpoints.isHealthy(state.mempool.endpoint)
+		}
+	}
+	if failed {
+		state.unsubscribeMempool()
+		if err := state.subscribeMempool(context); err != nil {
+			// Retry on next flush
+			state.logger.Warn("error resubscribing to pending transactions", log.Error(err))
+		}
+	}
+
+	if state.mempool.lastBlock == 0 {
+		state.mempool.lastBlock = head
+	}
+	state.fetchInclusions(context, head)
+	now := time.Now()
+	for k, subs := range state.pendingSubs {
+		if state.mempool.lastBlock >= head {
+			if updated := subs.expire(now); len(updated) > 0 {
+				state.publishIntents(context, k, subs, updated)
+			}
+		}
+		if time.Since(subs.lastPingTime) > 10*time.Second {
+			state.publishIntents(context, k, subs, nil)
+		}
+	}
+}
+
+// fetchInclusions fetches the blocks following the last scanned one outside of the actor,
+// then publishes the intents they include. The blocks fetched before an error are scanned.
+func (state *Executor) fetchInclusions(context actor.Context, head uint64) {
+	if state.mempool.fetching {
+		return
+	}
+	from, to := inclusionRange(state.mempool.lastBlock, head)
+	if from > to {
+		return
+	}
+	state.mempool.fetching = true
+	state.async(context, 2*time.Minute, func() (interface{}, error) {
+		var blocks []*types.Block
+		for n := from; n <= to; n++ {
+			out, err := state.endpoints.do(10*time.Second, func(ctx goContext.Context, client *ethclient.Client) (interface{}, error) {
+				return client.BlockByNumber(ctx, big.NewInt(int64(n)))
+			})
+			if err != nil {
+				return blocks, fmt.Errorf("error fetching block: %v", err)
+			}
+			blocks = append(blocks, out.(*types.Block))
+		}
+		return blocks, nil
+	}, func(out interface{}, err error) {
+		state.mempool.fetching = false
+		if err != nil {
+			state.logger.Warn("error fetching blocks for inclusions", log.Error(err))
+		}
+		blocks, _ := out.([]*types.Block)
+		for _, block := range blocks {
+			n := block.NumberU64()
+			// The scan might have been reset meanwhile
+			if n != state.mempool.lastBlock+1 {
+				return
+			}
+			for k, subs := range state.pendingSubs {
+				if updated := subs.block(n, block.Transactions()); len(updated) > 0 {
+					state.publishIntents(context, k, subs, updated)
+				}
+			}
+			state.mempool.lastBlock = n
+		}
+	})
+}
+
+func (state *Executor) removePendingSubscription(requestID uint64) {
+	subs, ok := state.pendingSubs[requestID]
+	if !ok {
+		return
+	}
+	state.mempool.watch(subs.contracts, -1)
+	delete(state.pendingSubs, requestID)
+	if len(state.pendingSubs) == 0 {
+		state.unsubscribeMempool()
+	}
+}
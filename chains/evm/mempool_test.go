@@ -0,0 +1,119 @@
+package evm
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+)
+
+const routerABI = `[{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactTokensForTokens","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"nonpayable","type":"function"}]`
+
+func TestPendingSubscription(t *testing.T) {
+	rabi, err := abi.JSON(strings.NewReader(routerABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	router := common.Address{1}
+	signer := types.LatestSignerForChainID(big.NewInt(1))
+	sign := func(nonce uint64, to common.Address, data []byte) *types.Transaction {
+		tx, err := types.SignNewTx(key, signer, &types.DynamicFeeTx{
+			ChainID:   big.NewInt(1),
+			Nonce:     nonce,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(100),
+			Gas:       200000,
+			To:        &to,
+			Data:      data,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tx
+	}
+	path := []common.Address{{2}, {3}}
+	data, err := rabi.Pack("swapExactTokensForTokens", big.NewInt(1000), big.NewInt(900), path, from, big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subs := &pendingSubscription{
+		contracts: map[common.Address]*abi.ABI{router: &rabi},
+		intents:   make(map[common.Hash]*messages.EVMTxIntent),
+	}
+	now := time.Now()
+	intent, err := subs.add(sign(1, common.Address{9}, data), now)
+	if err != nil || intent != nil {
+		t.Fatalf("was expecting the transaction to another contract to be ignored")
+	}
+	swap := sign(1, router, data)
+	intent, err = subs.add(swap, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if intent == nil || intent.From != from || intent.Method != "swapExactTokensForTokens" {
+		t.Fatalf("was expecting a decoded swap, got %+v", intent)
+	}
+	if intent.Args["amountIn"].(*big.Int).Int64() != 1000 || len(intent.Args["path"].([]common.Address)) != 2 {
+		t.Fatalf("wrong swap arguments: %+v", intent.Args)
+	}
+	if intent, _ := subs.add(swap, now); intent != nil {
+		t.Fatalf("was expecting the intent to be published once")
+	}
+
+	// Included
+	updated := subs.block(100, []*types.Transaction{sign(0, common.Address{9}, nil), swap})
+	if len(updated) != 1 || updated[0].Status != messages.EVMTransactionMined || updated[0].BlockNumber != 100 {
+		t.Fatalf("was expecting the swap to be included")
+	}
+
+	// Replaced by another transaction with the same nonce
+	swap = sign(2, router, data)
+	if _, err := subs.add(swap, now); err != nil {
+		t.Fatal(err)
+	}
+	updated = subs.block(101, []*types.Transaction{sign(2, common.Address{9}, nil)})
+	if len(updated) != 1 || updated[0].Status != messages.EVMTransactionDropped {
+		t.Fatalf("was expecting the swap to be dropped")
+	}
+
+	// Expired
+	if _, err := subs.add(sign(3, router, data), now); err != nil {
+		t.Fatal(err)
+	}
+	if updated := subs.expire(now.Add(time.Minute)); len(updated) != 0 {
+		t.Fatalf("was expecting the swap to be pending")
+	}
+	if updated := subs.expire(now.Add(maxPendingTime + time.Minute)); len(updated) != 1 || len(subs.intents) != 0 {
+		t.Fatalf("was expecting the swap to be dropped")
+	}
+}
+
+func TestInclusionRange(t *testing.T) {
+	if from, to := inclusionRange(100, 103); from != 101 || to != 103 {
+		t.Fatalf("was expecting blocks 101 to 103, got %d to %d", from, to)
+	}
+	// Far behind, the scan resumes from the last block over the next flushes
+	last, head := uint64(100), uint64(135)
+	for last < head {
+		from, to := inclusionRange(last, head)
+		if from != last+1 || to-from+1 > maxInclusionCatchUp {
+			t.Fatalf("was expecting at most %d blocks from %d, got %d to %d", maxInclusionCatchUp, last+1, from, to)
+		}
+		last = to
+	}
+	if from, to := inclusionRange(head, head); from <= to {
+		t.Fatalf("was expecting no block to scan, got %d to %d", from, to)
+	}
+}
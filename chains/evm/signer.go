@@ -0,0 +1,44 @@
+package evm
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// A Signer signs the transactions of an account, the executor can be given
+// signers backed by an external key store in place of the configured keys
+type Signer interface {
+	Address() common.Address
+	SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+type keySigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+// NewKeySigner returns a signer from an hex encoded private key
+func NewKeySigner(hexKey string) (Signer, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing private key: %v", err)
+	}
+	return &keySigner{
+		key:     key,
+		address: crypto.PubkeyToAddress(key.PublicKey),
+	}, nil
+}
+
+func (s *keySigner) Address() common.Address {
+	return s.address
+}
+
+func (s *keySigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), s.key)
+}
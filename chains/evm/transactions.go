@@ -0,0 +1,115 @@
+package evm
+
+import (
+	"math/big"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+)
+
+// The executor signs and submits the transactions of its accounts. Nonces are assigned
+// locally, starting from the pending nonce of the account on the chain, so transactions
+// can be submitted without waiting for the previous ones to be mined. A transaction is
+// tracked until it is confirmed, along with the replacements sharing its nonce.
+
+const transferGas = 21000
+
+type account struct {
+	signer Signer
+	nonce  uint64 // Next nonce
+	synced bool   // Whether the nonce was fetched from the chain
+	txs    map[uint64]*trackedTx
+}
+
+type trackedTx struct {
+	requestID     uint64
+	subscriber    *actor.PID
+	confirmations uint64
+	txs           []*types.Transaction // The transaction followed by its replacements
+	status        messages.EVMTransactionStatus
+	receipt       *types.Receipt
+	seqNum        uint64
+}
+
+func (t *trackedTx) nonce() uint64 {
+	return t.txs[0].Nonce()
+}
+
+func (t *trackedTx) last() *types.Transaction {
+	return t.txs[len(t.txs)-1]
+}
+
+func (t *trackedTx) done() bool {
+	return t.status == messages.EVMTransactionConfirmed ||
+		t.status == messages.EVMTransactionReverted ||
+		t.status == messages.EVMTransactionDropped
+}
+
+// update sets the status from the receipt of the mined transaction, nil if none of the
+// transactions is mined, and from the nonce of the account at the confirmation depth.
+// It returns whether the status changed.
+func (t *trackedTx) update(receipt *types.Receipt, head uint64, confirmedNonce uint64) bool {
+	prev := t.status
+	t.receipt = receipt
+	if receipt == nil {
+		if confirmedNonce > t.nonce() {
+			// The nonce was used by a transaction we don't know of
+			t.status = messages.EVMTransactionDropped
+		} else {
+			// Not mined yet, or mined in an orphaned block
+			t.status = messages.EVMTransactionPending
+		}
+	} else if isConfirmed(receipt.BlockNumber.Uint64(), head, t.confirmations) {
+		if receipt.Status == types.ReceiptStatusFailed {
+			t.status = messages.EVMTransactionReverted
+		} else {
+			t.status = messages.EVMTransactionConfirmed
+		}
+	} else {
+		t.status = messages.EVMTransactionMined
+	}
+	return t.status != prev
+}
+
+// bumpFee returns the fee of a replacement, raised by 12.5% plus one wei, above
+// the 10% nodes require, and at least the suggested fee
+func bumpFee(fee, suggested *big.Int) *big.Int {
+	bumped := new(big.Int).Div(fee, big.NewInt(8))
+	bumped.Add(bumped, fee)
+	bumped.Add(bumped, big.NewInt(1))
+	if suggested != nil && suggested.Cmp(bumped) > 0 {
+		return new(big.Int).Set(suggested)
+	}
+	return bumped
+}
+
+// replacement returns the transaction replacing tx with higher fees, or an empty transfer to self
+// with higher fees when cancelling. The suggested fees are nil for chains without EIP-1559.
+func replacement(tx *types.Transaction, from common.Address, cancel bool, suggestedTip, suggestedFeeCap *big.Int) *types.Transaction {
+	to, value, data, gas := tx.To(), tx.Value(), tx.Data(), tx.Gas()
+	if cancel {
+		to, value, data, gas = &from, new(big.Int), nil, transferGas
+	}
+	if tx.Type() == types.LegacyTxType {
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: bumpFee(tx.GasPrice(), suggestedFeeCap),
+			Gas:      gas,
+			To:       to,
+			Value:    value,
+			Data:     data,
+		})
+	}
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   tx.ChainId(),
+		Nonce:     tx.Nonce(),
+		GasTipCap: bumpFee(tx.GasTipCap(), suggestedTip),
+		GasFeeCap: bumpFee(tx.GasFeeCap(), suggestedFeeCap),
+		Gas:       gas,
+		To:        to,
+		Value:     value,
+		Data:      data,
+	})
+}
@@ -0,0 +1,82 @@
+package evm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+)
+
+func TestTrackedTxUpdate(t *testing.T) {
+	tx := types.NewTx(&types.DynamicFeeTx{Nonce: 5})
+	tracked := &trackedTx{
+		confirmations: 3,
+		txs:           []*types.Transaction{tx},
+		status:        messages.EVMTransactionPending,
+	}
+	if tracked.update(nil, 100, 5) {
+		t.Fatalf("was expecting the transaction to stay pending")
+	}
+	receipt := &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(100),
+	}
+	if !tracked.update(receipt, 100, 0) || tracked.status != messages.EVMTransactionMined {
+		t.Fatalf("was expecting the transaction to be mined")
+	}
+	if tracked.update(receipt, 102, 0) || tracked.status != messages.EVMTransactionMined {
+		t.Fatalf("was expecting the transaction to stay mined")
+	}
+	if !tracked.update(receipt, 103, 0) || tracked.status != messages.EVMTransactionConfirmed || !tracked.done() {
+		t.Fatalf("was expecting the transaction to be confirmed")
+	}
+
+	tracked.status = messages.EVMTransactionMined
+	receipt.Status = types.ReceiptStatusFailed
+	if !tracked.update(receipt, 103, 0) || tracked.status != messages.EVMTransactionReverted {
+		t.Fatalf("was expecting the transaction to be reverted")
+	}
+
+	// Mined in an orphaned block
+	tracked.status = messages.EVMTransactionMined
+	if !tracked.update(nil, 102, 5) || tracked.status != messages.EVMTransactionPending {
+		t.Fatalf("was expecting the transaction to be pending again")
+	}
+	if !tracked.update(nil, 102, 6) || tracked.status != messages.EVMTransactionDropped {
+		t.Fatalf("was expecting the transaction to be dropped")
+	}
+}
+
+func TestReplacement(t *testing.T) {
+	from := common.Address{1}
+	to := common.Address{2}
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(1337),
+		Nonce:     5,
+		GasTipCap: big.NewInt(100),
+		GasFeeCap: big.NewInt(1000),
+		Gas:       50000,
+		To:        &to,
+		Value:     big.NewInt(10),
+		Data:      []byte{1},
+	})
+	speedUp := replacement(tx, from, false, big.NewInt(10), big.NewInt(2000))
+	if speedUp.Nonce() != 5 || *speedUp.To() != to || speedUp.Gas() != 50000 {
+		t.Fatalf("was expecting the same transaction")
+	}
+	if speedUp.GasTipCap().Int64() != 113 || speedUp.GasFeeCap().Int64() != 2000 {
+		t.Fatalf("wrong fees: %s %s", speedUp.GasTipCap(), speedUp.GasFeeCap())
+	}
+
+	cancel := replacement(tx, from, true, nil, nil)
+	if *cancel.To() != from || cancel.Value().Sign() != 0 || len(cancel.Data()) != 0 || cancel.Gas() != transferGas {
+		t.Fatalf("was expecting an empty transfer to self")
+	}
+
+	legacy := types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(80), Gas: transferGas, To: &to})
+	if bumped := replacement(legacy, from, false, nil, big.NewInt(50)); bumped.Type() != types.LegacyTxType || bumped.GasPrice().Int64() != 91 {
+		t.Fatalf("wrong legacy replacement")
+	}
+}
@@ -1,6 +1,9 @@
 package chains
 
 import (
+	"fmt"
+
+	"gitlab.com/alphaticks/alpha-connect/chains/evm"
 	"gitlab.com/alphaticks/alpha-connect/config"
 	"gitlab.com/alphaticks/alpha-connect/models/messages"
 	registry "gitlab.com/alphaticks/alpha-public-registry-grpc"
@@ -19,20 +22,24 @@ type Executor struct {
 	cfg       *config.Config
 	registry  registry.StaticClient
 	executors map[uint32]*actor.PID // A map from exchange ID to executor
+	signers   []evm.Signer          // Signers of the EVM transactions, on all the EVM chains
 	logger    *log.Logger
 	strict    bool
 }
 
-func NewExecutorProducer(cfg *config.Config, registry registry.StaticClient) actor.Producer {
+// NewExecutorProducer returns the chains executor, the signers are added
+// to the ones of the private keys of the config
+func NewExecutorProducer(cfg *config.Config, registry registry.StaticClient, signers ...evm.Signer) actor.Producer {
 	return func() actor.Actor {
-		return NewExecutor(cfg, registry)
+		return NewExecutor(cfg, registry, signers...)
 	}
 }
 
-func NewExecutor(cfg *config.Config, registry registry.StaticClient) actor.Actor {
+func NewExecutor(cfg *config.Config, registry registry.StaticClient, signers ...evm.Signer) actor.Actor {
 	return &Executor{
 		cfg:      cfg,
 		registry: registry,
+		signers:  signers,
 	}
 }
 
@@ -83,6 +90,31 @@ func (state *Executor) Receive(context actor.Context) {
 			state.logger.Error("error processing OnEVMContractCallRequest", log.Error(err))
 			panic(err)
 		}
+	case *messages.EVMBalanceRequest:
+		if err := state.OnEVMBalanceRequest(context); err != nil {
+			state.logger.Error("error processing OnEVMBalanceRequest", log.Error(err))
+			panic(err)
+		}
+	case *messages.EVMTransactionRequest:
+		if err := state.OnEVMTransactionRequest(context); err != nil {
+			state.logger.Error("error processing OnEVMTransactionRequest", log.Error(err))
+			panic(err)
+		}
+	case *messages.EVMTransactionReplaceRequest:
+		if err := state.OnEVMTransactionReplaceRequest(context); err != nil {
+			state.logger.Error("error processing OnEVMTransactionReplaceRequest", log.Error(err))
+			panic(err)
+		}
+	case *messages.EVMPendingTxSubscribeRequest:
+		if err := state.OnEVMPendingTxSubscribeRequest(context); err != nil {
+			state.logger.Error("error processing OnEVMPendingTxSubscribeRequest", log.Error(err))
+			panic(err)
+		}
+	case *messages.EVMGasSubscribeRequest:
+		if err := state.OnEVMGasSubscribeRequest(context); err != nil {
+			state.logger.Error("error processing OnEVMGasSubscribeRequest", log.Error(err))
+			panic(err)
+		}
 	case *messages.SVMEventsQueryRequest:
 		if err := state.OnSVMEventsQueryRequest(context); err != nil {
 			state.logger.Error("error processing OnSVMEventsQueryRequest", log.Error(err))
@@ -119,6 +151,13 @@ func (state *Executor) Initialize(context actor.Context) error {
 		log.String("type", reflect.TypeOf(*state).String()))
 
 	state.executors = make(map[uint32]*actor.PID)
+	for _, key := range state.cfg.SignerKeys {
+		signer, err := evm.NewKeySigner(key)
+		if err != nil {
+			return fmt.Errorf("error creating signer: %v", err)
+		}
+		state.signers = append(state.signers, signer)
+	}
 	return nil
 }
 
@@ -234,6 +273,111 @@ func (state *Executor) OnEVMContractCallRequest(context actor.Context) error {
 	return nil
 }
 
+func (state *Executor) OnEVMBalanceRequest(context actor.Context) error {
+	req := context.Message().(*messages.EVMBalanceRequest)
+	if req.Chain == nil {
+		context.Respond(&messages.EVMBalanceResponse{
+			RequestID:       req.RequestID,
+			Success:         false,
+			RejectionReason: messages.RejectionReason_UnknownChain,
+		})
+		return nil
+	}
+	if rej := state.forward(context, req.Chain); rej != nil {
+		context.Respond(&messages.EVMBalanceResponse{
+			RequestID:       req.RequestID,
+			Success:         false,
+			RejectionReason: *rej,
+		})
+		return nil
+	}
+	return nil
+}
+
+func (state *Executor) OnEVMTransactionRequest(context actor.Context) error {
+	req := context.Message().(*messages.EVMTransactionRequest)
+	if req.Chain == nil {
+		context.Respond(&messages.EVMTransactionResponse{
+			RequestID:       req.RequestID,
+			Success:         false,
+			RejectionReason: messages.RejectionReason_UnknownChain,
+		})
+		return nil
+	}
+	if rej := state.forward(context, req.Chain); rej != nil {
+		context.Respond(&messages.EVMTransactionResponse{
+			RequestID:       req.RequestID,
+			Success:         false,
+			RejectionReason: *rej,
+		})
+		return nil
+	}
+	return nil
+}
+
+func (state *Executor) OnEVMTransactionReplaceRequest(context actor.Context) error {
+	req := context.Message().(*messages.EVMTransactionReplaceRequest)
+	if req.Chain == nil {
+		context.Respond(&messages.EVMTransactionReplaceResponse{
+			RequestID:       req.RequestID,
+			Success:         false,
+			RejectionReason: messages.RejectionReason_UnknownChain,
+		})
+		return nil
+	}
+	if rej := state.forward(context, req.Chain); rej != nil {
+		context.Respond(&messages.EVMTransactionReplaceResponse{
+			RequestID:       req.RequestID,
+			Success:         false,
+			RejectionReason: *rej,
+		})
+		return nil
+	}
+	return nil
+}
+
+func (state *Executor) OnEVMPendingTxSubscribeRequest(context actor.Context) error {
+	req := context.Message().(*messages.EVMPendingTxSubscribeRequest)
+	if req.Chain == nil {
+		context.Respond(&messages.EVMPendingTxSubscribeResponse{
+			RequestID:       req.RequestID,
+			Success:         false,
+			RejectionReason: messages.RejectionReason_UnknownChain,
+		})
+		return nil
+	}
+	if rej := state.forward(context, req.Chain); rej != nil {
+		context.Respond(&messages.EVMPendingTxSubscribeResponse{
+			RequestID:       req.RequestID,
+			Success:         false,
+			RejectionReason: *rej,
+		})
+		return nil
+	}
+	return nil
+}
+
+func (state *Executor) OnEVMGasSubscribeRequest(context actor.Context) error {
+	req := context.Message().(*messages.EVMGasSubscribeRequest)
+	if req.Chain == nil {
+		context.Respond(&messages.EVMGasSubscribeResponse{
+			RequestID:       req.RequestID,
+			Success:         false,
+			RejectionReason: messages.RejectionReason_UnknownChain,
+		})
+		return nil
+	}
+	if rej := state.forward(context, req.Chain); rej != nil {
+		context.Respond(&messages.EVMGasSubscribeResponse{
+			RequestID:       req.RequestID,
+			Success:         false,
+			RejectionReason: *rej,
+		})
+		return nil
+	}
+	return nil
+}
+
 func (state *Executor) OnSVMEventsQueryRequest(context actor.Context) error {
 	req := context.Message().(*messages.SVMEventsQueryRequest)
 	if req.Chain == nil {
@@ -347,18 +491,18 @@ func (state *Executor) OnSVMTransactionByHashRequest(context actor.Context) erro
 func (state *Executor) forward(context actor.Context, chain *models2.Chain) *messages.RejectionReason {
 	pid, ok := state.executors[chain.ID]
 	if !ok {
-		// Find the rpc
-		rpc := ""
+		// Find the rpc endpoints
+		var rpcs []config.ChainRPC
 		for _, r := range state.cfg.ChainRPCs {
-			if r.Chain == chain.ID {
-				rpc = r.Endpoint
+			if r.Chain == chain.ID && r.Endpoint != "" {
+				rpcs = append(rpcs, r)
 			}
 		}
-		if rpc == "" {
+		if len(rpcs) == 0 {
 			tmp := messages.RejectionReason_UnknownChain
 			return &tmp
 		}
-		producer := NewChainExecutorProducer(chain, rpc, state.registry)
+		producer := NewChainExecutorProducer(chain, rpcs, state.signers, state.registry)
 		if producer == nil {
 			tmp := messages.RejectionReason_UnknownChain
 			return &tmp
@@ -18,8 +18,13 @@ type Executor interface {
 	OnBlockNumberRequest(context actor.Context) error
 	OnBlockInfoRequest(context actor.Context) error
 	OnEVMContractCallRequest(context actor.Context) error
+	OnEVMBalanceRequest(context actor.Context) error
 	OnEVMLogsQueryRequest(context actor.Context) error
 	OnEVMLogsSubscribeRequest(context actor.Context) error
+	OnEVMTransactionRequest(context actor.Context) error
+	OnEVMTransactionReplaceRequest(context actor.Context) error
+	OnEVMPendingTxSubscribeRequest(context actor.Context) error
+	OnEVMGasSubscribeRequest(context actor.Context) error
 	OnSVMEventsQueryRequest(context actor.Context) error
 	OnSVMContractCallRequest(context actor.Context) error
 	OnSVMContractClassRequest(context actor.Context) error
@@ -76,6 +81,12 @@ func ReceiveExecutor(state Executor, context actor.Context) {
 			panic(err)
 		}
 
+	case *messages.EVMBalanceRequest:
+		if err := state.OnEVMBalanceRequest(context); err != nil {
+			state.GetLogger().Error("error processing OnEVMBalanceRequest", log.Error(err))
+			panic(err)
+		}
+
 	case *messages.EVMLogsQueryRequest:
 		if err := state.OnEVMLogsQueryRequest(context); err != nil {
 			state.GetLogger().Error("error processing OnEVMLogsQueryRequest", log.Error(err))
@@ -88,6 +99,30 @@ func ReceiveExecutor(state Executor, context actor.Context) {
 			panic(err)
 		}
 
+	case *messages.EVMTransactionRequest:
+		if err := state.OnEVMTransactionRequest(context); err != nil {
+			state.GetLogger().Error("error processing OnEVMTransactionRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.EVMTransactionReplaceRequest:
+		if err := state.OnEVMTransactionReplaceRequest(context); err != nil {
+			state.GetLogger().Error("error processing OnEVMTransactionReplaceRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.EVMPendingTxSubscribeRequest:
+		if err := state.OnEVMPendingTxSubscribeRequest(context); err != nil {
+			state.GetLogger().Error("error processing OnEVMPendingTxSubscribeRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.EVMGasSubscribeRequest:
+		if err := state.OnEVMGasSubscribeRequest(context); err != nil {
+			state.GetLogger().Error("error processing OnEVMGasSubscribeRequest", log.Error(err))
+			panic(err)
+		}
+
 	case *messages.SVMEventsQueryRequest:
 		if err := state.OnSVMEventsQueryRequest(context); err != nil {
 			state.GetLogger().Error("error processing OnSVMEventsQueryRequest", log.Error(err))
@@ -153,6 +188,17 @@ func (state *BaseExecutor) OnEVMContractCallRequest(context actor.Context) error
 	return nil
 }
 
+func (state *BaseExecutor) OnEVMBalanceRequest(context actor.Context) error {
+	req := context.Message().(*messages.EVMBalanceRequest)
+	context.Respond(&messages.EVMBalanceResponse{
+		RequestID:       req.RequestID,
+		ResponseID:      uint64(time.Now().UnixNano()),
+		Success:         false,
+		RejectionReason: messages.RejectionReason_UnsupportedRequest,
+	})
+	return nil
+}
+
 func (state *BaseExecutor) OnEVMLogsQueryRequest(context actor.Context) error {
 	req := context.Message().(*messages.EVMLogsQueryRequest)
 	context.Respond(&messages.EVMLogsQueryResponse{
@@ -175,6 +221,50 @@ func (state *BaseExecutor) OnEVMLogsSubscribeRequest(context actor.Context) erro
 	return nil
 }
 
+func (state *BaseExecutor) OnEVMTransactionRequest(context actor.Context) error {
+	req := context.Message().(*messages.EVMTransactionRequest)
+	context.Respond(&messages.EVMTransactionResponse{
+		RequestID:       req.RequestID,
+		ResponseID:      uint64(time.Now().UnixNano()),
+		Success:         false,
+		RejectionReason: messages.RejectionReason_UnsupportedRequest,
+	})
+	return nil
+}
+
+func (state *BaseExecutor) OnEVMTransactionReplaceRequest(context actor.Context) error {
+	req := context.Message().(*messages.EVMTransactionReplaceRequest)
+	context.Respond(&messages.EVMTransactionReplaceResponse{
+		RequestID:       req.RequestID,
+		ResponseID:      uint64(time.Now().UnixNano()),
+		Success:         false,
+		RejectionReason: messages.RejectionReason_UnsupportedRequest,
+	})
+	return nil
+}
+
+func (state *BaseExecutor) OnEVMPendingTxSubscribeRequest(context actor.Context) error {
+	req := context.Message().(*messages.EVMPendingTxSubscribeRequest)
+	context.Respond(&messages.EVMPendingTxSubscribeResponse{
+		RequestID:       req.RequestID,
+		ResponseID:      uint64(time.Now().UnixNano()),
+		Success:         false,
+		RejectionReason: messages.RejectionReason_UnsupportedRequest,
+	})
+	return nil
+}
+
+func (state *BaseExecutor) OnEVMGasSubscribeRequest(context actor.Context) error {
+	req := context.Message().(*messages.EVMGasSubscribeRequest)
+	context.Respond(&messages.EVMGasSubscribeResponse{
+		RequestID:       req.RequestID,
+		ResponseID:      uint64(time.Now().UnixNano()),
+		Success:         false,
+		RejectionReason: messages.RejectionReason_UnsupportedRequest,
+	})
+	return nil
+}
+
 func (state *BaseExecutor) OnSVMEventsQueryRequest(context actor.Context) error {
 	req := context.Message().(*messages.SVMEventsQueryRequest)
 	context.Respond(&messages.SVMEventsQueryResponse{
@@ -27,6 +27,10 @@ type Config struct {
 	Exchanges              []string
 	Protocols              []string
 	ChainRPCs              []ChainRPC
+	SignerKeys             []string // Hex private keys of the accounts submitting EVM transactions
+	PersistedBars          []uint64
+	Synthetics             []Synthetic
+	UniswapV2Forks         []UniswapV2Fork
 	DB                     *DataBase
 	Store                  *config.StoreClient
 }
@@ -47,6 +51,26 @@ type Account struct {
 	SOCKS5           string
 	FillCollector    bool
 	MakerFees        *float64
+	// On-chain wallets are accounts with a chain and an address in place of an exchange
+	Chain       uint32
+	Address     string
+	NativeAsset string   // Symbol of the native asset of the chain
+	Tokens      []string // Symbols of the ERC-20 tokens held by the wallet
+	// Uniswap V3 liquidity positions followed by the account, either a position
+	// NFT token ID, or pool:tickLower:tickUpper for a position owned by the address
+	Positions []string
+}
+
+// A Uniswap V2 fork is an exchange running the Uniswap V2 pair contracts, such as
+// Sushiswap with the factory 0xC0AEe478e3658e2610c5F7A4A2E1777cE9e4f2Ac and the
+// subgraph https://api.thegraph.com/subgraphs/name/sushiswap/exchange
+
+type UniswapV2Fork struct {
+	Exchange string // Name of the exchange of the fork
+	Chain    uint32
+	Factory  string // Address of the factory creating the pairs
+	Subgraph string // URL of the subgraph listing the pairs
+	Fee      float64
 }
 
 type DataBase struct {
@@ -58,9 +82,72 @@ type DataBase struct {
 	PostgresPort     string
 }
 
+// DSN returns the data source name of the Postgres database
+func (db *DataBase) DSN() string {
+	return fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable TimeZone=Asia/Shanghai",
+		db.PostgresHost,
+		db.PostgresUser,
+		db.PostgresPassword,
+		db.PostgresDB,
+		db.PostgresPort)
+}
+
+// A chain can have multiple RPC endpoints, the requests are
+// balanced over them and fail over when one is unhealthy
+
 type ChainRPC struct {
-	Chain    uint32
-	Endpoint string
+	Chain     uint32
+	Endpoint  string
+	RateLimit int // Max requests per second on the endpoint, 0 for no limit
+}
+
+// alchemyRPCs are the Alchemy endpoints by chain, without API key
+var alchemyRPCs = map[uint32]string{
+	1:     "wss://eth-mainnet.g.alchemy.com/v2/",
+	5:     "wss://eth-goerli.g.alchemy.com/v2/",
+	10:    "wss://opt-mainnet.g.alchemy.com/v2/",
+	147:   "wss://polygon-mainnet.g.alchemy.com/v2/",
+	42161: "wss://arb-mainnet.g.alchemy.com/v2/",
+}
+
+// defaultRPCs returns the default endpoints, the Alchemy ones only given an API key
+func defaultRPCs(alchemyKey string) []ChainRPC {
+	rpcs := []ChainRPC{
+		{Chain: 6, Endpoint: "wss://zksync2-testnet.zksync.dev/ws"},
+		{Chain: 1337, Endpoint: "http://127.0.0.1:7545"}, // Ganache
+	}
+	if alchemyKey != "" {
+		for chain, url := range alchemyRPCs {
+			rpcs = append(rpcs, ChainRPC{Chain: chain, Endpoint: url + alchemyKey})
+		}
+	}
+	return rpcs
+}
+
+// withDefaultRPCs adds the default endpoints of the chains without configured endpoints
+func withDefaultRPCs(rpcs, defaults []ChainRPC) []ChainRPC {
+	configured := make(map[uint32]bool)
+	for _, rpc := range rpcs {
+		configured[rpc.Chain] = true
+	}
+	for _, rpc := range defaults {
+		if !configured[rpc.Chain] {
+			rpcs = append(rpcs, rpc)
+		}
+	}
+	return rpcs
+}
+
+type Synthetic struct {
+	Symbol string
+	Legs   []SyntheticLeg
+}
+
+type SyntheticLeg struct {
+	SecurityID   uint64
+	Weight       float64
+	FXSecurityID uint64 // Optional security converting the leg quote to the synthetic quote
+	FXInverse    bool   // Convert with the inverse of the FX price
 }
 
 func LoadConfig() (*Config, error) {
@@ -96,21 +183,13 @@ func LoadConfig() (*Config, error) {
 	}
 
 	C := &Config{
-		ChainRPCs: []ChainRPC{
-			{Chain: 1, Endpoint: "wss://eth-mainnet.alchemyapi.io/v2/4kjvftiD6NzHc6kkD1ih3-5wilV--3mz"},
-			{Chain: 5, Endpoint: "wss://eth-goerli.g.alchemy.com/v2/YYQHtZhi0iJfppMa41Xj3sUmWuKMxhOf"},
-			{Chain: 6, Endpoint: "wss://zksync2-testnet.zksync.dev/ws"},
-			{Chain: 10, Endpoint: "wss://opt-mainnet.g.alchemy.com/v2/MAdidiXxtFnW5b4q9pTmBLcTW73SHoMN"},
-			{Chain: 147, Endpoint: "wss://polygon-mainnet.g.alchemy.com/v2/PYNN12EJrMrmlxWjy9KZyrYK6GHrErCM"},
-			{Chain: 42161, Endpoint: "wss://arb-mainnet.g.alchemy.com/v2/aFDxH7pilsr6I5Ifqp59wVNu_0pd9PaC"},
-			{Chain: 1337, Endpoint: "http://127.0.0.1:7545"}, // Ganache
-		},
 		RegistryAddress: "registry.alphaticks.io:8021",
 		StaticLoader:    true,
 	}
 	if err := viper.Unmarshal(C); err != nil {
 		return nil, fmt.Errorf("error unmarshalling config: %v", err)
 	}
+	C.ChainRPCs = withDefaultRPCs(C.ChainRPCs, defaultRPCs(os.Getenv("ALCHEMY_API_KEY")))
 
 	// Overwrite
 	if os.Getenv("DIALER_POOL_INTERFACE") != "" {
@@ -7,6 +7,7 @@ const (
 	SecurityType_CRYPTO_FUT  string = "CRFUT"
 	SecurityType_CRYPTO_AMM  string = "CRAMM"
 
+	SecuritySubType_UNIPOOLV2 string = "UNIPOOLV2"
 	SecuritySubType_UNIPOOLV3 string = "UNIPOOLV3"
 	SecuritySubType_CALL      string = "CALL"
 	SecuritySubType_PUT       string = "PUT"
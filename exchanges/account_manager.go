@@ -9,6 +9,8 @@ import (
 	"github.com/asynkron/protoactor-go/log"
 	"gitlab.com/alphaticks/alpha-connect/account"
 	"gitlab.com/alphaticks/alpha-connect/config"
+	v3 "gitlab.com/alphaticks/alpha-connect/exchanges/uniswap/v3"
+	"gitlab.com/alphaticks/alpha-connect/exchanges/wallet"
 	"gitlab.com/alphaticks/alpha-connect/models/messages"
 	registry "gitlab.com/alphaticks/alpha-public-registry-grpc"
 	tickstore_types "gitlab.com/alphaticks/tickstore-types"
@@ -29,6 +31,7 @@ type AccountManager struct {
 	trdSubscribers  map[uint64]*actor.PID
 	blcSubscribers  map[uint64]*actor.PID
 	listener        *actor.PID
+	positions       *actor.PID
 	reconcile       *actor.PID
 	logger          *log.Logger
 	paperTrading    bool
@@ -146,6 +149,8 @@ func (state *AccountManager) Initialize(context actor.Context) error {
 			if listenerProducer == nil {
 				return fmt.Errorf("error getting account listener")
 			}
+		} else if state.account.Chain != nil {
+			listenerProducer = wallet.NewAccountListenerProducer(state.account, state.NativeAsset, state.Tokens)
 		} else {
 			listenerProducer = NewAccountListenerProducer(state.account, state.registry, state.db, state.client, state.ReadOnly)
 			if listenerProducer == nil {
@@ -159,6 +164,16 @@ func (state *AccountManager) Initialize(context actor.Context) error {
 			return fmt.Errorf("error spawning account listener: %s", err)
 		}
 		state.listener = listener
+
+		// The liquidity positions of a wallet are followed alongside its balances
+		if !state.paperTrading && state.account.Chain != nil && len(state.Positions) > 0 {
+			props := actor.PropsFromProducer(v3.NewAccountListenerProducer(state.account, state.Positions), actor.WithMailbox(actor.UnboundedPriorityMpsc()))
+			positions, err := context.SpawnNamed(props, "positions")
+			if err != nil {
+				return fmt.Errorf("error spawning positions listener: %s", err)
+			}
+			state.positions = positions
+		}
 	}
 
 	if state.Reconcile && state.db != nil {
@@ -0,0 +1,390 @@
+package consolidated
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+	gmodels "gitlab.com/alphaticks/gorderbook/gorderbook.models"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// The consolidated book merges the L2 books of the securities of different venues
+// sharing the same security type, base and quote. Prices are adjusted by the taker fee
+// of each venue so that levels can be compared, and are rounded on a common tick: bids
+// down, asks up. Each level keeps the quantity contributed by each venue.
+
+const SecurityType = "CONSOLIDATED"
+
+// SecurityID returns the synthetic security ID of the consolidated book
+// of the securities of a type and base/quote
+func SecurityID(securityType, base, quote string) uint64 {
+	return utils.HashTags(map[string]string{
+		"type":     SecurityType,
+		"sub-type": securityType,
+		"base":     base,
+		"quote":    quote,
+	})
+}
+
+// isMember excludes the dated securities, the books of different maturities
+// aren't of the same instrument
+func isMember(sec *models.Security) bool {
+	return sec.Status == models.InstrumentStatus_Trading &&
+		!sec.IsInverse &&
+		sec.MaturityDate == nil &&
+		sec.Exchange != nil &&
+		sec.Underlying != nil &&
+		sec.QuoteCurrency != nil
+}
+
+// NewSecurities returns the consolidated securities, by security ID, of
+// all the security type and base/quote traded on at least two securities.
+// The type of the members is the sub type of the consolidated security.
+func NewSecurities(securities []*models.Security) map[uint64]*models.Security {
+	members := make(map[uint64][]*models.Security)
+	for _, s := range securities {
+		if !isMember(s) {
+			continue
+		}
+		ID := SecurityID(s.SecurityType, s.Underlying.Symbol, s.QuoteCurrency.Symbol)
+		members[ID] = append(members[ID], s)
+	}
+	consolidated := make(map[uint64]*models.Security)
+	for ID, secs := range members {
+		if len(secs) < 2 {
+			continue
+		}
+		base, quote := secs[0].Underlying, secs[0].QuoteCurrency
+		sec := &models.Security{
+			SecurityID:      ID,
+			SecurityType:    SecurityType,
+			SecuritySubType: wrapperspb.String(secs[0].SecurityType),
+			Symbol:          base.Symbol + "/" + quote.Symbol + " " + secs[0].SecurityType,
+			Underlying:      base,
+			QuoteCurrency:   quote,
+			Status:          models.InstrumentStatus_Trading,
+		}
+		tickPrecision, lotPrecision := precisions(secs)
+		sec.MinPriceIncrement = wrapperspb.Double(1. / float64(tickPrecision))
+		sec.RoundLot = wrapperspb.Double(1. / float64(lotPrecision))
+		consolidated[ID] = sec
+	}
+	return consolidated
+}
+
+// Members returns the securities merged in the consolidated security
+func Members(idx *utils.TagIndex, sec *models.Security) ([]*models.Security, error) {
+	if sec.SecuritySubType == nil {
+		return nil, fmt.Errorf("consolidated security without member type")
+	}
+	secs, err := idx.Query(map[string]string{
+		"type":  "^" + regexp.QuoteMeta(sec.SecuritySubType.Value) + "$",
+		"base":  "^" + regexp.QuoteMeta(sec.Underlying.Symbol) + "$",
+		"quote": "^" + regexp.QuoteMeta(sec.QuoteCurrency.Symbol) + "$",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error querying tag index: %v", err)
+	}
+	var members []*models.Security
+	for _, s := range secs {
+		if isMember(s) {
+			members = append(members, s)
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].SecurityID < members[j].SecurityID
+	})
+	return members, nil
+}
+
+// precisions returns a tick precision finer than the tick of all the securities
+// so that the fee adjusted prices of the venues remain distinct, and the finest lot precision
+func precisions(securities []*models.Security) (uint64, uint64) {
+	var tickPrecision, lotPrecision uint64 = 1, 1
+	for _, s := range securities {
+		if s.MinPriceIncrement != nil && s.MinPriceIncrement.Value > 0 {
+			tp := uint64(math.Ceil(1. / s.MinPriceIncrement.Value))
+			if tp > tickPrecision {
+				tickPrecision = tp
+			}
+		}
+		if s.RoundLot != nil && s.RoundLot.Value > 0 {
+			lp := uint64(math.Ceil(1. / s.RoundLot.Value))
+			if lp > lotPrecision {
+				lotPrecision = lp
+			}
+		}
+	}
+	return tickPrecision * 100, lotPrecision
+}
+
+type venue struct {
+	fee  float64
+	bids map[float64]float64 // Venue price to quantity
+	asks map[float64]float64
+}
+
+type Book struct {
+	TickPrecision uint64
+	LotPrecision  uint64
+	venues        map[uint64]*venue
+	bids          map[uint64]map[uint64]float64 // Tick to security ID to quantity
+	asks          map[uint64]map[uint64]float64
+}
+
+func NewBook(securities []*models.Security) *Book {
+	tickPrecision, lotPrecision := precisions(securities)
+	b := &Book{
+		TickPrecision: tickPrecision,
+		LotPrecision:  lotPrecision,
+		venues:        make(map[uint64]*venue),
+		bids:          make(map[uint64]map[uint64]float64),
+		asks:          make(map[uint64]map[uint64]float64),
+	}
+	for _, s := range securities {
+		var fee float64
+		if s.TakerFee != nil {
+			fee = s.TakerFee.Value
+		}
+		b.venues[s.SecurityID] = &venue{
+			fee:  fee,
+			bids: make(map[float64]float64),
+			asks: make(map[float64]float64),
+		}
+	}
+	return b
+}
+
+// tick returns the tick of the fee adjusted price of a venue level, the
+// epsilon prevents float errors from moving exact prices to the next tick
+func (b *Book) tick(v *venue, price float64, bid bool) uint64 {
+	tp := float64(b.TickPrecision)
+	if bid {
+		return uint64(math.Floor(price*(1-v.fee)*tp + 1e-6))
+	} else {
+		return uint64(math.Ceil(price*(1+v.fee)*tp - 1e-6))
+	}
+}
+
+// Update applies the levels of a venue and returns the updated consolidated
+// levels and the updated venue levels
+func (b *Book) Update(securityID uint64, levels []*gmodels.OrderBookLevel) ([]*gmodels.OrderBookLevel, []*models.VenueLevel, error) {
+	v, ok := b.venues[securityID]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown venue security %d", securityID)
+	}
+	changes := newChangeSet()
+	var venueLevels []*models.VenueLevel
+	for _, l := range levels {
+		venueLevels = append(venueLevels, b.set(v, securityID, l.Price, l.Quantity, l.Bid, changes))
+	}
+	return b.levels(changes), venueLevels, nil
+}
+
+// Trades returns the trades of a venue with their prices adjusted by the fee
+// and rounded like the levels they hit
+func (b *Book) Trades(securityID uint64, trades []*models.AggregatedTrade) ([]*models.AggregatedTrade, error) {
+	v, ok := b.venues[securityID]
+	if !ok {
+		return nil, fmt.Errorf("unknown venue security %d", securityID)
+	}
+	adjusted := make([]*models.AggregatedTrade, len(trades))
+	for i, aggTrade := range trades {
+		adjusted[i] = &models.AggregatedTrade{
+			Bid:         aggTrade.Bid,
+			Timestamp:   aggTrade.Timestamp,
+			AggregateID: aggTrade.AggregateID,
+			Trades:      make([]*models.Trade, len(aggTrade.Trades)),
+		}
+		for j, trd := range aggTrade.Trades {
+			adjusted[i].Trades[j] = &models.Trade{
+				Price:    float64(b.tick(v, trd.Price, aggTrade.Bid)) / float64(b.TickPrecision),
+				Quantity: trd.Quantity,
+				ID:       trd.ID,
+			}
+		}
+	}
+	return adjusted, nil
+}
+
+// Sync replaces the book of a venue
+func (b *Book) Sync(securityID uint64, bids, asks []*gmodels.OrderBookLevel) ([]*gmodels.OrderBookLevel, []*models.VenueLevel, error) {
+	v, ok := b.venues[securityID]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown venue security %d", securityID)
+	}
+	changes := newChangeSet()
+	var venueLevels []*models.VenueLevel
+	for p := range v.bids {
+		venueLevels = append(venueLevels, b.set(v, securityID, p, 0, true, changes))
+	}
+	for p := range v.asks {
+		venueLevels = append(venueLevels, b.set(v, securityID, p, 0, false, changes))
+	}
+	for _, l := range bids {
+		venueLevels = append(venueLevels, b.set(v, securityID, l.Price, l.Quantity, true, changes))
+	}
+	for _, l := range asks {
+		venueLevels = append(venueLevels, b.set(v, securityID, l.Price, l.Quantity, false, changes))
+	}
+	return b.levels(changes), venueLevels, nil
+}
+
+// Clear removes all the levels of a venue
+func (b *Book) Clear(securityID uint64) ([]*gmodels.OrderBookLevel, []*models.VenueLevel, error) {
+	return b.Sync(securityID, nil, nil)
+}
+
+func (b *Book) set(v *venue, securityID uint64, price, quantity float64, bid bool, changes *changeSet) *models.VenueLevel {
+	venueLevels, levels := v.asks, b.asks
+	if bid {
+		venueLevels, levels = v.bids, b.bids
+	}
+	prev := venueLevels[price]
+	if quantity > 0 {
+		venueLevels[price] = quantity
+	} else {
+		delete(venueLevels, price)
+	}
+	tick := b.tick(v, price, bid)
+	qties, ok := levels[tick]
+	if !ok {
+		qties = make(map[uint64]float64)
+		levels[tick] = qties
+	}
+	// Different venue prices might fall on the same tick
+	qty := qties[securityID] - prev + quantity
+	if qty < 0.5/float64(b.LotPrecision) {
+		delete(qties, securityID)
+		if len(qties) == 0 {
+			delete(levels, tick)
+		}
+	} else {
+		qties[securityID] = qty
+	}
+	changes.add(tick, bid)
+
+	return &models.VenueLevel{
+		Price:      float64(tick) / float64(b.TickPrecision),
+		Quantity:   quantity,
+		Bid:        bid,
+		SecurityID: securityID,
+		VenuePrice: price,
+	}
+}
+
+func (b *Book) level(tick uint64, bid bool) *gmodels.OrderBookLevel {
+	levels := b.asks
+	if bid {
+		levels = b.bids
+	}
+	var qty float64
+	for _, q := range levels[tick] {
+		qty += q
+	}
+	return &gmodels.OrderBookLevel{
+		Price:    float64(tick) / float64(b.TickPrecision),
+		Quantity: qty,
+		Bid:      bid,
+	}
+}
+
+func (b *Book) levels(changes *changeSet) []*gmodels.OrderBookLevel {
+	var levels []*gmodels.OrderBookLevel
+	for _, c := range changes.ticks {
+		levels = append(levels, b.level(c.tick, c.bid))
+	}
+	return levels
+}
+
+// GetBids returns the consolidated bids, best first
+func (b *Book) GetBids() []*gmodels.OrderBookLevel {
+	ticks := make([]uint64, 0, len(b.bids))
+	for t := range b.bids {
+		ticks = append(ticks, t)
+	}
+	sort.Slice(ticks, func(i, j int) bool { return ticks[i] > ticks[j] })
+	levels := make([]*gmodels.OrderBookLevel, len(ticks))
+	for i, t := range ticks {
+		levels[i] = b.level(t, true)
+	}
+	return levels
+}
+
+// GetAsks returns the consolidated asks, best first
+func (b *Book) GetAsks() []*gmodels.OrderBookLevel {
+	ticks := make([]uint64, 0, len(b.asks))
+	for t := range b.asks {
+		ticks = append(ticks, t)
+	}
+	sort.Slice(ticks, func(i, j int) bool { return ticks[i] < ticks[j] })
+	levels := make([]*gmodels.OrderBookLevel, len(ticks))
+	for i, t := range ticks {
+		levels[i] = b.level(t, false)
+	}
+	return levels
+}
+
+// GetVenueLevels returns the levels of all the venues
+func (b *Book) GetVenueLevels() []*models.VenueLevel {
+	var levels []*models.VenueLevel
+	for ID, v := range b.venues {
+		for p, q := range v.bids {
+			levels = append(levels, &models.VenueLevel{
+				Price:      float64(b.tick(v, p, true)) / float64(b.TickPrecision),
+				Quantity:   q,
+				Bid:        true,
+				SecurityID: ID,
+				VenuePrice: p,
+			})
+		}
+		for p, q := range v.asks {
+			levels = append(levels, &models.VenueLevel{
+				Price:      float64(b.tick(v, p, false)) / float64(b.TickPrecision),
+				Quantity:   q,
+				Bid:        false,
+				SecurityID: ID,
+				VenuePrice: p,
+			})
+		}
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		if levels[i].SecurityID != levels[j].SecurityID {
+			return levels[i].SecurityID < levels[j].SecurityID
+		}
+		if levels[i].Bid != levels[j].Bid {
+			return levels[i].Bid
+		}
+		return levels[i].VenuePrice < levels[j].VenuePrice
+	})
+	return levels
+}
+
+type tickChange struct {
+	tick uint64
+	bid  bool
+}
+
+// changeSet keeps the updated ticks in order, without duplicates
+type changeSet struct {
+	seen  map[tickChange]bool
+	ticks []tickChange
+}
+
+func newChangeSet() *changeSet {
+	return &changeSet{
+		seen: make(map[tickChange]bool),
+	}
+}
+
+func (c *changeSet) add(tick uint64, bid bool) {
+	tc := tickChange{tick: tick, bid: bid}
+	if !c.seen[tc] {
+		c.seen[tc] = true
+		c.ticks = append(c.ticks, tc)
+	}
+}
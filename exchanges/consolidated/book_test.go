@@ -0,0 +1,162 @@
+package consolidated
+
+import (
+	"math"
+	"testing"
+
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+	gmodels "gitlab.com/alphaticks/gorderbook/gorderbook.models"
+	xmodels "gitlab.com/alphaticks/xchanger/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func newSecurity(ID uint64, exchange string, fee float64) *models.Security {
+	return &models.Security{
+		SecurityID:        ID,
+		SecurityType:      "CRSPOT",
+		Exchange:          &xmodels.Exchange{Name: exchange},
+		Underlying:        &xmodels.Asset{Symbol: "BTC"},
+		QuoteCurrency:     &xmodels.Asset{Symbol: "USDT"},
+		Status:            models.InstrumentStatus_Trading,
+		MinPriceIncrement: wrapperspb.Double(0.01),
+		RoundLot:          wrapperspb.Double(0.001),
+		TakerFee:          wrapperspb.Double(fee),
+	}
+}
+
+func TestNewSecurities(t *testing.T) {
+	inverse := newSecurity(3, "bitmex", 0)
+	inverse.IsInverse = true
+	perp := newSecurity(4, "binance", 0.0004)
+	perp.SecurityType = "CRPERP"
+	future := newSecurity(5, "ftx", 0.0007)
+	future.SecurityType = "CRPERP"
+	future.MaturityDate = timestamppb.Now()
+	secs := NewSecurities([]*models.Security{
+		newSecurity(1, "binance", 0.001),
+		newSecurity(2, "ftx", 0.0007),
+		inverse,
+		perp,
+		future,
+	})
+	if len(secs) != 1 {
+		t.Fatalf("was expecting 1 consolidated security, got %d", len(secs))
+	}
+	sec, ok := secs[SecurityID("CRSPOT", "BTC", "USDT")]
+	if !ok {
+		t.Fatalf("consolidated security not found")
+	}
+	if sec.SecurityType != SecurityType || sec.SecuritySubType.GetValue() != "CRSPOT" || sec.Symbol != "BTC/USDT CRSPOT" {
+		t.Fatalf("wrong consolidated security: %s %s", sec.SecurityType, sec.Symbol)
+	}
+
+	members, err := Members(utils.NewTagIndex([]*models.Security{
+		newSecurity(1, "binance", 0.001),
+		newSecurity(2, "ftx", 0.0007),
+		perp,
+	}), sec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(members) != 2 || members[0].SecurityID != 1 || members[1].SecurityID != 2 {
+		t.Fatalf("wrong members: %v", members)
+	}
+}
+
+func TestBookTrades(t *testing.T) {
+	book := NewBook([]*models.Security{
+		newSecurity(1, "binance", 0.001),
+		newSecurity(2, "ftx", 0),
+	})
+	trades, err := book.Trades(1, []*models.AggregatedTrade{{
+		Bid:    true,
+		Trades: []*models.Trade{{Price: 100, Quantity: 1}},
+	}, {
+		Bid:    false,
+		Trades: []*models.Trade{{Price: 101, Quantity: 2}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Adjusted like the bid and ask levels they hit
+	if trades[0].Trades[0].Price != 99.9 || trades[1].Trades[0].Price != 101.101 {
+		t.Fatalf("wrong adjusted prices: %v %v", trades[0].Trades[0].Price, trades[1].Trades[0].Price)
+	}
+	if trades[1].Trades[0].Quantity != 2 {
+		t.Fatalf("wrong quantity: %v", trades[1].Trades[0].Quantity)
+	}
+	if _, err := book.Trades(3, nil); err == nil {
+		t.Fatalf("was expecting an error on unknown venue")
+	}
+}
+
+func TestBook(t *testing.T) {
+	book := NewBook([]*models.Security{
+		newSecurity(1, "binance", 0.001),
+		newSecurity(2, "ftx", 0),
+	})
+	if book.TickPrecision != 10000 || book.LotPrecision != 1000 {
+		t.Fatalf("wrong precisions: %d %d", book.TickPrecision, book.LotPrecision)
+	}
+	_, _, err := book.Sync(1, []*gmodels.OrderBookLevel{
+		{Price: 100, Quantity: 1, Bid: true},
+	}, []*gmodels.OrderBookLevel{
+		{Price: 101, Quantity: 2, Bid: false},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = book.Sync(2, []*gmodels.OrderBookLevel{
+		{Price: 99.9, Quantity: 3, Bid: true},
+	}, []*gmodels.OrderBookLevel{
+		{Price: 101.1, Quantity: 4, Bid: false},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Both venues are equal after fees
+	bids := book.GetBids()
+	if len(bids) != 1 || bids[0].Price != 99.9 || bids[0].Quantity != 4 {
+		t.Fatalf("wrong consolidated bids: %v", bids)
+	}
+	asks := book.GetAsks()
+	if len(asks) != 2 || asks[0].Price != 101.1 || asks[0].Quantity != 4 || asks[1].Price != 101.101 {
+		t.Fatalf("wrong consolidated asks: %v", asks)
+	}
+
+	levels, venueLevels, err := book.Update(2, []*gmodels.OrderBookLevel{
+		{Price: 99.9, Quantity: 0, Bid: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(levels) != 1 || levels[0].Quantity != 1 {
+		t.Fatalf("wrong consolidated update: %v", levels)
+	}
+	if len(venueLevels) != 1 || venueLevels[0].SecurityID != 2 || venueLevels[0].Quantity != 0 {
+		t.Fatalf("wrong venue update: %v", venueLevels)
+	}
+
+	levels, _, err = book.Clear(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(levels) != 2 {
+		t.Fatalf("was expecting 2 updated levels, got %d", len(levels))
+	}
+	if len(book.GetBids()) != 0 || len(book.GetAsks()) != 1 {
+		t.Fatalf("venue not cleared")
+	}
+	for _, l := range book.GetVenueLevels() {
+		if l.SecurityID != 2 || math.Abs(l.Price-l.VenuePrice) > 1e-9 {
+			t.Fatalf("wrong venue level: %v", l)
+		}
+	}
+
+	if _, _, err := book.Update(3, nil); err == nil {
+		t.Fatalf("was expecting an error on unknown venue")
+	}
+}
@@ -0,0 +1,346 @@
+package consolidated
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/log"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+	gmodels "gitlab.com/alphaticks/gorderbook/gorderbook.models"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// The listener subscribes to the L2 market data of all the member securities
+// and streams the consolidated book to its parent, as an instrument listener does.
+// A member with a sequence gap is cleared from the book until it is synced again.
+
+type checkHeartbeat struct{}
+
+type Listener struct {
+	security        *models.Security
+	members         []*models.Security
+	book            *Book
+	executor        *actor.PID
+	requests        map[uint64]uint64 // A map from request ID to member security ID
+	snapshots       map[uint64]uint64 // A map from member security ID to its pending snapshot request ID
+	seqNums         map[uint64]uint64 // A map from member security ID to its last seq num
+	syncing         map[uint64]bool
+	seqNum          uint64
+	lastUpdateTime  uint64
+	lastHBTime      time.Time
+	heartbeatTicker *time.Ticker
+	logger          *log.Logger
+}
+
+func NewListenerProducer(security *models.Security, members []*models.Security) actor.Producer {
+	return func() actor.Actor {
+		return NewListener(security, members)
+	}
+}
+
+func NewListener(security *models.Security, members []*models.Security) actor.Actor {
+	return &Listener{
+		security: security,
+		members:  members,
+	}
+}
+
+func (state *Listener) Receive(context actor.Context) {
+	switch context.Message().(type) {
+	case *actor.Started:
+		if err := state.Initialize(context); err != nil {
+			state.logger.Error("error initializing", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor started")
+
+	case *actor.Stopping:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error stopping", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor stopping")
+
+	case *actor.Stopped:
+		state.logger.Info("actor stopped")
+
+	case *actor.Restarting:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error restarting", log.Error(err))
+			// Attention, no panic in restarting or infinite loop
+		}
+		state.logger.Info("actor restarting")
+
+	case *messages.MarketDataRequest:
+		if err := state.OnMarketDataRequest(context); err != nil {
+			state.logger.Error("error processing OnMarketDataRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.MarketDataResponse:
+		if err := state.OnMarketDataResponse(context); err != nil {
+			state.logger.Error("error processing OnMarketDataResponse", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.MarketDataIncrementalRefresh:
+		if err := state.OnMarketDataIncrementalRefresh(context); err != nil {
+			state.logger.Error("error processing OnMarketDataIncrementalRefresh", log.Error(err))
+			panic(err)
+		}
+
+	case *checkHeartbeat:
+		if err := state.checkHeartbeat(context); err != nil {
+			state.logger.Error("error checking heartbeat", log.Error(err))
+			panic(err)
+		}
+	}
+}
+
+func (state *Listener) Initialize(context actor.Context) error {
+	state.logger = log.New(
+		log.InfoLevel,
+		"",
+		log.String("ID", context.Self().Id),
+		log.String("type", reflect.TypeOf(*state).String()),
+		log.String("instrument", state.security.Symbol))
+
+	state.book = NewBook(state.members)
+	state.executor = actor.NewPID(context.ActorSystem().Address(), "executor/exchanges")
+	state.requests = make(map[uint64]uint64)
+	state.snapshots = make(map[uint64]uint64)
+	state.seqNums = make(map[uint64]uint64)
+	state.syncing = make(map[uint64]bool)
+	state.seqNum = uint64(time.Now().UnixNano())
+	state.lastHBTime = time.Now()
+
+	// Subscribe to all the members at once
+	futures := make(map[uint64]*actor.Future)
+	for i, m := range state.members {
+		requestID := uint64(time.Now().UnixNano()) + uint64(i)
+		state.requests[requestID] = m.SecurityID
+		futures[m.SecurityID] = context.RequestFuture(state.executor, &messages.MarketDataRequest{
+			RequestID:  requestID,
+			Subscribe:  true,
+			Subscriber: context.Self(),
+			Instrument: &models.Instrument{
+				SecurityID: wrapperspb.UInt64(m.SecurityID),
+			},
+			Aggregation: models.OrderBookAggregation_L2,
+		}, 20*time.Second)
+	}
+	var synced int
+	for ID, fut := range futures {
+		res, err := fut.Result()
+		if err != nil {
+			state.logger.Warn(fmt.Sprintf("error subscribing to security %d", ID), log.Error(err))
+			continue
+		}
+		mdres, ok := res.(*messages.MarketDataResponse)
+		if !ok {
+			return fmt.Errorf("was expecting MarketDataResponse, got %s", reflect.TypeOf(res).String())
+		}
+		if !mdres.Success {
+			state.logger.Warn(fmt.Sprintf("error subscribing to security %d: %s", ID, mdres.RejectionReason.String()))
+			continue
+		}
+		if err := state.syncMember(context, ID, mdres); err != nil {
+			state.logger.Warn(fmt.Sprintf("error syncing security %d", ID), log.Error(err))
+			continue
+		}
+		synced += 1
+	}
+	if synced == 0 {
+		return fmt.Errorf("no member security could be subscribed to")
+	}
+
+	heartbeatTicker := time.NewTicker(time.Second)
+	state.heartbeatTicker = heartbeatTicker
+	go func(pid *actor.PID) {
+		for {
+			select {
+			case <-heartbeatTicker.C:
+				context.Send(pid, &checkHeartbeat{})
+			case <-time.After(2 * time.Second):
+				if state.heartbeatTicker != heartbeatTicker {
+					return
+				}
+			}
+		}
+	}(context.Self())
+
+	return nil
+}
+
+func (state *Listener) Clean(context actor.Context) error {
+	if state.heartbeatTicker != nil {
+		state.heartbeatTicker.Stop()
+		state.heartbeatTicker = nil
+	}
+
+	return nil
+}
+
+func (state *Listener) OnMarketDataRequest(context actor.Context) error {
+	msg := context.Message().(*messages.MarketDataRequest)
+	response := &messages.MarketDataResponse{
+		RequestID:  msg.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		SeqNum:     state.seqNum,
+		Success:    true,
+	}
+	if msg.Aggregation == models.OrderBookAggregation_L2 {
+		response.SnapshotL2 = &models.OBL2Snapshot{
+			Bids:          state.book.GetBids(),
+			Asks:          state.book.GetAsks(),
+			Timestamp:     utils.MilliToTimestamp(state.lastUpdateTime),
+			TickPrecision: &wrapperspb.UInt64Value{Value: state.book.TickPrecision},
+			LotPrecision:  &wrapperspb.UInt64Value{Value: state.book.LotPrecision},
+		}
+		response.VenueLevels = state.book.GetVenueLevels()
+	}
+
+	context.Respond(response)
+	return nil
+}
+
+func (state *Listener) OnMarketDataResponse(context actor.Context) error {
+	// Either a resync snapshot or a member listener that restarted
+	res := context.Message().(*messages.MarketDataResponse)
+	ID, ok := state.requests[res.RequestID]
+	if !ok {
+		return nil
+	}
+	if state.snapshots[ID] == res.RequestID {
+		delete(state.requests, res.RequestID)
+		delete(state.snapshots, ID)
+	}
+	if !res.Success {
+		state.logger.Warn(fmt.Sprintf("error syncing security %d: %s", ID, res.RejectionReason.String()))
+		return nil
+	}
+	if err := state.syncMember(context, ID, res); err != nil {
+		state.logger.Warn(fmt.Sprintf("error syncing security %d", ID), log.Error(err))
+	}
+	return nil
+}
+
+func (state *Listener) OnMarketDataIncrementalRefresh(context actor.Context) error {
+	refresh := context.Message().(*messages.MarketDataIncrementalRefresh)
+	ID, ok := state.requests[refresh.RequestID]
+	if !ok || state.syncing[ID] {
+		return nil
+	}
+	if refresh.SeqNum <= state.seqNums[ID] {
+		return nil
+	}
+	if state.seqNums[ID]+1 != refresh.SeqNum {
+		state.logger.Info(fmt.Sprintf("sequence gap on security %d: %d -> %d", ID, state.seqNums[ID], refresh.SeqNum))
+		return state.resyncMember(context, ID)
+	}
+	state.seqNums[ID] = refresh.SeqNum
+
+	var levels []*gmodels.OrderBookLevel
+	var venueLevels []*models.VenueLevel
+	ts := utils.MilliToTimestamp(uint64(time.Now().UnixNano() / 1000000))
+	if refresh.UpdateL2 != nil {
+		var err error
+		levels, venueLevels, err = state.book.Update(ID, refresh.UpdateL2.Levels)
+		if err != nil {
+			return err
+		}
+		if refresh.UpdateL2.Timestamp != nil {
+			ts = refresh.UpdateL2.Timestamp
+		}
+	}
+	trades, err := state.book.Trades(ID, refresh.Trades)
+	if err != nil {
+		return err
+	}
+	state.publish(context, levels, venueLevels, trades, utils.TimestampToMilli(ts))
+
+	return nil
+}
+
+func (state *Listener) syncMember(context actor.Context, ID uint64, res *messages.MarketDataResponse) error {
+	if res.SnapshotL2 == nil {
+		return fmt.Errorf("no L2 snapshot")
+	}
+	levels, venueLevels, err := state.book.Sync(ID, res.SnapshotL2.Bids, res.SnapshotL2.Asks)
+	if err != nil {
+		return err
+	}
+	state.seqNums[ID] = res.SeqNum
+	delete(state.syncing, ID)
+	state.publish(context, levels, venueLevels, nil, uint64(time.Now().UnixNano()/1000000))
+
+	return nil
+}
+
+func (state *Listener) resyncMember(context actor.Context, ID uint64) error {
+	levels, venueLevels, err := state.book.Clear(ID)
+	if err != nil {
+		return err
+	}
+	state.publish(context, levels, venueLevels, nil, uint64(time.Now().UnixNano()/1000000))
+	state.syncing[ID] = true
+
+	// Request a snapshot only, the subscription is still running.
+	// A snapshot request still pending is replaced.
+	if prev, ok := state.snapshots[ID]; ok {
+		delete(state.requests, prev)
+	}
+	requestID := uint64(time.Now().UnixNano())
+	state.requests[requestID] = ID
+	state.snapshots[ID] = requestID
+	context.Request(state.executor, &messages.MarketDataRequest{
+		RequestID: requestID,
+		Subscribe: false,
+		Instrument: &models.Instrument{
+			SecurityID: wrapperspb.UInt64(ID),
+		},
+		Aggregation: models.OrderBookAggregation_L2,
+	})
+
+	return nil
+}
+
+func (state *Listener) publish(context actor.Context, levels []*gmodels.OrderBookLevel, venueLevels []*models.VenueLevel, trades []*models.AggregatedTrade, ts uint64) {
+	if len(levels) == 0 && len(trades) == 0 {
+		return
+	}
+	refresh := &messages.MarketDataIncrementalRefresh{
+		VenueLevels: venueLevels,
+		Trades:      trades,
+		SeqNum:      state.seqNum + 1,
+	}
+	if len(levels) > 0 {
+		refresh.UpdateL2 = &models.OBL2Update{
+			Levels:    levels,
+			Timestamp: utils.MilliToTimestamp(ts),
+			Trade:     false,
+		}
+	}
+	context.Send(context.Parent(), refresh)
+	state.seqNum += 1
+	state.lastUpdateTime = ts
+	state.lastHBTime = time.Now()
+}
+
+func (state *Listener) checkHeartbeat(context actor.Context) error {
+	// If haven't sent anything for 2 seconds, send heartbeat
+	if time.Since(state.lastHBTime) > 2*time.Second {
+		// Send an empty refresh
+		context.Send(context.Parent(), &messages.MarketDataIncrementalRefresh{
+			SeqNum: state.seqNum + 1,
+		})
+		state.seqNum += 1
+		state.lastHBTime = time.Now()
+	}
+
+	return nil
+}
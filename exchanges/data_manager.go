@@ -7,21 +7,43 @@ import (
 
 	"github.com/asynkron/protoactor-go/actor"
 	"github.com/asynkron/protoactor-go/log"
+	"gitlab.com/alphaticks/alpha-connect/exchanges/types"
+	v3 "gitlab.com/alphaticks/alpha-connect/exchanges/uniswap/v3"
 	"gitlab.com/alphaticks/alpha-connect/models"
 	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/xchanger/constants"
 	"gitlab.com/alphaticks/xchanger/utils"
 )
 
 // The market data manager spawns an instrument listener and multiplex its messages
-// to actors who subscribed
+// to actors who subscribed. It follows the health of the instrument listener's book
+// and restarts the listener, thus resyncing its book, when it becomes unhealthy.
+// Subscribers can ask for a conflated book or the top of the book instead of the full
+// stream, they are then sent conflated snapshots of the book followed by the manager.
+
+type checkHealth struct{}
+
+type flushConflated struct{}
+
+type subscriber struct {
+	pid       *actor.PID
+	conflator *types.Conflator // nil for the full stream
+}
 
 type DataManager struct {
-	subscribers map[uint64]*actor.PID
-	listener    *actor.PID
-	security    *models.Security
-	dialerPool  *utils.DialerPool
-	wsPool      *utils.WebsocketPool
-	logger      *log.Logger
+	subscribers      map[uint64]*subscriber
+	listener         *actor.PID
+	listenerProducer actor.Producer
+	security         *models.Security
+	dialerPool       *utils.DialerPool
+	wsPool           *utils.WebsocketPool
+	health           *types.BookHealth
+	healthRequestID  uint64
+	healthTicker     *time.Ticker
+	healthStats      []*models.Stat
+	seqNum           uint64
+	flushTicker      *time.Ticker
+	logger           *log.Logger
 }
 
 func NewDataManagerProducer(security *models.Security, dialerPool *utils.DialerPool, wsPool *utils.WebsocketPool) actor.Producer {
@@ -39,6 +61,18 @@ func NewDataManager(security *models.Security, dialerPool *utils.DialerPool, wsP
 	}
 }
 
+// NewListenerDataManagerProducer returns a data manager multiplexing the messages
+// of the given listener instead of the security's instrument listener
+func NewListenerDataManagerProducer(security *models.Security, listenerProducer actor.Producer) actor.Producer {
+	return func() actor.Actor {
+		return &DataManager{
+			security:         security,
+			listenerProducer: listenerProducer,
+			logger:           nil,
+		}
+	}
+}
+
 func (state *DataManager) Receive(context actor.Context) {
 	switch context.Message().(type) {
 	case *actor.Started:
@@ -101,6 +135,21 @@ func (state *DataManager) Receive(context actor.Context) {
 			panic(err)
 		}
 
+	case *v3.PoolRequest:
+		context.Forward(state.listener)
+
+	case *checkHealth:
+		if err := state.checkHealth(context); err != nil {
+			state.logger.Error("error checking health", log.Error(err))
+			panic(err)
+		}
+
+	case *flushConflated:
+		if err := state.flushConflated(context); err != nil {
+			state.logger.Error("error flushing conflated snapshots", log.Error(err))
+			panic(err)
+		}
+
 	case *actor.Terminated:
 		if err := state.OnTerminated(context); err != nil {
 			state.logger.Error("error processing OnTerminated", log.Error(err))
@@ -116,18 +165,49 @@ func (state *DataManager) Initialize(context actor.Context) error {
 		log.String("ID", context.Self().Id),
 		log.String("type", reflect.TypeOf(*state).String()))
 
-	state.subscribers = make(map[uint64]*actor.PID)
-	producer := NewInstrumentListenerProducer(state.security.SecurityID, state.security.Exchange.ID, state.dialerPool, state.wsPool)
+	state.subscribers = make(map[uint64]*subscriber)
+	producer := state.listenerProducer
+	if producer == nil {
+		producer = NewInstrumentListenerProducer(state.security.SecurityID, state.security.Exchange.ID, state.dialerPool, state.wsPool)
+	}
 	if producer == nil {
 		return fmt.Errorf("error getting instrument listener")
 	}
 	props := actor.PropsFromProducer(producer)
 	state.listener = context.Spawn(props)
 
+	// Only the instrument listeners stream exchange books
+	if state.listenerProducer == nil && state.security.Exchange.ID != constants.UNISWAPV3.ID {
+		state.health = types.NewBookHealth(types.DefaultBookHealthConfig)
+		state.requestHealthSync(context)
+		healthTicker := time.NewTicker(5 * time.Second)
+		state.healthTicker = healthTicker
+		go func(pid *actor.PID) {
+			for {
+				select {
+				case <-healthTicker.C:
+					context.Send(pid, &checkHealth{})
+				case <-time.After(10 * time.Second):
+					if state.healthTicker != healthTicker {
+						return
+					}
+				}
+			}
+		}(context.Self())
+	}
+
 	return nil
 }
 
 func (state *DataManager) Clean(context actor.Context) error {
+	if state.healthTicker != nil {
+		state.healthTicker.Stop()
+		state.healthTicker = nil
+	}
+	if state.flushTicker != nil {
+		state.flushTicker.Stop()
+		state.flushTicker = nil
+	}
 	return nil
 }
 
@@ -135,7 +215,33 @@ func (state *DataManager) OnMarketDataRequest(context actor.Context) error {
 	request := context.Message().(*messages.MarketDataRequest)
 
 	if request.Subscribe {
-		state.subscribers[request.RequestID] = request.Subscriber
+		conflator, err := types.NewConflator(request)
+		if err != nil {
+			context.Respond(&messages.MarketDataResponse{
+				RequestID:       request.RequestID,
+				ResponseID:      uint64(time.Now().UnixNano()),
+				Success:         false,
+				RejectionReason: messages.RejectionReason_InvalidRequest,
+			})
+			return nil
+		}
+		if conflator != nil && state.health == nil {
+			// Conflation needs the book followed by the health
+			context.Respond(&messages.MarketDataResponse{
+				RequestID:       request.RequestID,
+				ResponseID:      uint64(time.Now().UnixNano()),
+				Success:         false,
+				RejectionReason: messages.RejectionReason_UnsupportedSubscription,
+			})
+			return nil
+		}
+		if conflator != nil && state.flushTicker == nil {
+			state.startFlushTicker(context)
+		}
+		state.subscribers[request.RequestID] = &subscriber{
+			pid:       request.Subscriber,
+			conflator: conflator,
+		}
 		context.Watch(request.Subscriber)
 	}
 
@@ -148,7 +254,7 @@ func (state *DataManager) OnUnipoolV3DataRequest(context actor.Context) error {
 	request := context.Message().(*messages.UnipoolV3DataRequest)
 
 	if request.Subscribe {
-		state.subscribers[request.RequestID] = request.Subscriber
+		state.subscribers[request.RequestID] = &subscriber{pid: request.Subscriber}
 		context.Watch(request.Subscriber)
 	}
 
@@ -159,34 +265,165 @@ func (state *DataManager) OnUnipoolV3DataRequest(context actor.Context) error {
 
 func (state *DataManager) OnMarketDataResponse(context actor.Context) error {
 	snapshot := context.Message().(*messages.MarketDataResponse)
+	if state.health != nil && snapshot.RequestID == state.healthRequestID {
+		if snapshot.Success {
+			state.health.Sync(snapshot, time.Now())
+		}
+		return nil
+	}
 	for k, v := range state.subscribers {
 		forward := &messages.MarketDataResponse{
-			RequestID:  k,
-			ResponseID: uint64(time.Now().UnixNano()),
-			SnapshotL2: snapshot.SnapshotL2,
-			SnapshotL3: snapshot.SnapshotL3,
-			Trades:     snapshot.Trades,
+			RequestID:   k,
+			ResponseID:  uint64(time.Now().UnixNano()),
+			SnapshotL2:  snapshot.SnapshotL2,
+			SnapshotL3:  snapshot.SnapshotL3,
+			Trades:      snapshot.Trades,
+			VenueLevels: snapshot.VenueLevels,
+			SeqNum:      snapshot.SeqNum,
+			Success:     snapshot.Success,
 		}
-		context.Send(v, forward)
+		context.Send(v.pid, forward)
 	}
 	return nil
 }
 
 func (state *DataManager) OnMarketDataIncrementalRefresh(context actor.Context) error {
 	refresh := context.Message().(*messages.MarketDataIncrementalRefresh)
+	stats := refresh.Stats
+	if len(state.healthStats) > 0 {
+		stats = append(state.healthStats, stats...)
+		state.healthStats = nil
+	}
+	status := types.BookHealthy
+	if state.health != nil {
+		status = state.health.Refresh(refresh, time.Now())
+	}
 	for k, v := range state.subscribers {
+		if v.conflator != nil {
+			v.conflator.Update(refresh, stats)
+			state.sendConflated(context, k, v, time.Now())
+			continue
+		}
 		forward := &messages.MarketDataIncrementalRefresh{
 			RequestID:   k,
 			ResponseID:  uint64(time.Now().UnixNano()),
+			UpdateL1:    refresh.UpdateL1,
 			UpdateL2:    refresh.UpdateL2,
 			UpdateL3:    refresh.UpdateL3,
 			Trades:      refresh.Trades,
 			Liquidation: refresh.Liquidation,
-			Stats:       refresh.Stats,
+			Stats:       stats,
+			VenueLevels: refresh.VenueLevels,
 			SeqNum:      refresh.SeqNum,
 		}
-		context.Send(v, forward)
+		context.Send(v.pid, forward)
+	}
+	if refresh.SeqNum > state.seqNum {
+		state.seqNum = refresh.SeqNum
+	}
+
+	switch status {
+	case types.BookHealthy:
+	case types.BookSeqGap:
+		// The listener resynced its book by itself
+		state.health = types.NewBookHealth(types.DefaultBookHealthConfig)
+		state.requestHealthSync(context)
+	default:
+		return state.resync(context)
+	}
+	return nil
+}
+
+func (state *DataManager) flushConflated(context actor.Context) error {
+	now := time.Now()
+	for k, v := range state.subscribers {
+		if v.conflator != nil {
+			state.sendConflated(context, k, v, now)
+		}
+	}
+	return nil
+}
+
+// sendConflated sends the pending conflated snapshot of the subscriber, if any
+func (state *DataManager) sendConflated(context actor.Context, requestID uint64, sub *subscriber, now time.Time) {
+	if state.health == nil {
+		return
 	}
+	if snapshot := sub.conflator.Snapshot(state.health.Book(), state.health.SeqNum(), now); snapshot != nil {
+		snapshot.RequestID = requestID
+		context.Send(sub.pid, snapshot)
+	}
+}
+
+func (state *DataManager) startFlushTicker(context actor.Context) {
+	flushTicker := time.NewTicker(50 * time.Millisecond)
+	state.flushTicker = flushTicker
+	go func(pid *actor.PID) {
+		for {
+			select {
+			case <-flushTicker.C:
+				context.Send(pid, &flushConflated{})
+			case <-time.After(time.Second):
+				if state.flushTicker != flushTicker {
+					return
+				}
+			}
+		}
+	}(context.Self())
+}
+
+func (state *DataManager) checkHealth(context actor.Context) error {
+	if state.health == nil {
+		return nil
+	}
+	if !state.health.Synced() {
+		// The listener didn't answer to the sync request yet
+		state.requestHealthSync(context)
+		return nil
+	}
+	if status := state.health.Check(time.Now()); status != types.BookHealthy {
+		return state.resync(context)
+	}
+	return nil
+}
+
+func (state *DataManager) requestHealthSync(context actor.Context) {
+	state.healthRequestID = uint64(time.Now().UnixNano())
+	context.Request(state.listener, &messages.MarketDataRequest{
+		RequestID:   state.healthRequestID,
+		Subscribe:   false,
+		Aggregation: models.OrderBookAggregation_L2,
+	})
+}
+
+// resync publishes the health of the book and restarts the listener
+func (state *DataManager) resync(context actor.Context) error {
+	now := time.Now()
+	state.logger.Warn("unhealthy book, restarting listener", log.String("status", state.health.Status().String()))
+	stats := []*models.Stat{state.health.Stat(state.security.SecurityID, now)}
+	for k, v := range state.subscribers {
+		if v.conflator != nil {
+			// Sent with the first snapshot of the resynced book
+			v.conflator.Update(&messages.MarketDataIncrementalRefresh{}, stats)
+			continue
+		}
+		context.Send(v.pid, &messages.MarketDataIncrementalRefresh{
+			RequestID:  k,
+			ResponseID: uint64(now.UnixNano()),
+			Stats:      stats,
+			SeqNum:     state.seqNum + 1,
+		})
+	}
+	state.seqNum += 1
+
+	context.Stop(state.listener)
+	producer := NewInstrumentListenerProducer(state.security.SecurityID, state.security.Exchange.ID, state.dialerPool, state.wsPool)
+	state.listener = context.Spawn(actor.PropsFromProducer(producer))
+	state.health = types.NewBookHealth(types.DefaultBookHealthConfig)
+	state.requestHealthSync(context)
+	// Subscribers will see the book is healthy again with the first refresh
+	state.healthStats = []*models.Stat{state.health.Stat(state.security.SecurityID, now)}
+
 	return nil
 }
 
@@ -198,7 +435,7 @@ func (state *DataManager) OnUnipoolV3DataResponse(context actor.Context) error {
 			ResponseID: uint64(time.Now().UnixNano()),
 			Update:     update.Update,
 		}
-		context.Send(v, forward)
+		context.Send(v.pid, forward)
 	}
 	return nil
 }
@@ -212,7 +449,7 @@ func (state *DataManager) OnUnipoolV3DataIncrementalRefresh(context actor.Contex
 			Update:     refresh.Update,
 			SeqNum:     refresh.SeqNum,
 		}
-		context.Send(v, forward)
+		context.Send(v.pid, forward)
 	}
 	return nil
 }
@@ -221,7 +458,7 @@ func (state *DataManager) OnTerminated(context actor.Context) error {
 	// Handle subscriber krash
 	msg := context.Message().(*actor.Terminated)
 	for k, v := range state.subscribers {
-		if v.String() == msg.Who.String() {
+		if v.pid.String() == msg.Who.String() {
 			delete(state.subscribers, k)
 		}
 	}
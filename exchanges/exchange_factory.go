@@ -1,6 +1,7 @@
 package exchanges
 
 import (
+	"fmt"
 	"net/http"
 	"sync"
 
@@ -34,6 +35,7 @@ import (
 	"gitlab.com/alphaticks/alpha-connect/exchanges/okex"
 	"gitlab.com/alphaticks/alpha-connect/exchanges/okexp"
 	"gitlab.com/alphaticks/alpha-connect/exchanges/opensea"
+	v2 "gitlab.com/alphaticks/alpha-connect/exchanges/uniswap/v2"
 	v3 "gitlab.com/alphaticks/alpha-connect/exchanges/uniswap/v3"
 	"gitlab.com/alphaticks/alpha-connect/exchanges/upbit"
 	"gitlab.com/alphaticks/alpha-connect/models"
@@ -93,6 +95,20 @@ func NewAccount(accountInfo *models.Account, fillCollector *account.FillCollecto
 	return accnt, nil
 }
 
+// NewWalletAccount creates the account of an on-chain wallet
+func NewWalletAccount(accountCfg config.Account) (*account.Account, error) {
+	chain, ok := constants.GetChainByID(accountCfg.Chain)
+	if !ok {
+		return nil, fmt.Errorf("unknown chain %d", accountCfg.Chain)
+	}
+	return NewAccount(&models.Account{
+		Portfolio: accountCfg.Portfolio,
+		Name:      accountCfg.Name,
+		Chain:     chain,
+		Address:   accountCfg.Address,
+	}, nil, nil)
+}
+
 func NewAccountListenerProducer(account *account.Account, registry registry.StaticClient, db *gorm.DB, client *http.Client, readOnly bool) actor.Producer {
 	switch account.Exchange.ID {
 	case constants.BITMEX.ID:
@@ -115,6 +131,9 @@ func NewAccountListenerProducer(account *account.Account, registry registry.Stat
 }
 
 func NewAccountReconcileProducer(accountCfg config.Account, account *models.Account, registry registry.StaticClient, store tickstore_types.TickstoreClient, db *gorm.DB) actor.Producer {
+	if account.Exchange == nil {
+		return nil
+	}
 	switch account.Exchange.ID {
 	case constants.BINANCE.ID:
 		return binance.NewAccountReconcileProducer(accountCfg, account, registry, store, db)
@@ -199,6 +218,10 @@ func NewInstrumentListenerProducer(securityID uint64, exchangeID uint32, dialerP
 			return func() actor.Actor { return bittrex.NewListener(instrument) }
 		*/
 	default:
+		// The Uniswap V2 forks share the pair contracts
+		if fork, ok := v2.GetFork(exchangeID); ok {
+			return func() actor.Actor { return v2.NewListener(fork, securityID, dialerPool) }
+		}
 		return nil
 	}
 }
@@ -268,6 +291,10 @@ func NewExchangeExecutorProducer(exchange *models2.Exchange, config *config.Conf
 				return func() actor.Actor { return bittrex.NewExecutor() }
 		*/
 	default:
+		// The Uniswap V2 forks share the pair contracts
+		if fork, ok := v2.GetFork(exchange.ID); ok {
+			return func() actor.Actor { return v2.NewExecutor(fork, dialerPool, registry) }
+		}
 		return nil
 	}
 }
@@ -3,6 +3,7 @@ package exchanges
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"net"
 	"net/http"
 	"reflect"
@@ -11,7 +12,12 @@ import (
 
 	"gitlab.com/alphaticks/alpha-connect/account"
 	"gitlab.com/alphaticks/alpha-connect/config"
+	"gitlab.com/alphaticks/alpha-connect/enum"
+	"gitlab.com/alphaticks/alpha-connect/exchanges/consolidated"
+	"gitlab.com/alphaticks/alpha-connect/exchanges/synthetic"
 	"gitlab.com/alphaticks/alpha-connect/exchanges/types"
+	v2 "gitlab.com/alphaticks/alpha-connect/exchanges/uniswap/v2"
+	v3 "gitlab.com/alphaticks/alpha-connect/exchanges/uniswap/v3"
 	registry "gitlab.com/alphaticks/alpha-public-registry-grpc"
 	_ "gitlab.com/alphaticks/tickfunctors/market/portfolio"
 	tickstore_go_client "gitlab.com/alphaticks/tickstore-go-client"
@@ -23,6 +29,7 @@ import (
 	"gitlab.com/alphaticks/xchanger/exchanges/hitbtc"
 	xmodels "gitlab.com/alphaticks/xchanger/models"
 	"golang.org/x/net/proxy"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/asynkron/protoactor-go/actor"
 	"github.com/asynkron/protoactor-go/log"
@@ -49,6 +56,8 @@ type Executor struct {
 	accountManagers          map[string]*actor.PID
 	executors                map[uint32]*actor.PID                      // A map from exchange ID to executor
 	securities               map[uint64]*models.Security                // A map from security ID to security
+	consolidated             map[uint64]*models.Security                // A map from consolidated security ID to consolidated security
+	synthetics               *synthetic.Registry                        // The synthetic securities and their definitions
 	marketableProtocolAssets map[uint64]*models.MarketableProtocolAsset // A map from MarketAsset ID to MarketAsset
 	symbToSecs               map[uint32]map[string]*models.Security
 	instruments              map[uint64]*actor.PID // A map from security ID to market manager
@@ -116,6 +125,18 @@ func (state *Executor) Receive(context actor.Context) {
 			panic(err)
 		}
 
+	case *messages.UnipoolV3QuoteRequest:
+		if err := state.OnUnipoolV3QuoteRequest(context); err != nil {
+			state.logger.Error("error processing OnUnipoolV3QuoteRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *v3.PoolRequest:
+		if err := state.OnPoolRequest(context); err != nil {
+			state.logger.Error("error processing OnPoolRequest", log.Error(err))
+			panic(err)
+		}
+
 	case *messages.HistoricalUnipoolV3DataRequest:
 		if err := state.OnHistoricalUnipoolV3EventRequest(context); err != nil {
 			state.logger.Error("error processing OnHistoricalUnipoolV3EventRequest", log.Error(err))
@@ -152,6 +173,12 @@ func (state *Executor) Receive(context actor.Context) {
 			panic(err)
 		}
 
+	case *messages.NFTMarketDataRequest:
+		if err := state.OnNFTMarketDataRequest(context); err != nil {
+			state.logger.Error("error processing OnNFTMarketDataRequest", log.Error(err))
+			panic(err)
+		}
+
 	case *messages.SecurityDefinitionRequest:
 		if err := state.OnSecurityDefinitionRequest(context); err != nil {
 			state.logger.Error("error processing OnSecurityDefinitionRequest", log.Error(err))
@@ -338,13 +365,7 @@ func (state *Executor) Initialize(context actor.Context) error {
 	}
 
 	if state.DB != nil {
-		dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable TimeZone=Asia/Shanghai",
-			state.DB.PostgresHost,
-			state.DB.PostgresUser,
-			state.DB.PostgresPassword,
-			state.DB.PostgresDB,
-			state.DB.PostgresPort)
-		sql, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+		sql, err := gorm.Open(postgres.Open(state.DB.DSN()), &gorm.Config{})
 		if err != nil {
 			panic("failed to connect database")
 		}
@@ -368,6 +389,9 @@ func (state *Executor) Initialize(context actor.Context) error {
 
 	// TODO add dialer pool test
 
+	if err := v2.LoadForks(state.UniswapV2Forks); err != nil {
+		return fmt.Errorf("error loading uniswap v2 forks: %v", err)
+	}
 	state.accountClients = make(map[string]map[string]*http.Client)
 	// Spawn all exchange executors
 	state.executors = make(map[uint32]*actor.PID)
@@ -379,6 +403,10 @@ func (state *Executor) Initialize(context actor.Context) error {
 		}
 		state.accountClients[exch.Name] = make(map[string]*http.Client)
 		for _, accntCfg := range state.Accounts {
+			if accntCfg.Address != "" {
+				// On-chain wallet
+				continue
+			}
 			accntExch, ok := constants.GetExchangeByName(accntCfg.Exchange)
 			if !ok {
 				return fmt.Errorf("unknown exchange %s", accntCfg.Exchange)
@@ -459,6 +487,14 @@ func (state *Executor) Initialize(context actor.Context) error {
 			state.symbToSecs[exchID] = symbToSec
 		}
 	}
+	state.updateConsolidated()
+
+	state.synthetics = synthetic.NewRegistry()
+	for _, def := range state.Synthetics {
+		if _, err := state.synthetics.Add(def, state.securities); err != nil {
+			state.logger.Warn("error adding synthetic "+def.Symbol, log.Error(err))
+		}
+	}
 
 	//Request marketable protocol assets for all of them
 	var futs []*actor.Future
@@ -500,6 +536,19 @@ func (state *Executor) Initialize(context actor.Context) error {
 	// Spawn all account listeners
 	state.accountManagers = make(map[string]*actor.PID)
 	for _, accntCfg := range state.Accounts {
+		if accntCfg.Address != "" {
+			accnt, err := NewWalletAccount(accntCfg)
+			if err != nil {
+				return fmt.Errorf("error creating new wallet account: %v", err)
+			}
+			props := actor.PropsFromProducer(NewAccountManagerProducer(accntCfg, accnt, state.store, state.db, state.registry, nil), actor.WithSupervisor(
+				actor.NewExponentialBackoffStrategy(100*time.Second, time.Second)))
+			state.accountManagers[accntCfg.Name], err = context.SpawnNamed(props, accntCfg.Name+"_account")
+			if err != nil {
+				return fmt.Errorf("error spawning wallet account manager: %v", err)
+			}
+			continue
+		}
 		exch, ok := constants.GetExchangeByName(accntCfg.Exchange)
 		if !ok {
 			return fmt.Errorf("unknown exchange %s", accntCfg.Exchange)
@@ -649,8 +698,46 @@ func (state *Executor) getExchange(instr *models.Instrument) (*xmodels.Exchange,
 	}
 }
 
+func (state *Executor) updateConsolidated() {
+	var securities []*models.Security
+	for _, v := range state.securities {
+		securities = append(securities, v)
+	}
+	state.consolidated = consolidated.NewSecurities(securities)
+}
+
+func (state *Executor) getConsolidated(instr *models.Instrument) (*models.Security, bool) {
+	if instr == nil || instr.SecurityID == nil {
+		return nil, false
+	}
+	sec, ok := state.consolidated[instr.SecurityID.Value]
+	return sec, ok
+}
+
+func (state *Executor) getSynthetic(instr *models.Instrument) (*models.Security, config.Synthetic, bool) {
+	if instr == nil || instr.SecurityID == nil {
+		return nil, config.Synthetic{}, false
+	}
+	return state.synthetics.Get(instr.SecurityID.Value)
+}
+
+// listSecurities returns the exchange and synthetic securities
+func (state *Executor) listSecurities() []*models.Security {
+	var securities []*models.Security
+	for _, v := range state.securities {
+		securities = append(securities, v)
+	}
+	return append(securities, state.synthetics.Securities()...)
+}
+
 func (state *Executor) OnMarketDataRequest(context actor.Context) error {
 	request := context.Message().(*messages.MarketDataRequest)
+	if sec, ok := state.getConsolidated(request.Instrument); ok {
+		return state.onConsolidatedMarketDataRequest(context, sec)
+	}
+	if sec, def, ok := state.getSynthetic(request.Instrument); ok {
+		return state.onSyntheticMarketDataRequest(context, sec, def)
+	}
 	sec, rej := state.getSecurity(request.Instrument)
 	if rej != nil {
 		context.Respond(&messages.MarketDataResponse{
@@ -745,6 +832,62 @@ func (state *Executor) OnMarketDataRequest(context actor.Context) error {
 	return nil
 }
 
+func (state *Executor) onConsolidatedMarketDataRequest(context actor.Context, sec *models.Security) error {
+	request := context.Message().(*messages.MarketDataRequest)
+	if pid, ok := state.instruments[sec.SecurityID]; ok {
+		context.Forward(pid)
+		return nil
+	}
+	if request.Aggregation != models.OrderBookAggregation_L2 {
+		context.Respond(&messages.MarketDataResponse{
+			RequestID:       request.RequestID,
+			Success:         false,
+			RejectionReason: messages.RejectionReason_UnsupportedSubscription,
+		})
+		return nil
+	}
+	var securities []*models.Security
+	for _, v := range state.securities {
+		securities = append(securities, v)
+	}
+	members, err := consolidated.Members(utils.NewTagIndex(securities), sec)
+	if err != nil {
+		return fmt.Errorf("error getting consolidated members: %v", err)
+	}
+	producer := consolidated.NewListenerProducer(sec, members)
+	props := actor.PropsFromProducer(NewListenerDataManagerProducer(sec, producer), actor.WithSupervisor(
+		utils.NewExponentialBackoffStrategy(100*time.Second, time.Second, time.Second)))
+	pid := context.Spawn(props)
+	state.instruments[sec.SecurityID] = pid
+	context.Forward(pid)
+
+	return nil
+}
+
+func (state *Executor) onSyntheticMarketDataRequest(context actor.Context, sec *models.Security, def config.Synthetic) error {
+	request := context.Message().(*messages.MarketDataRequest)
+	if pid, ok := state.instruments[sec.SecurityID]; ok {
+		context.Forward(pid)
+		return nil
+	}
+	if request.Aggregation == models.OrderBookAggregation_L3 {
+		context.Respond(&messages.MarketDataResponse{
+			RequestID:       request.RequestID,
+			Success:         false,
+			RejectionReason: messages.RejectionReason_UnsupportedSubscription,
+		})
+		return nil
+	}
+	producer := synthetic.NewListenerProducer(sec, def)
+	props := actor.PropsFromProducer(NewListenerDataManagerProducer(sec, producer), actor.WithSupervisor(
+		utils.NewExponentialBackoffStrategy(100*time.Second, time.Second, time.Second)))
+	pid := context.Spawn(props)
+	state.instruments[sec.SecurityID] = pid
+	context.Forward(pid)
+
+	return nil
+}
+
 func (state *Executor) OnUnipoolV3DataRequest(context actor.Context) error {
 	request := context.Message().(*messages.UnipoolV3DataRequest)
 	sec, rej := state.getSecurity(request.Instrument)
@@ -769,6 +912,157 @@ func (state *Executor) OnUnipoolV3DataRequest(context actor.Context) error {
 	return nil
 }
 
+func (state *Executor) OnPoolRequest(context actor.Context) error {
+	request := context.Message().(*v3.PoolRequest)
+	sec, rej := state.getSecurity(request.Instrument)
+	if rej != nil {
+		context.Respond(&v3.PoolResponse{
+			Err: fmt.Errorf("error getting pool security: %s", rej.String()),
+		})
+		return nil
+	}
+	if sec.SecuritySubType == nil || sec.SecuritySubType.Value != enum.SecuritySubType_UNIPOOLV3 {
+		context.Respond(&v3.PoolResponse{
+			Err: fmt.Errorf("security %d is not a uniswap v3 pool", sec.SecurityID),
+		})
+		return nil
+	}
+	if pid, ok := state.instruments[sec.SecurityID]; ok {
+		context.Forward(pid)
+	} else {
+		props := actor.PropsFromProducer(NewDataManagerProducer(sec, state.dialerPool, nil), actor.WithSupervisor(
+			utils.NewExponentialBackoffStrategy(100*time.Second, time.Second, time.Second)))
+		pid := context.Spawn(props)
+		state.instruments[sec.SecurityID] = pid
+		context.Forward(pid)
+	}
+
+	return nil
+}
+
+func (state *Executor) OnUnipoolV3QuoteRequest(context actor.Context) error {
+	request := context.Message().(*messages.UnipoolV3QuoteRequest)
+	reject := func(rej messages.RejectionReason) {
+		context.Respond(&messages.UnipoolV3QuoteResponse{
+			RequestID:       request.RequestID,
+			ResponseID:      uint64(time.Now().UnixNano()),
+			Success:         false,
+			RejectionReason: rej,
+		})
+	}
+	if len(request.Instruments) == 0 {
+		reject(messages.RejectionReason_MissingInstrument)
+		return nil
+	}
+	amount := big.NewInt(0).SetBytes(request.Amount)
+	if amount.Sign() == 0 || request.RouteParts > v3.MaxRouteParts {
+		reject(messages.RejectionReason_InvalidRequest)
+		return nil
+	}
+	var secs []*models.Security
+	for _, instr := range request.Instruments {
+		sec, rej := state.getSecurity(instr)
+		if rej != nil {
+			reject(*rej)
+			return nil
+		}
+		if sec.SecuritySubType == nil || sec.SecuritySubType.Value != enum.SecuritySubType_UNIPOOLV3 {
+			reject(messages.RejectionReason_UnsupportedRequest)
+			return nil
+		}
+		// The pools must be of the same pair
+		if len(secs) > 0 && (sec.Underlying.ID != secs[0].Underlying.ID || sec.QuoteCurrency.ID != secs[0].QuoteCurrency.ID) {
+			reject(messages.RejectionReason_InvalidRequest)
+			return nil
+		}
+		secs = append(secs, sec)
+	}
+
+	// Fetch the state of the pools from their listeners
+	var futures []*actor.Future
+	for _, sec := range secs {
+		pid, ok := state.instruments[sec.SecurityID]
+		if !ok {
+			props := actor.PropsFromProducer(NewDataManagerProducer(sec, state.dialerPool, nil), actor.WithSupervisor(
+				utils.NewExponentialBackoffStrategy(100*time.Second, time.Second, time.Second)))
+			pid = context.Spawn(props)
+			state.instruments[sec.SecurityID] = pid
+		}
+		futures = append(futures, context.RequestFuture(pid, &v3.PoolRequest{}, 2*time.Minute))
+	}
+
+	go func(sender *actor.PID) {
+		var pools []*v3.Pool
+		for _, fut := range futures {
+			res, err := fut.Result()
+			if err != nil {
+				state.logger.Warn("error fetching pool state", log.Error(err))
+				context.Send(sender, &messages.UnipoolV3QuoteResponse{
+					RequestID:       request.RequestID,
+					ResponseID:      uint64(time.Now().UnixNano()),
+					Success:         false,
+					RejectionReason: messages.RejectionReason_Other,
+				})
+				return
+			}
+			poolRes := res.(*v3.PoolResponse)
+			if poolRes.Err != nil {
+				state.logger.Warn("error fetching pool state", log.Error(poolRes.Err))
+				context.Send(sender, &messages.UnipoolV3QuoteResponse{
+					RequestID:       request.RequestID,
+					ResponseID:      uint64(time.Now().UnixNano()),
+					Success:         false,
+					RejectionReason: messages.RejectionReason_Other,
+				})
+				return
+			}
+			pools = append(pools, poolRes.Pool)
+		}
+		parts := int(request.RouteParts)
+		if parts == 0 {
+			parts = v3.DefaultRouteParts
+		}
+		route, err := v3.Route(pools, request.ZeroForOne, request.ExactOutput, amount, parts)
+		if err != nil {
+			state.logger.Info("error quoting swap", log.Error(err))
+			context.Send(sender, &messages.UnipoolV3QuoteResponse{
+				RequestID:       request.RequestID,
+				ResponseID:      uint64(time.Now().UnixNano()),
+				Success:         false,
+				RejectionReason: messages.RejectionReason_Other,
+			})
+			return
+		}
+		res := &messages.UnipoolV3QuoteResponse{
+			RequestID:    request.RequestID,
+			ResponseID:   uint64(time.Now().UnixNano()),
+			AmountIn:     route.AmountIn.Bytes(),
+			AmountOut:    route.AmountOut.Bytes(),
+			PriceImpact:  route.PriceImpact,
+			TicksCrossed: route.TicksCrossed,
+			Success:      true,
+		}
+		for _, leg := range route.Legs {
+			res.Legs = append(res.Legs, &messages.UnipoolV3QuoteLeg{
+				Instrument: &models.Instrument{
+					SecurityID: &wrapperspb.UInt64Value{Value: secs[leg.Pool].SecurityID},
+					Exchange:   secs[leg.Pool].Exchange,
+					Symbol:     &wrapperspb.StringValue{Value: secs[leg.Pool].Symbol},
+				},
+				AmountIn:          leg.AmountIn.Bytes(),
+				AmountOut:         leg.AmountOut.Bytes(),
+				SqrtPriceX96After: leg.SqrtPriceX96After.Bytes(),
+				TickAfter:         leg.TickAfter,
+				TicksCrossed:      leg.TicksCrossed,
+				PriceImpact:       leg.PriceImpact,
+			})
+		}
+		context.Send(sender, res)
+	}(context.Sender())
+
+	return nil
+}
+
 func (state *Executor) OnHistoricalUnipoolV3EventRequest(context actor.Context) error {
 	request := context.Message().(*messages.HistoricalUnipoolV3DataRequest)
 	sec, rej := state.getSecurity(request.Instrument)
@@ -917,8 +1211,52 @@ func (state *Executor) OnHistoricalSalesRequest(context actor.Context) error {
 	return nil
 }
 
+func (state *Executor) OnNFTMarketDataRequest(context actor.Context) error {
+	request := context.Message().(*messages.NFTMarketDataRequest)
+	passet, ok := state.marketableProtocolAssets[request.MarketableProtocolAssetID]
+	if !ok {
+		context.Respond(&messages.NFTMarketDataResponse{
+			RequestID:       request.RequestID,
+			ResponseID:      uint64(time.Now().UnixNano()),
+			Success:         false,
+			RejectionReason: messages.RejectionReason_UnknownProtocolAsset,
+		})
+		return nil
+	}
+	market, ok := state.executors[passet.Market.ID]
+	if !ok {
+		context.Respond(&messages.NFTMarketDataResponse{
+			RequestID:       request.RequestID,
+			ResponseID:      uint64(time.Now().UnixNano()),
+			Success:         false,
+			RejectionReason: messages.RejectionReason_UnknownExchange,
+		})
+		return nil
+	}
+	context.Forward(market)
+	return nil
+}
+
 func (state *Executor) OnSecurityDefinitionRequest(context actor.Context) error {
 	request := context.Message().(*messages.SecurityDefinitionRequest)
+	if sec, _, ok := state.getSynthetic(request.Instrument); ok {
+		context.Respond(&messages.SecurityDefinitionResponse{
+			RequestID:  request.RequestID,
+			ResponseID: uint64(time.Now().UnixNano()),
+			Security:   sec,
+			Success:    true,
+		})
+		return nil
+	}
+	if sec, ok := state.getConsolidated(request.Instrument); ok {
+		context.Respond(&messages.SecurityDefinitionResponse{
+			RequestID:  request.RequestID,
+			ResponseID: uint64(time.Now().UnixNano()),
+			Security:   sec,
+			Success:    true,
+		})
+		return nil
+	}
 	sec, rej := state.getSecurity(request.Instrument)
 	if rej != nil {
 		context.Respond(&messages.SecurityDefinitionResponse{
@@ -941,10 +1279,7 @@ func (state *Executor) OnSecurityDefinitionRequest(context actor.Context) error
 
 func (state *Executor) OnSecurityListRequest(context actor.Context) error {
 	request := context.Message().(*messages.SecurityListRequest)
-	var securities []*models.Security
-	for _, v := range state.securities {
-		securities = append(securities, v)
-	}
+	securities := state.listSecurities()
 	response := &messages.SecurityList{
 		RequestID:  request.RequestID,
 		ResponseID: uint64(time.Now().UnixNano()),
@@ -982,12 +1317,10 @@ func (state *Executor) OnSecurityList(context actor.Context) error {
 		secs[s.Symbol] = s
 	}
 	state.symbToSecs[exchangeID] = secs
+	state.updateConsolidated()
 
 	// build the updated list
-	var securities []*models.Security
-	for _, v := range state.securities {
-		securities = append(securities, v)
-	}
+	securities := state.listSecurities()
 	for k, v := range state.slSubscribers {
 		securityList := &messages.SecurityList{
 			RequestID:  k,
@@ -1394,7 +1727,19 @@ func (state *Executor) OnGetAccountRequest(context actor.Context) error {
 	}
 
 	accnt := GetAccount(req.Account.Name)
-	if accnt == nil {
+	if accnt == nil && req.Account.Address != "" {
+		var err error
+		accnt, err = NewWalletAccount(*req.Account)
+		if err != nil {
+			context.Respond(&commands.GetAccountResponse{
+				Err: fmt.Errorf("error creating new wallet account: %v", err),
+			})
+			return nil
+		}
+		props := actor.PropsFromProducer(NewAccountManagerProducer(*req.Account, accnt, state.store, state.db, state.registry, nil), actor.WithSupervisor(
+			actor.NewExponentialBackoffStrategy(100*time.Second, time.Second)))
+		state.accountManagers[req.Account.Name] = context.Spawn(props)
+	} else if accnt == nil {
 		exch, ok := constants.GetExchangeByName(req.Account.Exchange)
 		if !ok {
 			context.Respond(&commands.GetAccountResponse{
@@ -0,0 +1,130 @@
+package opensea
+
+import (
+	"sort"
+	"time"
+
+	gmodels "gitlab.com/alphaticks/gorderbook/gorderbook.models"
+)
+
+// nftOrder is a listing or an offer of the book
+type nftOrder struct {
+	hash       string
+	bid        bool
+	tokenID    string // Empty for a collection offer
+	price      float64
+	quantity   uint64
+	expiration time.Time // Zero if the order doesn't expire
+}
+
+type levelKey struct {
+	price float64
+	bid   bool
+}
+
+// book aggregates the listings and offers by price per token, the listings being
+// the asks and the offers the bids, the quantity of a level is its number of tokens.
+// The updates return the new quantity of the levels changed.
+type book struct {
+	orders map[string]*nftOrder
+	levels map[levelKey]uint64
+}
+
+func newBook() *book {
+	return &book{
+		orders: make(map[string]*nftOrder),
+		levels: make(map[levelKey]uint64),
+	}
+}
+
+func (b *book) level(k levelKey) *gmodels.OrderBookLevel {
+	return &gmodels.OrderBookLevel{
+		Price:    k.price,
+		Quantity: float64(b.levels[k]),
+		Bid:      k.bid,
+	}
+}
+
+func (b *book) add(o *nftOrder) []*gmodels.OrderBookLevel {
+	levels := b.remove(o.hash)
+	if o.quantity == 0 {
+		return levels
+	}
+	b.orders[o.hash] = o
+	k := levelKey{price: o.price, bid: o.bid}
+	b.levels[k] += o.quantity
+	return append(levels, b.level(k))
+}
+
+func (b *book) remove(hash string) []*gmodels.OrderBookLevel {
+	return b.fill(hash, 0)
+}
+
+// fill removes the quantity filled from the order, the whole order if zero
+func (b *book) fill(hash string, quantity uint64) []*gmodels.OrderBookLevel {
+	o, ok := b.orders[hash]
+	if !ok {
+		return nil
+	}
+	if quantity == 0 || quantity > o.quantity {
+		quantity = o.quantity
+	}
+	o.quantity -= quantity
+	if o.quantity == 0 {
+		delete(b.orders, hash)
+	}
+	k := levelKey{price: o.price, bid: o.bid}
+	b.levels[k] -= quantity
+	lvl := b.level(k)
+	if b.levels[k] == 0 {
+		delete(b.levels, k)
+	}
+	return []*gmodels.OrderBookLevel{lvl}
+}
+
+// removeToken removes the listings of the token, once sold or transferred they can't be filled
+func (b *book) removeToken(tokenID string) []*gmodels.OrderBookLevel {
+	var levels []*gmodels.OrderBookLevel
+	for _, h := range b.find(func(o *nftOrder) bool { return !o.bid && o.tokenID == tokenID }) {
+		levels = append(levels, b.remove(h)...)
+	}
+	return levels
+}
+
+func (b *book) expire(now time.Time) []*gmodels.OrderBookLevel {
+	var levels []*gmodels.OrderBookLevel
+	for _, h := range b.find(func(o *nftOrder) bool { return !o.expiration.IsZero() && !o.expiration.After(now) }) {
+		levels = append(levels, b.remove(h)...)
+	}
+	return levels
+}
+
+// find returns the hashes of the orders matching, sorted to remove them in the same order
+func (b *book) find(match func(o *nftOrder) bool) []string {
+	var hashes []string
+	for h, o := range b.orders {
+		if match(o) {
+			hashes = append(hashes, h)
+		}
+	}
+	sort.Strings(hashes)
+	return hashes
+}
+
+func (b *book) snapshot() ([]*gmodels.OrderBookLevel, []*gmodels.OrderBookLevel) {
+	var bids, asks []*gmodels.OrderBookLevel
+	for k := range b.levels {
+		if k.bid {
+			bids = append(bids, b.level(k))
+		} else {
+			asks = append(asks, b.level(k))
+		}
+	}
+	sort.Slice(bids, func(i, j int) bool {
+		return bids[i].Price > bids[j].Price
+	})
+	sort.Slice(asks, func(i, j int) bool {
+		return asks[i].Price < asks[j].Price
+	})
+	return bids, asks
+}
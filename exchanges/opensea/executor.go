@@ -37,6 +37,7 @@ type Executor struct {
 	extypes.BaseExecutor
 	queryRunners             []*QueryRunner
 	marketableProtocolAssets map[uint64]*models.MarketableProtocolAsset
+	listeners                map[uint64]*actor.PID // Listeners by marketable protocol asset ID
 	logger                   *log.Logger
 }
 
@@ -65,6 +66,7 @@ func (state *Executor) Initialize(context actor.Context) error {
 		"",
 		log.String("ID", context.Self().Id),
 		log.String("type", reflect.TypeOf(state).String()))
+	state.listeners = make(map[uint64]*actor.PID)
 
 	dialers := state.DialerPool.GetDialers()
 	for _, dialer := range dialers {
@@ -379,6 +381,51 @@ func (state *Executor) OnHistoricalSalesRequest(context actor.Context) error {
 	return nil
 }
 
+func (state *Executor) OnNFTMarketDataRequest(context actor.Context) error {
+	req := context.Message().(*messages.NFTMarketDataRequest)
+	if state.Config.OpenseaAPIKey == "" {
+		context.Respond(&messages.NFTMarketDataResponse{
+			RequestID:       req.RequestID,
+			ResponseID:      uint64(time.Now().UnixNano()),
+			Success:         false,
+			RejectionReason: messages.RejectionReason_UnsupportedRequest,
+		})
+		return nil
+	}
+	pAsset, ok := state.marketableProtocolAssets[req.MarketableProtocolAssetID]
+	if !ok {
+		context.Respond(&messages.NFTMarketDataResponse{
+			RequestID:       req.RequestID,
+			ResponseID:      uint64(time.Now().UnixNano()),
+			Success:         false,
+			RejectionReason: messages.RejectionReason_UnknownProtocolAsset,
+		})
+		return nil
+	}
+	// The listeners are kept once started, their subscribers come and go
+	pid, ok := state.listeners[req.MarketableProtocolAssetID]
+	if !ok {
+		producer := NewListenerProducer(pAsset, state.Config.OpenseaAPIKey, APIURL, StreamURL, state.DialerPool)
+		props := actor.PropsFromProducer(producer, actor.WithSupervisor(
+			utils.NewExponentialBackoffStrategy(100*time.Second, time.Second, time.Second)))
+		pid = context.Spawn(props)
+		context.Watch(pid)
+		state.listeners[req.MarketableProtocolAssetID] = pid
+	}
+	context.Forward(pid)
+	return nil
+}
+
+func (state *Executor) OnTerminated(context actor.Context) error {
+	msg := context.Message().(*actor.Terminated)
+	for k, v := range state.listeners {
+		if v.Id == msg.Who.Id {
+			delete(state.listeners, k)
+		}
+	}
+	return nil
+}
+
 func (state *Executor) Clean(context actor.Context) error {
 	return nil
 }
@@ -0,0 +1,943 @@
+package opensea
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gorilla/websocket"
+	"gitlab.com/alphaticks/alpha-connect/jobs"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+	gmodels "gitlab.com/alphaticks/gorderbook/gorderbook.models"
+	xchangerUtils "gitlab.com/alphaticks/xchanger/utils"
+)
+
+// The listener follows the events of a collection on the OpenSea stream and maintains
+// the book of its listings and offers priced in ETH. The book is loaded from the API
+// on each (re)subscription to the stream, the events missed while disconnected being
+// lost, and sent as a snapshot to the subscribers. The stats of the collection are
+// polled from the API. The subscribers are kept across the restarts of the listener.
+
+const (
+	APIURL    = "https://api.opensea.io"
+	StreamURL = "wss://stream.openseabeta.com/socket/websocket"
+)
+
+type checkSockets struct{}
+
+type updateStats struct{}
+
+// streamMessage is a message of the phoenix channel of the stream
+type streamMessage struct {
+	conn    *websocket.Conn
+	Topic   string          `json:"topic"`
+	Event   string          `json:"event"`
+	Payload json.RawMessage `json:"payload"`
+	Ref     *uint64         `json:"ref"`
+}
+
+type streamError struct {
+	conn *websocket.Conn
+	err  error
+}
+
+// bookLoaded is the book loaded from the API after joining the stream on conn
+type bookLoaded struct {
+	conn *websocket.Conn
+	book *book
+	err  error
+}
+
+type pendingRequest struct {
+	sender  *actor.PID
+	request *messages.NFTMarketDataRequest
+}
+
+// subscriptions holds the subscribers, the requests waiting for the book and the sequence
+// number of a listener, shared by its incarnations so that they follow it across its restarts
+type subscriptions struct {
+	subscribers map[uint64]*actor.PID
+	requests    []*pendingRequest
+	seqNum      uint64
+}
+
+func newSubscriptions() *subscriptions {
+	return &subscriptions{subscribers: make(map[uint64]*actor.PID)}
+}
+
+type streamAccount struct {
+	Address string `json:"address"`
+}
+
+type streamEvent struct {
+	EventType string `json:"event_type"`
+	Payload   struct {
+		OrderHash      string         `json:"order_hash"`
+		BasePrice      string         `json:"base_price"`
+		SalePrice      string         `json:"sale_price"`
+		Quantity       uint64         `json:"quantity"`
+		ExpirationDate string         `json:"expiration_date"`
+		EventTimestamp string         `json:"event_timestamp"`
+		Maker          *streamAccount `json:"maker"`
+		Taker          *streamAccount `json:"taker"`
+		PaymentToken   *struct {
+			Symbol   string `json:"symbol"`
+			Decimals int    `json:"decimals"`
+		} `json:"payment_token"`
+		Item *struct {
+			NFTID string `json:"nft_id"` // chain/contract/token ID
+		} `json:"item"`
+		Transaction *struct {
+			Hash string `json:"hash"`
+		} `json:"transaction"`
+	} `json:"payload"`
+}
+
+// apiOrder is a listing or an offer of the API, the price of a listing being its current price
+type apiOrder struct {
+	OrderHash string `json:"order_hash"`
+	Price     struct {
+		Current *apiPrice `json:"current"`
+		apiPrice
+	} `json:"price"`
+	ProtocolData struct {
+		Parameters struct {
+			Offer         []apiItem `json:"offer"`
+			Consideration []apiItem `json:"consideration"`
+			EndTime       string    `json:"endTime"`
+		} `json:"parameters"`
+	} `json:"protocol_data"`
+}
+
+type apiPrice struct {
+	Currency string `json:"currency"`
+	Decimals int    `json:"decimals"`
+	Value    string `json:"value"`
+}
+
+// apiItem is an item of a seaport order
+type apiItem struct {
+	ItemType             int    `json:"itemType"`
+	Token                string `json:"token"`
+	IdentifierOrCriteria string `json:"identifierOrCriteria"`
+	StartAmount          string `json:"startAmount"`
+}
+
+// Seaport item types of the NFTs
+const (
+	itemERC721          = 2
+	itemERC1155         = 3
+	itemERC721Criteria  = 4
+	itemERC1155Criteria = 5
+)
+
+// Pages of the listings and offers loaded at most, of 100 orders
+const maxOrderPages = 100
+
+type Listener struct {
+	asset           *models.MarketableProtocolAsset
+	apiKey          string
+	apiURL          string
+	streamURL       string
+	dialerPool      *xchangerUtils.DialerPool
+	queryRunner     *actor.PID
+	slug            string
+	conn            *websocket.Conn
+	book            *book
+	stats           *messages.NFTCollectionStats
+	subs            *subscriptions
+	loading         bool             // The book is being loaded
+	pending         []*streamMessage // Stream messages received while loading the book
+	lastMessageTime time.Time
+	lastPingTime    time.Time
+	lastHBTime      time.Time
+	lastStatsTime   time.Time
+	ref             uint64
+	logger          *log.Logger
+	socketTicker    *time.Ticker
+}
+
+func NewListenerProducer(asset *models.MarketableProtocolAsset, apiKey, apiURL, streamURL string, dialerPool *xchangerUtils.DialerPool) actor.Producer {
+	subs := newSubscriptions()
+	return func() actor.Actor {
+		return newListener(asset, apiKey, apiURL, streamURL, dialerPool, subs)
+	}
+}
+
+func NewListener(asset *models.MarketableProtocolAsset, apiKey, apiURL, streamURL string, dialerPool *xchangerUtils.DialerPool) actor.Actor {
+	return newListener(asset, apiKey, apiURL, streamURL, dialerPool, newSubscriptions())
+}
+
+func newListener(asset *models.MarketableProtocolAsset, apiKey, apiURL, streamURL string, dialerPool *xchangerUtils.DialerPool, subs *subscriptions) actor.Actor {
+	return &Listener{
+		asset:      asset,
+		apiKey:     apiKey,
+		apiURL:     apiURL,
+		streamURL:  streamURL,
+		dialerPool: dialerPool,
+		subs:       subs,
+		logger:     nil,
+	}
+}
+
+func (state *Listener) Receive(context actor.Context) {
+	switch context.Message().(type) {
+	case *actor.Started:
+		if err := state.Initialize(context); err != nil {
+			state.logger.Error("error initializing", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor started")
+
+	case *actor.Stopping:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error stopping", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor stopping")
+
+	case *actor.Stopped:
+		state.logger.Info("actor stopped")
+
+	case *actor.Restarting:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error restarting", log.Error(err))
+			// Attention, no panic in restarting or infinite loop
+		}
+		state.logger.Info("actor restarting")
+
+	case *messages.NFTMarketDataRequest:
+		if err := state.OnNFTMarketDataRequest(context); err != nil {
+			state.logger.Error("error processing OnNFTMarketDataRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *streamMessage:
+		if err := state.onStreamMessage(context); err != nil {
+			state.logger.Error("error processing stream message", log.Error(err))
+			panic(err)
+		}
+
+	case *streamError:
+		if err := state.onStreamError(context); err != nil {
+			state.logger.Error("error processing stream error", log.Error(err))
+			panic(err)
+		}
+
+	case *bookLoaded:
+		if err := state.onBookLoaded(context); err != nil {
+			state.logger.Error("error processing book loaded", log.Error(err))
+			panic(err)
+		}
+
+	case *checkSockets:
+		if err := state.checkSockets(context); err != nil {
+			state.logger.Error("error checking socket", log.Error(err))
+			panic(err)
+		}
+
+	case *updateStats:
+		if err := state.updateStats(context); err != nil {
+			state.logger.Error("error updating stats", log.Error(err))
+			panic(err)
+		}
+
+	case *actor.Terminated:
+		if err := state.OnTerminated(context); err != nil {
+			state.logger.Error("error processing OnTerminated", log.Error(err))
+			panic(err)
+		}
+	}
+}
+
+func (state *Listener) Initialize(context actor.Context) error {
+	state.logger = log.New(
+		log.InfoLevel,
+		"",
+		log.String("ID", context.Self().Id),
+		log.String("type", reflect.TypeOf(*state).String()),
+		log.String("asset", state.asset.ProtocolAsset.Asset.Symbol))
+	state.book = newBook()
+	if state.subs.seqNum == 0 {
+		state.subs.seqNum = uint64(time.Now().UnixNano())
+	}
+	// Subscribers of the previous incarnation
+	for _, pid := range state.subs.subscribers {
+		context.Watch(pid)
+	}
+	state.lastHBTime = time.Now()
+
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+	if state.dialerPool != nil {
+		client.Transport = &http.Transport{
+			DialContext: state.dialerPool.GetDialer().DialContext,
+		}
+	}
+	state.queryRunner = context.Spawn(actor.PropsFromProducer(func() actor.Actor {
+		return jobs.NewHTTPQuery(client)
+	}))
+
+	// The stream is by collection
+	address := state.asset.ProtocolAsset.ContractAddress.GetValue()
+	var contract struct {
+		Collection struct {
+			Slug string `json:"slug"`
+		} `json:"collection"`
+	}
+	if err := state.query(context, "/api/v1/asset_contract/"+address, &contract); err != nil {
+		return fmt.Errorf("error fetching asset contract: %v", err)
+	}
+	if contract.Collection.Slug == "" {
+		return fmt.Errorf("no collection for contract %s", address)
+	}
+	state.slug = contract.Collection.Slug
+
+	if err := state.subscribe(context); err != nil {
+		return fmt.Errorf("error subscribing to collection: %v", err)
+	}
+
+	socketTicker := time.NewTicker(5 * time.Second)
+	state.socketTicker = socketTicker
+	go func(pid *actor.PID) {
+		for {
+			select {
+			case <-socketTicker.C:
+				context.Send(pid, &checkSockets{})
+			case <-time.After(10 * time.Second):
+				if state.socketTicker != socketTicker {
+					// Only stop if socket ticker has changed
+					return
+				}
+			}
+		}
+	}(context.Self())
+
+	return nil
+}
+
+func (state *Listener) Clean(context actor.Context) error {
+	if state.conn != nil {
+		if err := state.conn.Close(); err != nil {
+			state.logger.Info("error closing socket", log.Error(err))
+		}
+		state.conn = nil
+	}
+	if state.socketTicker != nil {
+		state.socketTicker.Stop()
+		state.socketTicker = nil
+	}
+	return nil
+}
+
+// query fetches the API path and decodes the response
+func (state *Listener) query(context actor.SenderContext, path string, v interface{}) error {
+	req, err := state.newRequest(path)
+	if err != nil {
+		return err
+	}
+	res, err := context.RequestFuture(state.queryRunner, &jobs.PerformHTTPQueryRequest{Request: req}, 15*time.Second).Result()
+	if err != nil {
+		return err
+	}
+	return decodeResponse(res, v)
+}
+
+func (state *Listener) newRequest(path string) (*http.Request, error) {
+	req, err := http.NewRequest(http.MethodGet, state.apiURL+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if state.apiKey != "" {
+		req.Header.Set("X-API-KEY", state.apiKey)
+	}
+	return req, nil
+}
+
+func decodeResponse(res interface{}, v interface{}) error {
+	resp, ok := res.(*jobs.PerformQueryResponse)
+	if !ok {
+		return fmt.Errorf("was expecting PerformQueryResponse, got %s", reflect.TypeOf(res).String())
+	}
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("%d %s", resp.StatusCode, string(resp.Response))
+	}
+	if err := json.Unmarshal(resp.Response, v); err != nil {
+		return fmt.Errorf("error decoding response: %v", err)
+	}
+	return nil
+}
+
+func (state *Listener) subscribe(context actor.Context) error {
+	if state.conn != nil {
+		_ = state.conn.Close()
+		state.conn = nil
+	}
+	u, err := url.Parse(state.streamURL)
+	if err != nil {
+		return fmt.Errorf("error parsing stream url: %v", err)
+	}
+	q := u.Query()
+	q.Set("token", state.apiKey)
+	u.RawQuery = q.Encode()
+
+	dialer := &websocket.Dialer{
+		HandshakeTimeout: 10 * time.Second,
+	}
+	if state.dialerPool != nil {
+		dialer.NetDialContext = state.dialerPool.GetDialer().DialContext
+	}
+	conn, _, err := dialer.Dial(u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("error connecting to stream: %v", err)
+	}
+
+	topic := "collection:" + state.slug
+	state.ref += 1
+	ref := state.ref
+	if err := conn.WriteJSON(&streamMessage{Topic: topic, Event: "phx_join", Payload: json.RawMessage("{}"), Ref: &ref}); err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("error joining collection: %v", err)
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	for {
+		var msg streamMessage
+		if err := conn.ReadJSON(&msg); err != nil {
+			_ = conn.Close()
+			return fmt.Errorf("error reading message: %v", err)
+		}
+		if msg.Event != "phx_reply" || msg.Topic != topic {
+			continue
+		}
+		var reply struct {
+			Status string `json:"status"`
+		}
+		if err := json.Unmarshal(msg.Payload, &reply); err != nil {
+			_ = conn.Close()
+			return fmt.Errorf("error decoding join reply: %v", err)
+		}
+		if reply.Status != "ok" {
+			_ = conn.Close()
+			return fmt.Errorf("was expecting ok join status, got %s", reply.Status)
+		}
+		break
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+
+	state.conn = conn
+	state.lastMessageTime = time.Now()
+	state.lastPingTime = time.Now()
+
+	go func(conn *websocket.Conn, pid *actor.PID) {
+		for {
+			msg := &streamMessage{conn: conn}
+			if err := conn.ReadJSON(msg); err != nil {
+				context.Send(pid, &streamError{conn: conn, err: err})
+				return
+			}
+			context.Send(pid, msg)
+		}
+	}(conn, context.Self())
+
+	// Loaded once joined, outside of the actor, the events received meanwhile are applied after
+	state.loading = true
+	state.pending = nil
+	go func(pid *actor.PID, slug string) {
+		b, err := state.loadBook(context.ActorSystem().Root, slug)
+		context.Send(pid, &bookLoaded{conn: conn, book: b, err: err})
+	}(context.Self(), state.slug)
+
+	return nil
+}
+
+// loadBook loads the listings and offers of the collection
+func (state *Listener) loadBook(context actor.SenderContext, slug string) (*book, error) {
+	b := newBook()
+	for _, bid := range []bool{false, true} {
+		path := "/api/v2/listings/collection/" + slug + "/all"
+		if bid {
+			path = "/api/v2/offers/collection/" + slug + "/all"
+		}
+		next := ""
+		for i := 0; ; i++ {
+			if i == maxOrderPages {
+				return nil, fmt.Errorf("more than %d pages of orders", maxOrderPages)
+			}
+			q := url.Values{}
+			q.Set("limit", "100")
+			if next != "" {
+				q.Set("next", next)
+			}
+			var page struct {
+				Listings []*apiOrder `json:"listings"`
+				Offers   []*apiOrder `json:"offers"`
+				Next     string      `json:"next"`
+			}
+			if err := state.query(context, path+"?"+q.Encode(), &page); err != nil {
+				return nil, err
+			}
+			orders := page.Listings
+			if bid {
+				orders = page.Offers
+			}
+			for _, o := range orders {
+				order, err := state.bookOrder(o, bid)
+				if err != nil {
+					state.logger.Warn("error decoding order", log.String("hash", o.OrderHash), log.Error(err))
+					continue
+				}
+				if order != nil {
+					b.add(order)
+				}
+			}
+			if page.Next == "" {
+				break
+			}
+			next = page.Next
+		}
+	}
+	return b, nil
+}
+
+// bookOrder returns the order of the book of a listing or offer of the API, nil if it
+// is not in ETH or not of the contract
+func (state *Listener) bookOrder(o *apiOrder, bid bool) (*nftOrder, error) {
+	price := o.Price.apiPrice
+	if o.Price.Current != nil {
+		price = *o.Price.Current
+	}
+	if price.Currency != "ETH" && price.Currency != "WETH" {
+		return nil, nil
+	}
+	// The NFTs are offered by a listing, and asked for by an offer
+	items := o.ProtocolData.Parameters.Offer
+	if bid {
+		items = o.ProtocolData.Parameters.Consideration
+	}
+	var item *apiItem
+	for i := range items {
+		if strings.EqualFold(items[i].Token, state.asset.ProtocolAsset.ContractAddress.GetValue()) {
+			item = &items[i]
+			break
+		}
+	}
+	if item == nil {
+		return nil, nil
+	}
+	order := &nftOrder{
+		hash:     o.OrderHash,
+		bid:      bid,
+		quantity: 1,
+	}
+	switch item.ItemType {
+	case itemERC721, itemERC1155:
+		order.tokenID = item.IdentifierOrCriteria
+	case itemERC721Criteria, itemERC1155Criteria:
+		if !bid {
+			return nil, fmt.Errorf("listing of criteria item")
+		}
+	default:
+		return nil, fmt.Errorf("unexpected item type %d", item.ItemType)
+	}
+	if item.StartAmount != "" {
+		q, ok := big.NewInt(0).SetString(item.StartAmount, 10)
+		if !ok || !q.IsUint64() || q.Uint64() == 0 {
+			return nil, fmt.Errorf("invalid quantity %s", item.StartAmount)
+		}
+		order.quantity = q.Uint64()
+	}
+	total, ok := big.NewInt(0).SetString(price.Value, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid price %s", price.Value)
+	}
+	order.price = unitPrice(total, order.quantity, price.Decimals)
+	if end := o.ProtocolData.Parameters.EndTime; end != "" {
+		t, ok := big.NewInt(0).SetString(end, 10)
+		if !ok || !t.IsInt64() {
+			return nil, fmt.Errorf("invalid end time %s", end)
+		}
+		if t.Int64() > 0 {
+			order.expiration = time.Unix(t.Int64(), 0)
+		}
+	}
+	return order, nil
+}
+
+// OnNFTMarketDataRequest responds with the book, once loaded
+func (state *Listener) OnNFTMarketDataRequest(context actor.Context) error {
+	req := context.Message().(*messages.NFTMarketDataRequest)
+	if state.loading {
+		state.subs.requests = append(state.subs.requests, &pendingRequest{
+			sender:  context.Sender(),
+			request: req,
+		})
+		return nil
+	}
+	state.respond(context, context.Sender(), req)
+	return nil
+}
+
+// respond subscribes the subscriber of the request and sends the book to the sender
+func (state *Listener) respond(context actor.Context, sender *actor.PID, req *messages.NFTMarketDataRequest) {
+	if req.Subscriber != nil {
+		state.subs.subscribers[req.RequestID] = req.Subscriber
+		context.Watch(req.Subscriber)
+	}
+	if sender == nil {
+		return
+	}
+	bids, asks := state.book.snapshot()
+	context.Send(sender, &messages.NFTMarketDataResponse{
+		RequestID:  req.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		Success:    true,
+		SeqNum:     state.subs.seqNum,
+		SnapshotL2: &models.OBL2Snapshot{
+			Bids:      bids,
+			Asks:      asks,
+			Timestamp: utils.MilliToTimestamp(uint64(time.Now().UnixNano() / 1000000)),
+		},
+		Stats: state.stats,
+	})
+}
+
+// onBookLoaded replaces the book, sends it to the subscribers and applies the events
+// received while loading it
+func (state *Listener) onBookLoaded(context actor.Context) error {
+	msg := context.Message().(*bookLoaded)
+	if msg.conn != state.conn {
+		// Book of a previous connection
+		return nil
+	}
+	if msg.err != nil {
+		return fmt.Errorf("error loading book: %v", msg.err)
+	}
+	state.loading = false
+	state.book = msg.book
+	state.publishSnapshot(context)
+	// The requesters get the book in the response
+	for _, r := range state.subs.requests {
+		state.respond(context, r.sender, r.request)
+	}
+	state.subs.requests = nil
+	if state.lastStatsTime.IsZero() {
+		// Fetched once the first requests are answered
+		context.Send(context.Self(), &updateStats{})
+	}
+	pending := state.pending
+	state.pending = nil
+	for _, msg := range pending {
+		state.applyStreamMessage(context, msg)
+	}
+	return nil
+}
+
+func (state *Listener) onStreamMessage(context actor.Context) error {
+	msg := context.Message().(*streamMessage)
+	if msg.conn != state.conn {
+		// Message of a previous connection
+		return nil
+	}
+	state.lastMessageTime = time.Now()
+	switch msg.Event {
+	case "phx_reply":
+		return nil
+	case "phx_error", "phx_close":
+		state.logger.Info("channel closed, resubscribing", log.String("event", msg.Event))
+		return state.subscribe(context)
+	}
+	if state.loading {
+		state.pending = append(state.pending, msg)
+		return nil
+	}
+	state.applyStreamMessage(context, msg)
+	return nil
+}
+
+// applyStreamMessage applies the event of the message to the book and publishes it
+func (state *Listener) applyStreamMessage(context actor.Context, msg *streamMessage) {
+	var ev streamEvent
+	if err := json.Unmarshal(msg.Payload, &ev); err != nil {
+		state.logger.Warn("error decoding stream event", log.Error(err))
+		return
+	}
+	event, levels, err := state.processEvent(&ev)
+	if err != nil {
+		state.logger.Warn("error processing stream event", log.String("event", msg.Event), log.Error(err))
+		return
+	}
+	var events []*messages.NFTMarketEvent
+	if event != nil {
+		events = append(events, event)
+	}
+	if len(events) > 0 || len(levels) > 0 {
+		state.publish(context, events, levels, nil)
+	}
+}
+
+// processEvent returns the event of the stream event, if any, and applies it to the book
+func (state *Listener) processEvent(ev *streamEvent) (*messages.NFTMarketEvent, []*gmodels.OrderBookLevel, error) {
+	p := &ev.Payload
+	var tokenID *big.Int
+	var token string
+	if p.Item != nil && p.Item.NFTID != "" {
+		parts := strings.Split(p.Item.NFTID, "/")
+		if len(parts) != 3 {
+			return nil, nil, fmt.Errorf("invalid nft id %s", p.Item.NFTID)
+		}
+		if !strings.EqualFold(parts[1], state.asset.ProtocolAsset.ContractAddress.GetValue()) {
+			// Other contract of the collection
+			return nil, nil, nil
+		}
+		var ok bool
+		tokenID, ok = big.NewInt(0).SetString(parts[2], 10)
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid token id %s", parts[2])
+		}
+		token = parts[2]
+	}
+
+	var typ messages.NFTMarketEventType
+	switch ev.EventType {
+	case "item_listed":
+		typ = messages.NFTListing
+	case "item_received_offer", "item_received_bid", "collection_offer":
+		typ = messages.NFTOffer
+	case "item_cancelled":
+		typ = messages.NFTCancellation
+	case "item_sold":
+		typ = messages.NFTSale
+	case "item_transferred":
+		// The listings of the token can't be filled anymore
+		return nil, state.book.removeToken(token), nil
+	default:
+		return nil, nil, nil
+	}
+
+	event := &messages.NFTMarketEvent{
+		Type:      typ,
+		OrderHash: p.OrderHash,
+		TokenID:   tokenID,
+		Quantity:  p.Quantity,
+		Time:      time.Now(),
+	}
+	if event.Quantity == 0 {
+		event.Quantity = 1
+	}
+	if p.Maker != nil {
+		event.Maker = common.HexToAddress(p.Maker.Address)
+	}
+	if p.Taker != nil {
+		event.Taker = common.HexToAddress(p.Taker.Address)
+	}
+	if p.Transaction != nil {
+		event.TxHash = common.HexToHash(p.Transaction.Hash)
+	}
+	if p.EventTimestamp != "" {
+		if t, err := time.Parse(time.RFC3339, p.EventTimestamp); err == nil {
+			event.Time = t
+		}
+	}
+	if p.ExpirationDate != "" {
+		t, err := time.Parse(time.RFC3339, p.ExpirationDate)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid expiration date %s", p.ExpirationDate)
+		}
+		event.Expiration = t
+	}
+	price := p.BasePrice
+	if typ == messages.NFTSale {
+		price = p.SalePrice
+	}
+	if price != "" {
+		pr, ok := big.NewInt(0).SetString(price, 10)
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid price %s", price)
+		}
+		event.Price = pr
+	}
+	// Only the orders in ETH are in the book
+	inETH := false
+	decimals := 18
+	if p.PaymentToken != nil {
+		event.PaymentToken = p.PaymentToken.Symbol
+		inETH = p.PaymentToken.Symbol == "ETH" || p.PaymentToken.Symbol == "WETH"
+		decimals = p.PaymentToken.Decimals
+	}
+
+	var levels []*gmodels.OrderBookLevel
+	switch typ {
+	case messages.NFTListing, messages.NFTOffer:
+		if inETH && event.Price != nil && (typ == messages.NFTOffer || token != "") {
+			levels = state.book.add(&nftOrder{
+				hash:       p.OrderHash,
+				bid:        typ == messages.NFTOffer,
+				tokenID:    token,
+				price:      unitPrice(event.Price, event.Quantity, decimals),
+				quantity:   event.Quantity,
+				expiration: event.Expiration,
+			})
+		}
+	case messages.NFTCancellation:
+		levels = state.book.remove(p.OrderHash)
+	case messages.NFTSale:
+		levels = state.book.fill(p.OrderHash, event.Quantity)
+		if token != "" {
+			levels = append(levels, state.book.removeToken(token)...)
+		}
+	}
+	return event, levels, nil
+}
+
+// unitPrice returns the price per token in units of the payment token
+func unitPrice(total *big.Int, quantity uint64, decimals int) float64 {
+	p := big.NewFloat(0).SetInt(total)
+	p.Quo(p, big.NewFloat(0).SetInt(big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)))
+	p.Quo(p, big.NewFloat(float64(quantity)))
+	f, _ := p.Float64()
+	return f
+}
+
+func (state *Listener) publish(context actor.Context, events []*messages.NFTMarketEvent, levels []*gmodels.OrderBookLevel, stats *messages.NFTCollectionStats) {
+	state.subs.seqNum += 1
+	var update *models.OBL2Update
+	if len(levels) > 0 {
+		update = &models.OBL2Update{
+			Levels:    levels,
+			Timestamp: utils.MilliToTimestamp(uint64(time.Now().UnixNano() / 1000000)),
+		}
+	}
+	for k, v := range state.subs.subscribers {
+		context.Send(v, &messages.NFTMarketDataIncrementalRefresh{
+			RequestID:  k,
+			ResponseID: uint64(time.Now().UnixNano()),
+			SeqNum:     state.subs.seqNum,
+			Events:     events,
+			UpdateL2:   update,
+			Stats:      stats,
+		})
+	}
+	state.lastHBTime = time.Now()
+}
+
+// publishSnapshot sends the book to the subscribers, replacing the one they hold
+func (state *Listener) publishSnapshot(context actor.Context) {
+	state.subs.seqNum += 1
+	bids, asks := state.book.snapshot()
+	snapshot := &models.OBL2Snapshot{
+		Bids:      bids,
+		Asks:      asks,
+		Timestamp: utils.MilliToTimestamp(uint64(time.Now().UnixNano() / 1000000)),
+	}
+	for k, v := range state.subs.subscribers {
+		context.Send(v, &messages.NFTMarketDataIncrementalRefresh{
+			RequestID:  k,
+			ResponseID: uint64(time.Now().UnixNano()),
+			SeqNum:     state.subs.seqNum,
+			SnapshotL2: snapshot,
+		})
+	}
+	state.lastHBTime = time.Now()
+}
+
+func (state *Listener) onStreamError(context actor.Context) error {
+	msg := context.Message().(*streamError)
+	if msg.conn != state.conn {
+		return nil
+	}
+	state.logger.Info("error on socket", log.Error(msg.err))
+	return state.subscribe(context)
+}
+
+func (state *Listener) updateStats(context actor.Context) error {
+	req, err := state.newRequest("/api/v1/collection/" + state.slug + "/stats")
+	if err != nil {
+		return err
+	}
+	state.lastStatsTime = time.Now()
+	future := context.RequestFuture(state.queryRunner, &jobs.PerformHTTPQueryRequest{Request: req}, 15*time.Second)
+	context.ReenterAfter(future, func(res interface{}, err error) {
+		var r struct {
+			Stats struct {
+				FloorPrice   float64 `json:"floor_price"`
+				OneDayVolume float64 `json:"one_day_volume"`
+				OneDaySales  float64 `json:"one_day_sales"`
+				TotalVolume  float64 `json:"total_volume"`
+				TotalSupply  float64 `json:"total_supply"`
+				NumOwners    float64 `json:"num_owners"`
+			} `json:"stats"`
+		}
+		if err == nil {
+			err = decodeResponse(res, &r)
+		}
+		if err != nil {
+			state.logger.Warn("error fetching collection stats", log.Error(err))
+			return
+		}
+		state.stats = &messages.NFTCollectionStats{
+			FloorPrice:   r.Stats.FloorPrice,
+			OneDayVolume: r.Stats.OneDayVolume,
+			OneDaySales:  r.Stats.OneDaySales,
+			TotalVolume:  r.Stats.TotalVolume,
+			TotalSupply:  r.Stats.TotalSupply,
+			NumOwners:    r.Stats.NumOwners,
+			Time:         time.Now(),
+		}
+		state.publish(context, nil, nil, state.stats)
+	})
+	return nil
+}
+
+func (state *Listener) checkSockets(context actor.Context) error {
+	if time.Since(state.lastMessageTime) > time.Minute {
+		state.logger.Info("stream timed-out, resubscribing")
+		if err := state.subscribe(context); err != nil {
+			return fmt.Errorf("error subscribing to collection: %v", err)
+		}
+	}
+	// The phoenix channel is closed without heartbeat
+	if time.Since(state.lastPingTime) > 30*time.Second {
+		state.ref += 1
+		ref := state.ref
+		if err := state.conn.WriteJSON(&streamMessage{Topic: "phoenix", Event: "heartbeat", Payload: json.RawMessage("{}"), Ref: &ref}); err != nil {
+			state.logger.Info("error sending heartbeat", log.Error(err))
+		}
+		state.lastPingTime = time.Now()
+	}
+
+	if levels := state.book.expire(time.Now()); len(levels) > 0 {
+		state.publish(context, nil, levels, nil)
+	}
+	if time.Since(state.lastStatsTime) > time.Minute {
+		context.Send(context.Self(), &updateStats{})
+	}
+
+	// If haven't sent anything for 5 seconds, send heartbeat
+	if time.Since(state.lastHBTime) > 5*time.Second {
+		state.publish(context, nil, nil, nil)
+	}
+
+	return nil
+}
+
+func (state *Listener) OnTerminated(context actor.Context) error {
+	msg := context.Message().(*actor.Terminated)
+	for k, v := range state.subs.subscribers {
+		if v.String() == msg.Who.String() {
+			delete(state.subs.subscribers, k)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,373 @@
+package opensea
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/gorilla/websocket"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	xmodels "gitlab.com/alphaticks/xchanger/models"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const testContract = "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d"
+
+func streamPayload(eventType string, payload string) string {
+	return fmt.Sprintf(`{"topic":"collection:test","event":"%s","payload":{"event_type":"%s","payload":%s},"ref":null}`, eventType, eventType, payload)
+}
+
+func apiListing(hash string, token int, value string, currency string) string {
+	return fmt.Sprintf(`{"order_hash":"%s","price":{"current":{"currency":"%s","decimals":18,"value":"%s"}},"protocol_data":{"parameters":{"offer":[{"itemType":2,"token":"%s","identifierOrCriteria":"%d","startAmount":"1"}],"endTime":"4102444800"}}}`, hash, currency, value, testContract, token)
+}
+
+func apiOffer(hash string, quantity int, value string) string {
+	return fmt.Sprintf(`{"order_hash":"%s","price":{"currency":"WETH","decimals":18,"value":"%s"},"protocol_data":{"parameters":{"consideration":[{"itemType":4,"token":"%s","identifierOrCriteria":"0","startAmount":"%d"}],"endTime":"4102444800"}}}`, hash, value, testContract, quantity)
+}
+
+// newStandIn serves the API and the stream of the collection. The events are streamed once send is
+// closed, then the first connection is closed, the API serving the orders left by the events after it.
+func newStandIn(t *testing.T, send chan struct{}, events []string) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	mux := http.NewServeMux()
+	var mu sync.Mutex
+	streamed := false
+	conns := 0
+	mux.HandleFunc("/api/v2/listings/collection/test/all", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if streamed {
+			_, _ = w.Write([]byte(`{"listings":[` + apiListing("0x01", 1, "2000000000000000000", "ETH") + `]}`))
+		} else if r.URL.Query().Get("next") == "" {
+			_, _ = w.Write([]byte(`{"listings":[` + apiListing("0x10", 5, "4000000000000000000", "ETH") + `],"next":"p2"}`))
+		} else {
+			// Not in ETH
+			_, _ = w.Write([]byte(`{"listings":[` + apiListing("0x11", 6, "1000", "USDC") + `]}`))
+		}
+	})
+	mux.HandleFunc("/api/v2/offers/collection/test/all", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if streamed {
+			_, _ = w.Write([]byte(`{"offers":[` + apiOffer("0x04", 1, "1500000000000000000") + `]}`))
+		} else {
+			_, _ = w.Write([]byte(`{"offers":[]}`))
+		}
+	})
+	mux.HandleFunc("/api/v1/asset_contract/"+testContract, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"collection":{"slug":"test"}}`))
+	})
+	mux.HandleFunc("/api/v1/collection/test/stats", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"stats":{"floor_price":1.5,"one_day_volume":30,"one_day_sales":20,"total_supply":10000,"num_owners":6000}}`))
+	})
+	mux.HandleFunc("/socket/websocket", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("token") != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		var join streamMessage
+		if err := conn.ReadJSON(&join); err != nil || join.Event != "phx_join" || join.Topic != "collection:test" {
+			t.Errorf("was expecting a join of the collection, got %v %v", join, err)
+			return
+		}
+		reply := fmt.Sprintf(`{"topic":"collection:test","event":"phx_reply","payload":{"status":"ok","response":{}},"ref":%d}`, *join.Ref)
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(reply)); err != nil {
+			return
+		}
+		mu.Lock()
+		conns += 1
+		first := conns == 1
+		mu.Unlock()
+		if first {
+			<-send
+			for _, e := range events {
+				if err := conn.WriteMessage(websocket.TextMessage, []byte(e)); err != nil {
+					return
+				}
+			}
+			mu.Lock()
+			streamed = true
+			mu.Unlock()
+			// Disconnected
+			return
+		}
+		// Hold the connection
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestListener(t *testing.T) {
+	nft := func(id int) string {
+		return fmt.Sprintf(`{"nft_id":"ethereum/%s/%d"}`, testContract, id)
+	}
+	eth := `{"symbol":"ETH","decimals":18}`
+	weth := `{"symbol":"WETH","decimals":18}`
+	events := []string{
+		// Loaded from the API
+		streamPayload("item_cancelled", fmt.Sprintf(`{"order_hash":"0x10","item":%s}`, nft(5))),
+		streamPayload("item_listed", fmt.Sprintf(`{"order_hash":"0x01","base_price":"2000000000000000000","quantity":1,"maker":{"address":"0x0000000000000000000000000000000000000001"},"payment_token":%s,"item":%s}`, eth, nft(1))),
+		streamPayload("item_listed", fmt.Sprintf(`{"order_hash":"0x02","base_price":"2000000000000000000","quantity":1,"payment_token":%s,"item":%s}`, eth, nft(2))),
+		streamPayload("item_listed", fmt.Sprintf(`{"order_hash":"0x03","base_price":"3000000000000000000","quantity":1,"payment_token":%s,"item":%s}`, eth, nft(3))),
+		streamPayload("collection_offer", fmt.Sprintf(`{"order_hash":"0x04","base_price":"3000000000000000000","quantity":2,"payment_token":%s}`, weth)),
+		// Not in ETH
+		streamPayload("item_listed", fmt.Sprintf(`{"order_hash":"0x05","base_price":"1000","quantity":1,"payment_token":{"symbol":"USDC","decimals":6},"item":%s}`, nft(4))),
+		streamPayload("item_cancelled", fmt.Sprintf(`{"order_hash":"0x03","item":%s}`, nft(3))),
+		streamPayload("item_sold", fmt.Sprintf(`{"order_hash":"0x04","sale_price":"1500000000000000000","quantity":1,"payment_token":%s,"transaction":{"hash":"0x05"},"item":%s}`, weth, nft(2))),
+	}
+	send := make(chan struct{})
+	server := newStandIn(t, send, events)
+	defer server.Close()
+
+	asset := &models.MarketableProtocolAsset{
+		MarketableProtocolAssetID: 1,
+		ProtocolAsset: &models.ProtocolAsset{
+			Asset:           &xmodels.Asset{Symbol: "BAYC"},
+			ContractAddress: wrapperspb.String(testContract),
+		},
+	}
+	as := actor.NewActorSystem()
+	defer as.Shutdown()
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/socket/websocket"
+	listener := as.Root.Spawn(actor.PropsFromProducer(NewListenerProducer(asset, "key", server.URL, wsURL, nil)))
+
+	refreshes := make(chan *messages.NFTMarketDataIncrementalRefresh, 100)
+	subscriber := as.Root.Spawn(actor.PropsFromFunc(func(c actor.Context) {
+		if r, ok := c.Message().(*messages.NFTMarketDataIncrementalRefresh); ok {
+			refreshes <- r
+		}
+	}))
+	res, err := as.Root.RequestFuture(listener, &messages.NFTMarketDataRequest{
+		RequestID:                 1,
+		MarketableProtocolAssetID: 1,
+		Subscriber:                subscriber,
+	}, 10*time.Second).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	md, ok := res.(*messages.NFTMarketDataResponse)
+	if !ok || !md.Success {
+		t.Fatalf("unexpected response %v", res)
+	}
+	// The listing in USDC isn't in the book
+	if len(md.SnapshotL2.Bids) != 0 || len(md.SnapshotL2.Asks) != 1 || md.SnapshotL2.Asks[0].Price != 4 {
+		t.Fatalf("was expecting the listing of the API, got %v", md.SnapshotL2)
+	}
+	close(send)
+
+	var received []*messages.NFTMarketEvent
+	var stats *messages.NFTCollectionStats
+	var snapshot *models.OBL2Snapshot
+	seqNum := md.SeqNum
+	timeout := time.After(10 * time.Second)
+	for len(received) < 8 || stats == nil || snapshot == nil {
+		select {
+		case r := <-refreshes:
+			if r.SeqNum != seqNum+1 {
+				t.Fatalf("out of order sequence %d, was expecting %d", r.SeqNum, seqNum+1)
+			}
+			seqNum = r.SeqNum
+			received = append(received, r.Events...)
+			if r.Stats != nil {
+				stats = r.Stats
+			}
+			if r.SnapshotL2 != nil {
+				snapshot = r.SnapshotL2
+			}
+		case <-timeout:
+			t.Fatalf("timed-out waiting for events, got %d", len(received))
+		}
+	}
+	// Reloaded on reconnection
+	if len(snapshot.Asks) != 1 || snapshot.Asks[0].Price != 2 || len(snapshot.Bids) != 1 || snapshot.Bids[0].Price != 1.5 || snapshot.Bids[0].Quantity != 1 {
+		t.Fatalf("unexpected snapshot %v", snapshot)
+	}
+	types := []messages.NFTMarketEventType{messages.NFTCancellation, messages.NFTListing, messages.NFTListing, messages.NFTListing, messages.NFTOffer, messages.NFTListing, messages.NFTCancellation, messages.NFTSale}
+	for i, e := range received {
+		if e.Type != types[i] {
+			t.Fatalf("was expecting %s event, got %s", types[i], e.Type)
+		}
+	}
+	if received[1].TokenID.Int64() != 1 || received[1].Maker[19] != 1 {
+		t.Fatalf("unexpected listing %v", received[1])
+	}
+	if received[4].TokenID != nil || received[4].Quantity != 2 {
+		t.Fatalf("unexpected collection offer %v", received[4])
+	}
+
+	res, err = as.Root.RequestFuture(listener, &messages.NFTMarketDataRequest{
+		RequestID:                 2,
+		MarketableProtocolAssetID: 1,
+	}, 10*time.Second).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	md = res.(*messages.NFTMarketDataResponse)
+	// Token 2 was sold on the offer, its listing removed, one token of the offer remains
+	asks := md.SnapshotL2.Asks
+	if len(asks) != 1 || asks[0].Price != 2 || asks[0].Quantity != 1 {
+		t.Fatalf("unexpected asks %v", asks)
+	}
+	bids := md.SnapshotL2.Bids
+	if len(bids) != 1 || bids[0].Price != 1.5 || bids[0].Quantity != 1 {
+		t.Fatalf("unexpected bids %v", bids)
+	}
+	if md.Stats == nil || md.Stats.FloorPrice != 1.5 || md.Stats.NumOwners != 6000 {
+		t.Fatalf("unexpected stats %v", md.Stats)
+	}
+}
+
+func TestStreamEventDecoding(t *testing.T) {
+	var msg streamMessage
+	if err := json.Unmarshal([]byte(streamPayload("item_listed", `{"order_hash":"0x01","expiration_date":"2022-12-01T00:00:00.000000+00:00","quantity":1}`)), &msg); err != nil {
+		t.Fatal(err)
+	}
+	var ev streamEvent
+	if err := json.Unmarshal(msg.Payload, &ev); err != nil {
+		t.Fatal(err)
+	}
+	if ev.EventType != "item_listed" || ev.Payload.OrderHash != "0x01" {
+		t.Fatalf("unexpected event %v", ev)
+	}
+	exp, err := time.Parse(time.RFC3339, ev.Payload.ExpirationDate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exp.Equal(time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected expiration %v", exp)
+	}
+}
+
+func TestListenerRestart(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	mux := http.NewServeMux()
+	var mu sync.Mutex
+	loads, conns := 0, 0
+	closeStream := make(chan struct{})
+	mux.HandleFunc("/api/v1/asset_contract/"+testContract, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"collection":{"slug":"test"}}`))
+	})
+	mux.HandleFunc("/api/v1/collection/test/stats", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"stats":{"floor_price":1.5}}`))
+	})
+	mux.HandleFunc("/api/v2/listings/collection/test/all", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"listings":[` + apiListing("0x01", 1, "2000000000000000000", "ETH") + `]}`))
+	})
+	// The first and third loads of the book fail
+	mux.HandleFunc("/api/v2/offers/collection/test/all", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		loads += 1
+		fail := loads == 1 || loads == 3
+		mu.Unlock()
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(`{"offers":[]}`))
+	})
+	mux.HandleFunc("/socket/websocket", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		var join streamMessage
+		if err := conn.ReadJSON(&join); err != nil {
+			return
+		}
+		reply := fmt.Sprintf(`{"topic":"collection:test","event":"phx_reply","payload":{"status":"ok","response":{}},"ref":%d}`, *join.Ref)
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(reply)); err != nil {
+			return
+		}
+		mu.Lock()
+		conns += 1
+		second := conns == 2
+		mu.Unlock()
+		if second {
+			// Disconnected once subscribed
+			<-closeStream
+			return
+		}
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	asset := &models.MarketableProtocolAsset{
+		MarketableProtocolAssetID: 1,
+		ProtocolAsset: &models.ProtocolAsset{
+			Asset:           &xmodels.Asset{Symbol: "BAYC"},
+			ContractAddress: wrapperspb.String(testContract),
+		},
+	}
+	as := actor.NewActorSystem()
+	defer as.Shutdown()
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/socket/websocket"
+	listener := as.Root.Spawn(actor.PropsFromProducer(NewListenerProducer(asset, "key", server.URL, wsURL, nil)))
+
+	refreshes := make(chan *messages.NFTMarketDataIncrementalRefresh, 100)
+	subscriber := as.Root.Spawn(actor.PropsFromFunc(func(c actor.Context) {
+		if r, ok := c.Message().(*messages.NFTMarketDataIncrementalRefresh); ok {
+			refreshes <- r
+		}
+	}))
+	// The request waits for the book, across the restart of the first failed load
+	res, err := as.Root.RequestFuture(listener, &messages.NFTMarketDataRequest{
+		RequestID:                 1,
+		MarketableProtocolAssetID: 1,
+		Subscriber:                subscriber,
+	}, 10*time.Second).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	md, ok := res.(*messages.NFTMarketDataResponse)
+	if !ok || !md.Success || len(md.SnapshotL2.Asks) != 1 {
+		t.Fatalf("unexpected response %v", res)
+	}
+	close(closeStream)
+
+	// The book reloaded after the second restart is sent to the subscriber
+	seqNum := md.SeqNum
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case r := <-refreshes:
+			if r.SeqNum != seqNum+1 {
+				t.Fatalf("out of order sequence %d, was expecting %d", r.SeqNum, seqNum+1)
+			}
+			seqNum = r.SeqNum
+			if r.SnapshotL2 == nil {
+				continue
+			}
+			mu.Lock()
+			n := loads
+			mu.Unlock()
+			if n != 4 || len(r.SnapshotL2.Asks) != 1 {
+				t.Fatalf("unexpected snapshot %v after %d loads", r.SnapshotL2, n)
+			}
+			return
+		case <-timeout:
+			t.Fatalf("timed-out waiting for the snapshot")
+		}
+	}
+}
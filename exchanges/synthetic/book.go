@@ -0,0 +1,138 @@
+package synthetic
+
+import (
+	"math"
+
+	gmodels "gitlab.com/alphaticks/gorderbook/gorderbook.models"
+)
+
+// The synthetic book is derived by walking the books of the legs. Selling one unit
+// of synthetic sells |weight| of each long leg at its bid and buys |weight| of each
+// short leg at its ask, and inversely when buying. The quantity of a synthetic level
+// is limited by the leg with the least available quantity.
+
+type LegBook struct {
+	Weight float64
+	FX     float64                   // The price converting the leg quote to the synthetic quote
+	Bids   []*gmodels.OrderBookLevel // Best first
+	Asks   []*gmodels.OrderBookLevel // Best first
+}
+
+func (l LegBook) mid() (float64, bool) {
+	if len(l.Bids) == 0 || len(l.Asks) == 0 {
+		return 0, false
+	}
+	return (l.Bids[0].Price + l.Asks[0].Price) / 2, true
+}
+
+// roundPrice rounds bids down and asks up on the tick, the epsilon
+// prevents float errors from moving exact prices to the next tick
+func roundPrice(price float64, bid bool, tickPrecision uint64) float64 {
+	tp := float64(tickPrecision)
+	if bid {
+		return math.Floor(price*tp+1e-6) / tp
+	} else {
+		return math.Ceil(price*tp-1e-6) / tp
+	}
+}
+
+// Compose returns up to depth synthetic levels of one side, best first
+func Compose(legs []LegBook, bid bool, depth int, tickPrecision uint64) []*gmodels.OrderBookLevel {
+	if len(legs) == 0 {
+		return nil
+	}
+	sides := make([][]*gmodels.OrderBookLevel, len(legs))
+	for i, l := range legs {
+		if (l.Weight > 0) == bid {
+			sides[i] = l.Bids
+		} else {
+			sides[i] = l.Asks
+		}
+		if len(sides[i]) == 0 {
+			return nil
+		}
+	}
+	idx := make([]int, len(legs))
+	rem := make([]float64, len(legs))
+	for i := range sides {
+		rem[i] = sides[i][0].Quantity
+	}
+
+	var levels []*gmodels.OrderBookLevel
+	for {
+		qty := math.MaxFloat64
+		var price float64
+		for i, l := range legs {
+			qty = math.Min(qty, rem[i]/math.Abs(l.Weight))
+			price += l.Weight * sides[i][idx[i]].Price * l.FX
+		}
+		price = roundPrice(price, bid, tickPrecision)
+		if last := len(levels) - 1; last >= 0 && levels[last].Price == price {
+			levels[last].Quantity += qty
+		} else {
+			if len(levels) == depth {
+				return levels
+			}
+			levels = append(levels, &gmodels.OrderBookLevel{
+				Price:    price,
+				Quantity: qty,
+				Bid:      bid,
+			})
+		}
+		for i, l := range legs {
+			rem[i] -= qty * math.Abs(l.Weight)
+			// The limiting leg is consumed, up to float errors
+			if rem[i] <= 1e-9*sides[i][idx[i]].Quantity {
+				idx[i] += 1
+				if idx[i] == len(sides[i]) {
+					return levels
+				}
+				rem[i] = sides[i][idx[i]].Quantity
+			}
+		}
+	}
+}
+
+// TradePrice returns the synthetic price of a trade on one of the legs,
+// the other legs being valued at their mid price
+func TradePrice(legs []LegBook, leg int, price float64) (float64, bool) {
+	var synthPrice float64
+	for i, l := range legs {
+		if i == leg {
+			synthPrice += l.Weight * price * l.FX
+			continue
+		}
+		mid, ok := l.mid()
+		if !ok {
+			return 0, false
+		}
+		synthPrice += l.Weight * mid * l.FX
+	}
+	return synthPrice, true
+}
+
+// Diff returns the levels to apply on prev to get next, removed levels having a zero quantity
+func Diff(prev, next []*gmodels.OrderBookLevel) []*gmodels.OrderBookLevel {
+	var levels []*gmodels.OrderBookLevel
+	nextQties := make(map[float64]float64, len(next))
+	for _, l := range next {
+		nextQties[l.Price] = l.Quantity
+	}
+	prevQties := make(map[float64]float64, len(prev))
+	for _, l := range prev {
+		prevQties[l.Price] = l.Quantity
+		if _, ok := nextQties[l.Price]; !ok {
+			levels = append(levels, &gmodels.OrderBookLevel{
+				Price:    l.Price,
+				Quantity: 0,
+				Bid:      l.Bid,
+			})
+		}
+	}
+	for _, l := range next {
+		if q, ok := prevQties[l.Price]; !ok || q != l.Quantity {
+			levels = append(levels, l)
+		}
+	}
+	return levels
+}
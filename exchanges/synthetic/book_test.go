@@ -0,0 +1,122 @@
+package synthetic
+
+import (
+	"math"
+	"testing"
+
+	"gitlab.com/alphaticks/alpha-connect/config"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	gmodels "gitlab.com/alphaticks/gorderbook/gorderbook.models"
+	xmodels "gitlab.com/alphaticks/xchanger/models"
+)
+
+func TestComposeSpread(t *testing.T) {
+	// Long perp, short spot
+	legs := []LegBook{{
+		Weight: 1,
+		FX:     1,
+		Bids:   []*gmodels.OrderBookLevel{{Price: 101, Quantity: 1, Bid: true}, {Price: 100.5, Quantity: 2, Bid: true}},
+		Asks:   []*gmodels.OrderBookLevel{{Price: 102, Quantity: 1, Bid: false}},
+	}, {
+		Weight: -1,
+		FX:     1,
+		Bids:   []*gmodels.OrderBookLevel{{Price: 99, Quantity: 5, Bid: true}},
+		Asks:   []*gmodels.OrderBookLevel{{Price: 100, Quantity: 2, Bid: false}},
+	}}
+	bids := Compose(legs, true, 10, 100)
+	// Sell perp bids, buy spot asks: 101-100 for 1, 100.5-100 for 1, then spot asks are exhausted
+	if len(bids) != 2 {
+		t.Fatalf("was expecting 2 bids, got %d", len(bids))
+	}
+	if bids[0].Price != 1 || bids[0].Quantity != 1 || bids[1].Price != 0.5 || bids[1].Quantity != 1 {
+		t.Fatalf("wrong bids: %v %v", bids[0], bids[1])
+	}
+	asks := Compose(legs, false, 10, 100)
+	if len(asks) != 1 || asks[0].Price != 3 || asks[0].Quantity != 1 {
+		t.Fatalf("wrong asks: %v", asks)
+	}
+	if bids := Compose(legs, true, 1, 100); len(bids) != 1 {
+		t.Fatalf("was expecting depth to be limited")
+	}
+
+	price, ok := TradePrice(legs, 0, 101.5)
+	if !ok || math.Abs(price-2) > 1e-9 {
+		t.Fatalf("wrong trade price: %f", price)
+	}
+}
+
+func TestComposeFX(t *testing.T) {
+	legs := []LegBook{{
+		Weight: 2,
+		FX:     0.5,
+		Bids:   []*gmodels.OrderBookLevel{{Price: 10, Quantity: 3, Bid: true}},
+		Asks:   []*gmodels.OrderBookLevel{{Price: 11, Quantity: 3, Bid: false}},
+	}}
+	bids := Compose(legs, true, 10, 100)
+	if len(bids) != 1 || bids[0].Price != 10 || bids[0].Quantity != 1.5 {
+		t.Fatalf("wrong bids: %v", bids)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	prev := []*gmodels.OrderBookLevel{{Price: 1, Quantity: 1, Bid: true}, {Price: 2, Quantity: 1, Bid: true}}
+	next := []*gmodels.OrderBookLevel{{Price: 2, Quantity: 3, Bid: true}, {Price: 3, Quantity: 1, Bid: true}}
+	levels := Diff(prev, next)
+	if len(levels) != 3 {
+		t.Fatalf("was expecting 3 levels, got %d", len(levels))
+	}
+	if levels[0].Price != 1 || levels[0].Quantity != 0 {
+		t.Fatalf("was expecting level 1 to be removed")
+	}
+	if len(Diff(next, next)) != 0 {
+		t.Fatalf("was expecting no diff")
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	btc := &xmodels.Asset{ID: 1, Symbol: "BTC"}
+	usd := &xmodels.Asset{ID: 2, Symbol: "USD"}
+	eur := &xmodels.Asset{ID: 3, Symbol: "EUR"}
+	securities := map[uint64]*models.Security{
+		1: {SecurityID: 1, Symbol: "BTCUSD", Underlying: btc, QuoteCurrency: usd},
+		2: {SecurityID: 2, Symbol: "BTCEUR", Underlying: btc, QuoteCurrency: eur},
+		3: {SecurityID: 3, Symbol: "EURUSD", Underlying: eur, QuoteCurrency: usd},
+	}
+	r := NewRegistry()
+	sec, err := r.Add(config.Synthetic{
+		Symbol: "BTCUSD-BTCEUR",
+		Legs: []config.SyntheticLeg{
+			{SecurityID: 1, Weight: 1},
+			{SecurityID: 2, Weight: -1, FXSecurityID: 3},
+		},
+	}, securities)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sec.QuoteCurrency.ID != usd.ID || sec.SecurityID != SecurityID("BTCUSD-BTCEUR") {
+		t.Fatalf("wrong synthetic security")
+	}
+	if _, _, ok := r.Get(sec.SecurityID); !ok {
+		t.Fatalf("synthetic not registered")
+	}
+	_, err = r.Add(config.Synthetic{
+		Symbol: "BAD",
+		Legs: []config.SyntheticLeg{
+			{SecurityID: 1, Weight: 1},
+			{SecurityID: 2, Weight: -1},
+		},
+	}, securities)
+	if err == nil {
+		t.Fatalf("was expecting an error on different quotes")
+	}
+	_, err = r.Add(config.Synthetic{
+		Symbol: "BAD2",
+		Legs: []config.SyntheticLeg{
+			{SecurityID: 1, Weight: 1},
+			{SecurityID: 2, Weight: -1, FXSecurityID: 3, FXInverse: true},
+		},
+	}, securities)
+	if err == nil {
+		t.Fatalf("was expecting an error on wrong FX direction")
+	}
+}
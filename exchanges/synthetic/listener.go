@@ -0,0 +1,462 @@
+package synthetic
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/log"
+	"gitlab.com/alphaticks/alpha-connect/config"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+	"gitlab.com/alphaticks/gorderbook"
+	gmodels "gitlab.com/alphaticks/gorderbook/gorderbook.models"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// The listener subscribes to the L2 market data of the legs and of the FX securities
+// and streams the derived synthetic book and trades to its parent, as an instrument
+// listener does. The synthetic book is empty while one of the books is out of sync.
+
+const (
+	bookDepth = 20  // Depth of the synthetic book
+	legDepth  = 100 // Depth of the leg books walked to build it
+)
+
+type checkHeartbeat struct{}
+
+type Listener struct {
+	security        *models.Security
+	definition      config.Synthetic
+	executor        *actor.PID
+	books           map[uint64]*gorderbook.OrderBookL2 // A map from security ID to its book
+	requests        map[uint64]uint64                  // A map from request ID to security ID
+	snapshots       map[uint64]uint64                  // A map from security ID to its pending snapshot request ID
+	seqNums         map[uint64]uint64                  // A map from security ID to its last seq num
+	bids            []*gmodels.OrderBookLevel
+	asks            []*gmodels.OrderBookLevel
+	tickPrecision   uint64
+	lotPrecision    uint64
+	seqNum          uint64
+	lastUpdateTime  uint64
+	lastHBTime      time.Time
+	heartbeatTicker *time.Ticker
+	logger          *log.Logger
+}
+
+func NewListenerProducer(security *models.Security, definition config.Synthetic) actor.Producer {
+	return func() actor.Actor {
+		return NewListener(security, definition)
+	}
+}
+
+func NewListener(security *models.Security, definition config.Synthetic) actor.Actor {
+	return &Listener{
+		security:   security,
+		definition: definition,
+	}
+}
+
+func (state *Listener) Receive(context actor.Context) {
+	switch context.Message().(type) {
+	case *actor.Started:
+		if err := state.Initialize(context); err != nil {
+			state.logger.Error("error initializing", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor started")
+
+	case *actor.Stopping:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error stopping", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor stopping")
+
+	case *actor.Stopped:
+		state.logger.Info("actor stopped")
+
+	case *actor.Restarting:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error restarting", log.Error(err))
+			// Attention, no panic in restarting or infinite loop
+		}
+		state.logger.Info("actor restarting")
+
+	case *messages.MarketDataRequest:
+		if err := state.OnMarketDataRequest(context); err != nil {
+			state.logger.Error("error processing OnMarketDataRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.MarketDataResponse:
+		if err := state.OnMarketDataResponse(context); err != nil {
+			state.logger.Error("error processing OnMarketDataResponse", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.MarketDataIncrementalRefresh:
+		if err := state.OnMarketDataIncrementalRefresh(context); err != nil {
+			state.logger.Error("error processing OnMarketDataIncrementalRefresh", log.Error(err))
+			panic(err)
+		}
+
+	case *checkHeartbeat:
+		if err := state.checkHeartbeat(context); err != nil {
+			state.logger.Error("error checking heartbeat", log.Error(err))
+			panic(err)
+		}
+	}
+}
+
+func (state *Listener) Initialize(context actor.Context) error {
+	state.logger = log.New(
+		log.InfoLevel,
+		"",
+		log.String("ID", context.Self().Id),
+		log.String("type", reflect.TypeOf(*state).String()),
+		log.String("instrument", state.security.Symbol))
+
+	state.executor = actor.NewPID(context.ActorSystem().Address(), "executor/exchanges")
+	state.books = make(map[uint64]*gorderbook.OrderBookL2)
+	state.requests = make(map[uint64]uint64)
+	state.snapshots = make(map[uint64]uint64)
+	state.seqNums = make(map[uint64]uint64)
+	state.seqNum = uint64(time.Now().UnixNano())
+	state.lastHBTime = time.Now()
+
+	var IDs []uint64
+	for _, l := range state.definition.Legs {
+		IDs = append(IDs, l.SecurityID)
+		if l.FXSecurityID != 0 {
+			IDs = append(IDs, l.FXSecurityID)
+		}
+	}
+	futures := make(map[uint64]*actor.Future)
+	for i, ID := range IDs {
+		if _, ok := futures[ID]; ok {
+			continue
+		}
+		requestID := uint64(time.Now().UnixNano()) + uint64(i)
+		state.requests[requestID] = ID
+		futures[ID] = context.RequestFuture(state.executor, &messages.MarketDataRequest{
+			RequestID:  requestID,
+			Subscribe:  true,
+			Subscriber: context.Self(),
+			Instrument: &models.Instrument{
+				SecurityID: wrapperspb.UInt64(ID),
+			},
+			Aggregation: models.OrderBookAggregation_L2,
+		}, 20*time.Second)
+	}
+	for ID, fut := range futures {
+		res, err := fut.Result()
+		if err != nil {
+			return fmt.Errorf("error subscribing to security %d: %v", ID, err)
+		}
+		mdres, ok := res.(*messages.MarketDataResponse)
+		if !ok {
+			return fmt.Errorf("was expecting MarketDataResponse, got %s", reflect.TypeOf(res).String())
+		}
+		if !mdres.Success {
+			return fmt.Errorf("error subscribing to security %d: %s", ID, mdres.RejectionReason.String())
+		}
+		if mdres.SnapshotL2 == nil || mdres.SnapshotL2.TickPrecision == nil || mdres.SnapshotL2.LotPrecision == nil {
+			return fmt.Errorf("incomplete L2 snapshot for security %d", ID)
+		}
+		// The synthetic precision is the finest of its components
+		if mdres.SnapshotL2.TickPrecision.Value > state.tickPrecision {
+			state.tickPrecision = mdres.SnapshotL2.TickPrecision.Value
+		}
+		if mdres.SnapshotL2.LotPrecision.Value > state.lotPrecision {
+			state.lotPrecision = mdres.SnapshotL2.LotPrecision.Value
+		}
+		state.syncBook(ID, mdres)
+	}
+	state.publish(context, nil, uint64(time.Now().UnixNano()/1000000))
+
+	heartbeatTicker := time.NewTicker(time.Second)
+	state.heartbeatTicker = heartbeatTicker
+	go func(pid *actor.PID) {
+		for {
+			select {
+			case <-heartbeatTicker.C:
+				context.Send(pid, &checkHeartbeat{})
+			case <-time.After(2 * time.Second):
+				if state.heartbeatTicker != heartbeatTicker {
+					return
+				}
+			}
+		}
+	}(context.Self())
+
+	return nil
+}
+
+func (state *Listener) Clean(context actor.Context) error {
+	if state.heartbeatTicker != nil {
+		state.heartbeatTicker.Stop()
+		state.heartbeatTicker = nil
+	}
+
+	return nil
+}
+
+func (state *Listener) OnMarketDataRequest(context actor.Context) error {
+	msg := context.Message().(*messages.MarketDataRequest)
+	response := &messages.MarketDataResponse{
+		RequestID:  msg.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		SeqNum:     state.seqNum,
+		Success:    true,
+	}
+	switch msg.Aggregation {
+	case models.OrderBookAggregation_L1:
+		snapshot := &models.OBL1Snapshot{
+			Timestamp: utils.MilliToTimestamp(state.lastUpdateTime),
+		}
+		if len(state.bids) > 0 {
+			snapshot.BestBid = state.bids[0].Price
+		}
+		if len(state.asks) > 0 {
+			snapshot.BestAsk = state.asks[0].Price
+		}
+		response.SnapshotL1 = snapshot
+	case models.OrderBookAggregation_L2:
+		response.SnapshotL2 = &models.OBL2Snapshot{
+			Bids:          state.bids,
+			Asks:          state.asks,
+			Timestamp:     utils.MilliToTimestamp(state.lastUpdateTime),
+			TickPrecision: &wrapperspb.UInt64Value{Value: state.tickPrecision},
+			LotPrecision:  &wrapperspb.UInt64Value{Value: state.lotPrecision},
+		}
+	}
+
+	context.Respond(response)
+	return nil
+}
+
+func (state *Listener) OnMarketDataResponse(context actor.Context) error {
+	// Either a resync snapshot or a leg listener that restarted
+	res := context.Message().(*messages.MarketDataResponse)
+	ID, ok := state.requests[res.RequestID]
+	if !ok {
+		return nil
+	}
+	if state.snapshots[ID] == res.RequestID {
+		delete(state.requests, res.RequestID)
+		delete(state.snapshots, ID)
+	}
+	if !res.Success || res.SnapshotL2 == nil {
+		state.logger.Warn(fmt.Sprintf("error syncing security %d: %s", ID, res.RejectionReason.String()))
+		return nil
+	}
+	state.syncBook(ID, res)
+	state.publish(context, nil, uint64(time.Now().UnixNano()/1000000))
+
+	return nil
+}
+
+func (state *Listener) OnMarketDataIncrementalRefresh(context actor.Context) error {
+	refresh := context.Message().(*messages.MarketDataIncrementalRefresh)
+	ID, ok := state.requests[refresh.RequestID]
+	if !ok {
+		return nil
+	}
+	ob, ok := state.books[ID]
+	if !ok || refresh.SeqNum <= state.seqNums[ID] {
+		// Syncing
+		return nil
+	}
+	if state.seqNums[ID]+1 != refresh.SeqNum {
+		state.logger.Info(fmt.Sprintf("sequence gap on security %d: %d -> %d", ID, state.seqNums[ID], refresh.SeqNum))
+		return state.resyncBook(context, ID)
+	}
+	state.seqNums[ID] = refresh.SeqNum
+
+	ts := uint64(time.Now().UnixNano() / 1000000)
+	if refresh.UpdateL2 != nil {
+		for _, l := range refresh.UpdateL2.Levels {
+			ob.UpdateOrderBookLevel(l)
+		}
+		if ob.Crossed() {
+			state.logger.Info(fmt.Sprintf("crossed book on security %d", ID))
+			return state.resyncBook(context, ID)
+		}
+		if refresh.UpdateL2.Timestamp != nil {
+			ts = utils.TimestampToMilli(refresh.UpdateL2.Timestamp)
+		}
+	}
+	trades := state.trades(ID, refresh.Trades)
+	state.publish(context, trades, ts)
+
+	return nil
+}
+
+func (state *Listener) syncBook(ID uint64, res *messages.MarketDataResponse) {
+	tickPrecision := state.tickPrecision
+	lotPrecision := state.lotPrecision
+	if res.SnapshotL2.TickPrecision != nil {
+		tickPrecision = res.SnapshotL2.TickPrecision.Value
+	}
+	if res.SnapshotL2.LotPrecision != nil {
+		lotPrecision = res.SnapshotL2.LotPrecision.Value
+	}
+	ob := gorderbook.NewOrderBookL2(tickPrecision, lotPrecision, 10000)
+	ob.Sync(res.SnapshotL2.Bids, res.SnapshotL2.Asks)
+	state.books[ID] = ob
+	state.seqNums[ID] = res.SeqNum
+}
+
+func (state *Listener) resyncBook(context actor.Context, ID uint64) error {
+	delete(state.books, ID)
+	state.publish(context, nil, uint64(time.Now().UnixNano()/1000000))
+
+	// Request a snapshot only, the subscription is still running.
+	// A snapshot request still pending is replaced.
+	if prev, ok := state.snapshots[ID]; ok {
+		delete(state.requests, prev)
+	}
+	requestID := uint64(time.Now().UnixNano())
+	state.requests[requestID] = ID
+	state.snapshots[ID] = requestID
+	context.Request(state.executor, &messages.MarketDataRequest{
+		RequestID: requestID,
+		Subscribe: false,
+		Instrument: &models.Instrument{
+			SecurityID: wrapperspb.UInt64(ID),
+		},
+		Aggregation: models.OrderBookAggregation_L2,
+	})
+
+	return nil
+}
+
+// legBooks returns the books of the legs, false if one of them is out of sync
+func (state *Listener) legBooks() ([]LegBook, bool) {
+	legs := make([]LegBook, len(state.definition.Legs))
+	for i, l := range state.definition.Legs {
+		ob, ok := state.books[l.SecurityID]
+		if !ok {
+			return nil, false
+		}
+		legs[i] = LegBook{
+			Weight: l.Weight,
+			FX:     1,
+			Bids:   ob.GetBids(legDepth),
+			Asks:   ob.GetAsks(legDepth),
+		}
+		if l.FXSecurityID != 0 {
+			fxob, ok := state.books[l.FXSecurityID]
+			if !ok {
+				return nil, false
+			}
+			fx, ok := LegBook{Bids: fxob.GetBids(1), Asks: fxob.GetAsks(1)}.mid()
+			if !ok {
+				return nil, false
+			}
+			if l.FXInverse {
+				fx = 1 / fx
+			}
+			legs[i].FX = fx
+		}
+	}
+	return legs, true
+}
+
+func (state *Listener) trades(ID uint64, trades []*models.AggregatedTrade) []*models.AggregatedTrade {
+	if len(trades) == 0 {
+		return nil
+	}
+	legs, ok := state.legBooks()
+	if !ok {
+		return nil
+	}
+	var synthTrades []*models.AggregatedTrade
+	for i, l := range state.definition.Legs {
+		if l.SecurityID != ID {
+			continue
+		}
+		for _, aggTrade := range trades {
+			synthTrade := &models.AggregatedTrade{
+				// Buying a short leg sells the synthetic
+				Bid:         aggTrade.Bid != (l.Weight < 0),
+				Timestamp:   aggTrade.Timestamp,
+				AggregateID: aggTrade.AggregateID,
+			}
+			for _, trd := range aggTrade.Trades {
+				price, ok := TradePrice(legs, i, trd.Price)
+				if !ok {
+					return nil
+				}
+				synthTrade.Trades = append(synthTrade.Trades, &models.Trade{
+					Price:    price,
+					Quantity: trd.Quantity / math.Abs(l.Weight),
+					ID:       trd.ID,
+				})
+			}
+			synthTrades = append(synthTrades, synthTrade)
+		}
+	}
+	return synthTrades
+}
+
+// publish recomputes the synthetic book and sends the changes along with the trades
+func (state *Listener) publish(context actor.Context, trades []*models.AggregatedTrade, ts uint64) {
+	var bids, asks []*gmodels.OrderBookLevel
+	if legs, ok := state.legBooks(); ok {
+		bids = Compose(legs, true, bookDepth, state.tickPrecision)
+		asks = Compose(legs, false, bookDepth, state.tickPrecision)
+	}
+	levels := append(Diff(state.bids, bids), Diff(state.asks, asks)...)
+	refresh := &messages.MarketDataIncrementalRefresh{
+		Trades: trades,
+		SeqNum: state.seqNum + 1,
+	}
+	if len(levels) > 0 {
+		refresh.UpdateL2 = &models.OBL2Update{
+			Levels:    levels,
+			Timestamp: utils.MilliToTimestamp(ts),
+			Trade:     false,
+		}
+	}
+	if topPrice(state.bids) != topPrice(bids) || topPrice(state.asks) != topPrice(asks) {
+		refresh.UpdateL1 = &models.OBL1Update{
+			BestBid:   topPrice(bids),
+			BestAsk:   topPrice(asks),
+			Timestamp: utils.MilliToTimestamp(ts),
+		}
+	}
+	state.bids, state.asks = bids, asks
+	if refresh.UpdateL2 == nil && len(trades) == 0 {
+		return
+	}
+	context.Send(context.Parent(), refresh)
+	state.seqNum += 1
+	state.lastUpdateTime = ts
+	state.lastHBTime = time.Now()
+}
+
+func (state *Listener) checkHeartbeat(context actor.Context) error {
+	// If haven't sent anything for 2 seconds, send heartbeat
+	if time.Since(state.lastHBTime) > 2*time.Second {
+		// Send an empty refresh
+		context.Send(context.Parent(), &messages.MarketDataIncrementalRefresh{
+			SeqNum: state.seqNum + 1,
+		})
+		state.seqNum += 1
+		state.lastHBTime = time.Now()
+	}
+
+	return nil
+}
+
+func topPrice(levels []*gmodels.OrderBookLevel) float64 {
+	if len(levels) == 0 {
+		return 0
+	}
+	return levels[0].Price
+}
@@ -0,0 +1,142 @@
+package synthetic
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"gitlab.com/alphaticks/alpha-connect/config"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+	xmodels "gitlab.com/alphaticks/xchanger/models"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// A synthetic security is a weighted sum of leg securities, such as a spread,
+// a basket or a cross rate. The price of each leg can be converted to the
+// synthetic quote with the price of an FX security.
+
+const SecurityType = "SYNTHETIC"
+
+// Exchange is the pseudo exchange of the synthetic securities, so that
+// they can be indexed along with the other securities
+var Exchange = &xmodels.Exchange{
+	ID:   0,
+	Name: "synthetic",
+}
+
+// SecurityID returns the synthetic security ID of a definition
+func SecurityID(symbol string) uint64 {
+	return utils.HashTags(map[string]string{
+		"type":   SecurityType,
+		"symbol": symbol,
+	})
+}
+
+type Registry struct {
+	definitions map[uint64]config.Synthetic
+	securities  map[uint64]*models.Security
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		definitions: make(map[uint64]config.Synthetic),
+		securities:  make(map[uint64]*models.Security),
+	}
+}
+
+// Add validates the definition against the known securities and registers its synthetic security
+func (r *Registry) Add(def config.Synthetic, securities map[uint64]*models.Security) (*models.Security, error) {
+	if def.Symbol == "" {
+		return nil, fmt.Errorf("missing symbol")
+	}
+	if len(def.Legs) == 0 {
+		return nil, fmt.Errorf("no legs")
+	}
+	ID := SecurityID(def.Symbol)
+	if _, ok := r.definitions[ID]; ok {
+		return nil, fmt.Errorf("synthetic %s already registered", def.Symbol)
+	}
+
+	var base, quote *xmodels.Asset
+	var minPriceIncrement, roundLot float64
+	legIDs := make(map[uint64]bool)
+	for _, l := range def.Legs {
+		if l.Weight == 0 || math.IsNaN(l.Weight) {
+			return nil, fmt.Errorf("invalid weight for leg %d", l.SecurityID)
+		}
+		if legIDs[l.SecurityID] {
+			return nil, fmt.Errorf("duplicate leg %d", l.SecurityID)
+		}
+		legIDs[l.SecurityID] = true
+		sec, ok := securities[l.SecurityID]
+		if !ok {
+			return nil, fmt.Errorf("unknown leg security %d", l.SecurityID)
+		}
+		legQuote := sec.QuoteCurrency
+		if l.FXSecurityID != 0 {
+			fx, ok := securities[l.FXSecurityID]
+			if !ok {
+				return nil, fmt.Errorf("unknown FX security %d", l.FXSecurityID)
+			}
+			from, to := fx.Underlying, fx.QuoteCurrency
+			if l.FXInverse {
+				from, to = to, from
+			}
+			if from.ID != legQuote.ID {
+				return nil, fmt.Errorf("FX security %s doesn't convert from %s", fx.Symbol, legQuote.Symbol)
+			}
+			legQuote = to
+		}
+		if quote == nil {
+			base, quote = sec.Underlying, legQuote
+		} else if quote.ID != legQuote.ID {
+			return nil, fmt.Errorf("legs quoted in %s and %s", quote.Symbol, legQuote.Symbol)
+		}
+		if sec.MinPriceIncrement != nil && (minPriceIncrement == 0 || sec.MinPriceIncrement.Value < minPriceIncrement) {
+			minPriceIncrement = sec.MinPriceIncrement.Value
+		}
+		if sec.RoundLot != nil && (roundLot == 0 || sec.RoundLot.Value < roundLot) {
+			roundLot = sec.RoundLot.Value
+		}
+	}
+
+	sec := &models.Security{
+		SecurityID:    ID,
+		SecurityType:  SecurityType,
+		Exchange:      Exchange,
+		Symbol:        def.Symbol,
+		Underlying:    base,
+		QuoteCurrency: quote,
+		Status:        models.InstrumentStatus_Trading,
+	}
+	if minPriceIncrement > 0 {
+		sec.MinPriceIncrement = wrapperspb.Double(minPriceIncrement)
+	}
+	if roundLot > 0 {
+		sec.RoundLot = wrapperspb.Double(roundLot)
+	}
+	r.definitions[ID] = def
+	r.securities[ID] = sec
+
+	return sec, nil
+}
+
+func (r *Registry) Get(ID uint64) (*models.Security, config.Synthetic, bool) {
+	sec, ok := r.securities[ID]
+	if !ok {
+		return nil, config.Synthetic{}, false
+	}
+	return sec, r.definitions[ID], true
+}
+
+func (r *Registry) Securities() []*models.Security {
+	var securities []*models.Security
+	for _, s := range r.securities {
+		securities = append(securities, s)
+	}
+	sort.Slice(securities, func(i, j int) bool {
+		return securities[i].SecurityID < securities[j].SecurityID
+	})
+	return securities
+}
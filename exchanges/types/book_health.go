@@ -0,0 +1,214 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+	"gitlab.com/alphaticks/gorderbook"
+)
+
+// BookHealth follows the market data stream of a listener and detects
+// the states in which its book can't be trusted anymore and has to be resynced
+
+type BookHealthStatus int32
+
+const (
+	BookHealthy BookHealthStatus = iota
+	BookCrossed
+	BookLocked
+	BookSeqGap
+	BookStale
+	BookMissingHeartbeat
+	BookTradeDivergence
+)
+
+func (s BookHealthStatus) String() string {
+	switch s {
+	case BookHealthy:
+		return "healthy"
+	case BookCrossed:
+		return "crossed"
+	case BookLocked:
+		return "locked"
+	case BookSeqGap:
+		return "sequence gap"
+	case BookStale:
+		return "stale"
+	case BookMissingHeartbeat:
+		return "missing heartbeat"
+	case BookTradeDivergence:
+		return "trade divergence"
+	default:
+		return fmt.Sprintf("unknown(%d)", int32(s))
+	}
+}
+
+type BookHealthConfig struct {
+	HeartbeatTimeout     time.Duration // Max duration without any message
+	StaleTimeout         time.Duration // Max duration without book update while receiving trades
+	MaxTradeDivergence   float64       // Max relative distance of a trade print from the best bid and ask
+	TradeDivergenceCount int           // Number of consecutive diverging aggregated trades before the book diverges
+}
+
+// A sweep through a thin book can print far from the best bid and ask,
+// only a book that keeps missing the trades is considered diverging
+var DefaultBookHealthConfig = BookHealthConfig{
+	HeartbeatTimeout:     10 * time.Second,
+	StaleTimeout:         time.Minute,
+	MaxTradeDivergence:   0.05,
+	TradeDivergenceCount: 3,
+}
+
+type BookHealth struct {
+	config      BookHealthConfig
+	book        *gorderbook.OrderBookL2
+	synced      bool
+	seqNum      uint64
+	lastMessage time.Time
+	lastUpdate  time.Time
+	lastTrade   time.Time
+	divergences int // Consecutive diverging aggregated trades
+	status      BookHealthStatus
+}
+
+func NewBookHealth(config BookHealthConfig) *BookHealth {
+	return &BookHealth{
+		config: config,
+		status: BookHealthy,
+	}
+}
+
+// Sync resets the health from a snapshot, the book checks
+// are only done when the snapshot has an L2 book
+func (h *BookHealth) Sync(res *messages.MarketDataResponse, now time.Time) {
+	h.book = nil
+	if l2 := res.SnapshotL2; l2 != nil && l2.TickPrecision != nil && l2.LotPrecision != nil {
+		h.book = gorderbook.NewOrderBookL2(l2.TickPrecision.Value, l2.LotPrecision.Value, 10000)
+		h.book.Sync(l2.Bids, l2.Asks)
+	}
+	h.synced = true
+	h.seqNum = res.SeqNum
+	h.lastMessage = now
+	h.lastUpdate = now
+	h.lastTrade = time.Time{}
+	h.divergences = 0
+	h.status = BookHealthy
+}
+
+func (h *BookHealth) Synced() bool {
+	return h.synced
+}
+
+func (h *BookHealth) Status() BookHealthStatus {
+	return h.status
+}
+
+// Book returns the book followed by the health, or nil if it can't be trusted
+func (h *BookHealth) Book() *gorderbook.OrderBookL2 {
+	if !h.synced || h.status != BookHealthy {
+		return nil
+	}
+	return h.book
+}
+
+// SeqNum returns the sequence number of the last refresh applied on the book
+func (h *BookHealth) SeqNum() uint64 {
+	return h.seqNum
+}
+
+// Refresh applies a refresh of the stream and returns the resulting status
+func (h *BookHealth) Refresh(refresh *messages.MarketDataIncrementalRefresh, now time.Time) BookHealthStatus {
+	if !h.synced || h.status != BookHealthy {
+		return h.status
+	}
+	h.lastMessage = now
+	if refresh.SeqNum <= h.seqNum {
+		return h.status
+	}
+	if h.seqNum+1 != refresh.SeqNum {
+		h.status = BookSeqGap
+		return h.status
+	}
+	h.seqNum = refresh.SeqNum
+
+	// Trades print at the best bid or ask of the book before the update
+	bid, ask, ok := h.bestBidAsk()
+	for _, aggTrade := range refresh.Trades {
+		h.lastTrade = now
+		if !ok {
+			continue
+		}
+		diverging := false
+		for _, trd := range aggTrade.Trades {
+			if trd.Price < bid*(1-h.config.MaxTradeDivergence) || trd.Price > ask*(1+h.config.MaxTradeDivergence) {
+				diverging = true
+			}
+		}
+		if !diverging {
+			h.divergences = 0
+			continue
+		}
+		h.divergences += 1
+		if h.divergences >= h.config.TradeDivergenceCount {
+			h.status = BookTradeDivergence
+			return h.status
+		}
+	}
+
+	if refresh.UpdateL2 != nil && len(refresh.UpdateL2.Levels) > 0 {
+		h.lastUpdate = now
+		if h.book != nil {
+			for _, l := range refresh.UpdateL2.Levels {
+				h.book.UpdateOrderBookLevel(l)
+			}
+		}
+	}
+	if bid, ask, ok := h.bestBidAsk(); ok {
+		if bid > ask {
+			h.status = BookCrossed
+		} else if bid == ask {
+			h.status = BookLocked
+		}
+	}
+
+	return h.status
+}
+
+// Check returns the status given the time elapsed since the last messages
+func (h *BookHealth) Check(now time.Time) BookHealthStatus {
+	if !h.synced || h.status != BookHealthy {
+		return h.status
+	}
+	if now.Sub(h.lastMessage) > h.config.HeartbeatTimeout {
+		h.status = BookMissingHeartbeat
+	} else if h.lastTrade.After(h.lastUpdate) && h.lastTrade.Sub(h.lastUpdate) > h.config.StaleTimeout {
+		// The market trades but the book doesn't move
+		h.status = BookStale
+	}
+	return h.status
+}
+
+// Stat returns the status of the book as a stat of the security
+func (h *BookHealth) Stat(securityID uint64, now time.Time) *models.Stat {
+	return &models.Stat{
+		Timestamp:  utils.MilliToTimestamp(uint64(now.UnixNano() / 1000000)),
+		StatType:   models.StatType_BookHealth,
+		Value:      float64(h.status),
+		SecurityID: securityID,
+	}
+}
+
+func (h *BookHealth) bestBidAsk() (float64, float64, bool) {
+	if h.book == nil {
+		return 0, 0, false
+	}
+	bids := h.book.GetBids(1)
+	asks := h.book.GetAsks(1)
+	if len(bids) == 0 || len(asks) == 0 {
+		return 0, 0, false
+	}
+	return bids[0].Price, asks[0].Price, true
+}
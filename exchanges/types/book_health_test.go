@@ -0,0 +1,148 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	gmodels "gitlab.com/alphaticks/gorderbook/gorderbook.models"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func newHealth(now time.Time) *BookHealth {
+	h := NewBookHealth(DefaultBookHealthConfig)
+	h.Sync(&messages.MarketDataResponse{
+		SnapshotL2: &models.OBL2Snapshot{
+			Bids:          []*gmodels.OrderBookLevel{{Price: 99, Quantity: 1, Bid: true}},
+			Asks:          []*gmodels.OrderBookLevel{{Price: 101, Quantity: 1, Bid: false}},
+			TickPrecision: wrapperspb.UInt64(100),
+			LotPrecision:  wrapperspb.UInt64(100),
+		},
+		SeqNum:  10,
+		Success: true,
+	}, now)
+	return h
+}
+
+func TestBookHealth(t *testing.T) {
+	now := time.Now()
+
+	h := newHealth(now)
+	status := h.Refresh(&messages.MarketDataIncrementalRefresh{
+		SeqNum: 11,
+		UpdateL2: &models.OBL2Update{
+			Levels: []*gmodels.OrderBookLevel{{Price: 100, Quantity: 1, Bid: true}},
+		},
+		Trades: []*models.AggregatedTrade{{
+			Trades: []*models.Trade{{Price: 100.5, Quantity: 1}},
+		}},
+	}, now)
+	if status != BookHealthy {
+		t.Fatalf("was expecting healthy book, got %s", status)
+	}
+
+	if status := h.Refresh(&messages.MarketDataIncrementalRefresh{
+		SeqNum: 12,
+		UpdateL2: &models.OBL2Update{
+			Levels: []*gmodels.OrderBookLevel{{Price: 101, Quantity: 1, Bid: true}},
+		},
+	}, now); status != BookLocked {
+		t.Fatalf("was expecting locked book, got %s", status)
+	}
+
+	h = newHealth(now)
+	if status := h.Refresh(&messages.MarketDataIncrementalRefresh{
+		SeqNum: 11,
+		UpdateL2: &models.OBL2Update{
+			Levels: []*gmodels.OrderBookLevel{{Price: 102, Quantity: 1, Bid: true}},
+		},
+	}, now); status != BookCrossed {
+		t.Fatalf("was expecting crossed book, got %s", status)
+	}
+
+	h = newHealth(now)
+	if status := h.Refresh(&messages.MarketDataIncrementalRefresh{SeqNum: 13}, now); status != BookSeqGap {
+		t.Fatalf("was expecting sequence gap, got %s", status)
+	}
+
+	// A sweep is checked against the book before its update
+	h = newHealth(now)
+	if status := h.Refresh(&messages.MarketDataIncrementalRefresh{
+		SeqNum: 11,
+		UpdateL2: &models.OBL2Update{
+			Levels: []*gmodels.OrderBookLevel{
+				{Price: 101, Quantity: 0, Bid: false},
+				{Price: 120, Quantity: 1, Bid: false},
+			},
+		},
+		Trades: []*models.AggregatedTrade{{
+			Trades: []*models.Trade{{Price: 101, Quantity: 1}, {Price: 104, Quantity: 1}},
+		}},
+	}, now); status != BookHealthy {
+		t.Fatalf("was expecting healthy book, got %s", status)
+	}
+
+	// A single diverging sweep doesn't make the book diverge
+	h = newHealth(now)
+	seqNum := uint64(11)
+	for i := 0; i < DefaultBookHealthConfig.TradeDivergenceCount; i++ {
+		status := h.Refresh(&messages.MarketDataIncrementalRefresh{
+			SeqNum: seqNum,
+			Trades: []*models.AggregatedTrade{{
+				Trades: []*models.Trade{{Price: 110, Quantity: 1}},
+			}},
+		}, now)
+		seqNum += 1
+		if i < DefaultBookHealthConfig.TradeDivergenceCount-1 && status != BookHealthy {
+			t.Fatalf("was expecting healthy book, got %s", status)
+		} else if i == DefaultBookHealthConfig.TradeDivergenceCount-1 && status != BookTradeDivergence {
+			t.Fatalf("was expecting trade divergence, got %s", status)
+		}
+	}
+
+	// A trade at the book resets the count
+	h = newHealth(now)
+	for i, price := range []float64{110, 110, 101, 110, 110} {
+		if status := h.Refresh(&messages.MarketDataIncrementalRefresh{
+			SeqNum: uint64(11 + i),
+			Trades: []*models.AggregatedTrade{{
+				Trades: []*models.Trade{{Price: price, Quantity: 1}},
+			}},
+		}, now); status != BookHealthy {
+			t.Fatalf("was expecting healthy book, got %s", status)
+		}
+	}
+}
+
+func TestBookHealthCheck(t *testing.T) {
+	now := time.Now()
+
+	h := newHealth(now)
+	if status := h.Check(now.Add(5 * time.Second)); status != BookHealthy {
+		t.Fatalf("was expecting healthy book, got %s", status)
+	}
+	if status := h.Check(now.Add(11 * time.Second)); status != BookMissingHeartbeat {
+		t.Fatalf("was expecting missing heartbeat, got %s", status)
+	}
+
+	h = newHealth(now)
+	var seqNum uint64 = 10
+	for i := 0; i < 70; i++ {
+		seqNum += 1
+		ts := now.Add(time.Duration(i) * time.Second)
+		h.Refresh(&messages.MarketDataIncrementalRefresh{
+			SeqNum: seqNum,
+			Trades: []*models.AggregatedTrade{{
+				Trades: []*models.Trade{{Price: 100, Quantity: 1}},
+			}},
+		}, ts)
+	}
+	if status := h.Check(now.Add(70 * time.Second)); status != BookStale {
+		t.Fatalf("was expecting stale book, got %s", status)
+	}
+	stat := h.Stat(1, now)
+	if stat.StatType != models.StatType_BookHealth || stat.Value != float64(BookStale) {
+		t.Fatalf("wrong health stat")
+	}
+}
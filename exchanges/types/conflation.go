@@ -0,0 +1,141 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+	"gitlab.com/alphaticks/gorderbook"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// A Conflator accumulates the stream of a subscriber whose delivery policy
+// isn't the full stream, and builds the snapshots it is sent instead of the refreshes.
+// A snapshot is sent at most once per conflation interval, and only when something
+// the subscriber receives changed since the previous one. While there is no book to
+// snapshot, only the most recent trades and stats are kept for the next snapshot.
+
+const (
+	DefaultConflationDepth = 10
+	maxConflatedTrades     = 1000
+	maxConflatedStats      = 100
+)
+
+type Conflator struct {
+	policy   messages.DeliveryPolicy
+	depth    int
+	interval time.Duration
+	lastSent time.Time
+	dirty    bool
+	bestBid  float64
+	bestAsk  float64
+	trades   []*models.AggregatedTrade
+	stats    []*models.Stat
+}
+
+// NewConflator returns the conflator of a market data request, or nil if
+// the request is for the full stream
+func NewConflator(request *messages.MarketDataRequest) (*Conflator, error) {
+	c := &Conflator{
+		policy: request.DeliveryPolicy,
+		depth:  int(request.Depth),
+	}
+	if request.ConflationInterval != nil {
+		if err := request.ConflationInterval.CheckValid(); err != nil {
+			return nil, fmt.Errorf("invalid conflation interval: %v", err)
+		}
+		c.interval = request.ConflationInterval.AsDuration()
+		if c.interval < 0 {
+			return nil, fmt.Errorf("negative conflation interval")
+		}
+	}
+	switch request.DeliveryPolicy {
+	case messages.DeliveryPolicy_FullStream:
+		return nil, nil
+	case messages.DeliveryPolicy_ConflatedBook:
+		if c.depth == 0 {
+			c.depth = DefaultConflationDepth
+		}
+	case messages.DeliveryPolicy_TopOfBook:
+		c.depth = 1
+	default:
+		return nil, fmt.Errorf("unknown delivery policy %s", request.DeliveryPolicy.String())
+	}
+	return c, nil
+}
+
+// Update accumulates the trades and stats of a refresh
+func (c *Conflator) Update(refresh *messages.MarketDataIncrementalRefresh, stats []*models.Stat) {
+	if refresh.UpdateL2 != nil && len(refresh.UpdateL2.Levels) > 0 {
+		c.dirty = true
+	}
+	if len(refresh.Trades) > 0 {
+		c.trades = append(c.trades, refresh.Trades...)
+		if len(c.trades) > maxConflatedTrades {
+			c.trades = append([]*models.AggregatedTrade(nil), c.trades[len(c.trades)-maxConflatedTrades:]...)
+		}
+		c.dirty = true
+	}
+	if len(stats) > 0 {
+		c.stats = append(c.stats, stats...)
+		if len(c.stats) > maxConflatedStats {
+			c.stats = append([]*models.Stat(nil), c.stats[len(c.stats)-maxConflatedStats:]...)
+		}
+		c.dirty = true
+	}
+}
+
+// Snapshot returns the snapshot to send, or nil if there is none yet.
+// The seqNum is the sequence number of the last refresh applied on the book.
+func (c *Conflator) Snapshot(book *gorderbook.OrderBookL2, seqNum uint64, now time.Time) *messages.MarketDataResponse {
+	if book == nil || !c.dirty || now.Sub(c.lastSent) < c.interval {
+		return nil
+	}
+	bids := book.GetBids(c.depth)
+	asks := book.GetAsks(c.depth)
+	ts := utils.MilliToTimestamp(uint64(now.UnixNano() / 1000000))
+	res := &messages.MarketDataResponse{
+		ResponseID: uint64(now.UnixNano()),
+		Trades:     c.trades,
+		Stats:      c.stats,
+		SeqNum:     seqNum,
+		Success:    true,
+		Conflated:  true,
+	}
+	if c.policy == messages.DeliveryPolicy_TopOfBook {
+		var bestBid, bestAsk float64
+		if len(bids) > 0 {
+			bestBid = bids[0].Price
+		}
+		if len(asks) > 0 {
+			bestAsk = asks[0].Price
+		}
+		if bestBid == c.bestBid && bestAsk == c.bestAsk && len(c.trades) == 0 && len(c.stats) == 0 {
+			// Changes below the top of the book
+			c.dirty = false
+			return nil
+		}
+		c.bestBid, c.bestAsk = bestBid, bestAsk
+		res.SnapshotL1 = &models.OBL1Snapshot{
+			BestBid:   bestBid,
+			BestAsk:   bestAsk,
+			Timestamp: ts,
+		}
+	} else {
+		res.SnapshotL2 = &models.OBL2Snapshot{
+			Bids:          bids,
+			Asks:          asks,
+			Timestamp:     ts,
+			TickPrecision: &wrapperspb.UInt64Value{Value: book.TickPrecision},
+			LotPrecision:  &wrapperspb.UInt64Value{Value: book.LotPrecision},
+		}
+	}
+	c.lastSent = now
+	c.dirty = false
+	c.trades = nil
+	c.stats = nil
+
+	return res
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/gorderbook"
+	gmodels "gitlab.com/alphaticks/gorderbook/gorderbook.models"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newConflationBook() *gorderbook.OrderBookL2 {
+	book := gorderbook.NewOrderBookL2(100, 100, 10000)
+	book.Sync(
+		[]*gmodels.OrderBookLevel{{Price: 99, Quantity: 1, Bid: true}, {Price: 98, Quantity: 1, Bid: true}},
+		[]*gmodels.OrderBookLevel{{Price: 101, Quantity: 1, Bid: false}, {Price: 102, Quantity: 1, Bid: false}},
+	)
+	return book
+}
+
+func TestConflatedBook(t *testing.T) {
+	now := time.Now()
+	c, err := NewConflator(&messages.MarketDataRequest{
+		DeliveryPolicy:     messages.DeliveryPolicy_ConflatedBook,
+		Depth:              1,
+		ConflationInterval: durationpb.New(time.Second),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	book := newConflationBook()
+	if c.Snapshot(book, 10, now) != nil {
+		t.Fatalf("was expecting no snapshot without update")
+	}
+	update := &gmodels.OrderBookLevel{Price: 100, Quantity: 2, Bid: true}
+	book.UpdateOrderBookLevel(update)
+	c.Update(&messages.MarketDataIncrementalRefresh{
+		SeqNum:   11,
+		UpdateL2: &models.OBL2Update{Levels: []*gmodels.OrderBookLevel{update}},
+		Trades:   []*models.AggregatedTrade{{Trades: []*models.Trade{{Price: 100, Quantity: 1}}}},
+	}, nil)
+	snapshot := c.Snapshot(book, 11, now)
+	if snapshot == nil || !snapshot.Conflated || snapshot.SeqNum != 11 {
+		t.Fatalf("was expecting a conflated snapshot")
+	}
+	if len(snapshot.SnapshotL2.Bids) != 1 || snapshot.SnapshotL2.Bids[0].Price != 100 || len(snapshot.Trades) != 1 {
+		t.Fatalf("wrong conflated snapshot")
+	}
+
+	c.Update(&messages.MarketDataIncrementalRefresh{
+		SeqNum:   12,
+		UpdateL2: &models.OBL2Update{Levels: []*gmodels.OrderBookLevel{{Price: 98, Quantity: 0, Bid: true}}},
+	}, nil)
+	if c.Snapshot(book, 12, now.Add(500*time.Millisecond)) != nil {
+		t.Fatalf("was expecting the snapshot to be throttled")
+	}
+	snapshot = c.Snapshot(book, 12, now.Add(time.Second))
+	if snapshot == nil || snapshot.SeqNum != 12 || len(snapshot.Trades) != 0 {
+		t.Fatalf("was expecting the pending snapshot")
+	}
+}
+
+func TestTopOfBook(t *testing.T) {
+	now := time.Now()
+	c, err := NewConflator(&messages.MarketDataRequest{
+		DeliveryPolicy: messages.DeliveryPolicy_TopOfBook,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	book := newConflationBook()
+	update := &gmodels.OrderBookLevel{Price: 98, Quantity: 2, Bid: true}
+	c.Update(&messages.MarketDataIncrementalRefresh{
+		SeqNum:   11,
+		UpdateL2: &models.OBL2Update{Levels: []*gmodels.OrderBookLevel{update}},
+	}, nil)
+	snapshot := c.Snapshot(book, 11, now)
+	if snapshot == nil || snapshot.SnapshotL1 == nil || snapshot.SnapshotL1.BestBid != 99 || snapshot.SnapshotL1.BestAsk != 101 {
+		t.Fatalf("was expecting the top of the book")
+	}
+
+	book.UpdateOrderBookLevel(update)
+	c.Update(&messages.MarketDataIncrementalRefresh{
+		SeqNum:   12,
+		UpdateL2: &models.OBL2Update{Levels: []*gmodels.OrderBookLevel{update}},
+	}, nil)
+	if c.Snapshot(book, 12, now) != nil {
+		t.Fatalf("was expecting no snapshot when the top doesn't change")
+	}
+
+	if _, err := NewConflator(&messages.MarketDataRequest{DeliveryPolicy: 10}); err == nil {
+		t.Fatalf("was expecting an error on unknown policy")
+	}
+	if c, err := NewConflator(&messages.MarketDataRequest{}); err != nil || c != nil {
+		t.Fatalf("was expecting no conflator for the full stream")
+	}
+}
+
+func TestConflationWithoutBook(t *testing.T) {
+	now := time.Now()
+	c, err := NewConflator(&messages.MarketDataRequest{
+		DeliveryPolicy: messages.DeliveryPolicy_ConflatedBook,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2*maxConflatedTrades; i++ {
+		c.Update(&messages.MarketDataIncrementalRefresh{
+			Trades: []*models.AggregatedTrade{{AggregateID: uint64(i), Trades: []*models.Trade{{Price: 100, Quantity: 1}}}},
+		}, []*models.Stat{{Value: float64(i)}})
+		if c.Snapshot(nil, uint64(i), now) != nil {
+			t.Fatalf("was expecting no snapshot without book")
+		}
+	}
+	if len(c.trades) != maxConflatedTrades || len(c.stats) != maxConflatedStats {
+		t.Fatalf("was expecting capped buffers, got %d trades and %d stats", len(c.trades), len(c.stats))
+	}
+	snapshot := c.Snapshot(newConflationBook(), 10, now)
+	if snapshot == nil || len(snapshot.Trades) != maxConflatedTrades {
+		t.Fatalf("was expecting a snapshot with the pending trades")
+	}
+	if snapshot.Trades[maxConflatedTrades-1].AggregateID != 2*maxConflatedTrades-1 {
+		t.Fatalf("was expecting the most recent trades")
+	}
+}
@@ -59,6 +59,8 @@ type Executor interface {
 	OnOrderMassCancelRequest(context actor.Context) error
 	OnHistoricalUnipoolV3DataRequest(context actor.Context) error
 	OnHistoricalSalesRequest(context actor.Context) error
+	OnNFTMarketDataRequest(context actor.Context) error
+	OnTerminated(context actor.Context) error
 	UpdateSecurityList(context actor.Context) error
 	UpdateMarketableProtocolAssetList(context actor.Context) error
 	GetLogger() *log.Logger
@@ -263,6 +265,18 @@ func ReceiveExecutor(state Executor, context actor.Context) {
 			panic(err)
 		}
 
+	case *messages.NFTMarketDataRequest:
+		if err := state.OnNFTMarketDataRequest(context); err != nil {
+			state.GetLogger().Error("error processing NFTMarketDataRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *actor.Terminated:
+		if err := state.OnTerminated(context); err != nil {
+			state.GetLogger().Error("error processing OnTerminated", log.Error(err))
+			panic(err)
+		}
+
 	case *updateSecurityList:
 		go func() {
 			if err := state.UpdateSecurityList(context); err != nil {
@@ -555,6 +569,21 @@ func (state *BaseExecutor) OnHistoricalSalesRequest(context actor.Context) error
 	return nil
 }
 
+func (state *BaseExecutor) OnNFTMarketDataRequest(context actor.Context) error {
+	req := context.Message().(*messages.NFTMarketDataRequest)
+	context.Respond(&messages.NFTMarketDataResponse{
+		RequestID:       req.RequestID,
+		ResponseID:      rand.Uint64(),
+		Success:         false,
+		RejectionReason: messages.RejectionReason_UnsupportedRequest,
+	})
+	return nil
+}
+
+func (state *BaseExecutor) OnTerminated(context actor.Context) error {
+	return nil
+}
+
 func (state *BaseExecutor) UpdateSecurityList(context actor.Context) error {
 	return nil
 }
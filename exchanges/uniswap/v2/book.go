@@ -0,0 +1,142 @@
+package v2
+
+import (
+	"math"
+	"sort"
+
+	gmodels "gitlab.com/alphaticks/gorderbook/gorderbook.models"
+)
+
+// A constant product pool has no order book, the book published is the one a taker
+// would walk: the quantity of a level is the base amount swapped to move the pool
+// price from the previous level to this one, and the level price includes the fee.
+
+type Book struct {
+	TickPrecision uint64
+	LotPrecision  uint64
+	Fee           float64 // Fraction of the amount in
+	Spacing       float64 // Relative price distance between two levels
+	Depth         int     // Number of levels on each side
+	bids          map[uint64]float64
+	asks          map[uint64]float64
+}
+
+func NewBook(tickPrecision, lotPrecision uint64, fee, spacing float64, depth int) *Book {
+	return &Book{
+		TickPrecision: tickPrecision,
+		LotPrecision:  lotPrecision,
+		Fee:           fee,
+		Spacing:       spacing,
+		Depth:         depth,
+		bids:          make(map[uint64]float64),
+		asks:          make(map[uint64]float64),
+	}
+}
+
+// levels returns the levels of the pool reserves, by raw price
+func (b *Book) levels(base, quote float64) (map[uint64]float64, map[uint64]float64) {
+	bids := make(map[uint64]float64)
+	asks := make(map[uint64]float64)
+	if base <= 0 || quote <= 0 {
+		return bids, asks
+	}
+	k := base * quote
+	mid := quote / base
+	tp := float64(b.TickPrecision)
+	lp := float64(b.LotPrecision)
+
+	// Buying the base raises the pool price, the base reserve shrinks
+	prevBase := base
+	for i := 1; i <= b.Depth; i++ {
+		price := mid * math.Pow(1+b.Spacing, float64(i))
+		nextBase := math.Sqrt(k / price)
+		rawPrice := uint64(math.Ceil(price / (1 - b.Fee) * tp))
+		asks[rawPrice] += prevBase - nextBase
+		prevBase = nextBase
+	}
+	// Selling the base lowers the pool price, the base reserve grows
+	prevBase = base
+	for i := 1; i <= b.Depth; i++ {
+		price := mid * math.Pow(1-b.Spacing, float64(i))
+		nextBase := math.Sqrt(k / price)
+		rawPrice := uint64(math.Floor(price * (1 - b.Fee) * tp))
+		if rawPrice == 0 {
+			break
+		}
+		bids[rawPrice] += nextBase - prevBase
+		prevBase = nextBase
+	}
+	for _, side := range []map[uint64]float64{bids, asks} {
+		for p, q := range side {
+			q = math.Floor(q*lp) / lp
+			if q <= 0 {
+				delete(side, p)
+			} else {
+				side[p] = q
+			}
+		}
+	}
+	return bids, asks
+}
+
+// Sync sets the book to the levels of the reserves
+func (b *Book) Sync(base, quote float64) {
+	b.bids, b.asks = b.levels(base, quote)
+}
+
+// Update sets the book to the levels of the reserves and returns the levels that changed,
+// the levels removed having a zero quantity
+func (b *Book) Update(base, quote float64) []*gmodels.OrderBookLevel {
+	bids, asks := b.levels(base, quote)
+	var levels []*gmodels.OrderBookLevel
+	diff := func(prev, next map[uint64]float64, bid bool) {
+		for p, q := range next {
+			if pq, ok := prev[p]; !ok || pq != q {
+				levels = append(levels, b.level(p, q, bid))
+			}
+		}
+		for p := range prev {
+			if _, ok := next[p]; !ok {
+				levels = append(levels, b.level(p, 0, bid))
+			}
+		}
+	}
+	diff(b.bids, bids, true)
+	diff(b.asks, asks, false)
+	b.bids, b.asks = bids, asks
+	return levels
+}
+
+func (b *Book) GetBids() []*gmodels.OrderBookLevel {
+	return b.sorted(b.bids, true)
+}
+
+func (b *Book) GetAsks() []*gmodels.OrderBookLevel {
+	return b.sorted(b.asks, false)
+}
+
+func (b *Book) sorted(side map[uint64]float64, bid bool) []*gmodels.OrderBookLevel {
+	prices := make([]uint64, 0, len(side))
+	for p := range side {
+		prices = append(prices, p)
+	}
+	sort.Slice(prices, func(i, j int) bool {
+		if bid {
+			return prices[i] > prices[j]
+		}
+		return prices[i] < prices[j]
+	})
+	levels := make([]*gmodels.OrderBookLevel, len(prices))
+	for i, p := range prices {
+		levels[i] = b.level(p, side[p], bid)
+	}
+	return levels
+}
+
+func (b *Book) level(rawPrice uint64, quantity float64, bid bool) *gmodels.OrderBookLevel {
+	return &gmodels.OrderBookLevel{
+		Price:    float64(rawPrice) / float64(b.TickPrecision),
+		Quantity: quantity,
+		Bid:      bid,
+	}
+}
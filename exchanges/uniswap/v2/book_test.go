@@ -0,0 +1,53 @@
+package v2
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBook(t *testing.T) {
+	// 100 base and 200000 quote, a price of 2000
+	book := NewBook(100, 1e8, 0.003, 0.001, 50)
+	book.Sync(100, 200000)
+	bids, asks := book.GetBids(), book.GetAsks()
+	if len(bids) != 50 || len(asks) != 50 {
+		t.Fatalf("was expecting 50 levels on each side, got %d %d", len(bids), len(asks))
+	}
+	if bids[0].Price >= 2000*(1-0.003) || asks[0].Price <= 2000/(1-0.003) {
+		t.Fatalf("was expecting the fee in the spread: %g %g", bids[0].Price, asks[0].Price)
+	}
+	for i := 1; i < 50; i++ {
+		if bids[i].Price >= bids[i-1].Price || asks[i].Price <= asks[i-1].Price {
+			t.Fatalf("levels are not sorted")
+		}
+	}
+
+	// Walking the asks moves the pool price to the last level
+	var bought float64
+	for _, l := range asks {
+		bought += l.Quantity
+	}
+	price := 2000 * math.Pow(1.001, 50)
+	expected := 100 - math.Sqrt(100*200000/price)
+	if math.Abs(bought-expected) > 1e-6 {
+		t.Fatalf("wrong ask depth: %g != %g", bought, expected)
+	}
+
+	// A swap changes the levels, the previous ones are removed
+	levels := book.Update(101, 200000*100/101.)
+	removed := 0
+	for _, l := range levels {
+		if l.Quantity == 0 {
+			removed++
+		}
+	}
+	if removed == 0 {
+		t.Fatalf("was expecting removed levels")
+	}
+	if book.GetBids()[0].Price >= bids[0].Price {
+		t.Fatalf("was expecting a lower bid after the sell")
+	}
+	if levels := book.Update(101, 200000*100/101.); len(levels) != 0 {
+		t.Fatalf("was expecting no update for the same reserves, got %d levels", len(levels))
+	}
+}
@@ -0,0 +1,237 @@
+package v2
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/log"
+	"gitlab.com/alphaticks/alpha-connect/enum"
+	extypes "gitlab.com/alphaticks/alpha-connect/exchanges/types"
+	"gitlab.com/alphaticks/alpha-connect/jobs"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+	registry "gitlab.com/alphaticks/alpha-public-registry-grpc"
+	"gitlab.com/alphaticks/go-graphql-client"
+	"gitlab.com/alphaticks/xchanger/constants"
+	xmodels "gitlab.com/alphaticks/xchanger/models"
+	xutils "gitlab.com/alphaticks/xchanger/utils"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type pairToken struct {
+	ID       string `graphql:"id"`
+	Symbol   string `graphql:"symbol"`
+	Decimals string `graphql:"decimals"`
+}
+
+type pairDefinitionsQuery struct {
+	Pairs []struct {
+		ID                   string    `graphql:"id"`
+		Token0               pairToken `graphql:"token0"`
+		Token1               pairToken `graphql:"token1"`
+		Reserve0             string    `graphql:"reserve0"`
+		Reserve1             string    `graphql:"reserve1"`
+		CreatedAtBlockNumber string    `graphql:"createdAtBlockNumber"`
+	} `graphql:"pairs(first: 1000, orderBy: id, where: {id_gt: $lastID, reserveUSD_gt: 10000})"`
+}
+
+func getPairDefinitionsQuery(lastID graphql.ID) (pairDefinitionsQuery, map[string]interface{}) {
+	query := pairDefinitionsQuery{}
+	variables := map[string]interface{}{
+		"lastID": lastID,
+	}
+	return query, variables
+}
+
+type QueryRunner struct {
+	pid *actor.PID
+}
+
+type Executor struct {
+	extypes.BaseExecutor
+	fork         *Fork
+	queryRunners []*QueryRunner
+	logger       *log.Logger
+}
+
+func NewExecutor(fork *Fork, dialerPool *xutils.DialerPool, registry registry.StaticClient) actor.Actor {
+	e := &Executor{
+		fork: fork,
+	}
+	e.DialerPool = dialerPool
+	e.Registry = registry
+	return e
+}
+
+func (state *Executor) getQueryRunner() *QueryRunner {
+	sort.Slice(state.queryRunners, func(i, j int) bool {
+		return rand.Uint64()%2 == 0
+	})
+
+	return state.queryRunners[0]
+}
+
+func (state *Executor) Receive(context actor.Context) {
+	extypes.ReceiveExecutor(state, context)
+}
+
+func (state *Executor) GetLogger() *log.Logger {
+	return state.logger
+}
+
+func (state *Executor) Initialize(context actor.Context) error {
+	state.logger = log.New(
+		log.InfoLevel,
+		"",
+		log.String("ID", context.Self().Id),
+		log.String("type", reflect.TypeOf(state).String()))
+
+	dialers := state.DialerPool.GetDialers()
+	for _, dialer := range dialers {
+		httpClient := &http.Client{
+			Transport: &http.Transport{
+				MaxIdleConnsPerHost: 1024,
+				TLSHandshakeTimeout: 10 * time.Second,
+				DialContext:         dialer.DialContext,
+			},
+			Timeout: 10 * time.Second,
+		}
+		uniClient := graphql.NewClient(state.fork.SubgraphURL, httpClient)
+		props := actor.PropsFromProducer(func() actor.Actor {
+			return jobs.NewGraphQuery(uniClient)
+		})
+		state.queryRunners = append(state.queryRunners, &QueryRunner{
+			pid: context.Spawn(props),
+		})
+	}
+
+	return state.UpdateSecurityList(context)
+}
+
+func (state *Executor) Clean(context actor.Context) error {
+	return nil
+}
+
+func (state *Executor) UpdateSecurityList(context actor.Context) error {
+	var securities []*models.Security
+
+	lastID := ""
+	for {
+		query, variables := getPairDefinitionsQuery(graphql.ID(lastID))
+		qr := state.getQueryRunner()
+		if qr == nil {
+			return fmt.Errorf("rate limited")
+		}
+		future := context.RequestFuture(qr.pid, &jobs.PerformGraphQueryRequest{Query: &query, Variables: variables}, 10*time.Second)
+		res, err := future.Result()
+		if err != nil {
+			return fmt.Errorf("error updating security list: %v", err)
+		}
+		gqr := res.(*jobs.PerformGraphQueryResponse)
+		if gqr.Error != nil {
+			return fmt.Errorf("error updating security list: %v", gqr.Error)
+		}
+		for _, pair := range query.Pairs {
+			token0, ok := constants.GetAssetBySymbol(pair.Token0.Symbol)
+			if !ok {
+				continue
+			}
+			token1, ok := constants.GetAssetBySymbol(pair.Token1.Symbol)
+			if !ok {
+				continue
+			}
+			decimals0, err := strconv.ParseUint(pair.Token0.Decimals, 10, 8)
+			if err != nil {
+				continue
+			}
+			decimals1, err := strconv.ParseUint(pair.Token1.Decimals, 10, 8)
+			if err != nil {
+				continue
+			}
+			reserve0, err := strconv.ParseFloat(pair.Reserve0, 64)
+			if err != nil || reserve0 == 0 {
+				continue
+			}
+			reserve1, err := strconv.ParseFloat(pair.Reserve1, 64)
+			if err != nil || reserve1 == 0 {
+				continue
+			}
+			creationBlock, err := strconv.ParseUint(pair.CreatedAtBlockNumber, 10, 64)
+			if err != nil {
+				continue
+			}
+
+			baseCurrency, quoteCurrency, inverse := baseQuote(token0, token1)
+			price := reserve1 / reserve0
+			baseDecimals := decimals0
+			if inverse {
+				price = reserve0 / reserve1
+				baseDecimals = decimals1
+			}
+			if baseDecimals > 8 {
+				baseDecimals = 8
+			}
+
+			security := models.Security{}
+			security.Symbol = pair.ID
+			security.Underlying = baseCurrency
+			security.QuoteCurrency = quoteCurrency
+			security.IsInverse = inverse
+			security.Status = models.InstrumentStatus_Trading
+			security.Exchange = state.fork.Exchange
+			security.SecurityType = enum.SecurityType_CRYPTO_AMM
+			security.SecuritySubType = &wrapperspb.StringValue{Value: enum.SecuritySubType_UNIPOOLV2}
+			security.SecurityID = utils.SecurityID(security.SecurityType, security.Symbol, security.Exchange.Name, security.MaturityDate)
+			// Six significant digits at the current price
+			security.MinPriceIncrement = &wrapperspb.DoubleValue{Value: math.Pow(10, math.Floor(math.Log10(price))-5)}
+			security.RoundLot = &wrapperspb.DoubleValue{Value: math.Pow(10, -float64(baseDecimals))}
+			security.TakerFee = &wrapperspb.DoubleValue{Value: state.fork.Fee}
+			security.CreationBlock = &wrapperspb.UInt64Value{Value: creationBlock}
+			security.Protocol = &xmodels.Protocol{ID: 1, Name: "ERC-20"}
+			security.Chain = state.fork.Chain
+			securities = append(securities, &security)
+		}
+		if len(query.Pairs) != 1000 {
+			break
+		}
+		lastID = query.Pairs[len(query.Pairs)-1].ID
+	}
+
+	state.SyncSecurities(securities, nil)
+
+	context.Send(context.Parent(), &messages.SecurityList{
+		ResponseID: uint64(time.Now().UnixNano()),
+		Success:    true,
+		Securities: securities})
+
+	return nil
+}
+
+// baseQuote quotes the pair in a stable coin, then in WBTC or WETH, the pair is inverse
+// when the underlying is token1
+func baseQuote(token0, token1 *xmodels.Asset) (*xmodels.Asset, *xmodels.Asset, bool) {
+	isStable := func(a *xmodels.Asset) bool {
+		return a.Symbol == "USDC" || a.Symbol == "USDT" || a.Symbol == "DAI" || a.Symbol == "BUSD"
+	}
+	isMajor := func(a *xmodels.Asset) bool {
+		return a.Symbol == "WBTC" || a.Symbol == "WETH"
+	}
+	if isStable(token1) {
+		return token0, token1, false
+	} else if isStable(token0) {
+		return token1, token0, true
+	} else if isMajor(token1) {
+		return token0, token1, false
+	} else if isMajor(token0) {
+		return token1, token0, true
+	}
+	return token0, token1, false
+}
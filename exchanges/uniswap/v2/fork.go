@@ -0,0 +1,79 @@
+package v2
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gitlab.com/alphaticks/alpha-connect/config"
+	"gitlab.com/alphaticks/xchanger/constants"
+	xmodels "gitlab.com/alphaticks/xchanger/models"
+)
+
+// A fork is an exchange running the Uniswap V2 pair contracts. Its pairs are listed
+// from its subgraph, and the listener checks they were created by its factory.
+
+type Fork struct {
+	Exchange    *xmodels.Exchange
+	Chain       *xmodels.Chain
+	Factory     common.Address
+	SubgraphURL string
+	Fee         float64
+}
+
+var UniswapV2 = &Fork{
+	Exchange:    constants.UNISWAPV2,
+	Chain:       constants.EthereumMainnet,
+	Factory:     common.HexToAddress("0x5C69bEe701ef814a2B6a3EDD4B1652CB9cc5aA6f"),
+	SubgraphURL: "https://api.thegraph.com/subgraphs/name/uniswap/uniswap-v2",
+	Fee:         0.003,
+}
+
+var (
+	forksMu sync.RWMutex
+	forks   = map[uint32]*Fork{
+		constants.UNISWAPV2.ID: UniswapV2,
+	}
+)
+
+// LoadForks adds the forks of the configuration, by exchange
+func LoadForks(cfgs []config.UniswapV2Fork) error {
+	forksMu.Lock()
+	defer forksMu.Unlock()
+	for _, cfg := range cfgs {
+		exchange, ok := constants.GetExchangeByName(cfg.Exchange)
+		if !ok {
+			return fmt.Errorf("unknown exchange %s", cfg.Exchange)
+		}
+		chain, ok := constants.GetChainByID(cfg.Chain)
+		if !ok {
+			return fmt.Errorf("unknown chain %d", cfg.Chain)
+		}
+		if !common.IsHexAddress(cfg.Factory) {
+			return fmt.Errorf("invalid factory address %s", cfg.Factory)
+		}
+		if cfg.Subgraph == "" {
+			return fmt.Errorf("no subgraph for fork %s", cfg.Exchange)
+		}
+		fee := cfg.Fee
+		if fee == 0 {
+			fee = UniswapV2.Fee
+		}
+		forks[exchange.ID] = &Fork{
+			Exchange:    exchange,
+			Chain:       chain,
+			Factory:     common.HexToAddress(cfg.Factory),
+			SubgraphURL: cfg.Subgraph,
+			Fee:         fee,
+		}
+	}
+	return nil
+}
+
+// GetFork returns the fork of the exchange
+func GetFork(exchangeID uint32) (*Fork, bool) {
+	forksMu.RLock()
+	defer forksMu.RUnlock()
+	f, ok := forks[exchangeID]
+	return f, ok
+}
@@ -0,0 +1,467 @@
+package v2
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/log"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+	"gitlab.com/alphaticks/xchanger/chains/evm"
+	"gitlab.com/alphaticks/xchanger/constants"
+	tokenevm "gitlab.com/alphaticks/xchanger/protocols/erc20/evm"
+	xchangerUtils "gitlab.com/alphaticks/xchanger/utils"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// The listener follows the reserves of a pair through its Sync logs, and publishes
+// the L2 book equivalent to the reserves, along with the trades of its Swap logs.
+
+const (
+	confirmations = 4
+	bookSpacing   = 0.001
+	bookDepth     = 100
+)
+
+type checkTimeout struct{}
+
+type InstrumentData struct {
+	book           *Book
+	seqNum         uint64
+	lastUpdateTime uint64
+	lastHBTime     time.Time
+	lastAggTradeTs uint64
+}
+
+type Listener struct {
+	fork            *Fork
+	securityID      uint64
+	security        *models.Security
+	executor        *actor.PID
+	chainExecutor   *actor.PID
+	pair            common.Address
+	pabi            *abi.ABI
+	baseIs0         bool
+	decimals0       uint8
+	decimals1       uint8
+	reserve0        *big.Int
+	reserve1        *big.Int
+	logsSeqNum      uint64
+	lastRefreshTime time.Time
+	instrumentData  *InstrumentData
+	logger          *log.Logger
+	timeoutTicker   *time.Ticker
+}
+
+func NewListenerProducer(fork *Fork, securityID uint64, dialerPool *xchangerUtils.DialerPool) actor.Producer {
+	return func() actor.Actor {
+		return NewListener(fork, securityID, dialerPool)
+	}
+}
+
+func NewListener(fork *Fork, securityID uint64, dialerPool *xchangerUtils.DialerPool) actor.Actor {
+	return &Listener{
+		fork:       fork,
+		securityID: securityID,
+	}
+}
+
+func (state *Listener) Receive(context actor.Context) {
+	switch context.Message().(type) {
+	case *actor.Started:
+		if err := state.Initialize(context); err != nil {
+			state.logger.Error("error initializing", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor started")
+
+	case *actor.Stopping:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error stopping", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor stopping")
+
+	case *actor.Stopped:
+		state.logger.Info("actor stopped")
+
+	case *actor.Restarting:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error restarting", log.Error(err))
+			// Attention, no panic in restarting or infinite loop
+		}
+		state.logger.Info("actor restarting")
+
+	case *messages.MarketDataRequest:
+		if err := state.OnMarketDataRequest(context); err != nil {
+			state.logger.Error("error processing OnMarketDataRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.EVMLogsSubscribeRefresh:
+		if err := state.OnEVMLogsSubscribeRefresh(context); err != nil {
+			state.logger.Error("error processing OnEVMLogsSubscribeRefresh", log.Error(err))
+			panic(err)
+		}
+
+	case *checkTimeout:
+		if err := state.onCheckTimeout(context); err != nil {
+			state.logger.Error("error processing onCheckTimeout", log.Error(err))
+			panic(err)
+		}
+	}
+}
+
+func (state *Listener) Initialize(context actor.Context) error {
+	state.logger = log.New(
+		log.InfoLevel,
+		"",
+		log.String("ID", context.Self().Id),
+		log.String("type", reflect.TypeOf(*state).String()),
+		log.String("security-id", fmt.Sprintf("%d", state.securityID)))
+	state.executor = actor.NewPID(context.ActorSystem().Address(), "executor/exchanges/"+state.fork.Exchange.Name+"_executor")
+	state.chainExecutor = actor.NewPID(context.ActorSystem().Address(), "executor")
+
+	res, err := context.RequestFuture(state.executor, &messages.SecurityDefinitionRequest{
+		RequestID:  0,
+		Instrument: &models.Instrument{SecurityID: wrapperspb.UInt64(state.securityID)},
+	}, 5*time.Second).Result()
+	if err != nil {
+		return fmt.Errorf("error fetching security definition: %v", err)
+	}
+	def := res.(*messages.SecurityDefinitionResponse)
+	if !def.Success {
+		return fmt.Errorf("error fetching security definition: %s", def.RejectionReason.String())
+	}
+	state.security = def.Security
+	state.logger = log.New(
+		log.InfoLevel,
+		"",
+		log.String("ID", context.Self().Id),
+		log.String("type", reflect.TypeOf(*state).String()),
+		log.String("security-id", fmt.Sprintf("%d", state.securityID)),
+		log.String("exchange", state.security.Exchange.Name),
+		log.String("symbol", state.security.Symbol))
+
+	if state.security.MinPriceIncrement == nil || state.security.RoundLot == nil {
+		return fmt.Errorf("security is missing MinPriceIncrement or RoundLot")
+	}
+	if state.security.Chain == nil {
+		return fmt.Errorf("security has no chain")
+	}
+	state.pair = common.HexToAddress(state.security.Symbol)
+	// The underlying of an inverse pair is its token1
+	state.baseIs0 = !state.security.IsInverse
+	pabi, err := abi.JSON(strings.NewReader(PairABI))
+	if err != nil {
+		return fmt.Errorf("error getting pair abi: %v", err)
+	}
+	state.pabi = &pabi
+
+	// Read the reserves at a confirmed block, the subscription replays the logs after it
+	head, err := utils.EVMBlockNumber(context, state.chainExecutor, state.security.Chain)
+	if err != nil {
+		return err
+	}
+	block := head - confirmations
+	if err := state.readTokens(context, block); err != nil {
+		return err
+	}
+	if err := state.readReserves(context, block); err != nil {
+		return err
+	}
+	tickPrecision := uint64(math.Round(1. / state.security.MinPriceIncrement.Value))
+	lotPrecision := uint64(math.Round(1. / state.security.RoundLot.Value))
+	fee := 0.003
+	if state.security.TakerFee != nil {
+		fee = state.security.TakerFee.Value
+	}
+	book := NewBook(tickPrecision, lotPrecision, fee, bookSpacing, bookDepth)
+	base, quote := state.baseQuote()
+	book.Sync(base, quote)
+	state.instrumentData = &InstrumentData{
+		book:           book,
+		seqNum:         uint64(time.Now().UnixNano()),
+		lastUpdateTime: uint64(time.Now().UnixNano() / 1000000),
+		lastHBTime:     time.Now(),
+	}
+
+	if err := state.subscribeLogs(context, block+1); err != nil {
+		return err
+	}
+
+	state.lastRefreshTime = time.Now()
+	timeoutTicker := time.NewTicker(1 * time.Second)
+	state.timeoutTicker = timeoutTicker
+	go func(pid *actor.PID) {
+		for {
+			select {
+			case <-timeoutTicker.C:
+				context.Send(pid, &checkTimeout{})
+			case <-time.After(5 * time.Second):
+				if state.timeoutTicker != timeoutTicker {
+					return
+				}
+			}
+		}
+	}(context.Self())
+
+	return nil
+}
+
+func (state *Listener) Clean(context actor.Context) error {
+	if state.timeoutTicker != nil {
+		state.timeoutTicker.Stop()
+		state.timeoutTicker = nil
+	}
+	return nil
+}
+
+func (state *Listener) subscribeLogs(context actor.Context, from uint64) error {
+	topics, err := abi.MakeTopics([]interface{}{
+		state.pabi.Events["Sync"].ID,
+		state.pabi.Events["Swap"].ID,
+		state.pabi.Events["Mint"].ID,
+		state.pabi.Events["Burn"].ID,
+	})
+	if err != nil {
+		return fmt.Errorf("error making topics: %v", err)
+	}
+	res, err := context.RequestFuture(state.chainExecutor, &messages.EVMLogsSubscribeRequest{
+		RequestID: state.securityID,
+		Chain:     state.security.Chain,
+		Query: ethereum.FilterQuery{
+			FromBlock: big.NewInt(int64(from)),
+			Addresses: []common.Address{state.pair},
+			Topics:    topics,
+		},
+		Subscriber:    context.Self(),
+		Confirmations: confirmations,
+	}, 30*time.Second).Result()
+	if err != nil {
+		return fmt.Errorf("error subscribing to EVM logs: %v", err)
+	}
+	subRes, ok := res.(*messages.EVMLogsSubscribeResponse)
+	if !ok {
+		return fmt.Errorf("was expecting *messages.EVMLogsSubscribeResponse, got %s", reflect.TypeOf(res).String())
+	}
+	if !subRes.Success {
+		return fmt.Errorf("error subscribing to EVM logs: %s", subRes.RejectionReason.String())
+	}
+	state.logsSeqNum = subRes.SeqNum
+	return nil
+}
+
+func (state *Listener) OnMarketDataRequest(context actor.Context) error {
+	msg := context.Message().(*messages.MarketDataRequest)
+
+	response := &messages.MarketDataResponse{
+		RequestID:  msg.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		SeqNum:     state.instrumentData.seqNum,
+		Success:    true,
+	}
+	if msg.Aggregation == models.OrderBookAggregation_L2 {
+		book := state.instrumentData.book
+		snapshot := &models.OBL2Snapshot{
+			Bids:          book.GetBids(),
+			Asks:          book.GetAsks(),
+			Timestamp:     utils.MilliToTimestamp(state.instrumentData.lastUpdateTime),
+			TickPrecision: &wrapperspb.UInt64Value{Value: book.TickPrecision},
+			LotPrecision:  &wrapperspb.UInt64Value{Value: book.LotPrecision},
+		}
+		response.SnapshotL2 = snapshot
+	}
+	context.Respond(response)
+
+	return nil
+}
+
+func (state *Listener) OnEVMLogsSubscribeRefresh(context actor.Context) error {
+	refresh := context.Message().(*messages.EVMLogsSubscribeRefresh)
+	if refresh.SeqNum <= state.logsSeqNum {
+		return nil
+	}
+	if refresh.SeqNum != state.logsSeqNum+1 {
+		return fmt.Errorf("out of order sequence")
+	}
+	state.logsSeqNum = refresh.SeqNum
+	state.lastRefreshTime = time.Now()
+	update := refresh.Update
+	if update == nil {
+		return nil
+	}
+	ts := uint64(update.BlockTime.UnixNano() / 1000000)
+	if update.Removed {
+		// The block was reverted, the reserves are the ones of the previous block
+		if err := state.readReserves(context, update.BlockNumber-1); err != nil {
+			return err
+		}
+		state.publishBook(context, ts)
+		return nil
+	}
+
+	var trades []*models.AggregatedTrade
+	for _, l := range update.Logs {
+		if len(l.Topics) == 0 {
+			continue
+		}
+		switch l.Topics[0] {
+		case state.pabi.Events["Sync"].ID:
+			event := PairSync{}
+			if err := evm.UnpackLog(state.pabi, &event, "Sync", l); err != nil {
+				return fmt.Errorf("error unpacking log: %v", err)
+			}
+			state.reserve0, state.reserve1 = event.Reserve0, event.Reserve1
+		case state.pabi.Events["Swap"].ID:
+			event := PairSwap{}
+			if err := evm.UnpackLog(state.pabi, &event, "Swap", l); err != nil {
+				return fmt.Errorf("error unpacking log: %v", err)
+			}
+			if trade := state.swapToTrade(&event, l, ts); trade != nil {
+				trades = append(trades, trade)
+			}
+		}
+		// Mint and Burn are followed by a Sync carrying the new reserves
+	}
+	if len(trades) > 0 {
+		context.Send(context.Parent(), &messages.MarketDataIncrementalRefresh{
+			Trades: trades,
+			SeqNum: state.instrumentData.seqNum + 1,
+		})
+		state.instrumentData.seqNum += 1
+		state.instrumentData.lastHBTime = time.Now()
+	}
+	state.publishBook(context, ts)
+
+	return nil
+}
+
+func (state *Listener) swapToTrade(event *PairSwap, l types.Log, ts uint64) *models.AggregatedTrade {
+	baseIn, baseOut, quoteIn, quoteOut := event.Amount0In, event.Amount0Out, event.Amount1In, event.Amount1Out
+	baseDecimals, quoteDecimals := state.decimals0, state.decimals1
+	if !state.baseIs0 {
+		baseIn, baseOut, quoteIn, quoteOut = quoteIn, quoteOut, baseIn, baseOut
+		baseDecimals, quoteDecimals = quoteDecimals, baseDecimals
+	}
+	base := utils.ToQuantity(new(big.Int).Sub(baseOut, baseIn), uint32(baseDecimals))
+	quote := utils.ToQuantity(new(big.Int).Sub(quoteIn, quoteOut), uint32(quoteDecimals))
+	if base == 0 || quote == 0 {
+		return nil
+	}
+	// A buy takes the base out of the pool, a sell hits the bid
+	bid := base < 0
+	aggID := l.BlockNumber<<20 | uint64(l.Index)
+	// ensure increasing timestamp
+	if ts <= state.instrumentData.lastAggTradeTs {
+		ts = state.instrumentData.lastAggTradeTs + 1
+	}
+	state.instrumentData.lastAggTradeTs = ts
+	return &models.AggregatedTrade{
+		Bid:         bid,
+		Timestamp:   utils.MilliToTimestamp(ts),
+		AggregateID: aggID,
+		Trades: []*models.Trade{{
+			Price:    math.Abs(quote / base),
+			Quantity: math.Abs(base),
+			ID:       aggID,
+		}},
+	}
+}
+
+// publishBook publishes the levels of the book that changed with the reserves
+func (state *Listener) publishBook(context actor.Context, ts uint64) {
+	base, quote := state.baseQuote()
+	levels := state.instrumentData.book.Update(base, quote)
+	if len(levels) == 0 {
+		return
+	}
+	context.Send(context.Parent(), &messages.MarketDataIncrementalRefresh{
+		UpdateL2: &models.OBL2Update{
+			Levels:    levels,
+			Timestamp: utils.MilliToTimestamp(ts),
+			Trade:     false,
+		},
+		SeqNum: state.instrumentData.seqNum + 1,
+	})
+	state.instrumentData.seqNum += 1
+	state.instrumentData.lastUpdateTime = ts
+	state.instrumentData.lastHBTime = time.Now()
+}
+
+func (state *Listener) onCheckTimeout(context actor.Context) error {
+	// The logs subscription sends a heartbeat every 10 seconds
+	if time.Since(state.lastRefreshTime) > 30*time.Second {
+		return fmt.Errorf("timed-out")
+	}
+	// If haven't sent anything for 2 seconds, send heartbeat
+	if time.Since(state.instrumentData.lastHBTime) > 2*time.Second {
+		// Send an empty refresh
+		context.Send(context.Parent(), &messages.MarketDataIncrementalRefresh{
+			SeqNum: state.instrumentData.seqNum + 1,
+		})
+		state.instrumentData.seqNum += 1
+		state.instrumentData.lastHBTime = time.Now()
+	}
+	return nil
+}
+
+// baseQuote returns the base and quote reserves in token units
+func (state *Listener) baseQuote() (float64, float64) {
+	reserve0 := utils.ToQuantity(state.reserve0, uint32(state.decimals0))
+	reserve1 := utils.ToQuantity(state.reserve1, uint32(state.decimals1))
+	if state.baseIs0 {
+		return reserve0, reserve1
+	}
+	return reserve1, reserve0
+}
+
+func (state *Listener) readTokens(context actor.Context, block uint64) error {
+	eabi, err := tokenevm.ERC20MetaData.GetAbi()
+	if err != nil {
+		return fmt.Errorf("error getting erc20 abi: %v", err)
+	}
+	out, err := utils.EVMCall(context, state.chainExecutor, state.security.Chain, state.pabi, state.pair, block, "factory")
+	if err != nil {
+		return err
+	}
+	if factory := out[0].(common.Address); factory != state.fork.Factory {
+		return fmt.Errorf("pair %s was created by %s, not the %s factory", state.security.Symbol, factory.Hex(), state.fork.Exchange.Name)
+	}
+	for i, method := range []string{"token0", "token1"} {
+		out, err := utils.EVMCall(context, state.chainExecutor, state.security.Chain, state.pabi, state.pair, block, method)
+		if err != nil {
+			return err
+		}
+		out, err = utils.EVMCall(context, state.chainExecutor, state.security.Chain, eabi, out[0].(common.Address), block, "decimals")
+		if err != nil {
+			return err
+		}
+		if i == 0 {
+			state.decimals0 = out[0].(uint8)
+		} else {
+			state.decimals1 = out[0].(uint8)
+		}
+	}
+	return nil
+}
+
+func (state *Listener) readReserves(context actor.Context, block uint64) error {
+	out, err := utils.EVMCall(context, state.chainExecutor, state.security.Chain, state.pabi, state.pair, block, "getReserves")
+	if err != nil {
+		return err
+	}
+	state.reserve0 = out[0].(*big.Int)
+	state.reserve1 = out[1].(*big.Int)
+	return nil
+}
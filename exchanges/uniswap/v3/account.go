@@ -0,0 +1,765 @@
+package v3
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/log"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"gitlab.com/alphaticks/alpha-connect/account"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+	"gitlab.com/alphaticks/xchanger/constants"
+	uniswap "gitlab.com/alphaticks/xchanger/exchanges/uniswap/V3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// The account listener follows Uniswap V3 liquidity positions. A position is either a token
+// of the nonfungible position manager, or a position owned by the account address in a pool.
+// The positions are read from the chain each time a Mint, Burn or Collect of their range
+// shows in the pool stream, and their amounts and fees are computed against the pool
+// state maintained from that stream. The deposits of a position, the amounts added less
+// the amounts collected, are read from its event history when it is first followed, from
+// the pool creation block by chunks and outside of the actor. The position is published
+// once its history is read. It runs alongside the wallet listener of the account, which
+// syncs the account.
+
+var positionManagerAddress = common.HexToAddress("0xC36442b4a4522E871399CD717aBDD847Ab11FE88")
+
+const positionManagerABI = `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"tokenId","type":"uint256"},{"indexed":false,"internalType":"address","name":"recipient","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount0","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount1","type":"uint256"}],"name":"Collect","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"tokenId","type":"uint256"},{"indexed":false,"internalType":"uint128","name":"liquidity","type":"uint128"},{"indexed":false,"internalType":"uint256","name":"amount0","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount1","type":"uint256"}],"name":"IncreaseLiquidity","type":"event"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"positions","outputs":[{"internalType":"uint96","name":"nonce","type":"uint96"},{"internalType":"address","name":"operator","type":"address"},{"internalType":"address","name":"token0","type":"address"},{"internalType":"address","name":"token1","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"int24","name":"tickLower","type":"int24"},{"internalType":"int24","name":"tickUpper","type":"int24"},{"internalType":"uint128","name":"liquidity","type":"uint128"},{"internalType":"uint256","name":"feeGrowthInside0LastX128","type":"uint256"},{"internalType":"uint256","name":"feeGrowthInside1LastX128","type":"uint256"},{"internalType":"uint128","name":"tokensOwed0","type":"uint128"},{"internalType":"uint128","name":"tokensOwed1","type":"uint128"}],"stateMutability":"view","type":"function"}]`
+
+// Number of blocks of a position history query
+const historyChunkSize = 2000
+
+type checkAccount struct{}
+
+type depositsLoaded struct {
+	pool       *lpPool
+	lp         *lp
+	deposited0 *big.Int
+	deposited1 *big.Int
+	err        error
+}
+
+type lpPool struct {
+	security *models.Security
+	address  common.Address
+	token0   *models.ProtocolAsset
+	token1   *models.ProtocolAsset
+	pool     *Pool
+	seqNum   uint64
+	block    uint64 // Block at which the pool state is known
+	lps      []*lp
+}
+
+type lp struct {
+	id       string
+	tokenID  *big.Int       // Token of the position manager, nil for a position owned by the address
+	owner    common.Address // Owner of the position in the pool
+	position *Position
+	block    uint64 // Block of the last position read
+	state    *account.LPPosition
+	// Tokens deposited less withdrawn since the history block, while the history is loading
+	historyBlock uint64
+	loading      bool
+	deposited0   *big.Int
+	deposited1   *big.Int
+}
+
+type AccountListener struct {
+	account            *account.Account
+	positions          []string
+	executor           *actor.PID
+	address            common.Address
+	pools              map[uint64]*lpPool // By subscription request ID
+	pabi               *abi.ABI
+	mabi               *abi.ABI
+	logger             *log.Logger
+	checkAccountTicker *time.Ticker
+}
+
+func NewAccountListenerProducer(account *account.Account, positions []string) actor.Producer {
+	return func() actor.Actor {
+		return NewAccountListener(account, positions)
+	}
+}
+
+func NewAccountListener(account *account.Account, positions []string) actor.Actor {
+	return &AccountListener{
+		account:   account,
+		positions: positions,
+		logger:    nil,
+	}
+}
+
+func (state *AccountListener) Receive(context actor.Context) {
+	switch context.Message().(type) {
+	case *actor.Started:
+		if err := state.Initialize(context); err != nil {
+			state.logger.Error("error initializing", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor started")
+
+	case *actor.Stopping:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error stopping", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor stopping")
+
+	case *actor.Stopped:
+		state.logger.Info("actor stopped")
+
+	case *actor.Restarting:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error restarting", log.Error(err))
+			// Attention, no panic in restarting or infinite loop
+		}
+		state.logger.Info("actor restarting")
+
+	case *messages.AccountDataRequest:
+		if err := state.OnAccountDataRequest(context); err != nil {
+			state.logger.Error("error processing OnAccountDataRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.PositionsRequest:
+		if err := state.OnPositionsRequest(context); err != nil {
+			state.logger.Error("error processing OnPositionListRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.BalancesRequest:
+		if err := state.OnBalancesRequest(context); err != nil {
+			state.logger.Error("error processing OnBalancesRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.OrderStatusRequest:
+		if err := state.OnOrderStatusRequest(context); err != nil {
+			state.logger.Error("error processing OnOrderStatusRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.AccountInformationRequest:
+		if err := state.OnAccountInformationRequest(context); err != nil {
+			state.logger.Error("error processing OnAccountInformationRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.NewOrderSingleRequest,
+		*messages.NewOrderBulkRequest,
+		*messages.OrderReplaceRequest,
+		*messages.OrderBulkReplaceRequest,
+		*messages.OrderCancelRequest,
+		*messages.OrderMassCancelRequest,
+		*messages.AccountMovementRequest,
+		*messages.TradeCaptureReportRequest:
+		if err := state.OnUnsupportedRequest(context); err != nil {
+			state.logger.Error("error processing OnUnsupportedRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.UnipoolV3DataIncrementalRefresh:
+		// A pool not synced is synced on the next refresh
+		if err := state.OnUnipoolV3DataIncrementalRefresh(context); err != nil {
+			state.logger.Warn("error processing OnUnipoolV3DataIncrementalRefresh", log.Error(err))
+		}
+
+	case *checkAccount:
+		state.checkAccount(context)
+
+	case *depositsLoaded:
+		state.onDepositsLoaded(context)
+	}
+}
+
+func (state *AccountListener) Initialize(context actor.Context) error {
+	state.logger = log.New(
+		log.InfoLevel,
+		"",
+		log.String("ID", context.Self().Id),
+		log.String("type", reflect.TypeOf(*state).String()))
+	state.executor = actor.NewPID(context.ActorSystem().Address(), "executor")
+
+	if state.account.Chain == nil {
+		return fmt.Errorf("account has no chain")
+	}
+	if !common.IsHexAddress(state.account.Address) {
+		return fmt.Errorf("invalid wallet address %s", state.account.Address)
+	}
+	state.address = common.HexToAddress(state.account.Address)
+	pabi, err := uniswap.UniswapMetaData.GetAbi()
+	if err != nil {
+		return fmt.Errorf("error getting pool abi: %v", err)
+	}
+	state.pabi = pabi
+	mabi, err := abi.JSON(strings.NewReader(positionManagerABI))
+	if err != nil {
+		return fmt.Errorf("error getting position manager abi: %v", err)
+	}
+	state.mabi = &mabi
+
+	res, err := context.RequestFuture(state.executor, &messages.ProtocolAssetListRequest{}, 10*time.Second).Result()
+	if err != nil {
+		return fmt.Errorf("error getting protocol assets: %v", err)
+	}
+	assetList, ok := res.(*messages.ProtocolAssetList)
+	if !ok {
+		return fmt.Errorf("was expecting *messages.ProtocolAssetList, got %s", reflect.TypeOf(res).String())
+	}
+	if !assetList.Success {
+		return fmt.Errorf("error getting protocol assets: %s", assetList.RejectionReason.String())
+	}
+
+	// Find the pool of each position
+	head, err := utils.EVMBlockNumber(context, state.executor, state.account.Chain)
+	if err != nil {
+		return err
+	}
+	pools := make(map[common.Address]*lpPool)
+	var order []*lpPool
+	for _, id := range state.positions {
+		l := &lp{id: id}
+		var address common.Address
+		if splits := strings.Split(id, ":"); len(splits) == 3 {
+			if !common.IsHexAddress(splits[0]) {
+				return fmt.Errorf("invalid pool address in position %s", id)
+			}
+			address = common.HexToAddress(splits[0])
+			tickLower, err := strconv.ParseInt(splits[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid lower tick in position %s: %v", id, err)
+			}
+			tickUpper, err := strconv.ParseInt(splits[2], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid upper tick in position %s: %v", id, err)
+			}
+			l.owner = state.address
+			l.position = &Position{TickLower: int32(tickLower), TickUpper: int32(tickUpper)}
+		} else {
+			tokenID, ok := new(big.Int).SetString(id, 10)
+			if !ok {
+				return fmt.Errorf("invalid position %s, expecting a token ID or pool:tickLower:tickUpper", id)
+			}
+			l.tokenID = tokenID
+			l.owner = positionManagerAddress
+			out, err := utils.EVMCall(context, state.executor, state.account.Chain, state.mabi, positionManagerAddress, head, "positions", tokenID)
+			if err != nil {
+				return err
+			}
+			l.position = &Position{TickLower: int32(out[5].(*big.Int).Int64()), TickUpper: int32(out[6].(*big.Int).Int64())}
+			address = PoolAddress(out[2].(common.Address), out[3].(common.Address), uint32(out[4].(*big.Int).Uint64()))
+		}
+		p, ok := pools[address]
+		if !ok {
+			p, err = state.getPool(context, address, assetList.ProtocolAssets)
+			if err != nil {
+				return err
+			}
+			pools[address] = p
+			order = append(order, p)
+		}
+		p.lps = append(p.lps, l)
+	}
+
+	// Follow the pool streams, then read the positions against the pool states
+	state.pools = make(map[uint64]*lpPool)
+	for _, p := range order {
+		requestID := uint64(time.Now().UnixNano())
+		res, err := context.RequestFuture(state.executor, &messages.UnipoolV3DataRequest{
+			RequestID:  requestID,
+			Subscribe:  true,
+			Subscriber: context.Self(),
+			Instrument: p.instrument(),
+		}, 2*time.Minute).Result()
+		if err != nil {
+			return fmt.Errorf("error subscribing to pool %s: %v", p.security.Symbol, err)
+		}
+		dataRes, ok := res.(*messages.UnipoolV3DataResponse)
+		if !ok {
+			return fmt.Errorf("was expecting *messages.UnipoolV3DataResponse, got %s", reflect.TypeOf(res).String())
+		}
+		if !dataRes.Success {
+			return fmt.Errorf("error subscribing to pool %s: %s", p.security.Symbol, dataRes.RejectionReason.String())
+		}
+		state.pools[requestID] = p
+		if err := state.syncPool(context, p); err != nil {
+			return err
+		}
+	}
+
+	checkAccountTicker := time.NewTicker(5 * time.Minute)
+	state.checkAccountTicker = checkAccountTicker
+	go func(pid *actor.PID) {
+		for {
+			select {
+			case <-checkAccountTicker.C:
+				context.Send(pid, &checkAccount{})
+			case <-time.After(6 * time.Minute):
+				if state.checkAccountTicker != checkAccountTicker {
+					return
+				}
+			}
+		}
+	}(context.Self())
+
+	return nil
+}
+
+func (state *AccountListener) Clean(context actor.Context) error {
+	if state.checkAccountTicker != nil {
+		state.checkAccountTicker.Stop()
+		state.checkAccountTicker = nil
+	}
+	return nil
+}
+
+func (state *AccountListener) OnAccountDataRequest(context actor.Context) error {
+	msg := context.Message().(*messages.AccountDataRequest)
+	context.Respond(&messages.AccountDataResponse{
+		RequestID:  msg.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		Success:    true,
+		Positions:  state.account.GetPositions(),
+		Balances:   state.account.GetBalances(),
+	})
+	return nil
+}
+
+func (state *AccountListener) OnPositionsRequest(context actor.Context) error {
+	msg := context.Message().(*messages.PositionsRequest)
+	var positions []*models.Position
+	for _, p := range state.account.GetPositions() {
+		if msg.Instrument == nil || msg.Instrument.SecurityID == nil || (p.Instrument != nil && p.Instrument.SecurityID.Value == msg.Instrument.SecurityID.Value) {
+			positions = append(positions, p)
+		}
+	}
+	context.Respond(&messages.PositionList{
+		RequestID:  msg.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		Success:    true,
+		Positions:  positions,
+	})
+	return nil
+}
+
+func (state *AccountListener) OnBalancesRequest(context actor.Context) error {
+	msg := context.Message().(*messages.BalancesRequest)
+	context.Respond(&messages.BalanceList{
+		RequestID:  msg.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		Success:    true,
+	})
+	return nil
+}
+
+func (state *AccountListener) OnOrderStatusRequest(context actor.Context) error {
+	msg := context.Message().(*messages.OrderStatusRequest)
+	context.Respond(&messages.OrderList{
+		RequestID: msg.RequestID,
+		Success:   true,
+	})
+	return nil
+}
+
+func (state *AccountListener) OnAccountInformationRequest(context actor.Context) error {
+	msg := context.Message().(*messages.AccountInformationRequest)
+	context.Respond(&messages.AccountInformationResponse{
+		RequestID:  msg.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		Success:    true,
+	})
+	return nil
+}
+
+// OnUnsupportedRequest rejects the trading requests, liquidity positions have no orders
+func (state *AccountListener) OnUnsupportedRequest(context actor.Context) error {
+	rej := messages.RejectionReason_UnsupportedRequest
+	switch msg := context.Message().(type) {
+	case *messages.NewOrderSingleRequest:
+		context.Respond(&messages.NewOrderSingleResponse{RequestID: msg.RequestID, RejectionReason: rej})
+	case *messages.NewOrderBulkRequest:
+		context.Respond(&messages.NewOrderBulkResponse{RequestID: msg.RequestID, RejectionReason: rej})
+	case *messages.OrderReplaceRequest:
+		context.Respond(&messages.OrderReplaceResponse{RequestID: msg.RequestID, RejectionReason: rej})
+	case *messages.OrderBulkReplaceRequest:
+		context.Respond(&messages.OrderBulkReplaceResponse{RequestID: msg.RequestID, RejectionReason: rej})
+	case *messages.OrderCancelRequest:
+		context.Respond(&messages.OrderCancelResponse{RequestID: msg.RequestID, RejectionReason: rej})
+	case *messages.OrderMassCancelRequest:
+		context.Respond(&messages.OrderMassCancelResponse{RequestID: msg.RequestID, RejectionReason: rej})
+	case *messages.AccountMovementRequest:
+		context.Respond(&messages.AccountMovementResponse{RequestID: msg.RequestID, RejectionReason: rej})
+	case *messages.TradeCaptureReportRequest:
+		context.Respond(&messages.TradeCaptureReport{RequestID: msg.RequestID, RejectionReason: rej})
+	}
+	return nil
+}
+
+func (state *AccountListener) OnUnipoolV3DataIncrementalRefresh(context actor.Context) error {
+	refresh := context.Message().(*messages.UnipoolV3DataIncrementalRefresh)
+	p, ok := state.pools[refresh.RequestID]
+	if !ok || refresh.SeqNum <= p.seqNum {
+		return nil
+	}
+	if refresh.SeqNum != p.seqNum+1 {
+		// Updates were missed, start again from the listener pool state
+		state.logger.Info(fmt.Sprintf("gap in pool %s updates, syncing pool", p.security.Symbol))
+		return state.syncPool(context, p)
+	}
+	p.seqNum = refresh.SeqNum
+	update := refresh.Update
+	if update == nil {
+		return nil
+	}
+	p.pool.Apply(update)
+	if update.Block > p.block {
+		p.block = update.Block
+	}
+	for _, l := range p.lps {
+		if touches(update, l) {
+			if err := state.readPosition(context, p, l, update.Block); err != nil {
+				// The position is read again on the next account check
+				state.logger.Warn("error reading position", log.String("position", l.id), log.Error(err))
+			}
+		}
+		state.updatePosition(p, l)
+	}
+	return nil
+}
+
+// touches returns whether the update modifies the position stored by the pool or the position manager
+func touches(update *models.UPV3Update, l *lp) bool {
+	if m := update.Mint; m != nil {
+		return bytes.Equal(m.Owner, l.owner[:]) && m.TickLower == l.position.TickLower && m.TickUpper == l.position.TickUpper
+	}
+	if b := update.Burn; b != nil {
+		return bytes.Equal(b.Owner, l.owner[:]) && b.TickLower == l.position.TickLower && b.TickUpper == l.position.TickUpper
+	}
+	// The owner of a collect is the sender, the position manager for its tokens
+	if c := update.Collect; c != nil {
+		return bytes.Equal(c.Owner, l.owner[:]) && c.TickLower == l.position.TickLower && c.TickUpper == l.position.TickUpper
+	}
+	return false
+}
+
+// checkAccount reads all the positions, to recover from missed events and read errors
+func (state *AccountListener) checkAccount(context actor.Context) {
+	for _, p := range state.pools {
+		for _, l := range p.lps {
+			if l.state == nil && !l.loading && l.block > 0 {
+				state.loadDeposits(context, p, l)
+			}
+			if err := state.readPosition(context, p, l, p.block); err != nil {
+				state.logger.Warn("error reading position", log.String("position", l.id), log.Error(err))
+				continue
+			}
+			state.updatePosition(p, l)
+		}
+	}
+}
+
+// syncPool fetches the pool state of the listener and reads the positions at its block
+func (state *AccountListener) syncPool(context actor.Context, p *lpPool) error {
+	res, err := context.RequestFuture(state.executor, &PoolRequest{Instrument: p.instrument()}, 2*time.Minute).Result()
+	if err != nil {
+		return fmt.Errorf("error fetching pool %s: %v", p.security.Symbol, err)
+	}
+	poolRes, ok := res.(*PoolResponse)
+	if !ok {
+		return fmt.Errorf("was expecting *PoolResponse, got %s", reflect.TypeOf(res).String())
+	}
+	if poolRes.Err != nil {
+		return fmt.Errorf("error fetching pool %s: %v", p.security.Symbol, poolRes.Err)
+	}
+	p.pool = poolRes.Pool
+	p.seqNum = poolRes.SeqNum
+	p.block = poolRes.Block
+	for _, l := range p.lps {
+		if err := state.readPosition(context, p, l, p.block); err != nil {
+			return err
+		}
+		state.updatePosition(p, l)
+	}
+	return nil
+}
+
+// readPosition reads the position at the given block. The tokens deposited or withdrawn are
+// the difference of the position amounts and fees before and after, at the same pool state.
+func (state *AccountListener) readPosition(context actor.Context, p *lpPool, l *lp, block uint64) error {
+	if block < l.block {
+		return nil
+	}
+	var position *Position
+	if l.tokenID != nil {
+		out, err := utils.EVMCall(context, state.executor, state.account.Chain, state.mabi, positionManagerAddress, block, "positions", l.tokenID)
+		if err != nil {
+			return err
+		}
+		position = &Position{
+			TickLower:                l.position.TickLower,
+			TickUpper:                l.position.TickUpper,
+			Liquidity:                out[7].(*big.Int),
+			FeeGrowthInside0LastX128: out[8].(*big.Int),
+			FeeGrowthInside1LastX128: out[9].(*big.Int),
+			TokensOwed0:              out[10].(*big.Int),
+			TokensOwed1:              out[11].(*big.Int),
+		}
+	} else {
+		out, err := utils.EVMCall(context, state.executor, state.account.Chain, state.pabi, p.address, block, "positions", PositionKey(l.owner, l.position.TickLower, l.position.TickUpper))
+		if err != nil {
+			return err
+		}
+		position = &Position{
+			TickLower:                l.position.TickLower,
+			TickUpper:                l.position.TickUpper,
+			Liquidity:                out[0].(*big.Int),
+			FeeGrowthInside0LastX128: out[1].(*big.Int),
+			FeeGrowthInside1LastX128: out[2].(*big.Int),
+			TokensOwed0:              out[3].(*big.Int),
+			TokensOwed1:              out[4].(*big.Int),
+		}
+	}
+	if l.block == 0 {
+		// First read, the deposits up to the block are read from the position history
+		l.position = position
+		l.block = block
+		l.historyBlock = block
+		l.deposited0, l.deposited1 = big.NewInt(0), big.NewInt(0)
+		state.loadDeposits(context, p, l)
+		return nil
+	}
+	after0, after1 := p.positionTotal(position)
+	before0, before1 := p.positionTotal(l.position)
+	delta0, delta1 := after0.Sub(after0, before0), after1.Sub(after1, before1)
+	if l.state != nil {
+		l.state.Deposited0 += utils.ToQuantity(delta0, p.token0.Decimals.Value)
+		l.state.Deposited1 += utils.ToQuantity(delta1, p.token1.Decimals.Value)
+	} else {
+		l.deposited0.Add(l.deposited0, delta0)
+		l.deposited1.Add(l.deposited1, delta1)
+	}
+	l.position = position
+	l.block = block
+	return nil
+}
+
+// loadDeposits reads the deposits of the position up to its history block outside of the actor
+func (state *AccountListener) loadDeposits(context actor.Context, p *lpPool, l *lp) {
+	l.loading = true
+	root := context.ActorSystem().Root
+	go func(pid *actor.PID) {
+		deposited0, deposited1, err := state.deposits(root, p, l, l.historyBlock)
+		context.Send(pid, &depositsLoaded{
+			pool:       p,
+			lp:         l,
+			deposited0: deposited0,
+			deposited1: deposited1,
+			err:        err,
+		})
+	}(context.Self())
+}
+
+func (state *AccountListener) onDepositsLoaded(context actor.Context) {
+	msg := context.Message().(*depositsLoaded)
+	p, l := msg.pool, msg.lp
+	l.loading = false
+	// The listener might have restarted meanwhile
+	followed := false
+	for _, sp := range state.pools {
+		if sp == p {
+			followed = true
+		}
+	}
+	if !followed {
+		return
+	}
+	if msg.err != nil {
+		// The history is read again on the next account check
+		state.logger.Warn("error reading position history", log.String("position", l.id), log.Error(msg.err))
+		return
+	}
+	l.state = &account.LPPosition{
+		ID:         l.id,
+		Security:   p.security,
+		Token0:     p.token0.Asset,
+		Token1:     p.token1.Asset,
+		TickLower:  l.position.TickLower,
+		TickUpper:  l.position.TickUpper,
+		Deposited0: utils.ToQuantity(msg.deposited0.Add(msg.deposited0, l.deposited0), p.token0.Decimals.Value),
+		Deposited1: utils.ToQuantity(msg.deposited1.Add(msg.deposited1, l.deposited1), p.token1.Decimals.Value),
+	}
+	state.updatePosition(p, l)
+}
+
+// deposits returns the amounts added to the position less the amounts collected, up to the block.
+// A token of the position manager is followed from its IncreaseLiquidity and Collect events,
+// a position owned by the address from the Mint and Collect events of the pool. The events
+// are queried from the pool creation block, by chunks.
+func (state *AccountListener) deposits(root *actor.RootContext, p *lpPool, l *lp, block uint64) (*big.Int, *big.Int, error) {
+	if p.security.CreationBlock == nil {
+		return nil, nil, fmt.Errorf("unknown creation block of pool %s", p.security.Symbol)
+	}
+	cabi, address := state.pabi, p.address
+	added, removed := cabi.Events["Mint"], cabi.Events["Collect"]
+	query := [][]interface{}{{added.ID, removed.ID}, {l.owner}, {l.position.TickLower}, {l.position.TickUpper}}
+	if l.tokenID != nil {
+		cabi, address = state.mabi, positionManagerAddress
+		added, removed = cabi.Events["IncreaseLiquidity"], cabi.Events["Collect"]
+		query = [][]interface{}{{added.ID, removed.ID}, {l.tokenID}}
+	}
+	topics, err := abi.MakeTopics(query...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error making topics: %v", err)
+	}
+	var logs []types.Log
+	for start := p.security.CreationBlock.Value; start <= block; start += historyChunkSize {
+		end := start + historyChunkSize - 1
+		if end > block {
+			end = block
+		}
+		res, err := root.RequestFuture(state.executor, &messages.EVMLogsQueryRequest{
+			RequestID: uint64(time.Now().UnixNano()),
+			Chain:     state.account.Chain,
+			Query: ethereum.FilterQuery{
+				FromBlock: new(big.Int).SetUint64(start),
+				ToBlock:   new(big.Int).SetUint64(end),
+				Addresses: []common.Address{address},
+				Topics:    topics,
+			},
+		}, 2*time.Minute).Result()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error querying position %s history: %v", l.id, err)
+		}
+		chunk, ok := res.(*messages.EVMLogsQueryResponse)
+		if !ok {
+			return nil, nil, fmt.Errorf("was expecting *messages.EVMLogsQueryResponse, got %s", reflect.TypeOf(res).String())
+		}
+		if !chunk.Success {
+			return nil, nil, fmt.Errorf("error querying position %s history: %s", l.id, chunk.RejectionReason.String())
+		}
+		logs = append(logs, chunk.Logs...)
+	}
+	deposited0, deposited1 := big.NewInt(0), big.NewInt(0)
+	for _, lg := range logs {
+		if lg.Removed || len(lg.Topics) == 0 {
+			continue
+		}
+		event := added
+		if lg.Topics[0] == removed.ID {
+			event = removed
+		} else if lg.Topics[0] != added.ID {
+			continue
+		}
+		out, err := cabi.Unpack(event.Name, lg.Data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error unpacking %s log: %v", event.Name, err)
+		}
+		// The amounts are the last outputs of the events
+		amount0, amount1 := out[len(out)-2].(*big.Int), out[len(out)-1].(*big.Int)
+		if event.ID == added.ID {
+			deposited0.Add(deposited0, amount0)
+			deposited1.Add(deposited1, amount1)
+		} else {
+			deposited0.Sub(deposited0, amount0)
+			deposited1.Sub(deposited1, amount1)
+		}
+	}
+	return deposited0, deposited1, nil
+}
+
+// updatePosition updates the account position with the amounts and fees at the pool state
+func (state *AccountListener) updatePosition(p *lpPool, l *lp) {
+	if l.state == nil {
+		return
+	}
+	amount0, amount1 := p.pool.PositionAmounts(l.position)
+	fees0, fees1 := p.pool.PositionFees(l.position)
+	l.state.Liquidity, _ = new(big.Float).SetInt(l.position.Liquidity).Float64()
+	l.state.Amount0 = utils.ToQuantity(amount0, p.token0.Decimals.Value)
+	l.state.Amount1 = utils.ToQuantity(amount1, p.token1.Decimals.Value)
+	l.state.Fees0 = utils.ToQuantity(fees0, p.token0.Decimals.Value)
+	l.state.Fees1 = utils.ToQuantity(fees1, p.token1.Decimals.Value)
+	l.state.Price = p.price()
+	state.account.UpdateLPPosition(l.state)
+}
+
+// getPool finds the security and the tokens of the pool at the address
+func (state *AccountListener) getPool(context actor.Context, address common.Address, assets []*models.ProtocolAsset) (*lpPool, error) {
+	symbol := strings.ToLower(address.Hex())
+	res, err := context.RequestFuture(state.executor, &messages.SecurityDefinitionRequest{
+		RequestID: uint64(time.Now().UnixNano()),
+		Instrument: &models.Instrument{
+			Exchange: constants.UNISWAPV3,
+			Symbol:   &wrapperspb.StringValue{Value: symbol},
+		},
+	}, 10*time.Second).Result()
+	if err != nil {
+		return nil, fmt.Errorf("error getting pool %s: %v", symbol, err)
+	}
+	def, ok := res.(*messages.SecurityDefinitionResponse)
+	if !ok {
+		return nil, fmt.Errorf("was expecting *messages.SecurityDefinitionResponse, got %s", reflect.TypeOf(res).String())
+	}
+	if !def.Success {
+		return nil, fmt.Errorf("error getting pool %s: %s", symbol, def.RejectionReason.String())
+	}
+	p := &lpPool{
+		security: def.Security,
+		address:  address,
+	}
+	// The underlying of an inverse pool is its token1
+	asset0, asset1 := def.Security.Underlying, def.Security.QuoteCurrency
+	if def.Security.IsInverse {
+		asset0, asset1 = asset1, asset0
+	}
+	for _, pa := range assets {
+		if pa.Protocol.ID != constants.ERC20.ID || pa.Chain.ID != state.account.Chain.ID || pa.Asset == nil || pa.Decimals == nil {
+			continue
+		}
+		if pa.Asset.ID == asset0.ID {
+			p.token0 = pa
+		} else if pa.Asset.ID == asset1.ID {
+			p.token1 = pa
+		}
+	}
+	if p.token0 == nil || p.token1 == nil {
+		return nil, fmt.Errorf("unknown tokens of pool %s", symbol)
+	}
+	return p, nil
+}
+
+func (p *lpPool) instrument() *models.Instrument {
+	return &models.Instrument{
+		SecurityID: &wrapperspb.UInt64Value{Value: p.security.SecurityID},
+		Exchange:   p.security.Exchange,
+		Symbol:     &wrapperspb.StringValue{Value: p.security.Symbol},
+	}
+}
+
+// positionTotal returns the amounts and fees of the position
+func (p *lpPool) positionTotal(position *Position) (*big.Int, *big.Int) {
+	amount0, amount1 := p.pool.PositionAmounts(position)
+	fees0, fees1 := p.pool.PositionFees(position)
+	return amount0.Add(amount0, fees0), amount1.Add(amount1, fees1)
+}
+
+// price returns the price of token0 in token1
+func (p *lpPool) price() float64 {
+	sqrtPrice, _ := new(big.Float).Quo(new(big.Float).SetInt(p.pool.GetSqrtPrice()), new(big.Float).SetInt(q96)).Float64()
+	decimals := float64(int64(p.token0.Decimals.Value) - int64(p.token1.Decimals.Value))
+	return sqrtPrice * sqrtPrice * math.Pow(10, decimals)
+}
@@ -369,7 +369,7 @@ func (state *Executor) OnHistoricalUnipoolV3DataRequest(context actor.Context) e
 				}
 				update := &models.UPV3Update{
 					Collect: &gorderbook.UPV3Collect{
-						Owner:            event.Recipient[:],
+						Owner:            event.Owner[:],
 						TickLower:        int32(event.TickLower.Int64()),
 						TickUpper:        int32(event.TickUpper.Int64()),
 						AmountRequested0: event.Amount0.Bytes(),
@@ -9,6 +9,7 @@ import (
 	"gitlab.com/alphaticks/xchanger/constants"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"math/big"
+	"net/http"
 	"reflect"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	extypes "gitlab.com/alphaticks/alpha-connect/exchanges/types"
 	"gitlab.com/alphaticks/alpha-connect/models"
 	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/go-graphql-client"
 	gorderbook "gitlab.com/alphaticks/gorderbook/gorderbook.models"
 	uniswap "gitlab.com/alphaticks/xchanger/exchanges/uniswap/V3"
 	xchangerUtils "gitlab.com/alphaticks/xchanger/utils"
@@ -30,6 +32,19 @@ import (
 type checkSockets struct{}
 type flush struct{}
 
+// PoolRequest asks the listener for a copy of its pool state, used to quote swaps
+// and to account for liquidity positions
+type PoolRequest struct {
+	Instrument *models.Instrument
+}
+
+type PoolResponse struct {
+	Pool   *Pool
+	SeqNum uint64 // Sequence number of the last update applied to the pool
+	Block  uint64 // Block at which the pool state is known
+	Err    error
+}
+
 type InstrumentData struct {
 	seqNum uint64
 	lastHB time.Time
@@ -48,6 +63,9 @@ type Listener struct {
 	flushTicker    *time.Ticker
 	lastPingTime   time.Time
 	updates        *list.List
+	pool           *Pool
+	poolBlock      uint64
+	replayedBlock  uint64
 }
 
 func NewListenerProducer(securityID uint64, dialerPool *xchangerUtils.DialerPool) actor.Producer {
@@ -94,6 +112,12 @@ func (state *Listener) Receive(context actor.Context) {
 			panic(err)
 		}
 
+	case *PoolRequest:
+		if err := state.OnPoolRequest(context); err != nil {
+			state.logger.Error("error processing OnPoolRequest", log.Error(err))
+			panic(err)
+		}
+
 	case *types.Log:
 		if err := state.onLog(context); err != nil {
 			state.logger.Error("error processing log", log.Error(err))
@@ -152,10 +176,14 @@ func (state *Listener) Initialize(context actor.Context) error {
 		return fmt.Errorf("error while dialing eth rpc client %v", err)
 	}
 	state.client = client
+	state.updates = list.New()
 
 	if err := state.subscribeLogs(context); err != nil {
 		return fmt.Errorf("error subscribing to logs %v", err)
 	}
+	if err := state.syncPool(); err != nil {
+		return fmt.Errorf("error syncing pool: %v", err)
+	}
 
 	socketTicker := time.NewTicker(5 * time.Second)
 	state.socketTicker = socketTicker
@@ -187,8 +215,6 @@ func (state *Listener) Initialize(context actor.Context) error {
 		}
 	}(context.Self())
 
-	state.updates = list.New()
-
 	return nil
 }
 
@@ -205,19 +231,16 @@ func (state *Listener) OnUnipoolV3DataRequest(context actor.Context) error {
 	return nil
 }
 
-func (state *Listener) subscribeLogs(context actor.Context) error {
-	if state.iterator != nil {
-		state.iterator.Close()
-	}
-
-	symbol := state.security.Symbol
-
-	uabi, err := uniswap.UniswapMetaData.GetAbi()
-	if err != nil {
-		return fmt.Errorf("error getting contract abi %v", err)
-	}
-	it := evm.NewLogIterator(uabi)
+func (state *Listener) OnPoolRequest(context actor.Context) error {
+	context.Respond(&PoolResponse{
+		Pool:   state.pool.Clone(),
+		SeqNum: state.instrumentData.seqNum,
+		Block:  state.poolBlock,
+	})
+	return nil
+}
 
+func (state *Listener) filterQuery(uabi *abi.ABI) (ethereum.FilterQuery, error) {
 	query := [][]interface{}{{
 		uabi.Events["Initialize"].ID,
 		uabi.Events["Mint"].ID,
@@ -230,11 +253,27 @@ func (state *Listener) subscribeLogs(context actor.Context) error {
 	}}
 	topics, err := abi.MakeTopics(query...)
 	if err != nil {
-		return fmt.Errorf("error getting topics %v", err)
+		return ethereum.FilterQuery{}, fmt.Errorf("error getting topics %v", err)
 	}
-	fQuery := ethereum.FilterQuery{
-		Addresses: []common.Address{common.HexToAddress(symbol)},
+	return ethereum.FilterQuery{
+		Addresses: []common.Address{common.HexToAddress(state.security.Symbol)},
 		Topics:    topics,
+	}, nil
+}
+
+func (state *Listener) subscribeLogs(context actor.Context) error {
+	if state.iterator != nil {
+		state.iterator.Close()
+	}
+
+	uabi, err := uniswap.UniswapMetaData.GetAbi()
+	if err != nil {
+		return fmt.Errorf("error getting contract abi %v", err)
+	}
+	it := evm.NewLogIterator(uabi)
+	fQuery, err := state.filterQuery(uabi)
+	if err != nil {
+		return err
 	}
 
 	ctx, cancel := goContext.WithTimeout(goContext.Background(), 10*time.Second)
@@ -255,6 +294,94 @@ func (state *Listener) subscribeLogs(context actor.Context) error {
 	return nil
 }
 
+// syncPool takes a snapshot of the pool from the graph and replays the logs
+// emitted since, up to the head, the subscription delivering the following ones
+func (state *Listener) syncPool() error {
+	ctx, cancel := goContext.WithTimeout(goContext.Background(), 2*time.Minute)
+	defer cancel()
+	head, err := state.client.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("error fetching current block number: %v", err)
+	}
+	// The graph lags behind the chain
+	snapshotBlock := head - 20
+
+	client := graphql.NewClient(uniswap.GRAPHQL_URL, &http.Client{Timeout: 10 * time.Second})
+	query, variables := uniswap.GetPoolSnapshotQuery(graphql.ID(state.security.Symbol), graphql.Int(snapshotBlock), graphql.ID(""))
+	if err := client.Query(ctx, &query, variables); err != nil {
+		return fmt.Errorf("error querying pool snapshot: %v", err)
+	}
+	pool := NewPool(query.Pool.FeeTier, query.Pool.SqrtPrice, query.Pool.Tick, query.Pool.Liquidity)
+	for {
+		for _, t := range query.Pool.Ticks {
+			pool.SetTick(t.TickIdx, t.LiquidityNet, t.LiquidityGross, t.FeeGrowthOutside0X128, t.FeeGrowthOutside1X128)
+		}
+		if len(query.Pool.Ticks) != 1000 {
+			break
+		}
+		query, variables = uniswap.GetPoolSnapshotQuery(graphql.ID(state.security.Symbol), graphql.Int(snapshotBlock), graphql.ID(query.Pool.Ticks[999].Id))
+		if err := client.Query(ctx, &query, variables); err != nil {
+			return fmt.Errorf("error querying pool snapshot: %v", err)
+		}
+	}
+
+	uabi, err := uniswap.UniswapMetaData.GetAbi()
+	if err != nil {
+		return fmt.Errorf("error getting contract abi %v", err)
+	}
+	// The fee growth and the protocol fee are not in the graph snapshot
+	call := func(method string) ([]interface{}, error) {
+		data, err := uabi.Pack(method)
+		if err != nil {
+			return nil, fmt.Errorf("error packing %s call: %v", method, err)
+		}
+		address := common.HexToAddress(state.security.Symbol)
+		out, err := state.client.CallContract(ctx, ethereum.CallMsg{To: &address, Data: data}, big.NewInt(int64(snapshotBlock)))
+		if err != nil {
+			return nil, fmt.Errorf("error calling %s: %v", method, err)
+		}
+		return uabi.Unpack(method, out)
+	}
+	fg0, err := call("feeGrowthGlobal0X128")
+	if err != nil {
+		return err
+	}
+	fg1, err := call("feeGrowthGlobal1X128")
+	if err != nil {
+		return err
+	}
+	pool.SetFeeGrowthGlobal(fg0[0].(*big.Int), fg1[0].(*big.Int))
+	slot0, err := call("slot0")
+	if err != nil {
+		return err
+	}
+	feeProtocol := uint32(slot0[5].(uint8))
+	pool.SetFeeProtocol(feeProtocol%16, feeProtocol>>4)
+
+	fQuery, err := state.filterQuery(uabi)
+	if err != nil {
+		return err
+	}
+	fQuery.FromBlock = big.NewInt(int64(snapshotBlock + 1))
+	fQuery.ToBlock = big.NewInt(int64(head))
+	logs, err := state.client.FilterLogs(ctx, fQuery)
+	if err != nil {
+		return fmt.Errorf("error filtering logs: %v", err)
+	}
+	for i := range logs {
+		update, err := state.parseLog(uabi, &logs[i])
+		if err != nil {
+			return err
+		}
+		state.updates.PushBack(update)
+	}
+	state.pool = pool
+	state.poolBlock = snapshotBlock
+	state.replayedBlock = head
+
+	return nil
+}
+
 func (state *Listener) Clean(context actor.Context) error {
 	if state.socketTicker != nil {
 		state.socketTicker.Stop()
@@ -283,20 +410,34 @@ func (state *Listener) onLog(context actor.Context) error {
 			return nil
 		}
 	}
+	if msg.BlockNumber <= state.replayedBlock {
+		// Already replayed when syncing the pool
+		return nil
+	}
 	uabi, err := uniswap.UniswapMetaData.GetAbi()
 	if err != nil {
 		return fmt.Errorf("error getting contract abi %v", err)
 	}
+	update, err := state.parseLog(uabi, msg)
+	if err != nil {
+		return err
+	}
+
+	state.updates.PushBack(update)
+	return nil
+}
+
+func (state *Listener) parseLog(uabi *abi.ABI, msg *types.Log) (*models.UPV3Update, error) {
 	var update *models.UPV3Update
 	header, err := state.client.HeaderByNumber(goContext.Background(), big.NewInt(int64(msg.BlockNumber)))
 	if err != nil {
-		return fmt.Errorf("error getting block number: %v", err)
+		return nil, fmt.Errorf("error getting block number: %v", err)
 	}
 	switch msg.Topics[0] {
 	case uabi.Events["Initialize"].ID:
 		event := new(uniswap.UniswapInitialize)
 		if err := evm.UnpackLog(uabi, event, "Initialize", *msg); err != nil {
-			return fmt.Errorf("error unpacking the log %v", err)
+			return nil, fmt.Errorf("error unpacking the log %v", err)
 		}
 		update = &models.UPV3Update{
 			Initialize: &gorderbook.UPV3Initialize{
@@ -309,7 +450,7 @@ func (state *Listener) onLog(context actor.Context) error {
 	case uabi.Events["Mint"].ID:
 		event := new(uniswap.UniswapMint)
 		if err := evm.UnpackLog(uabi, event, "Mint", *msg); err != nil {
-			return fmt.Errorf("error unpacking the log %v", err)
+			return nil, fmt.Errorf("error unpacking the log %v", err)
 		}
 		update = &models.UPV3Update{
 			Mint: &gorderbook.UPV3Mint{
@@ -326,7 +467,7 @@ func (state *Listener) onLog(context actor.Context) error {
 	case uabi.Events["Burn"].ID:
 		event := new(uniswap.UniswapBurn)
 		if err := evm.UnpackLog(uabi, event, "Burn", *msg); err != nil {
-			return fmt.Errorf("error unpacking the log %v", err)
+			return nil, fmt.Errorf("error unpacking the log %v", err)
 		}
 		update = &models.UPV3Update{
 			Burn: &gorderbook.UPV3Burn{
@@ -343,12 +484,13 @@ func (state *Listener) onLog(context actor.Context) error {
 	case uabi.Events["Swap"].ID:
 		event := new(uniswap.UniswapSwap)
 		if err := evm.UnpackLog(uabi, event, "Swap", *msg); err != nil {
-			return fmt.Errorf("error unpacking the log %v", err)
+			return nil, fmt.Errorf("error unpacking the log %v", err)
 		}
 		update = &models.UPV3Update{
 			Swap: &gorderbook.UPV3Swap{
 				SqrtPriceX96: event.SqrtPriceX96.Bytes(),
 				Tick:         int32(event.Tick.Int64()),
+				Liquidity:    event.Liquidity.Bytes(),
 				Amount0:      event.Amount0.Bytes(),
 				Amount1:      event.Amount1.Bytes(),
 			},
@@ -358,11 +500,11 @@ func (state *Listener) onLog(context actor.Context) error {
 	case uabi.Events["Collect"].ID:
 		event := new(uniswap.UniswapCollect)
 		if err := evm.UnpackLog(uabi, event, "Collect", *msg); err != nil {
-			return fmt.Errorf("error unpacking the log %v", err)
+			return nil, fmt.Errorf("error unpacking the log %v", err)
 		}
 		update = &models.UPV3Update{
 			Collect: &gorderbook.UPV3Collect{
-				Owner:            event.Recipient[:],
+				Owner:            event.Owner[:],
 				TickLower:        int32(event.TickLower.Int64()),
 				TickUpper:        int32(event.TickUpper.Int64()),
 				AmountRequested0: event.Amount0.Bytes(),
@@ -374,7 +516,7 @@ func (state *Listener) onLog(context actor.Context) error {
 	case uabi.Events["Flash"].ID:
 		event := new(uniswap.UniswapFlash)
 		if err := evm.UnpackLog(uabi, event, "Flash", *msg); err != nil {
-			return fmt.Errorf("error unpacking the log %v", err)
+			return nil, fmt.Errorf("error unpacking the log %v", err)
 		}
 		update = &models.UPV3Update{
 			Flash: &gorderbook.UPV3Flash{
@@ -387,7 +529,7 @@ func (state *Listener) onLog(context actor.Context) error {
 	case uabi.Events["SetFeeProtocol"].ID:
 		event := new(uniswap.UniswapSetFeeProtocol)
 		if err := evm.UnpackLog(uabi, event, "SetFeeProtocol", *msg); err != nil {
-			return fmt.Errorf("error unpacking the log %v", err)
+			return nil, fmt.Errorf("error unpacking the log %v", err)
 		}
 		update = &models.UPV3Update{
 			SetFeeProtocol: &gorderbook.UPV3SetFeeProtocol{
@@ -399,7 +541,7 @@ func (state *Listener) onLog(context actor.Context) error {
 	case uabi.Events["CollectProtocol"].ID:
 		event := new(uniswap.UniswapCollectProtocol)
 		if err := evm.UnpackLog(uabi, event, "CollectProtocol", *msg); err != nil {
-			return fmt.Errorf("error unpacking the log %v", err)
+			return nil, fmt.Errorf("error unpacking the log %v", err)
 		}
 		update = &models.UPV3Update{
 			CollectProtocol: &gorderbook.UPV3CollectProtocol{
@@ -410,11 +552,10 @@ func (state *Listener) onLog(context actor.Context) error {
 			Timestamp: utils.SecondToTimestamp(header.Time),
 		}
 	default:
-		return fmt.Errorf("received unknown event: %v", msg.Topics[0])
+		return nil, fmt.Errorf("received unknown event: %v", msg.Topics[0])
 	}
 
-	state.updates.PushBack(update)
-	return nil
+	return update, nil
 }
 
 func (state *Listener) onFlush(context actor.Context) error {
@@ -426,6 +567,7 @@ func (state *Listener) onFlush(context actor.Context) error {
 	for el := state.updates.Front(); el != nil; el = state.updates.Front() {
 		update := el.Value.(*models.UPV3Update)
 		if update.Block <= current-4 {
+			state.pool.Apply(update)
 			context.Send(context.Parent(), &messages.UnipoolV3DataIncrementalRefresh{
 				SeqNum: state.instrumentData.seqNum + 1,
 				Update: update,
@@ -436,6 +578,9 @@ func (state *Listener) onFlush(context actor.Context) error {
 			break
 		}
 	}
+	if current-4 > state.poolBlock {
+		state.poolBlock = current - 4
+	}
 	return nil
 }
 
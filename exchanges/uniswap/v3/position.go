@@ -0,0 +1,102 @@
+package v3
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var maxInt256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1))
+
+var (
+	factoryAddress   = common.HexToAddress("0x1F98431c8aD98523631AE4a59f267346ea31F984")
+	poolInitCodeHash = common.FromHex("0xe34f199b19b2b4f47f68442619d555527d244f78a3297ea89325f843f87b8b54")
+)
+
+// PoolAddress returns the address the factory deploys the pool of the tokens and fee at
+func PoolAddress(token0, token1 common.Address, fee uint32) common.Address {
+	salt := crypto.Keccak256Hash(
+		common.LeftPadBytes(token0[:], 32),
+		common.LeftPadBytes(token1[:], 32),
+		common.LeftPadBytes(big.NewInt(int64(fee)).Bytes(), 32))
+	return crypto.CreateAddress2(factoryAddress, salt, poolInitCodeHash)
+}
+
+// PositionKey returns the key of the position of the owner in the pool
+func PositionKey(owner common.Address, tickLower, tickUpper int32) [32]byte {
+	int24 := func(tick int32) []byte {
+		v := uint32(tick) & 0xffffff
+		return []byte{byte(v >> 16), byte(v >> 8), byte(v)}
+	}
+	return crypto.Keccak256Hash(owner[:], int24(tickLower), int24(tickUpper))
+}
+
+// Position is a liquidity position, as stored by the pool for its owner,
+// or by the nonfungible position manager for a token ID
+type Position struct {
+	TickLower                int32
+	TickUpper                int32
+	Liquidity                *big.Int
+	FeeGrowthInside0LastX128 *big.Int
+	FeeGrowthInside1LastX128 *big.Int
+	TokensOwed0              *big.Int
+	TokensOwed1              *big.Int
+}
+
+// FeeGrowthInside returns the fees earned per unit of liquidity inside the tick range, in Q128.128
+func (p *Pool) FeeGrowthInside(tickLower, tickUpper int32) (*big.Int, *big.Int) {
+	zero := big.NewInt(0)
+	lower0, lower1 := zero, zero
+	if info, ok := p.ticks[tickLower]; ok {
+		lower0, lower1 = info.feeGrowthOutside0X128, info.feeGrowthOutside1X128
+	}
+	upper0, upper1 := zero, zero
+	if info, ok := p.ticks[tickUpper]; ok {
+		upper0, upper1 = info.feeGrowthOutside0X128, info.feeGrowthOutside1X128
+	}
+	inside := func(global, lower, upper *big.Int) *big.Int {
+		below := lower
+		if p.tick < tickLower {
+			below = new(big.Int).Sub(global, lower)
+		}
+		above := upper
+		if p.tick >= tickUpper {
+			above = new(big.Int).Sub(global, upper)
+		}
+		r := new(big.Int).Sub(global, below)
+		return mod256(r.Sub(r, above))
+	}
+	return inside(p.feeGrowthGlobal0X128, lower0, upper0), inside(p.feeGrowthGlobal1X128, lower1, upper1)
+}
+
+// PositionAmounts returns the token amounts of the position liquidity at the pool price
+func (p *Pool) PositionAmounts(pos *Position) (*big.Int, *big.Int) {
+	sqrtLower := GetSqrtRatioAtTick(pos.TickLower)
+	sqrtUpper := GetSqrtRatioAtTick(pos.TickUpper)
+	if p.tick < pos.TickLower {
+		return getAmount0Delta(sqrtLower, sqrtUpper, pos.Liquidity, false), big.NewInt(0)
+	} else if p.tick < pos.TickUpper {
+		return getAmount0Delta(p.sqrtPriceX96, sqrtUpper, pos.Liquidity, false), getAmount1Delta(sqrtLower, p.sqrtPriceX96, pos.Liquidity, false)
+	} else {
+		return big.NewInt(0), getAmount1Delta(sqrtLower, sqrtUpper, pos.Liquidity, false)
+	}
+}
+
+// PositionFees returns the tokens owed to the position, including the fees accrued
+// since it was last updated
+func (p *Pool) PositionFees(pos *Position) (*big.Int, *big.Int) {
+	inside0, inside1 := p.FeeGrowthInside(pos.TickLower, pos.TickUpper)
+	accrued := func(inside, last *big.Int) *big.Int {
+		d := mod256(new(big.Int).Sub(inside, last))
+		// A difference above 2^255 is a negative one: the position was read
+		// at a later block than the pool state
+		if d.Cmp(maxInt256) > 0 {
+			return big.NewInt(0)
+		}
+		return mulDiv(d, pos.Liquidity, q128)
+	}
+	fees0 := accrued(inside0, pos.FeeGrowthInside0LastX128)
+	fees1 := accrued(inside1, pos.FeeGrowthInside1LastX128)
+	return fees0.Add(fees0, pos.TokensOwed0), fees1.Add(fees1, pos.TokensOwed1)
+}
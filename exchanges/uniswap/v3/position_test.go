@@ -0,0 +1,153 @@
+package v3
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	gorderbook "gitlab.com/alphaticks/gorderbook/gorderbook.models"
+)
+
+func mint(pool *Pool, tickLower, tickUpper int32, liquidity *big.Int) *Position {
+	pool.Apply(&models.UPV3Update{Mint: &gorderbook.UPV3Mint{
+		TickLower: tickLower,
+		TickUpper: tickUpper,
+		Amount:    liquidity.Bytes(),
+	}})
+	fg0, fg1 := pool.FeeGrowthInside(tickLower, tickUpper)
+	return &Position{
+		TickLower:                tickLower,
+		TickUpper:                tickUpper,
+		Liquidity:                liquidity,
+		FeeGrowthInside0LastX128: fg0,
+		FeeGrowthInside1LastX128: fg1,
+		TokensOwed0:              big.NewInt(0),
+		TokensOwed1:              big.NewInt(0),
+	}
+}
+
+// swap swaps on the pool through the Swap event the listener would publish
+func swap(t *testing.T, pool *Pool, zeroForOne bool, amount *big.Int) *SwapResult {
+	res, err := pool.Swap(zeroForOne, false, amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	amount0, amount1 := res.AmountIn, res.AmountOut
+	if !zeroForOne {
+		amount0, amount1 = res.AmountOut, res.AmountIn
+	}
+	pool.Apply(&models.UPV3Update{Swap: &gorderbook.UPV3Swap{
+		SqrtPriceX96: res.SqrtPriceX96After.Bytes(),
+		Tick:         res.TickAfter,
+		Amount0:      amount0.Bytes(),
+		Amount1:      amount1.Bytes(),
+	}})
+	return res
+}
+
+func checkClose(t *testing.T, name string, a, b *big.Int, tolerance int64) {
+	if new(big.Int).Sub(a, b).CmpAbs(big.NewInt(tolerance)) > 0 {
+		t.Fatalf("%s: %s != %s", name, a, b)
+	}
+}
+
+func TestPositionFees(t *testing.T) {
+	liquidity := expandTo18Decimals(1000)
+	pool := NewPool(3000, encodePriceSqrt(1, 1), 0, big.NewInt(0))
+	wide := mint(pool, -600, 600, liquidity)
+	narrow := mint(pool, -60, 60, liquidity)
+
+	amount0, amount1 := pool.PositionAmounts(wide)
+	checkClose(t, "wide amounts", amount0, amount1, 1)
+	amount0, _ = pool.PositionAmounts(narrow)
+	if amount0.Cmp(new(big.Int).Quo(liquidity, big.NewInt(100))) > 0 {
+		t.Fatalf("was expecting the narrow position to hold less tokens")
+	}
+
+	// Within both ranges, the fees are shared equally
+	res := swap(t, pool, true, expandTo18Decimals(1))
+	wide0, wide1 := pool.PositionFees(wide)
+	narrow0, narrow1 := pool.PositionFees(narrow)
+	checkClose(t, "shared fees", wide0, narrow0, 1)
+	checkClose(t, "total fees", new(big.Int).Add(wide0, narrow0), res.FeeAmount, 2)
+	if wide1.Sign() != 0 || narrow1.Sign() != 0 {
+		t.Fatalf("was expecting no fees in token1")
+	}
+
+	// Below the narrow range, only the wide position earns fees
+	res = swap(t, pool, true, expandTo18Decimals(10))
+	if res.TicksCrossed != 1 || pool.GetTick() >= -60 {
+		t.Fatalf("was expecting to cross the narrow range")
+	}
+	narrowBefore, _ := pool.PositionFees(narrow)
+	wideBefore, _ := pool.PositionFees(wide)
+	res = swap(t, pool, true, expandTo18Decimals(1))
+	narrow0, _ = pool.PositionFees(narrow)
+	wide0, _ = pool.PositionFees(wide)
+	checkClose(t, "narrow out of range fees", narrow0, narrowBefore, 0)
+	checkClose(t, "wide fees", new(big.Int).Sub(wide0, wideBefore), res.FeeAmount, 1)
+	_, amount1 = pool.PositionAmounts(narrow)
+	amount0, _ = pool.PositionAmounts(narrow)
+	if amount1.Sign() != 0 || amount0.Sign() == 0 {
+		t.Fatalf("was expecting the narrow position to only hold token0")
+	}
+
+	// Back through the narrow range, fees are paid in token1
+	swap(t, pool, false, expandTo18Decimals(20))
+	if pool.GetTick() < 60 {
+		t.Fatalf("was expecting to cross the narrow range back")
+	}
+	_, wide1 = pool.PositionFees(wide)
+	_, narrow1 = pool.PositionFees(narrow)
+	if wide1.Cmp(narrow1) <= 0 || narrow1.Sign() == 0 {
+		t.Fatalf("wrong token1 fees: %s %s", wide1, narrow1)
+	}
+
+	// With a protocol fee, the positions earn the rest
+	pool.Apply(&models.UPV3Update{SetFeeProtocol: &gorderbook.UPV3SetFeeProtocol{FeesProtocol: 4 + 4<<8}})
+	wideBefore, _ = pool.PositionFees(wide)
+	res = swap(t, pool, true, expandTo18Decimals(1))
+	wide0, _ = pool.PositionFees(wide)
+	lpFee := new(big.Int).Sub(res.FeeAmount, new(big.Int).Quo(res.FeeAmount, big.NewInt(4)))
+	checkClose(t, "fees with protocol fee", new(big.Int).Sub(wide0, wideBefore), lpFee, 1)
+
+	// A position read later than the pool state has no negative fees
+	fg0, _ := pool.FeeGrowthInside(wide.TickLower, wide.TickUpper)
+	stale := *wide
+	stale.FeeGrowthInside0LastX128 = new(big.Int).Add(fg0, big.NewInt(1<<40))
+	if fees0, _ := pool.PositionFees(&stale); fees0.Cmp(wide.TokensOwed0) != 0 {
+		t.Fatalf("was expecting no fees for a stale pool state: %s", fees0)
+	}
+}
+
+func TestPoolAddress(t *testing.T) {
+	usdc := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	weth := common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	expected := common.HexToAddress("0x88e6A0c2dDD26FEEb64F039a2c41296FcB3f5640")
+	if address := PoolAddress(usdc, weth, 500); address != expected {
+		t.Fatalf("wrong pool address: %s", address)
+	}
+}
+
+func TestTouches(t *testing.T) {
+	l := &lp{
+		tokenID:  big.NewInt(1),
+		owner:    positionManagerAddress,
+		position: &Position{TickLower: -60, TickUpper: 60},
+	}
+	collect := &models.UPV3Update{Collect: &gorderbook.UPV3Collect{
+		Owner:     positionManagerAddress[:],
+		TickLower: -60,
+		TickUpper: 60,
+	}}
+	if !touches(collect, l) {
+		t.Fatalf("was expecting the collect of the position manager to touch the position")
+	}
+	// The same range collected by another owner
+	other := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	collect.Collect.Owner = other[:]
+	if touches(collect, l) {
+		t.Fatalf("was not expecting the collect of another owner to touch the position")
+	}
+}
@@ -0,0 +1,792 @@
+package v3
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	"gitlab.com/alphaticks/alpha-connect/models"
+)
+
+// Port of the uniswap v3 TickMath, SqrtPriceMath and SwapMath libraries,
+// used to simulate swaps against a pool without sending a transaction
+
+const (
+	MinTick = -887272
+	MaxTick = 887272
+)
+
+var (
+	minSqrtRatio, _ = new(big.Int).SetString("4295128739", 10)
+	maxSqrtRatio, _ = new(big.Int).SetString("1461446703485210103287273052203988822378723970342", 10)
+	q96             = new(big.Int).Lsh(big.NewInt(1), 96)
+	q128            = new(big.Int).Lsh(big.NewInt(1), 128)
+	maxUint256      = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	two256          = new(big.Int).Lsh(big.NewInt(1), 256)
+	feeDenominator  = big.NewInt(1000000)
+	tickRatios      = []*big.Int{
+		hexInt("fff97272373d413259a46990580e213a"),
+		hexInt("fff2e50f5f656932ef12357cf3c7fdcc"),
+		hexInt("ffe5caca7e10e4e61c3624eaa0941cd0"),
+		hexInt("ffcb9843d60f6159c9db58835c926644"),
+		hexInt("ff973b41fa98c081472e6896dfb254c0"),
+		hexInt("ff2ea16466c96a3843ec78b326b52861"),
+		hexInt("fe5dee046a99a2a811c461f1969c3053"),
+		hexInt("fcbe86c7900a88aedcffc83b479aa3a4"),
+		hexInt("f987a7253ac413176f2b074cf7815e54"),
+		hexInt("f3392b0822b70005940c7a398e4b70f3"),
+		hexInt("e7159475a2c29b7443b29c7fa6e889d9"),
+		hexInt("d097f3bdfd2022b8845ad8f792aa5825"),
+		hexInt("a9f746462d870fdf8a65dc1f90e061e5"),
+		hexInt("70d869a156d2a1b890bb3df62baf32f7"),
+		hexInt("31be135f97d08fd981231505542fcfa6"),
+		hexInt("9aa508b5b7a84e1c677de54f3e99bc9"),
+		hexInt("5d6af8dedb81196699c329225ee604"),
+		hexInt("2216e584f5fa1ea926041bedfe98"),
+		hexInt("48a170391f7dc42444e8fa2"),
+	}
+	tickRatio0 = hexInt("fffcb933bd6fad37aa2d162d1a594001")
+)
+
+func hexInt(s string) *big.Int {
+	i, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		panic("invalid hex constant " + s)
+	}
+	return i
+}
+
+// GetSqrtRatioAtTick returns sqrt(1.0001^tick) * 2^96
+func GetSqrtRatioAtTick(tick int32) *big.Int {
+	absTick := int64(tick)
+	if absTick < 0 {
+		absTick = -absTick
+	}
+	if absTick > MaxTick {
+		panic(fmt.Sprintf("tick %d out of range", tick))
+	}
+	var ratio *big.Int
+	if absTick&1 != 0 {
+		ratio = new(big.Int).Set(tickRatio0)
+	} else {
+		ratio = new(big.Int).Set(q128)
+	}
+	for i, r := range tickRatios {
+		if absTick&(2<<i) != 0 {
+			ratio.Mul(ratio, r)
+			ratio.Rsh(ratio, 128)
+		}
+	}
+	if tick > 0 {
+		ratio.Div(maxUint256, ratio)
+	}
+	// Round up to go from a Q128.128 to a Q128.96
+	rem := new(big.Int).And(ratio, big.NewInt(0xffffffff))
+	ratio.Rsh(ratio, 32)
+	if rem.Sign() != 0 {
+		ratio.Add(ratio, big.NewInt(1))
+	}
+	return ratio
+}
+
+// GetTickAtSqrtRatio returns the greatest tick whose sqrt ratio is lower or equal to sqrtPriceX96
+func GetTickAtSqrtRatio(sqrtPriceX96 *big.Int) int32 {
+	if sqrtPriceX96.Cmp(minSqrtRatio) < 0 || sqrtPriceX96.Cmp(maxSqrtRatio) >= 0 {
+		panic(fmt.Sprintf("sqrt price %s out of range", sqrtPriceX96))
+	}
+	// Estimate in floating point, then settle on the exact tick
+	f, _ := new(big.Float).Quo(new(big.Float).SetInt(sqrtPriceX96), new(big.Float).SetInt(q96)).Float64()
+	tick := int32(math.Floor(2 * math.Log(f) / math.Log(1.0001)))
+	if tick < MinTick {
+		tick = MinTick
+	} else if tick > MaxTick-1 {
+		tick = MaxTick - 1
+	}
+	for tick > MinTick && GetSqrtRatioAtTick(tick).Cmp(sqrtPriceX96) > 0 {
+		tick -= 1
+	}
+	for tick < MaxTick-1 && GetSqrtRatioAtTick(tick+1).Cmp(sqrtPriceX96) <= 0 {
+		tick += 1
+	}
+	return tick
+}
+
+func mulDiv(a, b, denominator *big.Int) *big.Int {
+	r := new(big.Int).Mul(a, b)
+	return r.Quo(r, denominator)
+}
+
+func mulDivRoundingUp(a, b, denominator *big.Int) *big.Int {
+	r := new(big.Int).Mul(a, b)
+	return divRoundingUp(r, denominator)
+}
+
+func divRoundingUp(a, denominator *big.Int) *big.Int {
+	q, m := new(big.Int).QuoRem(a, denominator, new(big.Int))
+	if m.Sign() != 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
+
+// getAmount0Delta returns the amount of token0 between two prices for the given liquidity
+func getAmount0Delta(sqrtA, sqrtB, liquidity *big.Int, roundUp bool) *big.Int {
+	if sqrtA.Cmp(sqrtB) > 0 {
+		sqrtA, sqrtB = sqrtB, sqrtA
+	}
+	numerator1 := new(big.Int).Lsh(liquidity, 96)
+	numerator2 := new(big.Int).Sub(sqrtB, sqrtA)
+	if roundUp {
+		return divRoundingUp(mulDivRoundingUp(numerator1, numerator2, sqrtB), sqrtA)
+	}
+	r := mulDiv(numerator1, numerator2, sqrtB)
+	return r.Quo(r, sqrtA)
+}
+
+// getAmount1Delta returns the amount of token1 between two prices for the given liquidity
+func getAmount1Delta(sqrtA, sqrtB, liquidity *big.Int, roundUp bool) *big.Int {
+	if sqrtA.Cmp(sqrtB) > 0 {
+		sqrtA, sqrtB = sqrtB, sqrtA
+	}
+	diff := new(big.Int).Sub(sqrtB, sqrtA)
+	if roundUp {
+		return mulDivRoundingUp(liquidity, diff, q96)
+	}
+	return mulDiv(liquidity, diff, q96)
+}
+
+func getNextSqrtPriceFromAmount0RoundingUp(sqrtPX96, liquidity, amount *big.Int, add bool) (*big.Int, error) {
+	if amount.Sign() == 0 {
+		return sqrtPX96, nil
+	}
+	numerator1 := new(big.Int).Lsh(liquidity, 96)
+	product := new(big.Int).Mul(amount, sqrtPX96)
+	if add {
+		denominator := new(big.Int).Add(numerator1, product)
+		return mulDivRoundingUp(numerator1, sqrtPX96, denominator), nil
+	}
+	if numerator1.Cmp(product) <= 0 {
+		return nil, fmt.Errorf("not enough liquidity")
+	}
+	denominator := new(big.Int).Sub(numerator1, product)
+	return mulDivRoundingUp(numerator1, sqrtPX96, denominator), nil
+}
+
+func getNextSqrtPriceFromAmount1RoundingDown(sqrtPX96, liquidity, amount *big.Int, add bool) (*big.Int, error) {
+	if add {
+		quotient := mulDiv(amount, q96, liquidity)
+		return quotient.Add(quotient, sqrtPX96), nil
+	}
+	quotient := mulDivRoundingUp(amount, q96, liquidity)
+	if sqrtPX96.Cmp(quotient) <= 0 {
+		return nil, fmt.Errorf("not enough liquidity")
+	}
+	return quotient.Sub(sqrtPX96, quotient), nil
+}
+
+func getNextSqrtPriceFromInput(sqrtPX96, liquidity, amountIn *big.Int, zeroForOne bool) (*big.Int, error) {
+	if zeroForOne {
+		return getNextSqrtPriceFromAmount0RoundingUp(sqrtPX96, liquidity, amountIn, true)
+	}
+	return getNextSqrtPriceFromAmount1RoundingDown(sqrtPX96, liquidity, amountIn, true)
+}
+
+func getNextSqrtPriceFromOutput(sqrtPX96, liquidity, amountOut *big.Int, zeroForOne bool) (*big.Int, error) {
+	if zeroForOne {
+		return getNextSqrtPriceFromAmount1RoundingDown(sqrtPX96, liquidity, amountOut, false)
+	}
+	return getNextSqrtPriceFromAmount0RoundingUp(sqrtPX96, liquidity, amountOut, false)
+}
+
+// computeSwapStep swaps within a single liquidity range, towards sqrtTarget.
+// amountRemaining is positive for an exact input and negative for an exact output
+func computeSwapStep(sqrtCurrent, sqrtTarget, liquidity, amountRemaining *big.Int, feePips int64) (sqrtNext, amountIn, amountOut, feeAmount *big.Int, err error) {
+	zeroForOne := sqrtCurrent.Cmp(sqrtTarget) >= 0
+	exactIn := amountRemaining.Sign() >= 0
+	fee := big.NewInt(feePips)
+	oneMinusFee := new(big.Int).Sub(feeDenominator, fee)
+
+	if exactIn {
+		amountRemainingLessFee := mulDiv(amountRemaining, oneMinusFee, feeDenominator)
+		if zeroForOne {
+			amountIn = getAmount0Delta(sqrtTarget, sqrtCurrent, liquidity, true)
+		} else {
+			amountIn = getAmount1Delta(sqrtCurrent, sqrtTarget, liquidity, true)
+		}
+		if amountRemainingLessFee.Cmp(amountIn) >= 0 {
+			sqrtNext = sqrtTarget
+		} else {
+			sqrtNext, err = getNextSqrtPriceFromInput(sqrtCurrent, liquidity, amountRemainingLessFee, zeroForOne)
+			if err != nil {
+				return
+			}
+		}
+	} else {
+		if zeroForOne {
+			amountOut = getAmount1Delta(sqrtTarget, sqrtCurrent, liquidity, false)
+		} else {
+			amountOut = getAmount0Delta(sqrtCurrent, sqrtTarget, liquidity, false)
+		}
+		remaining := new(big.Int).Neg(amountRemaining)
+		if remaining.Cmp(amountOut) >= 0 {
+			sqrtNext = sqrtTarget
+		} else {
+			sqrtNext, err = getNextSqrtPriceFromOutput(sqrtCurrent, liquidity, remaining, zeroForOne)
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	max := sqrtTarget.Cmp(sqrtNext) == 0
+	if zeroForOne {
+		if !(max && exactIn) {
+			amountIn = getAmount0Delta(sqrtNext, sqrtCurrent, liquidity, true)
+		}
+		if !(max && !exactIn) {
+			amountOut = getAmount1Delta(sqrtNext, sqrtCurrent, liquidity, false)
+		}
+	} else {
+		if !(max && exactIn) {
+			amountIn = getAmount1Delta(sqrtCurrent, sqrtNext, liquidity, true)
+		}
+		if !(max && !exactIn) {
+			amountOut = getAmount0Delta(sqrtCurrent, sqrtNext, liquidity, false)
+		}
+	}
+
+	// Cap the output amount to not exceed the remaining output amount
+	if !exactIn && amountOut.Cmp(new(big.Int).Neg(amountRemaining)) > 0 {
+		amountOut = new(big.Int).Neg(amountRemaining)
+	}
+
+	if exactIn && sqrtNext.Cmp(sqrtTarget) != 0 {
+		// We didn't reach the target, so take the remainder of the maximum input as fee
+		feeAmount = new(big.Int).Sub(amountRemaining, amountIn)
+	} else {
+		feeAmount = mulDivRoundingUp(amountIn, fee, oneMinusFee)
+	}
+	return
+}
+
+// mod256 reduces x modulo 2^256, as the uint256 fee growth counters of the pool wrap
+func mod256(x *big.Int) *big.Int {
+	return x.Mod(x, two256)
+}
+
+type tickInfo struct {
+	liquidityGross        *big.Int
+	liquidityNet          *big.Int
+	feeGrowthOutside0X128 *big.Int
+	feeGrowthOutside1X128 *big.Int
+}
+
+func (t *tickInfo) clone() *tickInfo {
+	return &tickInfo{
+		liquidityGross:        new(big.Int).Set(t.liquidityGross),
+		liquidityNet:          new(big.Int).Set(t.liquidityNet),
+		feeGrowthOutside0X128: new(big.Int).Set(t.feeGrowthOutside0X128),
+		feeGrowthOutside1X128: new(big.Int).Set(t.feeGrowthOutside1X128),
+	}
+}
+
+// Pool is the part of a uniswap v3 pool state needed to simulate swaps and to
+// account for the fees of positions: the current price and liquidity, the fee
+// growth and the initialized ticks
+type Pool struct {
+	sqrtPriceX96         *big.Int
+	tick                 int32
+	liquidity            *big.Int
+	fee                  int64
+	feeProtocol0         uint32
+	feeProtocol1         uint32
+	feeGrowthGlobal0X128 *big.Int
+	feeGrowthGlobal1X128 *big.Int
+	ticks                map[int32]*tickInfo
+	sortedTicks          []int32
+}
+
+func NewPool(fee int32, sqrtPriceX96 *big.Int, tick int32, liquidity *big.Int) *Pool {
+	return &Pool{
+		sqrtPriceX96:         new(big.Int).Set(sqrtPriceX96),
+		tick:                 tick,
+		liquidity:            new(big.Int).Set(liquidity),
+		fee:                  int64(fee),
+		feeGrowthGlobal0X128: big.NewInt(0),
+		feeGrowthGlobal1X128: big.NewInt(0),
+		ticks:                make(map[int32]*tickInfo),
+	}
+}
+
+// SetTick sets an initialized tick, as found in a pool snapshot
+func (p *Pool) SetTick(tick int32, liquidityNet, liquidityGross, feeGrowthOutside0X128, feeGrowthOutside1X128 *big.Int) {
+	if liquidityGross.Sign() == 0 {
+		delete(p.ticks, tick)
+	} else {
+		p.ticks[tick] = &tickInfo{
+			liquidityGross:        new(big.Int).Set(liquidityGross),
+			liquidityNet:          new(big.Int).Set(liquidityNet),
+			feeGrowthOutside0X128: new(big.Int).Set(feeGrowthOutside0X128),
+			feeGrowthOutside1X128: new(big.Int).Set(feeGrowthOutside1X128),
+		}
+	}
+	p.sortedTicks = nil
+}
+
+func (p *Pool) SetFeeGrowthGlobal(feeGrowthGlobal0X128, feeGrowthGlobal1X128 *big.Int) {
+	p.feeGrowthGlobal0X128 = new(big.Int).Set(feeGrowthGlobal0X128)
+	p.feeGrowthGlobal1X128 = new(big.Int).Set(feeGrowthGlobal1X128)
+}
+
+// SetFeeProtocol sets the denominators of the share of the swap fees going to the protocol, 0 for none
+func (p *Pool) SetFeeProtocol(feeProtocol0, feeProtocol1 uint32) {
+	p.feeProtocol0 = feeProtocol0
+	p.feeProtocol1 = feeProtocol1
+}
+
+func (p *Pool) GetSqrtPrice() *big.Int {
+	return new(big.Int).Set(p.sqrtPriceX96)
+}
+
+func (p *Pool) GetLiquidity() *big.Int {
+	return new(big.Int).Set(p.liquidity)
+}
+
+func (p *Pool) GetTick() int32 {
+	return p.tick
+}
+
+func (p *Pool) Clone() *Pool {
+	c := NewPool(int32(p.fee), p.sqrtPriceX96, p.tick, p.liquidity)
+	c.SetFeeGrowthGlobal(p.feeGrowthGlobal0X128, p.feeGrowthGlobal1X128)
+	c.SetFeeProtocol(p.feeProtocol0, p.feeProtocol1)
+	for t, info := range p.ticks {
+		c.ticks[t] = info.clone()
+	}
+	return c
+}
+
+func (p *Pool) updateTick(tick int32, liquidityDelta *big.Int, upper bool) {
+	info, ok := p.ticks[tick]
+	if !ok {
+		info = &tickInfo{
+			liquidityGross:        big.NewInt(0),
+			liquidityNet:          big.NewInt(0),
+			feeGrowthOutside0X128: big.NewInt(0),
+			feeGrowthOutside1X128: big.NewInt(0),
+		}
+		// By convention, all the growth before a tick was initialized happened below it
+		if tick <= p.tick {
+			info.feeGrowthOutside0X128.Set(p.feeGrowthGlobal0X128)
+			info.feeGrowthOutside1X128.Set(p.feeGrowthGlobal1X128)
+		}
+		p.ticks[tick] = info
+		p.sortedTicks = nil
+	}
+	info.liquidityGross.Add(info.liquidityGross, liquidityDelta)
+	if upper {
+		info.liquidityNet.Sub(info.liquidityNet, liquidityDelta)
+	} else {
+		info.liquidityNet.Add(info.liquidityNet, liquidityDelta)
+	}
+	if info.liquidityGross.Sign() == 0 {
+		delete(p.ticks, tick)
+		p.sortedTicks = nil
+	}
+}
+
+func (p *Pool) modifyPosition(tickLower, tickUpper int32, liquidityDelta *big.Int) {
+	if liquidityDelta.Sign() == 0 {
+		return
+	}
+	p.updateTick(tickLower, liquidityDelta, false)
+	p.updateTick(tickUpper, liquidityDelta, true)
+	if tickLower <= p.tick && p.tick < tickUpper {
+		p.liquidity.Add(p.liquidity, liquidityDelta)
+	}
+}
+
+// Apply updates the pool state with an update published by the listener
+func (p *Pool) Apply(update *models.UPV3Update) {
+	if i := update.Initialize; i != nil {
+		p.sqrtPriceX96 = new(big.Int).SetBytes(i.SqrtPriceX96)
+		p.tick = i.Tick
+	}
+	if m := update.Mint; m != nil {
+		p.modifyPosition(m.TickLower, m.TickUpper, new(big.Int).SetBytes(m.Amount))
+	}
+	if b := update.Burn; b != nil {
+		p.modifyPosition(b.TickLower, b.TickUpper, new(big.Int).Neg(new(big.Int).SetBytes(b.Amount)))
+	}
+	if s := update.Swap; s != nil {
+		// Replay the swap to grow the fees and cross the ticks, then
+		// align on the final state of the event
+		sqrtPrice := new(big.Int).SetBytes(s.SqrtPriceX96)
+		if c := sqrtPrice.Cmp(p.sqrtPriceX96); c != 0 && sqrtPrice.Cmp(minSqrtRatio) >= 0 && sqrtPrice.Cmp(maxSqrtRatio) < 0 {
+			zeroForOne := c < 0
+			amountIn := new(big.Int).SetBytes(s.Amount1)
+			if zeroForOne {
+				amountIn = new(big.Int).SetBytes(s.Amount0)
+			}
+			if amountIn.Sign() > 0 {
+				// An exact input swap can't run out of liquidity
+				p.swap(zeroForOne, false, amountIn, sqrtPrice, true)
+			}
+		}
+		p.sqrtPriceX96 = sqrtPrice
+		p.tick = s.Tick
+		if s.Liquidity != nil {
+			p.liquidity = new(big.Int).SetBytes(s.Liquidity)
+		}
+	}
+	if f := update.Flash; f != nil {
+		p.feeGrowthGlobal0X128 = p.flashFeeGrowth(p.feeGrowthGlobal0X128, new(big.Int).SetBytes(f.Amount0), p.feeProtocol0)
+		p.feeGrowthGlobal1X128 = p.flashFeeGrowth(p.feeGrowthGlobal1X128, new(big.Int).SetBytes(f.Amount1), p.feeProtocol1)
+	}
+	if f := update.SetFeeProtocol; f != nil {
+		p.SetFeeProtocol(f.FeesProtocol&0xff, f.FeesProtocol>>8)
+	}
+}
+
+// flashFeeGrowth returns the fee growth after the fee of a flash loan of the amount.
+// The update only has the amounts borrowed, the fee paid is taken to be the fee
+// the pool requires on them, which is the least the borrower pays.
+func (p *Pool) flashFeeGrowth(feeGrowthGlobal, amount *big.Int, feeProtocol uint32) *big.Int {
+	if amount.Sign() == 0 || p.liquidity.Sign() == 0 {
+		return feeGrowthGlobal
+	}
+	lpFee := mulDivRoundingUp(amount, big.NewInt(p.fee), feeDenominator)
+	if feeProtocol > 0 {
+		lpFee.Sub(lpFee, new(big.Int).Quo(lpFee, big.NewInt(int64(feeProtocol))))
+	}
+	growth := new(big.Int).Add(feeGrowthGlobal, mulDiv(lpFee, q128, p.liquidity))
+	return mod256(growth)
+}
+
+// nextInitializedTick returns the next initialized tick in the swap direction,
+// or the tick range bound if there is none
+func (p *Pool) nextInitializedTick(tick int32, lte bool) (int32, bool) {
+	if p.sortedTicks == nil {
+		p.sortedTicks = make([]int32, 0, len(p.ticks))
+		for t := range p.ticks {
+			p.sortedTicks = append(p.sortedTicks, t)
+		}
+		sort.Slice(p.sortedTicks, func(i, j int) bool { return p.sortedTicks[i] < p.sortedTicks[j] })
+	}
+	if lte {
+		// Index of the first tick > tick
+		i := sort.Search(len(p.sortedTicks), func(i int) bool { return p.sortedTicks[i] > tick })
+		if i == 0 {
+			return MinTick, false
+		}
+		return p.sortedTicks[i-1], true
+	}
+	i := sort.Search(len(p.sortedTicks), func(i int) bool { return p.sortedTicks[i] > tick })
+	if i == len(p.sortedTicks) {
+		return MaxTick, false
+	}
+	return p.sortedTicks[i], true
+}
+
+type SwapResult struct {
+	AmountIn          *big.Int
+	AmountOut         *big.Int
+	FeeAmount         *big.Int
+	SqrtPriceX96After *big.Int
+	TickAfter         int32
+	TicksCrossed      uint32
+	// The price impact, fees included, relative to the pool price before the swap
+	PriceImpact float64
+}
+
+// Swap simulates a swap without modifying the pool. The amount is the exact
+// amount in, or the exact amount out if exactOutput is set. If the pool doesn't
+// have enough liquidity, the swap stops at the price bound, as it would on chain,
+// and the result amounts are the partially filled ones.
+func (p *Pool) Swap(zeroForOne, exactOutput bool, amount *big.Int) (*SwapResult, error) {
+	if amount.Sign() <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+	var sqrtPriceLimit *big.Int
+	if zeroForOne {
+		sqrtPriceLimit = new(big.Int).Add(minSqrtRatio, big.NewInt(1))
+	} else {
+		sqrtPriceLimit = new(big.Int).Sub(maxSqrtRatio, big.NewInt(1))
+	}
+	return p.swap(zeroForOne, exactOutput, amount, sqrtPriceLimit, false)
+}
+
+// swap runs the swap loop of the pool contract. When commit is set, the fee growth
+// and the crossed ticks of the pool are updated, as well as its price and liquidity
+func (p *Pool) swap(zeroForOne, exactOutput bool, amount, sqrtPriceLimit *big.Int, commit bool) (*SwapResult, error) {
+	feeProtocol := p.feeProtocol1
+	feeGrowthGlobal := p.feeGrowthGlobal1X128
+	if zeroForOne {
+		feeProtocol = p.feeProtocol0
+		feeGrowthGlobal = p.feeGrowthGlobal0X128
+	}
+	remaining := new(big.Int).Set(amount)
+	if exactOutput {
+		remaining.Neg(remaining)
+	}
+	amountIn := big.NewInt(0)
+	amountOut := big.NewInt(0)
+	feeAmount := big.NewInt(0)
+	sqrtPrice := new(big.Int).Set(p.sqrtPriceX96)
+	tick := p.tick
+	liquidity := new(big.Int).Set(p.liquidity)
+	var crossed uint32
+
+	for remaining.Sign() != 0 && sqrtPrice.Cmp(sqrtPriceLimit) != 0 {
+		sqrtStart := sqrtPrice
+		tickNext, initialized := p.nextInitializedTick(tick, zeroForOne)
+		if tickNext < MinTick {
+			tickNext = MinTick
+		} else if tickNext > MaxTick {
+			tickNext = MaxTick
+		}
+		sqrtNext := GetSqrtRatioAtTick(tickNext)
+		target := sqrtNext
+		if (zeroForOne && sqrtNext.Cmp(sqrtPriceLimit) < 0) || (!zeroForOne && sqrtNext.Cmp(sqrtPriceLimit) > 0) {
+			target = sqrtPriceLimit
+		}
+		var stepIn, stepOut, stepFee *big.Int
+		var err error
+		sqrtPrice, stepIn, stepOut, stepFee, err = computeSwapStep(sqrtPrice, target, liquidity, remaining, p.fee)
+		if err != nil {
+			return nil, fmt.Errorf("error computing swap step: %v", err)
+		}
+		if exactOutput {
+			remaining.Add(remaining, stepOut)
+		} else {
+			remaining.Sub(remaining, stepIn)
+			remaining.Sub(remaining, stepFee)
+		}
+		amountIn.Add(amountIn, stepIn)
+		amountIn.Add(amountIn, stepFee)
+		amountOut.Add(amountOut, stepOut)
+		feeAmount.Add(feeAmount, stepFee)
+
+		if commit {
+			lpFee := stepFee
+			if feeProtocol > 0 {
+				lpFee = new(big.Int).Sub(stepFee, new(big.Int).Quo(stepFee, big.NewInt(int64(feeProtocol))))
+			}
+			if liquidity.Sign() > 0 {
+				feeGrowthGlobal.Add(feeGrowthGlobal, mulDiv(lpFee, q128, liquidity))
+				mod256(feeGrowthGlobal)
+			}
+		}
+
+		if sqrtPrice.Cmp(sqrtNext) == 0 {
+			if initialized {
+				info := p.ticks[tickNext]
+				if commit {
+					info.feeGrowthOutside0X128 = mod256(new(big.Int).Sub(p.feeGrowthGlobal0X128, info.feeGrowthOutside0X128))
+					info.feeGrowthOutside1X128 = mod256(new(big.Int).Sub(p.feeGrowthGlobal1X128, info.feeGrowthOutside1X128))
+				}
+				net := info.liquidityNet
+				if zeroForOne {
+					liquidity.Sub(liquidity, net)
+				} else {
+					liquidity.Add(liquidity, net)
+				}
+				crossed += 1
+			}
+			if zeroForOne {
+				tick = tickNext - 1
+			} else {
+				tick = tickNext
+			}
+		} else if sqrtPrice.Cmp(sqrtStart) != 0 {
+			tick = GetTickAtSqrtRatio(sqrtPrice)
+		}
+	}
+	if commit {
+		p.sqrtPriceX96 = new(big.Int).Set(sqrtPrice)
+		p.tick = tick
+		p.liquidity = liquidity
+	}
+
+	return &SwapResult{
+		AmountIn:          amountIn,
+		AmountOut:         amountOut,
+		FeeAmount:         feeAmount,
+		SqrtPriceX96After: sqrtPrice,
+		TickAfter:         tick,
+		TicksCrossed:      crossed,
+		PriceImpact:       priceImpact(spotPrice(p.sqrtPriceX96, zeroForOne), amountIn, amountOut),
+	}, nil
+}
+
+// spotPrice returns the price of the input token in output token
+func spotPrice(sqrtPriceX96 *big.Int, zeroForOne bool) *big.Float {
+	sqrtPrice := new(big.Float).Quo(new(big.Float).SetInt(sqrtPriceX96), new(big.Float).SetInt(q96))
+	price := new(big.Float).Mul(sqrtPrice, sqrtPrice)
+	if !zeroForOne {
+		price.Quo(big.NewFloat(1), price)
+	}
+	return price
+}
+
+func priceImpact(spot *big.Float, amountIn, amountOut *big.Int) float64 {
+	if amountIn.Sign() == 0 || spot.Sign() == 0 {
+		return 0
+	}
+	execution := new(big.Float).Quo(new(big.Float).SetInt(amountOut), new(big.Float).SetInt(amountIn))
+	ratio, _ := execution.Quo(execution, spot).Float64()
+	return 1 - ratio
+}
+
+type RouteLeg struct {
+	Pool int
+	*SwapResult
+}
+
+type RouteResult struct {
+	AmountIn     *big.Int
+	AmountOut    *big.Int
+	TicksCrossed uint32
+	// The price impact, fees included, relative to the best pool price before the swap
+	PriceImpact float64
+	Legs        []RouteLeg
+}
+
+const (
+	DefaultRouteParts = 10
+	// MaxRouteParts bounds the parts of a route, which costs pools * parts swaps
+	// and pools * parts^2 steps to split
+	MaxRouteParts = 100
+)
+
+// Route splits a swap across pools of the same pair, in parts of amount / parts,
+// the remainder going to the last leg, so as to maximize the amount out of an
+// exact input, or minimize the amount in of an exact output
+func Route(pools []*Pool, zeroForOne, exactOutput bool, amount *big.Int, parts int) (*RouteResult, error) {
+	if len(pools) == 0 {
+		return nil, fmt.Errorf("no pool to route on")
+	}
+	if parts > MaxRouteParts {
+		return nil, fmt.Errorf("more than %d route parts", MaxRouteParts)
+	}
+	if amount.Sign() <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+	if parts < 1 || len(pools) == 1 {
+		parts = 1
+	}
+	if amount.Cmp(big.NewInt(int64(parts))) < 0 {
+		parts = int(amount.Int64())
+	}
+	// The parts are of amount / parts, the remainder is given to the last leg
+	unit, remainder := new(big.Int).QuoRem(amount, big.NewInt(int64(parts)), new(big.Int))
+	// quotes[i][k] is the swap of k parts on pool i
+	quotes := make([][]*SwapResult, len(pools))
+	for i, p := range pools {
+		quotes[i] = make([]*SwapResult, parts+1)
+		for k := 1; k <= parts; k++ {
+			partAmount := new(big.Int).Mul(unit, big.NewInt(int64(k)))
+			res, err := p.Swap(zeroForOne, exactOutput, partAmount)
+			if err != nil {
+				return nil, err
+			}
+			// A pool which can't fill an exact output part is not an option
+			if exactOutput && res.AmountOut.Cmp(partAmount) < 0 {
+				continue
+			}
+			quotes[i][k] = res
+		}
+	}
+
+	value := func(r *SwapResult) *big.Int {
+		if exactOutput {
+			return new(big.Int).Neg(r.AmountIn)
+		}
+		return r.AmountOut
+	}
+
+	// best[i][k] is the best value of k parts over the first i+1 pools, choice[i][k]
+	// the parts given to pool i
+	best := make([][]*big.Int, len(pools))
+	choice := make([][]int, len(pools))
+	for i := range pools {
+		best[i] = make([]*big.Int, parts+1)
+		choice[i] = make([]int, parts+1)
+		for k := 0; k <= parts; k++ {
+			for j := 0; j <= k; j++ {
+				var v *big.Int
+				if j == 0 {
+					v = big.NewInt(0)
+				} else if quotes[i][j] == nil {
+					continue
+				} else {
+					v = value(quotes[i][j])
+				}
+				if i > 0 {
+					if best[i-1][k-j] == nil {
+						continue
+					}
+					v = new(big.Int).Add(v, best[i-1][k-j])
+				} else if j != k {
+					continue
+				}
+				if best[i][k] == nil || v.Cmp(best[i][k]) > 0 {
+					best[i][k] = v
+					choice[i][k] = j
+				}
+			}
+		}
+	}
+	if best[len(pools)-1][parts] == nil {
+		return nil, fmt.Errorf("not enough liquidity")
+	}
+
+	res := &RouteResult{
+		AmountIn:  big.NewInt(0),
+		AmountOut: big.NewInt(0),
+	}
+	var spot *big.Float
+	var legParts []int
+	for i, k := len(pools)-1, parts; i >= 0; i-- {
+		s := spotPrice(pools[i].sqrtPriceX96, zeroForOne)
+		if spot == nil || s.Cmp(spot) > 0 {
+			spot = s
+		}
+		j := choice[i][k]
+		k -= j
+		if j == 0 {
+			continue
+		}
+		res.Legs = append([]RouteLeg{{Pool: i, SwapResult: quotes[i][j]}}, res.Legs...)
+		legParts = append([]int{j}, legParts...)
+	}
+	if remainder.Sign() > 0 {
+		// Give the remainder to the last leg which can fill it
+		filled := false
+		for l := len(res.Legs) - 1; l >= 0 && !filled; l-- {
+			legAmount := new(big.Int).Mul(unit, big.NewInt(int64(legParts[l])))
+			legAmount.Add(legAmount, remainder)
+			q, err := pools[res.Legs[l].Pool].Swap(zeroForOne, exactOutput, legAmount)
+			if err != nil {
+				return nil, err
+			}
+			if exactOutput && q.AmountOut.Cmp(legAmount) < 0 {
+				continue
+			}
+			res.Legs[l].SwapResult = q
+			filled = true
+		}
+		if !filled {
+			return nil, fmt.Errorf("not enough liquidity")
+		}
+	}
+	for _, l := range res.Legs {
+		res.AmountIn.Add(res.AmountIn, l.AmountIn)
+		res.AmountOut.Add(res.AmountOut, l.AmountOut)
+		res.TicksCrossed += l.TicksCrossed
+	}
+	res.PriceImpact = priceImpact(spot, res.AmountIn, res.AmountOut)
+
+	return res, nil
+}
@@ -0,0 +1,260 @@
+package v3
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"gitlab.com/alphaticks/alpha-connect/models"
+	gorderbook "gitlab.com/alphaticks/gorderbook/gorderbook.models"
+)
+
+func encodePriceSqrt(reserve1, reserve0 int64) *big.Int {
+	r := new(big.Int).Lsh(big.NewInt(reserve1), 192)
+	r.Quo(r, big.NewInt(reserve0))
+	return r.Sqrt(r)
+}
+
+func expandTo18Decimals(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+}
+
+func TestGetSqrtRatioAtTick(t *testing.T) {
+	if GetSqrtRatioAtTick(MinTick).Cmp(minSqrtRatio) != 0 {
+		t.Fatalf("wrong min sqrt ratio: %s", GetSqrtRatioAtTick(MinTick))
+	}
+	if GetSqrtRatioAtTick(MaxTick).Cmp(maxSqrtRatio) != 0 {
+		t.Fatalf("wrong max sqrt ratio: %s", GetSqrtRatioAtTick(MaxTick))
+	}
+	if GetSqrtRatioAtTick(0).Cmp(q96) != 0 {
+		t.Fatalf("wrong sqrt ratio at tick 0: %s", GetSqrtRatioAtTick(0))
+	}
+	for i := 0; i < 20; i++ {
+		for _, tick := range []int32{1 << i, -(1 << i), 1<<i + 7, -(1<<i + 7)} {
+			if tick > MaxTick || tick < MinTick {
+				continue
+			}
+			sqrt := GetSqrtRatioAtTick(tick)
+			f, _ := new(big.Float).Quo(new(big.Float).SetInt(sqrt), new(big.Float).SetInt(q96)).Float64()
+			expected := math.Pow(1.0001, float64(tick)/2)
+			if math.Abs(f/expected-1) > 1e-9 {
+				t.Fatalf("wrong sqrt ratio at tick %d: %g != %g", tick, f, expected)
+			}
+			if GetTickAtSqrtRatio(sqrt) != tick {
+				t.Fatalf("wrong tick at sqrt ratio of tick %d: %d", tick, GetTickAtSqrtRatio(sqrt))
+			}
+			if GetTickAtSqrtRatio(new(big.Int).Sub(sqrt, big.NewInt(1))) != tick-1 {
+				t.Fatalf("wrong tick below sqrt ratio of tick %d", tick)
+			}
+		}
+	}
+}
+
+func TestComputeSwapStep(t *testing.T) {
+	price := encodePriceSqrt(1, 1)
+	target := encodePriceSqrt(101, 100)
+	liquidity := expandTo18Decimals(2)
+
+	// Exact amount in that gets capped at price target in one for zero
+	next, in, out, fee, err := computeSwapStep(price, target, liquidity, expandTo18Decimals(1), 600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.Cmp(target) != 0 || in.String() != "9975124224178055" || out.String() != "9925619580021728" || fee.String() != "5988667735148" {
+		t.Fatalf("wrong exact in step: %s %s %s %s", next, in, out, fee)
+	}
+
+	// Exact amount out that gets capped at price target in one for zero
+	next, in, out, fee, err = computeSwapStep(price, target, liquidity, new(big.Int).Neg(expandTo18Decimals(1)), 600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.Cmp(target) != 0 || in.String() != "9975124224178055" || out.String() != "9925619580021728" || fee.String() != "5988667735148" {
+		t.Fatalf("wrong exact out step: %s %s %s %s", next, in, out, fee)
+	}
+}
+
+func TestPoolSwap(t *testing.T) {
+	liquidity := expandTo18Decimals(1000)
+	pool := NewPool(3000, encodePriceSqrt(1, 1), 0, big.NewInt(0))
+	pool.Apply(&models.UPV3Update{Mint: &gorderbook.UPV3Mint{
+		TickLower: -600,
+		TickUpper: 600,
+		Amount:    liquidity.Bytes(),
+	}})
+	if pool.GetLiquidity().Cmp(liquidity) != 0 {
+		t.Fatalf("wrong liquidity after mint: %s", pool.GetLiquidity())
+	}
+
+	// Within the range, the pool behaves like a constant product, with fees
+	amount := expandTo18Decimals(1)
+	res, err := pool.Swap(true, false, amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.AmountIn.Cmp(amount) != 0 || res.TicksCrossed != 0 {
+		t.Fatalf("wrong swap: %s %d", res.AmountIn, res.TicksCrossed)
+	}
+	fl := new(big.Float).SetInt(liquidity)
+	in := new(big.Float).Mul(new(big.Float).SetInt(amount), big.NewFloat(0.997))
+	expected, _ := new(big.Float).Sub(fl, new(big.Float).Quo(new(big.Float).Mul(fl, fl), new(big.Float).Add(fl, in))).Float64()
+	outF, _ := new(big.Float).SetInt(res.AmountOut).Float64()
+	if math.Abs(outF/expected-1) > 1e-12 {
+		t.Fatalf("wrong amount out: %g != %g", outF, expected)
+	}
+	if res.PriceImpact <= 0.0039 || res.PriceImpact > 0.0041 {
+		t.Fatalf("wrong price impact: %g", res.PriceImpact)
+	}
+	if res.TickAfter != GetTickAtSqrtRatio(res.SqrtPriceX96After) {
+		t.Fatalf("wrong tick after swap")
+	}
+	// The swap doesn't modify the pool
+	if pool.GetSqrtPrice().Cmp(encodePriceSqrt(1, 1)) != 0 {
+		t.Fatalf("pool was modified by the simulation")
+	}
+
+	// Add a narrower position, swapping through its lower bound crosses it
+	pool.Apply(&models.UPV3Update{Mint: &gorderbook.UPV3Mint{
+		TickLower: -60,
+		TickUpper: 60,
+		Amount:    liquidity.Bytes(),
+	}})
+	amount = expandTo18Decimals(10)
+	res, err = pool.Swap(true, false, amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.TicksCrossed != 1 || res.TickAfter >= -60 {
+		t.Fatalf("was expecting to cross tick -60: %d %d", res.TicksCrossed, res.TickAfter)
+	}
+	// The exact output of the amount out costs the same amount in, up to rounding
+	exact, err := pool.Swap(true, true, res.AmountOut)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exact.AmountOut.Cmp(res.AmountOut) != 0 || new(big.Int).Sub(exact.AmountIn, amount).CmpAbs(big.NewInt(10)) > 0 {
+		t.Fatalf("wrong exact output: %s %s", exact.AmountIn, exact.AmountOut)
+	}
+
+	// Not enough liquidity, the swap stops at the price bound
+	res, err = pool.Swap(false, false, expandTo18Decimals(1000000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.AmountIn.Cmp(expandTo18Decimals(1000000)) >= 0 || res.TicksCrossed != 2 {
+		t.Fatalf("was expecting a partial fill: %s %d", res.AmountIn, res.TicksCrossed)
+	}
+
+	// Burning the positions removes their ticks
+	pool.Apply(&models.UPV3Update{Burn: &gorderbook.UPV3Burn{
+		TickLower: -60,
+		TickUpper: 60,
+		Amount:    liquidity.Bytes(),
+	}})
+	if len(pool.ticks) != 2 || pool.GetLiquidity().Cmp(liquidity) != 0 {
+		t.Fatalf("wrong pool after burn")
+	}
+}
+
+func TestRoute(t *testing.T) {
+	liquidity := expandTo18Decimals(100)
+	var pools []*Pool
+	for _, fee := range []int32{500, 3000} {
+		pool := NewPool(fee, encodePriceSqrt(1, 1), 0, big.NewInt(0))
+		pool.Apply(&models.UPV3Update{Mint: &gorderbook.UPV3Mint{
+			TickLower: -6000,
+			TickUpper: 6000,
+			Amount:    liquidity.Bytes(),
+		}})
+		pools = append(pools, pool)
+	}
+
+	// A small swap goes to the cheapest pool
+	res, err := Route(pools, true, false, big.NewInt(1e16), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Legs) != 1 || res.Legs[0].Pool != 0 {
+		t.Fatalf("was expecting a single leg on the cheapest pool: %v", res.Legs)
+	}
+
+	// A large swap is split
+	amount := expandTo18Decimals(20)
+	res, err = Route(pools, true, false, amount, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Legs) != 2 || res.AmountIn.Cmp(amount) != 0 {
+		t.Fatalf("was expecting the swap to be split: %v", res.Legs)
+	}
+	single, err := pools[0].Swap(true, false, amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.AmountOut.Cmp(single.AmountOut) <= 0 || res.PriceImpact >= single.PriceImpact {
+		t.Fatalf("was expecting a better route than the single pool")
+	}
+
+	// The remainder of the parts is swapped
+	amount.Add(amount, big.NewInt(7))
+	res, err = Route(pools, true, false, amount, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.AmountIn.Cmp(amount) != 0 {
+		t.Fatalf("was expecting the whole amount to be swapped: %s %s", res.AmountIn, amount)
+	}
+
+	// Exact output
+	out := expandTo18Decimals(15)
+	res, err = Route(pools, true, true, out, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	single, err = pools[0].Swap(true, true, out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.AmountOut.Cmp(out) != 0 || res.AmountIn.Cmp(single.AmountIn) >= 0 {
+		t.Fatalf("was expecting a cheaper route than the single pool: %s %s", res.AmountIn, single.AmountIn)
+	}
+
+	out.Add(out, big.NewInt(3))
+	res, err = Route(pools, true, true, out, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.AmountOut.Cmp(out) != 0 {
+		t.Fatalf("was expecting the whole amount out: %s %s", res.AmountOut, out)
+	}
+
+	if _, err := Route(pools, true, false, amount, MaxRouteParts+1); err == nil {
+		t.Fatalf("was expecting the route parts to be bounded")
+	}
+}
+
+func TestPoolFlash(t *testing.T) {
+	liquidity := expandTo18Decimals(2)
+	pool := NewPool(3000, encodePriceSqrt(1, 1), 0, big.NewInt(0))
+	pool.Apply(&models.UPV3Update{Mint: &gorderbook.UPV3Mint{
+		TickLower: -60,
+		TickUpper: 60,
+		Amount:    liquidity.Bytes(),
+	}})
+	pool.SetFeeProtocol(0, 4)
+	pool.Apply(&models.UPV3Update{Flash: &gorderbook.UPV3Flash{
+		Amount0: expandTo18Decimals(1).Bytes(),
+		Amount1: expandTo18Decimals(1).Bytes(),
+	}})
+	// 0.3% of 1e18 over the liquidity, a quarter of the token1 fee going to the protocol
+	fee := big.NewInt(3e15)
+	growth0 := new(big.Int).Div(new(big.Int).Mul(fee, q128), liquidity)
+	if pool.feeGrowthGlobal0X128.Cmp(growth0) != 0 {
+		t.Fatalf("wrong token0 fee growth: %s %s", pool.feeGrowthGlobal0X128, growth0)
+	}
+	lpFee := new(big.Int).Sub(fee, new(big.Int).Div(fee, big.NewInt(4)))
+	growth1 := new(big.Int).Div(new(big.Int).Mul(lpFee, q128), liquidity)
+	if pool.feeGrowthGlobal1X128.Cmp(growth1) != 0 {
+		t.Fatalf("wrong token1 fee growth: %s %s", pool.feeGrowthGlobal1X128, growth1)
+	}
+}
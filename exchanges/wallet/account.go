@@ -0,0 +1,532 @@
+package wallet
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/log"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"gitlab.com/alphaticks/alpha-connect/account"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+	"gitlab.com/alphaticks/xchanger/constants"
+	xmodels "gitlab.com/alphaticks/xchanger/models"
+	tokenevm "gitlab.com/alphaticks/xchanger/protocols/erc20/evm"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// The wallet account listener tracks the native and ERC-20 balances of an on-chain
+// wallet. The balances are read from the chain with balanceOf, and read again each time
+// the transfer stream of the erc20 protocol listener shows a transfer from or to the wallet.
+// The native balance has no event stream, it is polled.
+
+const nativeDecimals = 18
+
+type checkBalances struct{}
+type checkAccount struct{}
+
+type token struct {
+	asset    *models.ProtocolAsset
+	contract common.Address
+	seqNum   uint64
+	block    uint64 // Block of the last balance read
+}
+
+type AccountListener struct {
+	account             *account.Account
+	nativeSymbol        string
+	tokenSymbols        []string
+	executor            *actor.PID
+	address             common.Address
+	native              *xmodels.Asset
+	tokens              map[uint64]*token // By subscription request ID
+	eabi                *abi.ABI
+	seqNum              uint64
+	logger              *log.Logger
+	checkBalancesTicker *time.Ticker
+	checkAccountTicker  *time.Ticker
+}
+
+func NewAccountListenerProducer(account *account.Account, nativeAsset string, tokens []string) actor.Producer {
+	return func() actor.Actor {
+		return NewAccountListener(account, nativeAsset, tokens)
+	}
+}
+
+func NewAccountListener(account *account.Account, nativeAsset string, tokens []string) actor.Actor {
+	return &AccountListener{
+		account:      account,
+		nativeSymbol: nativeAsset,
+		tokenSymbols: tokens,
+		logger:       nil,
+	}
+}
+
+func (state *AccountListener) Receive(context actor.Context) {
+	switch context.Message().(type) {
+	case *actor.Started:
+		if err := state.Initialize(context); err != nil {
+			state.logger.Error("error initializing", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor started")
+
+	case *actor.Stopping:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error stopping", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor stopping")
+
+	case *actor.Stopped:
+		state.logger.Info("actor stopped")
+
+	case *actor.Restarting:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error restarting", log.Error(err))
+			// Attention, no panic in restarting or infinite loop
+		}
+		state.logger.Info("actor restarting")
+
+	case *messages.AccountDataRequest:
+		if err := state.OnAccountDataRequest(context); err != nil {
+			state.logger.Error("error processing OnAccountDataRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.PositionsRequest:
+		if err := state.OnPositionsRequest(context); err != nil {
+			state.logger.Error("error processing OnPositionListRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.BalancesRequest:
+		if err := state.OnBalancesRequest(context); err != nil {
+			state.logger.Error("error processing OnBalancesRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.OrderStatusRequest:
+		if err := state.OnOrderStatusRequest(context); err != nil {
+			state.logger.Error("error processing OnOrderStatusRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.AccountInformationRequest:
+		if err := state.OnAccountInformationRequest(context); err != nil {
+			state.logger.Error("error processing OnAccountInformationRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.NewOrderSingleRequest,
+		*messages.NewOrderBulkRequest,
+		*messages.OrderReplaceRequest,
+		*messages.OrderBulkReplaceRequest,
+		*messages.OrderCancelRequest,
+		*messages.OrderMassCancelRequest,
+		*messages.AccountMovementRequest,
+		*messages.TradeCaptureReportRequest:
+		if err := state.OnUnsupportedRequest(context); err != nil {
+			state.logger.Error("error processing OnUnsupportedRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.ProtocolAssetDataIncrementalRefresh:
+		// A balance not read is read on the next account check
+		if err := state.OnProtocolAssetDataIncrementalRefresh(context); err != nil {
+			state.logger.Warn("error processing OnProtocolAssetDataIncrementalRefresh", log.Error(err))
+		}
+
+	case *checkBalances:
+		// The RPC errors are transient, the balances are checked again on the next tick
+		if err := state.checkBalances(context); err != nil {
+			state.logger.Warn("error checking balances", log.Error(err))
+		}
+
+	case *checkAccount:
+		if err := state.checkAccount(context); err != nil {
+			state.logger.Warn("error checking account", log.Error(err))
+		}
+	}
+}
+
+func (state *AccountListener) Initialize(context actor.Context) error {
+	state.logger = log.New(
+		log.InfoLevel,
+		"",
+		log.String("ID", context.Self().Id),
+		log.String("type", reflect.TypeOf(*state).String()))
+	state.executor = actor.NewPID(context.ActorSystem().Address(), "executor")
+
+	if state.account.Chain == nil {
+		return fmt.Errorf("account has no chain")
+	}
+	if !common.IsHexAddress(state.account.Address) {
+		return fmt.Errorf("invalid wallet address %s", state.account.Address)
+	}
+	state.address = common.HexToAddress(state.account.Address)
+	eabi, err := tokenevm.ERC20MetaData.GetAbi()
+	if err != nil {
+		return fmt.Errorf("error getting erc20 abi: %v", err)
+	}
+	state.eabi = eabi
+
+	if state.nativeSymbol != "" {
+		native, ok := constants.GetAssetBySymbol(state.nativeSymbol)
+		if !ok {
+			return fmt.Errorf("unknown native asset %s", state.nativeSymbol)
+		}
+		state.native = native
+	}
+
+	// Find the protocol assets of the tokens
+	res, err := context.RequestFuture(state.executor, &messages.ProtocolAssetListRequest{}, 10*time.Second).Result()
+	if err != nil {
+		return fmt.Errorf("error getting protocol assets: %v", err)
+	}
+	assetList, ok := res.(*messages.ProtocolAssetList)
+	if !ok {
+		return fmt.Errorf("was expecting *messages.ProtocolAssetList, got %s", reflect.TypeOf(res).String())
+	}
+	if !assetList.Success {
+		return fmt.Errorf("error getting protocol assets: %s", assetList.RejectionReason.String())
+	}
+	var tokens []*token
+	for _, symbol := range state.tokenSymbols {
+		var tokenAsset *models.ProtocolAsset
+		for _, pa := range assetList.ProtocolAssets {
+			if pa.Protocol.ID == constants.ERC20.ID && pa.Chain.ID == state.account.Chain.ID && pa.Asset != nil && pa.Asset.Symbol == symbol {
+				tokenAsset = pa
+				break
+			}
+		}
+		if tokenAsset == nil || tokenAsset.ContractAddress == nil || tokenAsset.Decimals == nil {
+			return fmt.Errorf("unknown ERC-20 token %s on chain %s", symbol, state.account.Chain.Name)
+		}
+		tokens = append(tokens, &token{
+			asset:    tokenAsset,
+			contract: common.HexToAddress(tokenAsset.ContractAddress.Value),
+		})
+	}
+
+	// Read the balances and sync the account
+	head, err := utils.EVMBlockNumber(context, state.executor, state.account.Chain)
+	if err != nil {
+		return err
+	}
+	var balances []*models.Balance
+	if state.native != nil {
+		balance, err := state.nativeBalance(context, head)
+		if err != nil {
+			return err
+		}
+		balances = append(balances, &models.Balance{
+			Account:  state.account.Name,
+			Asset:    state.native,
+			Quantity: balance,
+		})
+	}
+	for _, t := range tokens {
+		balance, err := state.tokenBalance(context, t, head)
+		if err != nil {
+			return err
+		}
+		t.block = head
+		balances = append(balances, &models.Balance{
+			Account:  state.account.Name,
+			Asset:    t.asset.Asset,
+			Quantity: balance,
+		})
+	}
+	if err := state.account.Sync(nil, nil, nil, balances, nil, nil); err != nil {
+		return fmt.Errorf("error syncing account: %v", err)
+	}
+	state.seqNum = 0
+
+	// Then follow the transfers of the tokens
+	state.tokens = make(map[uint64]*token)
+	for _, t := range tokens {
+		requestID := uint64(time.Now().UnixNano())
+		res, err := context.RequestFuture(state.executor, &messages.ProtocolAssetDataRequest{
+			RequestID:  requestID,
+			Subscribe:  true,
+			Subscriber: context.Self(),
+			AssetID:    &wrapperspb.UInt32Value{Value: t.asset.Asset.ID},
+			ProtocolID: t.asset.Protocol.ID,
+			ChainID:    t.asset.Chain.ID,
+		}, 20*time.Second).Result()
+		if err != nil {
+			return fmt.Errorf("error subscribing to %s transfers: %v", t.asset.Asset.Symbol, err)
+		}
+		dataRes, ok := res.(*messages.ProtocolAssetDataResponse)
+		if !ok {
+			return fmt.Errorf("was expecting *messages.ProtocolAssetDataResponse, got %s", reflect.TypeOf(res).String())
+		}
+		if !dataRes.Success {
+			return fmt.Errorf("error subscribing to %s transfers: %s", t.asset.Asset.Symbol, dataRes.RejectionReason.String())
+		}
+		t.seqNum = dataRes.SeqNum
+		state.tokens[requestID] = t
+	}
+
+	checkBalancesTicker := time.NewTicker(15 * time.Second)
+	state.checkBalancesTicker = checkBalancesTicker
+	go func(pid *actor.PID) {
+		for {
+			select {
+			case <-checkBalancesTicker.C:
+				context.Send(pid, &checkBalances{})
+			case <-time.After(30 * time.Second):
+				if state.checkBalancesTicker != checkBalancesTicker {
+					return
+				}
+			}
+		}
+	}(context.Self())
+
+	checkAccountTicker := time.NewTicker(5 * time.Minute)
+	state.checkAccountTicker = checkAccountTicker
+	go func(pid *actor.PID) {
+		for {
+			select {
+			case <-checkAccountTicker.C:
+				context.Send(pid, &checkAccount{})
+			case <-time.After(6 * time.Minute):
+				if state.checkAccountTicker != checkAccountTicker {
+					return
+				}
+			}
+		}
+	}(context.Self())
+
+	return nil
+}
+
+func (state *AccountListener) Clean(context actor.Context) error {
+	if state.checkBalancesTicker != nil {
+		state.checkBalancesTicker.Stop()
+		state.checkBalancesTicker = nil
+	}
+	if state.checkAccountTicker != nil {
+		state.checkAccountTicker.Stop()
+		state.checkAccountTicker = nil
+	}
+	return nil
+}
+
+func (state *AccountListener) OnAccountDataRequest(context actor.Context) error {
+	msg := context.Message().(*messages.AccountDataRequest)
+	context.Respond(&messages.AccountDataResponse{
+		RequestID:  msg.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		Success:    true,
+		Positions:  state.account.GetPositions(),
+		Balances:   state.account.GetBalances(),
+		SeqNum:     state.seqNum,
+	})
+	return nil
+}
+
+func (state *AccountListener) OnPositionsRequest(context actor.Context) error {
+	msg := context.Message().(*messages.PositionsRequest)
+	// The liquidity positions, followed by the positions listener
+	var positions []*models.Position
+	for _, p := range state.account.GetPositions() {
+		if msg.Instrument == nil || msg.Instrument.SecurityID == nil || (p.Instrument != nil && p.Instrument.SecurityID.Value == msg.Instrument.SecurityID.Value) {
+			positions = append(positions, p)
+		}
+	}
+	context.Respond(&messages.PositionList{
+		RequestID:  msg.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		Success:    true,
+		Positions:  positions,
+	})
+	return nil
+}
+
+func (state *AccountListener) OnBalancesRequest(context actor.Context) error {
+	msg := context.Message().(*messages.BalancesRequest)
+	var balances []*models.Balance
+	for _, b := range state.account.GetBalances() {
+		if msg.Asset == nil || msg.Asset.ID == b.Asset.ID {
+			balances = append(balances, b)
+		}
+	}
+	context.Respond(&messages.BalanceList{
+		RequestID:  msg.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		Success:    true,
+		Balances:   balances,
+	})
+	return nil
+}
+
+func (state *AccountListener) OnOrderStatusRequest(context actor.Context) error {
+	msg := context.Message().(*messages.OrderStatusRequest)
+	context.Respond(&messages.OrderList{
+		RequestID: msg.RequestID,
+		Success:   true,
+	})
+	return nil
+}
+
+func (state *AccountListener) OnAccountInformationRequest(context actor.Context) error {
+	msg := context.Message().(*messages.AccountInformationRequest)
+	context.Respond(&messages.AccountInformationResponse{
+		RequestID:  msg.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		Success:    true,
+	})
+	return nil
+}
+
+// OnUnsupportedRequest rejects the trading requests, a wallet has no orders
+func (state *AccountListener) OnUnsupportedRequest(context actor.Context) error {
+	rej := messages.RejectionReason_UnsupportedRequest
+	switch msg := context.Message().(type) {
+	case *messages.NewOrderSingleRequest:
+		context.Respond(&messages.NewOrderSingleResponse{RequestID: msg.RequestID, RejectionReason: rej})
+	case *messages.NewOrderBulkRequest:
+		context.Respond(&messages.NewOrderBulkResponse{RequestID: msg.RequestID, RejectionReason: rej})
+	case *messages.OrderReplaceRequest:
+		context.Respond(&messages.OrderReplaceResponse{RequestID: msg.RequestID, RejectionReason: rej})
+	case *messages.OrderBulkReplaceRequest:
+		context.Respond(&messages.OrderBulkReplaceResponse{RequestID: msg.RequestID, RejectionReason: rej})
+	case *messages.OrderCancelRequest:
+		context.Respond(&messages.OrderCancelResponse{RequestID: msg.RequestID, RejectionReason: rej})
+	case *messages.OrderMassCancelRequest:
+		context.Respond(&messages.OrderMassCancelResponse{RequestID: msg.RequestID, RejectionReason: rej})
+	case *messages.AccountMovementRequest:
+		context.Respond(&messages.AccountMovementResponse{RequestID: msg.RequestID, RejectionReason: rej})
+	case *messages.TradeCaptureReportRequest:
+		context.Respond(&messages.TradeCaptureReport{RequestID: msg.RequestID, RejectionReason: rej})
+	}
+	return nil
+}
+
+func (state *AccountListener) OnProtocolAssetDataIncrementalRefresh(context actor.Context) error {
+	refresh := context.Message().(*messages.ProtocolAssetDataIncrementalRefresh)
+	t, ok := state.tokens[refresh.RequestID]
+	if !ok || refresh.SeqNum <= t.seqNum {
+		return nil
+	}
+	if refresh.SeqNum != t.seqNum+1 {
+		// Transfers were missed, read the balance at the head
+		state.logger.Info(fmt.Sprintf("gap in %s transfers, reading balance", t.asset.Asset.Symbol))
+		t.seqNum = refresh.SeqNum
+		return state.refreshToken(context, t, 0)
+	}
+	t.seqNum = refresh.SeqNum
+	if refresh.Update == nil || !state.isInvolved(refresh.Update) {
+		return nil
+	}
+	if refresh.Update.Removed {
+		// The block was reverted, read the balance at the head
+		return state.refreshToken(context, t, 0)
+	}
+	return state.refreshToken(context, t, refresh.Update.BlockNumber)
+}
+
+func (state *AccountListener) isInvolved(update *models.ProtocolAssetUpdate) bool {
+	for _, tr := range update.Transfers {
+		if bytes.Equal(tr.From, state.address[:]) || bytes.Equal(tr.To, state.address[:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// refreshToken reads the balance of the token at the given block, or at the head if 0.
+// Balances older than the last one read are discarded.
+func (state *AccountListener) refreshToken(context actor.Context, t *token, block uint64) error {
+	if block == 0 {
+		head, err := utils.EVMBlockNumber(context, state.executor, state.account.Chain)
+		if err != nil {
+			return err
+		}
+		block = head
+	}
+	if block < t.block {
+		return nil
+	}
+	balance, err := state.tokenBalance(context, t, block)
+	if err != nil {
+		return err
+	}
+	t.block = block
+	if _, err := state.account.UpdateBalance(t.asset.Asset, balance, messages.AccountMovementType_Unknown); err != nil {
+		return fmt.Errorf("error updating account balance: %v", err)
+	}
+	return nil
+}
+
+func (state *AccountListener) checkBalances(context actor.Context) error {
+	if state.native == nil {
+		return nil
+	}
+	head, err := utils.EVMBlockNumber(context, state.executor, state.account.Chain)
+	if err != nil {
+		return err
+	}
+	balance, err := state.nativeBalance(context, head)
+	if err != nil {
+		return err
+	}
+	if _, err := state.account.UpdateBalance(state.native, balance, messages.AccountMovementType_Unknown); err != nil {
+		return fmt.Errorf("error updating account balance: %v", err)
+	}
+	return nil
+}
+
+// checkAccount reads all the token balances, to recover from a stalled transfer stream
+func (state *AccountListener) checkAccount(context actor.Context) error {
+	head, err := utils.EVMBlockNumber(context, state.executor, state.account.Chain)
+	if err != nil {
+		return err
+	}
+	for _, t := range state.tokens {
+		if err := state.refreshToken(context, t, head); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (state *AccountListener) nativeBalance(context actor.Context, block uint64) (float64, error) {
+	res, err := context.RequestFuture(state.executor, &messages.EVMBalanceRequest{
+		RequestID:   uint64(time.Now().UnixNano()),
+		Chain:       state.account.Chain,
+		Address:     state.address,
+		BlockNumber: block,
+	}, 15*time.Second).Result()
+	if err != nil {
+		return 0, fmt.Errorf("error fetching native balance: %v", err)
+	}
+	b, ok := res.(*messages.EVMBalanceResponse)
+	if !ok {
+		return 0, fmt.Errorf("was expecting *messages.EVMBalanceResponse, got %s", reflect.TypeOf(res).String())
+	}
+	if !b.Success {
+		return 0, fmt.Errorf("error fetching native balance: %s", b.RejectionReason.String())
+	}
+	return utils.ToQuantity(b.Balance, nativeDecimals), nil
+}
+
+func (state *AccountListener) tokenBalance(context actor.Context, t *token, block uint64) (float64, error) {
+	out, err := utils.EVMCall(context, state.executor, state.account.Chain, state.eabi, t.contract, block, "balanceOf", state.address)
+	if err != nil {
+		return 0, fmt.Errorf("error fetching %s balance: %v", t.asset.Asset.Symbol, err)
+	}
+	balance, ok := out[0].(*big.Int)
+	if !ok {
+		return 0, fmt.Errorf("was expecting *big.Int balance, got %s", reflect.TypeOf(out[0]).String())
+	}
+	return utils.ToQuantity(balance, t.asset.Decimals.Value), nil
+}
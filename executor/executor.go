@@ -2,6 +2,8 @@ package executor
 
 import (
 	"fmt"
+	"gitlab.com/alphaticks/alpha-connect/backfill"
+	"gitlab.com/alphaticks/alpha-connect/bars"
 	"gitlab.com/alphaticks/alpha-connect/chains"
 	"gitlab.com/alphaticks/alpha-connect/config"
 	"gitlab.com/alphaticks/alpha-connect/utils"
@@ -13,6 +15,7 @@ import (
 	"github.com/asynkron/protoactor-go/actor"
 	"github.com/asynkron/protoactor-go/log"
 	"gitlab.com/alphaticks/alpha-connect/exchanges"
+	v3 "gitlab.com/alphaticks/alpha-connect/exchanges/uniswap/v3"
 	"gitlab.com/alphaticks/alpha-connect/models/commands"
 	"gitlab.com/alphaticks/alpha-connect/models/messages"
 	"gitlab.com/alphaticks/alpha-connect/protocols"
@@ -23,6 +26,8 @@ type Executor struct {
 	exchanges *actor.PID
 	protocols *actor.PID
 	chains    *actor.PID
+	bars      *actor.PID
+	backfill  *actor.PID
 	logger    *log.Logger
 }
 
@@ -65,11 +70,13 @@ func (state *Executor) Receive(context actor.Context) {
 	case *messages.AccountDataRequest,
 		*messages.MarketDataRequest,
 		*messages.UnipoolV3DataRequest,
+		*messages.UnipoolV3QuoteRequest,
 		*messages.MarketStatisticsRequest,
 		*messages.HistoricalUnipoolV3DataRequest,
 		*messages.HistoricalFundingRatesRequest,
 		*messages.HistoricalLiquidationsRequest,
 		*messages.HistoricalSalesRequest,
+		*messages.NFTMarketDataRequest,
 		*messages.SecurityDefinitionRequest,
 		*messages.SecurityListRequest,
 		*messages.SecurityList,
@@ -88,7 +95,8 @@ func (state *Executor) Receive(context actor.Context) {
 		*messages.OrderBulkReplaceRequest,
 		*messages.OrderCancelRequest,
 		*messages.OrderMassCancelRequest,
-		*commands.GetAccountRequest:
+		*commands.GetAccountRequest,
+		*v3.PoolRequest:
 		if err := state.OnExchangesMessage(context); err != nil {
 			state.logger.Error("error processing OnExchangesMessage", log.Error(err))
 			panic(err)
@@ -97,7 +105,8 @@ func (state *Executor) Receive(context actor.Context) {
 		*messages.ProtocolAssetList,
 		*messages.HistoricalProtocolAssetTransferRequest,
 		*messages.ProtocolAssetDataRequest,
-		*messages.ProtocolAssetDefinitionRequest:
+		*messages.ProtocolAssetDefinitionRequest,
+		*messages.ContractEventDataRequest:
 		if err := state.OnProtocolsMessage(context); err != nil {
 			state.logger.Error("error processing OnProtocolsMessage", log.Error(err))
 			panic(err)
@@ -106,6 +115,11 @@ func (state *Executor) Receive(context actor.Context) {
 	case *messages.EVMLogsQueryRequest,
 		*messages.EVMLogsSubscribeRequest,
 		*messages.EVMContractCallRequest,
+		*messages.EVMBalanceRequest,
+		*messages.EVMTransactionRequest,
+		*messages.EVMTransactionReplaceRequest,
+		*messages.EVMPendingTxSubscribeRequest,
+		*messages.EVMGasSubscribeRequest,
 		*messages.SVMBlockQueryRequest,
 		*messages.SVMEventsQueryRequest,
 		*messages.SVMContractCallRequest,
@@ -118,6 +132,19 @@ func (state *Executor) Receive(context actor.Context) {
 			panic(err)
 		}
 
+	case *messages.BarDataRequest:
+		if err := state.OnBarsMessage(context); err != nil {
+			state.logger.Error("error processing OnBarsMessage", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.BackfillRequest,
+		*messages.BackfillStatusRequest:
+		if err := state.OnBackfillMessage(context); err != nil {
+			state.logger.Error("error processing OnBackfillMessage", log.Error(err))
+			panic(err)
+		}
+
 	case *utils.Ready:
 		context.Respond(&utils.Ready{})
 	}
@@ -173,6 +200,26 @@ func (state *Executor) Initialize(context actor.Context) error {
 		return fmt.Errorf("error spawning chains executor: %v", err)
 	}
 	state.chains = chEx
+
+	baProducer := bars.NewExecutorProducer(state.cfg)
+	baProps := actor.PropsFromProducer(baProducer, actor.WithSupervisor(
+		actor.NewExponentialBackoffStrategy(100*time.Second, time.Second),
+	))
+	baEx, err := context.SpawnNamed(baProps, "bars")
+	if err != nil {
+		return fmt.Errorf("error spawning bars executor: %v", err)
+	}
+	state.bars = baEx
+
+	bfProducer := backfill.NewExecutorProducer(state.cfg)
+	bfProps := actor.PropsFromProducer(bfProducer, actor.WithSupervisor(
+		actor.NewExponentialBackoffStrategy(100*time.Second, time.Second),
+	))
+	bfEx, err := context.SpawnNamed(bfProps, "backfill")
+	if err != nil {
+		return fmt.Errorf("error spawning backfill executor: %v", err)
+	}
+	state.backfill = bfEx
 	return nil
 }
 
@@ -200,6 +247,22 @@ func (state *Executor) OnChainsMessage(context actor.Context) error {
 	return nil
 }
 
+func (state *Executor) OnBarsMessage(context actor.Context) error {
+	if state.bars == nil {
+		return fmt.Errorf("missing bars executor")
+	}
+	context.Forward(state.bars)
+	return nil
+}
+
+func (state *Executor) OnBackfillMessage(context actor.Context) error {
+	if state.backfill == nil {
+		return fmt.Errorf("missing backfill executor")
+	}
+	context.Forward(state.backfill)
+	return nil
+}
+
 func (state *Executor) Clean(context actor.Context) error {
 	return nil
 }
@@ -0,0 +1,300 @@
+package modeling
+
+import (
+	"fmt"
+	"math"
+	"sync"
+)
+
+const (
+	// maxSweeps bounds the coordinate descent sweeps of an allocation solve
+	maxSweeps = 500
+	// leverageIterations is the number of bisections on the leverage multiplier
+	leverageIterations = 60
+)
+
+// SampleMarket gives the prices and sample prices the allocations are estimated from
+type SampleMarket interface {
+	GetPrice(ID uint64) (float64, bool)
+	GetSamplePrices(ID uint64, time uint64, sampleSize int) []float64
+}
+
+// MeanVarianceAllocationModel allocates the margin between the securities, maximizing the
+// expected return of the portfolio minus lambda/2 times its variance, both estimated
+// from the sample prices of the market at the horizon.
+// The allocations are values in margin currency, signed, keyed by security ID in the maps.
+// The allocation of a security is bounded by its limit, if any, the gross allocation by
+// gamma times the margin if gamma is positive, and the turnover cost is the cost rate
+// times the value traded.
+var _ AllocationModel = (*MeanVarianceAllocationModel)(nil)
+
+type MeanVarianceAllocationModel struct {
+	sync.RWMutex
+	market     SampleMarket
+	IDs        []uint64
+	horizon    uint64
+	sampleSize int
+	time       uint64
+	mean       []float64
+	cov        [][]float64
+	bounds     []float64 // Maximum absolute weight of each security
+	targets    map[uint64]float64
+	solved     bool
+}
+
+func NewMeanVarianceAllocationModel(market SampleMarket, IDs []uint64, horizon uint64, sampleSize int) *MeanVarianceAllocationModel {
+	return &MeanVarianceAllocationModel{
+		market:     market,
+		IDs:        IDs,
+		horizon:    horizon,
+		sampleSize: sampleSize,
+		targets:    make(map[uint64]float64),
+	}
+}
+
+func (m *MeanVarianceAllocationModel) Forward(_ int, tick uint64, _ interface{}) error {
+	m.Progress(tick)
+	return nil
+}
+
+func (m *MeanVarianceAllocationModel) Progress(time uint64) {
+	m.Lock()
+	defer m.Unlock()
+	m.time = time
+}
+
+func (m *MeanVarianceAllocationModel) Backward(_ uint64, _ interface{}) {
+
+}
+
+func (m *MeanVarianceAllocationModel) Ready() bool {
+	return m.Solved()
+}
+
+func (m *MeanVarianceAllocationModel) Frequency() uint64 {
+	return 0
+}
+
+func (m *MeanVarianceAllocationModel) GetSelectors() []string {
+	return nil
+}
+
+// Solve estimates the returns at the horizon from the time of the model, set by Forward or Progress,
+// and solves the target allocations within the limits, without turnover cost, the limits being
+// values in margin currency.
+func (m *MeanVarianceAllocationModel) Solve(allocs, limits *sync.Map, margin, lambda, gamma float64) error {
+	if margin <= 0 {
+		return fmt.Errorf("non-positive margin %f", margin)
+	}
+	m.Lock()
+	defer m.Unlock()
+	n := len(m.IDs)
+	returns := make([][]float64, n)
+	for i, ID := range m.IDs {
+		p, ok := m.market.GetPrice(ID)
+		if !ok || p <= 0 {
+			return fmt.Errorf("no price for %d", ID)
+		}
+		samples := m.market.GetSamplePrices(ID, m.time+m.horizon, m.sampleSize)
+		if len(samples) != m.sampleSize {
+			return fmt.Errorf("was expecting %d samples for %d, got %d", m.sampleSize, ID, len(samples))
+		}
+		returns[i] = make([]float64, m.sampleSize)
+		for k, s := range samples {
+			returns[i][k] = s/p - 1
+		}
+	}
+	m.mean = make([]float64, n)
+	for i := range returns {
+		for _, r := range returns[i] {
+			m.mean[i] += r / float64(m.sampleSize)
+		}
+	}
+	m.cov = make([][]float64, n)
+	for i := range returns {
+		m.cov[i] = make([]float64, n)
+	}
+	for i := range returns {
+		for j := i; j < n; j++ {
+			var c float64
+			for k := range returns[i] {
+				c += (returns[i][k] - m.mean[i]) * (returns[j][k] - m.mean[j])
+			}
+			c /= float64(m.sampleSize)
+			m.cov[i][j] = c
+			m.cov[j][i] = c
+		}
+	}
+	m.bounds = make([]float64, n)
+	for i, ID := range m.IDs {
+		m.bounds[i] = math.Inf(1)
+		if limits == nil {
+			continue
+		}
+		if l, ok := limits.Load(ID); ok {
+			m.bounds[i] = math.Abs(l.(float64)) / margin
+		}
+	}
+
+	weights := m.solve(m.weights(allocs, margin), 0, lambda, gamma)
+	m.targets = make(map[uint64]float64)
+	for i, ID := range m.IDs {
+		m.targets[ID] = weights[i] * margin
+	}
+	m.solved = true
+	return nil
+}
+
+func (m *MeanVarianceAllocationModel) Solved() bool {
+	m.RLock()
+	defer m.RUnlock()
+	return m.solved
+}
+
+// GetTargets returns the target allocations of the last solve, without turnover cost
+func (m *MeanVarianceAllocationModel) GetTargets() map[uint64]float64 {
+	m.RLock()
+	defer m.RUnlock()
+	targets := make(map[uint64]float64)
+	for k, v := range m.targets {
+		targets[k] = v
+	}
+	return targets
+}
+
+// GetAllocations returns the target allocations given the current ones, trading
+// only when the gain covers the turnover cost. It returns nil until solved.
+func (m *MeanVarianceAllocationModel) GetAllocations(allocations *sync.Map, margin, cost, lambda, gamma float64) map[uint64]float64 {
+	m.RLock()
+	defer m.RUnlock()
+	if !m.solved || margin <= 0 {
+		return nil
+	}
+	weights := m.solve(m.weights(allocations, margin), cost, lambda, gamma)
+	targets := make(map[uint64]float64)
+	for i, ID := range m.IDs {
+		targets[ID] = weights[i] * margin
+	}
+	return targets
+}
+
+// GetAllocationDelta returns the value to trade to reach the target allocation of the security
+func (m *MeanVarianceAllocationModel) GetAllocationDelta(ID uint64, allocations *sync.Map, margin, cost, lambda, gamma float64) (float64, bool) {
+	targets := m.GetAllocations(allocations, margin, cost, lambda, gamma)
+	target, ok := targets[ID]
+	if !ok {
+		return 0, false
+	}
+	var current float64
+	if allocations != nil {
+		if v, ok := allocations.Load(ID); ok {
+			current = v.(float64)
+		}
+	}
+	return target - current, true
+}
+
+// weights returns the current weights of the securities
+func (m *MeanVarianceAllocationModel) weights(allocations *sync.Map, margin float64) []float64 {
+	weights := make([]float64, len(m.IDs))
+	if allocations == nil {
+		return weights
+	}
+	for i, ID := range m.IDs {
+		if v, ok := allocations.Load(ID); ok {
+			weights[i] = v.(float64) / margin
+		}
+	}
+	return weights
+}
+
+// solve returns the weights maximizing the objective with the turnover cost from the
+// current weights. The gross leverage constraint is dualized, its multiplier being
+// found by bisection, each problem being solved by coordinate descent.
+func (m *MeanVarianceAllocationModel) solve(current []float64, cost, lambda, gamma float64) []float64 {
+	weights := m.descent(current, cost, lambda, 0)
+	if gamma <= 0 || leverage(weights) <= gamma {
+		return weights
+	}
+	lo, hi := 0., 1.
+	for i := 0; i < leverageIterations && leverage(m.descent(current, cost, lambda, hi)) > gamma; i++ {
+		lo = hi
+		hi *= 2
+	}
+	for i := 0; i < leverageIterations; i++ {
+		mid := (lo + hi) / 2
+		if leverage(m.descent(current, cost, lambda, mid)) > gamma {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return m.descent(current, cost, lambda, hi)
+}
+
+// descent minimizes lambda/2 w'Cw - mean'w + cost|w - current| + nu|w| within the bounds
+func (m *MeanVarianceAllocationModel) descent(current []float64, cost, lambda, nu float64) []float64 {
+	n := len(m.IDs)
+	weights := make([]float64, n)
+	copy(weights, current)
+	for i := range weights {
+		weights[i] = math.Max(math.Min(weights[i], m.bounds[i]), -m.bounds[i])
+	}
+	for sweep := 0; sweep < maxSweeps; sweep++ {
+		var change float64
+		for i := 0; i < n; i++ {
+			a := lambda * m.cov[i][i]
+			b := m.mean[i]
+			for j := 0; j < n; j++ {
+				if j != i {
+					b -= lambda * m.cov[i][j] * weights[j]
+				}
+			}
+			w := minimizeCoordinate(a, b, cost, nu, current[i], m.bounds[i])
+			change = math.Max(change, math.Abs(w-weights[i]))
+			weights[i] = w
+		}
+		if change < 1e-12 {
+			break
+		}
+	}
+	return weights
+}
+
+// minimizeCoordinate minimizes a/2 w^2 - b w + cost|w - w0| + nu|w| for |w| <= bound.
+// The function is convex and piecewise quadratic, the minimum is at a kink,
+// a bound or the stationary point of a piece.
+func minimizeCoordinate(a, b, cost, nu, w0, bound float64) float64 {
+	f := func(w float64) float64 {
+		return a/2*w*w - b*w + cost*math.Abs(w-w0) + nu*math.Abs(w)
+	}
+	clip := func(w float64) float64 {
+		return math.Max(math.Min(w, bound), -bound)
+	}
+	candidates := []float64{0, clip(w0)}
+	if !math.IsInf(bound, 1) {
+		candidates = append(candidates, bound, -bound)
+	}
+	if a > 0 {
+		for _, s1 := range []float64{-1, 1} {
+			for _, s2 := range []float64{-1, 1} {
+				candidates = append(candidates, clip((b-cost*s1-nu*s2)/a))
+			}
+		}
+	}
+	best := candidates[0]
+	for _, w := range candidates[1:] {
+		if f(w) < f(best) {
+			best = w
+		}
+	}
+	return best
+}
+
+func leverage(weights []float64) float64 {
+	var l float64
+	for _, w := range weights {
+		l += math.Abs(w)
+	}
+	return l
+}
@@ -0,0 +1,126 @@
+package modeling
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"gitlab.com/alphaticks/tickstore-types/tickobjects"
+)
+
+// fixedSamples returns the same samples at any time
+type fixedSamples struct {
+	prices  map[uint64]float64
+	samples map[uint64][]float64
+}
+
+func (m *fixedSamples) GetPrice(ID uint64) (float64, bool) {
+	p, ok := m.prices[ID]
+	return p, ok
+}
+
+func (m *fixedSamples) GetSamplePrices(ID uint64, _ uint64, _ int) []float64 {
+	return m.samples[ID]
+}
+
+func TestMeanVarianceAllocationModel(t *testing.T) {
+	// Uncorrelated returns of mean 1% and 0.5%, of volatility 2% and 1%
+	market := &fixedSamples{
+		prices: map[uint64]float64{1: 100, 2: 10},
+		samples: map[uint64][]float64{
+			1: {103, 99, 103, 99},
+			2: {10.15, 10.15, 9.95, 9.95},
+		},
+	}
+	model := NewMeanVarianceAllocationModel(market, []uint64{1, 2}, 60000, 4)
+	margin, lambda := 100., 10.
+	allocs := &sync.Map{}
+	limits := &sync.Map{}
+	check := func(targets map[uint64]float64, w1, w2 float64) {
+		t.Helper()
+		if math.Abs(targets[1]-w1*margin) > 1e-6 || math.Abs(targets[2]-w2*margin) > 1e-6 {
+			t.Fatalf("was expecting %f %f, got %v", w1*margin, w2*margin, targets)
+		}
+	}
+
+	// Unconstrained, w = mean / (lambda * variance)
+	if err := model.Solve(allocs, limits, margin, lambda, 0); err != nil {
+		t.Fatal(err)
+	}
+	if !model.Solved() {
+		t.Fatalf("was expecting a solved model")
+	}
+	check(model.GetTargets(), 2.5, 5)
+
+	limits.Store(uint64(2), 100.)
+	if err := model.Solve(allocs, limits, margin, lambda, 0); err != nil {
+		t.Fatal(err)
+	}
+	check(model.GetTargets(), 2.5, 1)
+
+	// Gross leverage of 2, the multiplier equalizes the marginal gains
+	limits.Delete(uint64(2))
+	if err := model.Solve(allocs, limits, margin, lambda, 2); err != nil {
+		t.Fatal(err)
+	}
+	check(model.GetTargets(), 1.4, 0.6)
+
+	// From flat, the turnover cost lowers the returns
+	check(model.GetAllocations(allocs, margin, 0.001, lambda, 0), 2.25, 4)
+	delta, ok := model.GetAllocationDelta(2, allocs, margin, 0.001, lambda, 0)
+	if !ok || math.Abs(delta-400) > 1e-6 {
+		t.Fatalf("unexpected delta %f", delta)
+	}
+	// Close to the targets, not worth trading
+	allocs.Store(uint64(1), 240.)
+	allocs.Store(uint64(2), 450.)
+	check(model.GetAllocations(allocs, margin, 0.001, lambda, 0), 2.4, 4.5)
+}
+
+func TestMeanVarianceAllocationModelGARCH(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	prices := NewGARCHPriceModel("", 1000, 3000)
+	omega, alpha, beta := 1e-6, 0.1, 0.85
+	price, s2 := 100., omega/(1-alpha-beta)
+	var now uint64
+	for i := 0; i < 4000; i++ {
+		now = uint64(i) * 1000
+		if err := prices.Forward(0, now, tickobjects.NewTrade(price, 1)); err != nil {
+			t.Fatal(err)
+		}
+		r := math.Sqrt(s2) * rng.NormFloat64()
+		price *= math.Exp(r)
+		s2 = omega + alpha*r*r + beta*s2
+	}
+	if !prices.Ready() {
+		t.Fatalf("price model not ready")
+	}
+	market := NewMarketMap()
+	market.SetPriceModel(prices)
+
+	// The returns are sampled over the 60 steps of the horizon from now, not from the epoch
+	model := NewMeanVarianceAllocationModel(market, []uint64{1}, 60000, 1000)
+	alloc := NewMarketAllocationModel(market, model)
+	if err := alloc.Forward(0, now, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := alloc.Solve(nil, nil, 100, 10, 0); err != nil {
+		t.Fatal(err)
+	}
+	if !alloc.Ready() {
+		t.Fatalf("was expecting the allocation model to be ready")
+	}
+	variance := 60 * omega / (1 - alpha - beta)
+	if model.cov[0][0] < variance/2 || model.cov[0][0] > variance*2 {
+		t.Fatalf("was expecting a variance around %f, got %f", variance, model.cov[0][0])
+	}
+	target := model.GetTargets()[1]
+	if target == 0 || math.IsNaN(target) || math.IsInf(target, 0) {
+		t.Fatalf("unexpected target %f", target)
+	}
+	delta, ok := model.GetAllocationDelta(1, nil, 100, 0, 10, 0)
+	if !ok || math.Abs(delta-target) > 1e-6 {
+		t.Fatalf("was expecting a delta of %f, got %f", target, delta)
+	}
+}
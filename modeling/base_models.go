@@ -1,6 +1,7 @@
 package modeling
 
 import (
+	"gitlab.com/alphaticks/alpha-connect/models"
 	"gitlab.com/alphaticks/tickstore-types/tickobjects"
 	"math"
 	"math/rand"
@@ -20,6 +21,21 @@ type Model interface {
 	GetSelectors() []string
 }
 
+// WarmUpModel is a model needing history before being ready,
+// WarmUp returns the window in ms of the selector to replay.
+type WarmUpModel interface {
+	Model
+	WarmUp(selector int) uint64
+}
+
+// Snapshotter is a model able to serialize its state, the Modeler
+// checkpoints it and restores it on restart.
+type Snapshotter interface {
+	Model
+	Snapshot() ([]byte, error)
+	Restore(snapshot []byte) error
+}
+
 type MarketModel interface {
 	Market
 	Model
@@ -114,6 +130,16 @@ func (m *MarketMap) GetPrice(ID uint64) (float64, bool) {
 	return p, ok
 }
 
+// GetSamplePrices returns the sample prices of the price model, nil without price model
+func (m *MarketMap) GetSamplePrices(ID uint64, time uint64, sampleSize int) []float64 {
+	m.RLock()
+	defer m.RUnlock()
+	if m.priceModel == nil {
+		return nil
+	}
+	return m.priceModel.GetSamplePrices(ID, time, sampleSize)
+}
+
 func (m *MarketMap) GetPairPrice(base, quote uint32) (float64, bool) {
 	m.RLock()
 	defer m.RUnlock()
@@ -125,6 +151,52 @@ func (m *MarketMap) GetPairPrice(base, quote uint32) (float64, bool) {
 	return p, ok
 }
 
+// GasMap holds the gas prices in gwei of the chains, from their gas stats
+type GasMap struct {
+	sync.RWMutex
+	baseFees     map[uint32]float64
+	priorityFees map[uint32]float64
+}
+
+func NewGasMap() *GasMap {
+	return &GasMap{
+		baseFees:     make(map[uint32]float64),
+		priorityFees: make(map[uint32]float64),
+	}
+}
+
+// SetStat updates the gas price of the chain of a gas stat, other stats are ignored
+func (m *GasMap) SetStat(stat *models.Stat) {
+	m.Lock()
+	defer m.Unlock()
+	switch stat.StatType {
+	case models.StatType_BaseFee:
+		m.baseFees[stat.ChainID] = stat.Value
+	case models.StatType_PriorityFee:
+		m.priorityFees[stat.ChainID] = stat.Value
+	}
+}
+
+// GetGasPrice returns the base fee plus the priority fee of the chain, in gwei
+func (m *GasMap) GetGasPrice(chainID uint32) (float64, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	base, ok := m.baseFees[chainID]
+	if !ok {
+		return 0, false
+	}
+	return base + m.priorityFees[chainID], true
+}
+
+// GetTxCost returns the cost in the native asset of the chain of a transaction using gas
+func (m *GasMap) GetTxCost(chainID uint32, gas uint64) (float64, bool) {
+	p, ok := m.GetGasPrice(chainID)
+	if !ok {
+		return 0, false
+	}
+	return float64(gas) * p / 1e9, true
+}
+
 type MarketAllocationModel struct {
 	sync.RWMutex
 	AllocationModel
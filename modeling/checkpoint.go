@@ -0,0 +1,200 @@
+package modeling
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"time"
+
+	"github.com/melaurent/gotickfile/v2"
+	types "gitlab.com/alphaticks/tickstore-types"
+	"gitlab.com/alphaticks/tickstore-types/tickobjects"
+)
+
+// The checkpoints of the models are kept in files, or in the tickstore as the snapshots of
+// checkpoint objects, the last one written being the checkpoint of the model.
+// The store must know the same type.
+
+const CheckpointsMeasurement = "checkpoints"
+
+func init() {
+	if err := tickobjects.RegisterTickObject("ModelCheckpoint", reflect.TypeOf(ModelCheckpoint{}), reflect.TypeOf(ModelCheckpointDelta{})); err != nil {
+		panic(err)
+	}
+}
+
+// Checkpoint is the snapshot of a model with the last tick forwarded of each selector
+type Checkpoint struct {
+	Time      uint64
+	Selectors []string
+	LastTicks []uint64
+	State     []byte
+}
+
+type CheckpointStore interface {
+	Save(ID string, checkpoint *Checkpoint) error
+	// Load returns nil if there is no checkpoint
+	Load(ID string) (*Checkpoint, error)
+}
+
+// FileCheckpointStore keeps the checkpoint of each model in a file of the directory,
+// named after the escaped name of the model
+type FileCheckpointStore struct {
+	dir string
+}
+
+func NewFileCheckpointStore(dir string) (*FileCheckpointStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("error creating checkpoint directory: %v", err)
+	}
+	return &FileCheckpointStore{
+		dir: dir,
+	}, nil
+}
+
+func (s *FileCheckpointStore) path(ID string) string {
+	return filepath.Join(s.dir, url.PathEscape(ID)+".json")
+}
+
+func (s *FileCheckpointStore) Save(ID string, checkpoint *Checkpoint) error {
+	b, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("error marshalling checkpoint: %v", err)
+	}
+	// Write then rename, to never leave a partial checkpoint
+	tmp := s.path(ID) + ".tmp"
+	if err := os.WriteFile(tmp, b, 0644); err != nil {
+		return fmt.Errorf("error writing checkpoint: %v", err)
+	}
+	if err := os.Rename(tmp, s.path(ID)); err != nil {
+		return fmt.Errorf("error renaming checkpoint: %v", err)
+	}
+	return nil
+}
+
+func (s *FileCheckpointStore) Load(ID string) (*Checkpoint, error) {
+	b, err := os.ReadFile(s.path(ID))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading checkpoint: %v", err)
+	}
+	checkpoint := &Checkpoint{}
+	if err := json.Unmarshal(b, checkpoint); err != nil {
+		return nil, fmt.Errorf("error unmarshalling checkpoint: %v", err)
+	}
+	return checkpoint, nil
+}
+
+// TickstoreCheckpointStore keeps the checkpoints of the models in the tickstore, tagged
+// with the name of the model
+type TickstoreCheckpointStore struct {
+	client types.TickstoreClient
+}
+
+func NewTickstoreCheckpointStore(client types.TickstoreClient) (*TickstoreCheckpointStore, error) {
+	if err := client.RegisterMeasurement(CheckpointsMeasurement, "ModelCheckpoint"); err != nil {
+		return nil, fmt.Errorf("error registering checkpoints measurement: %v", err)
+	}
+	return &TickstoreCheckpointStore{
+		client: client,
+	}, nil
+}
+
+func (s *TickstoreCheckpointStore) Save(ID string, checkpoint *Checkpoint) error {
+	b, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("error marshalling checkpoint: %v", err)
+	}
+	writer, err := s.client.NewTickWriter(CheckpointsMeasurement, map[string]string{"name": ID}, time.Minute)
+	if err != nil {
+		return fmt.Errorf("error creating writer: %v", err)
+	}
+	defer writer.Close()
+	if err := writer.WriteObject(uint64(time.Now().UnixNano()/1000000), &ModelCheckpoint{checkpoint: b}); err != nil {
+		return fmt.Errorf("error writing checkpoint: %v", err)
+	}
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("error flushing checkpoint: %v", err)
+	}
+	return nil
+}
+
+func (s *TickstoreCheckpointStore) Load(ID string) (*Checkpoint, error) {
+	tags := map[string]string{"name": ID}
+	last, err := s.client.GetLastEventTime(CheckpointsMeasurement, tags)
+	if err != nil {
+		return nil, fmt.Errorf("error getting last checkpoint time: %v", err)
+	}
+	if last == 0 {
+		return nil, nil
+	}
+	q, err := s.client.NewQuery(types.NewQuerySettings(
+		types.WithSelector(fmt.Sprintf(`SELECT %s WHERE name="^%s$"`, CheckpointsMeasurement, regexp.QuoteMeta(ID))),
+		types.WithFrom(last),
+		types.WithTo(math.MaxUint64)))
+	if err != nil {
+		return nil, fmt.Errorf("error querying checkpoint: %v", err)
+	}
+	defer q.Close()
+	var obj *ModelCheckpoint
+	for q.Next() {
+		_, o, _ := q.Read()
+		if c, ok := o.(*ModelCheckpoint); ok {
+			obj = c
+		}
+	}
+	if err := q.Err(); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("error reading checkpoint: %v", err)
+	}
+	if obj == nil {
+		return nil, nil
+	}
+	checkpoint := &Checkpoint{}
+	if err := json.Unmarshal(obj.checkpoint, checkpoint); err != nil {
+		return nil, fmt.Errorf("error unmarshalling checkpoint: %v", err)
+	}
+	return checkpoint, nil
+}
+
+// ModelCheckpointDelta is never written, a checkpoint replacing the previous one
+type ModelCheckpointDelta struct {
+	Time uint64
+}
+
+// ModelCheckpoint holds the checkpoint of a model, marshalled
+type ModelCheckpoint struct {
+	checkpoint []byte
+}
+
+func (c *ModelCheckpoint) ToSnapshot() []byte {
+	return c.checkpoint
+}
+
+func (c *ModelCheckpoint) FromSnapshot(b []byte) error {
+	c.checkpoint = make([]byte, len(b))
+	copy(c.checkpoint, b)
+	return nil
+}
+
+func (c *ModelCheckpoint) DeltasTo(other tickobjects.TickObject) (gotickfile.TickDeltas, error) {
+	return gotickfile.TickDeltas{}, nil
+}
+
+func (c *ModelCheckpoint) AggregateDeltas(deltas []gotickfile.TickDeltas) gotickfile.TickDeltas {
+	return gotickfile.TickDeltas{}
+}
+
+func (c *ModelCheckpoint) ProcessDeltas(delta gotickfile.TickDeltas) error {
+	return nil
+}
+
+func (c *ModelCheckpoint) Clone() tickobjects.TickObject {
+	return &ModelCheckpoint{checkpoint: c.checkpoint}
+}
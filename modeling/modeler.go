@@ -4,16 +4,52 @@ import (
 	"fmt"
 	"github.com/asynkron/protoactor-go/actor"
 	"github.com/asynkron/protoactor-go/log"
+	"gitlab.com/alphaticks/alpha-connect/data"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
 	"gitlab.com/alphaticks/alpha-connect/utils"
 	types "gitlab.com/alphaticks/tickstore-types"
 	"gitlab.com/alphaticks/tickstore-types/tickobjects"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"io"
 	"math"
 	"reflect"
+	"sync"
 	"time"
 )
 
+// checkpointFrequency is the interval between two checkpoints of a Snapshotter model
+const checkpointFrequency = time.Minute
+
 type checkQueries struct{}
 
+type publishTick struct{}
+
+type saveCheckpoint struct{}
+
+type warmUpDone struct {
+	ID  uint64
+	err error
+}
+
+// The model outputs published to the subscribers, depending on the model
+type priceOutput interface {
+	GetPrice(ID uint64) (float64, bool)
+}
+
+type scoreOutput interface {
+	GetScore(ID uint64) (float64, bool)
+}
+
+type sampleOutput interface {
+	GetSamplePrices(ID uint64, time uint64, sampleSize int) []float64
+}
+
+type modelSubscription struct {
+	subscriber *actor.PID
+	IDs        []uint64
+	sampleSize int
+}
+
 type securityInfo struct {
 	requestID     uint64
 	securityID    uint64
@@ -24,6 +60,13 @@ type securityInfo struct {
 	lastDeltaTime uint64
 }
 
+// modelProgress holds the last tick forwarded to the model of each selector. It is
+// shared by the incarnations of a modeler, the model surviving the restarts.
+type modelProgress struct {
+	sync.Mutex
+	lastTicks []uint64
+}
+
 type Feed struct {
 	tradeFunctor     tickobjects.TickFunctor
 	orderBookFunctor tickobjects.TickFunctor
@@ -34,6 +77,8 @@ type Modeler struct {
 	executor      *actor.PID
 	index         *utils.TagIndex
 	store         types.TickstoreClient
+	history       data.DataClient
+	checkpoints   *CheckpointConfig
 	securityInfos map[uint64]*securityInfo
 	subscriptions map[uint64]*securityInfo
 	feeds         map[uint64]*Feed
@@ -44,19 +89,47 @@ type Modeler struct {
 	frequency     uint64
 	queries       []types.TickstoreQuery
 	queryTicker   *time.Ticker
+	subscribers   map[uint64]*modelSubscription
+	seqNum        uint64
+	publishTicker *time.Ticker
+	lastReady     bool
+	warmingUp     bool
+	warmUpID      uint64
+	warmUpQueries []types.TickstoreQuery
+	// Guards the model and the last tick forwarded of each selector
+	progress         *modelProgress
+	checkpointTicker *time.Ticker
 }
 
-func NewModelerProducer(model Model, store types.TickstoreClient, selectors []string) actor.Producer {
+// CheckpointConfig is where the checkpoints of a Snapshotter model are saved, under
+// its name. The name must be kept across restarts for the model to be restored.
+type CheckpointConfig struct {
+	Store CheckpointStore
+	Name  string
+}
+
+// NewModelerProducer returns the producer of a modeler, the checkpoints of Snapshotter
+// models are saved if a checkpoint config is given.
+// The restarts resume from the last ticks forwarded to the model.
+func NewModelerProducer(model Model, store types.TickstoreClient, history data.DataClient, checkpoints *CheckpointConfig, selectors []string) actor.Producer {
+	progress := &modelProgress{lastTicks: make([]uint64, len(selectors))}
 	return func() actor.Actor {
-		return NewModeler(model, store, selectors)
+		return newModeler(model, store, history, checkpoints, selectors, progress)
 	}
 }
 
-func NewModeler(model Model, store types.TickstoreClient, selectors []string) actor.Actor {
+func NewModeler(model Model, store types.TickstoreClient, history data.DataClient, checkpoints *CheckpointConfig, selectors []string) actor.Actor {
+	return newModeler(model, store, history, checkpoints, selectors, &modelProgress{lastTicks: make([]uint64, len(selectors))})
+}
+
+func newModeler(model Model, store types.TickstoreClient, history data.DataClient, checkpoints *CheckpointConfig, selectors []string, progress *modelProgress) actor.Actor {
 	return &Modeler{
-		model:     model,
-		store:     store,
-		selectors: selectors,
+		model:       model,
+		store:       store,
+		history:     history,
+		checkpoints: checkpoints,
+		selectors:   selectors,
+		progress:    progress,
 	}
 }
 
@@ -71,6 +144,9 @@ func (state *Modeler) Receive(context actor.Context) {
 
 	case *actor.Stopping:
 		state.logger.Info("actor stopping")
+		if err := state.saveCheckpoint(context); err != nil {
+			state.logger.Error("error saving checkpoint", log.Error(err))
+		}
 		state.Clean(context)
 
 	case *actor.Stopped:
@@ -85,6 +161,36 @@ func (state *Modeler) Receive(context actor.Context) {
 			state.logger.Error("error checking queries", log.Error(err))
 			panic(err)
 		}
+
+	case *messages.ModelDataRequest:
+		if err := state.OnModelDataRequest(context); err != nil {
+			state.logger.Error("error processing OnModelDataRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *warmUpDone:
+		if err := state.onWarmUpDone(context); err != nil {
+			state.logger.Error("error warming up model", log.Error(err))
+			panic(err)
+		}
+
+	case *saveCheckpoint:
+		if err := state.saveCheckpoint(context); err != nil {
+			// Retried on next checkpoint
+			state.logger.Error("error saving checkpoint", log.Error(err))
+		}
+
+	case *publishTick:
+		if err := state.onPublishTick(context); err != nil {
+			state.logger.Error("error publishing model tick", log.Error(err))
+			panic(err)
+		}
+
+	case *actor.Terminated:
+		if err := state.OnTerminated(context); err != nil {
+			state.logger.Error("error processing OnTerminated", log.Error(err))
+			panic(err)
+		}
 	}
 }
 
@@ -100,6 +206,11 @@ func (state *Modeler) Initialize(context actor.Context) error {
 	state.subscriptions = make(map[uint64]*securityInfo)
 	state.feeds = make(map[uint64]*Feed)
 	state.frequency = state.model.Frequency()
+	state.subscribers = make(map[uint64]*modelSubscription)
+	state.seqNum = uint64(time.Now().UnixNano())
+	if err := state.restoreCheckpoint(context); err != nil {
+		return err
+	}
 
 	queryTicker := time.NewTicker(5 * time.Second)
 	state.queryTicker = queryTicker
@@ -115,82 +226,363 @@ func (state *Modeler) Initialize(context actor.Context) error {
 		}
 	}(context.Self())
 
-	return state.startQueries(context)
+	// Models without frequency are published every second
+	publishFreq := time.Second
+	if state.frequency > 0 {
+		publishFreq = time.Duration(state.frequency) * time.Millisecond
+	}
+	publishTicker := time.NewTicker(publishFreq)
+	state.publishTicker = publishTicker
+	go func(pid *actor.PID) {
+		for {
+			select {
+			case <-publishTicker.C:
+				context.Send(pid, &publishTick{})
+			case <-time.After(publishFreq + 10*time.Second):
+				if state.publishTicker != publishTicker {
+					// Only stop if publish ticker has changed
+					return
+				}
+			}
+		}
+	}(context.Self())
+
+	if _, ok := state.model.(Snapshotter); ok && state.checkpoints != nil {
+		checkpointTicker := time.NewTicker(checkpointFrequency)
+		state.checkpointTicker = checkpointTicker
+		go func(pid *actor.PID) {
+			for {
+				select {
+				case <-checkpointTicker.C:
+					context.Send(pid, &saveCheckpoint{})
+				case <-time.After(checkpointFrequency + 10*time.Second):
+					if state.checkpointTicker != checkpointTicker {
+						// Only stop if checkpoint ticker has changed
+						return
+					}
+				}
+			}
+		}(context.Self())
+	}
+
+	return state.startWarmUp(context)
 }
 
+// restoreCheckpoint restores the model from its checkpoint, the replay then starts from
+// the last tick of the checkpoint. Checkpoints of other selectors are discarded, and the
+// checkpoint is not restored on restart, the model being more recent.
+func (state *Modeler) restoreCheckpoint(context actor.Context) error {
+	model, ok := state.model.(Snapshotter)
+	if !ok || state.checkpoints == nil {
+		return nil
+	}
+	state.progress.Lock()
+	lastTicks := state.lastTicks()
+	state.progress.Unlock()
+	for _, tick := range lastTicks {
+		if tick > 0 {
+			return nil
+		}
+	}
+	if state.checkpoints.Name == "" {
+		return fmt.Errorf("no checkpoint name")
+	}
+	checkpoint, err := state.checkpoints.Store.Load(state.checkpoints.Name)
+	if err != nil {
+		return fmt.Errorf("error loading checkpoint: %v", err)
+	}
+	if checkpoint == nil {
+		return nil
+	}
+	if !reflect.DeepEqual(checkpoint.Selectors, state.selectors) || len(checkpoint.LastTicks) != len(state.selectors) {
+		state.logger.Info("discarding checkpoint of different selectors")
+		return nil
+	}
+	if err := model.Restore(checkpoint.State); err != nil {
+		return fmt.Errorf("error restoring model: %v", err)
+	}
+	state.progress.Lock()
+	copy(state.progress.lastTicks, checkpoint.LastTicks)
+	state.progress.Unlock()
+	state.logger.Info("model restored from checkpoint", log.Uint64("time", checkpoint.Time))
+	return nil
+}
+
+func (state *Modeler) saveCheckpoint(context actor.Context) error {
+	model, ok := state.model.(Snapshotter)
+	if !ok || state.checkpoints == nil || state.warmingUp {
+		return nil
+	}
+	state.progress.Lock()
+	snapshot, err := model.Snapshot()
+	lastTicks := state.lastTicks()
+	state.progress.Unlock()
+	if err != nil {
+		return fmt.Errorf("error taking model snapshot: %v", err)
+	}
+	checkpoint := &Checkpoint{
+		Time:      uint64(time.Now().UnixNano() / 1000000),
+		Selectors: state.selectors,
+		LastTicks: lastTicks,
+		State:     snapshot,
+	}
+	if err := state.checkpoints.Store.Save(state.checkpoints.Name, checkpoint); err != nil {
+		return fmt.Errorf("error saving checkpoint: %v", err)
+	}
+	return nil
+}
+
+// forward forwards a tick of the selector to the model
+func (state *Modeler) forward(i int, tick uint64, obj interface{}) error {
+	state.progress.Lock()
+	defer state.progress.Unlock()
+	if tick < state.progress.lastTicks[i] {
+		// From the queries of a previous incarnation
+		return nil
+	}
+	if err := state.model.Forward(i, tick, obj); err != nil {
+		return err
+	}
+	state.progress.lastTicks[i] = tick
+	return nil
+}
+
+// lastTicks returns a copy of the last ticks forwarded, the progress must be locked
+func (state *Modeler) lastTicks() []uint64 {
+	lastTicks := make([]uint64, len(state.progress.lastTicks))
+	copy(lastTicks, state.progress.lastTicks)
+	return lastTicks
+}
+
+// liveQuery opens the streaming query of the selector from its last tick forwarded,
+// or from now if none, and returns it with the last tick
+func (state *Modeler) liveQuery(idx int) (types.TickstoreQuery, uint64, error) {
+	state.progress.Lock()
+	last := state.progress.lastTicks[idx]
+	state.progress.Unlock()
+	from := last
+	if from == 0 {
+		from = uint64(time.Now().UnixNano() / 1000000)
+	}
+	qs := types.NewQuerySettings(
+		types.WithSelector(state.selectors[idx]),
+		types.WithFrom(from),
+		types.WithTo(math.MaxUint64),
+		types.WithStreaming(true),
+		types.WithTimeout(100*time.Millisecond),
+		types.WithBatchSize(100),
+	)
+	q, err := state.store.NewQuery(qs)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error querying store: %v", err)
+	}
+	return q, last, nil
+}
+
+// startQueries opens the live queries once the model is warmed up and forwards them
+// to the model, so that they follow the replay without gap
 func (state *Modeler) startQueries(context actor.Context) error {
 	var queries []types.TickstoreQuery
-	for _, selector := range state.selectors {
-		fmt.Println(selector)
-		now := uint64(time.Now().UnixNano() / 1000000)
-		qs := types.NewQuerySettings(
-			types.WithSelector(selector),
-			types.WithFrom(now),
-			types.WithTo(math.MaxUint64),
-			types.WithStreaming(true),
-			types.WithTimeout(100*time.Millisecond),
-			types.WithBatchSize(100),
-		)
-		qs.WithSelector(selector)
-
-		q, err := state.store.NewQuery(qs)
+	var lasts []uint64
+	for i := range state.selectors {
+		q, last, err := state.liveQuery(i)
 		if err != nil {
-			return fmt.Errorf("error querying store: %v", err)
+			for _, q := range queries {
+				_ = q.Close()
+			}
+			return err
 		}
-
 		queries = append(queries, q)
+		lasts = append(lasts, last)
 	}
 
 	for _, q := range state.queries {
 		_ = q.Close()
 	}
+	state.queries = queries
 	for i, q := range queries {
-		go func(i int, q types.TickstoreQuery) {
-			for q.Err() == nil {
-				for q.Next() {
-					tick, obj, _ := q.Read()
-					if err := state.model.Forward(i, tick, obj); err != nil {
-						state.logger.Error("error forwarding tick", log.Error(err))
-						_ = q.Close()
-					}
-				}
-			}
-		}(i, q)
+		go state.forwardQuery(i, q, lasts[i])
 	}
-	state.queries = queries
 
 	return nil
 }
 
-func (state *Modeler) restartQuery(idx int) error {
-	selector := state.selectors[idx]
-	fmt.Println(selector)
+// startWarmUp replays the warm-up window of each selector from the history, or the gap
+// since the last tick forwarded if restored or restarted, before starting the live queries
+// from the last tick replayed.
+func (state *Modeler) startWarmUp(context actor.Context) error {
 	now := uint64(time.Now().UnixNano() / 1000000)
-	qs := types.NewQuerySettings(
-		types.WithSelector(selector),
-		types.WithFrom(now),
-		types.WithTo(math.MaxUint64),
-		types.WithStreaming(true),
-		types.WithTimeout(100*time.Millisecond),
-		types.WithBatchSize(100),
-	)
-	qs.WithSelector(selector)
-
-	q, err := state.store.NewQuery(qs)
+	froms := make([]uint64, len(state.selectors))
+	replays := make([]bool, len(state.selectors))
+	replay := false
+	model, warmUp := state.model.(WarmUpModel)
+	state.progress.Lock()
+	lastTicks := state.lastTicks()
+	state.progress.Unlock()
+	for i := range state.selectors {
+		if lastTicks[i] > 0 {
+			froms[i] = lastTicks[i] + 1
+			replays[i] = froms[i] < now
+		} else if warmUp {
+			window := model.WarmUp(i)
+			if window > now {
+				window = now
+			}
+			froms[i] = now - window
+			replays[i] = window > 0
+		}
+		replay = replay || replays[i]
+	}
+	if !replay || state.history == nil {
+		return state.startQueries(context)
+	}
+	freq := int64(state.frequency)
+	if freq < data.DATA_CLIENT_1S {
+		freq = data.DATA_CLIENT_1S
+	}
+	client, _, err := state.history.GetClient(freq)
 	if err != nil {
-		return fmt.Errorf("error querying store: %v", err)
+		return fmt.Errorf("error getting history client: %v", err)
 	}
-	_ = state.queries[idx].Close()
-	go func(i int, q types.TickstoreQuery) {
-		for q.Err() == nil {
-			for q.Next() {
-				tick, obj, _ := q.Read()
-				if err := state.model.Forward(i, tick, obj); err != nil {
+	queries := make([]types.TickstoreQuery, len(state.selectors))
+	for i, selector := range state.selectors {
+		if !replays[i] {
+			continue
+		}
+		qs := types.NewQuerySettings(
+			types.WithSelector(selector),
+			types.WithFrom(froms[i]),
+			types.WithTo(now),
+			types.WithStreaming(false),
+			types.WithTimeout(10*time.Second),
+			types.WithBatchSize(1000),
+		)
+		q, err := client.NewQuery(qs)
+		if err != nil {
+			for _, q := range queries {
+				if q != nil {
 					_ = q.Close()
 				}
 			}
+			return fmt.Errorf("error querying history: %v", err)
+		}
+		queries[i] = q
+	}
+	state.warmingUp = true
+	state.warmUpQueries = queries
+	state.warmUpID = uint64(time.Now().UnixNano())
+	state.logger.Info("warming up model")
+	go func(pid *actor.PID, ID uint64) {
+		err := state.replay(queries)
+		context.Send(pid, &warmUpDone{
+			ID:  ID,
+			err: err,
+		})
+	}(context.Self(), state.warmUpID)
+
+	return nil
+}
+
+// replay forwards the history of the selectors to the model in tick order
+func (state *Modeler) replay(queries []types.TickstoreQuery) error {
+	heads := make([]bool, len(queries))
+	for i, q := range queries {
+		if q == nil {
+			continue
 		}
-	}(idx, q)
+		ok, err := nextHistory(q)
+		if err != nil {
+			return fmt.Errorf("error reading history of %s: %v", state.selectors[i], err)
+		}
+		heads[i] = ok
+	}
+	for {
+		idx := -1
+		var tick uint64
+		for i, q := range queries {
+			if !heads[i] {
+				continue
+			}
+			t, _, _ := q.Read()
+			if idx == -1 || t < tick {
+				idx = i
+				tick = t
+			}
+		}
+		if idx == -1 {
+			return nil
+		}
+		_, obj, _ := queries[idx].Read()
+		if err := state.forward(idx, tick, obj); err != nil {
+			return fmt.Errorf("error forwarding tick: %v", err)
+		}
+		ok, err := nextHistory(queries[idx])
+		if err != nil {
+			return fmt.Errorf("error reading history of %s: %v", state.selectors[idx], err)
+		}
+		heads[idx] = ok
+	}
+}
+
+// nextHistory advances a history query, returning false once exhausted
+func nextHistory(q types.TickstoreQuery) (bool, error) {
+	for !q.Next() {
+		if err := q.Err(); err == io.EOF {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (state *Modeler) onWarmUpDone(context actor.Context) error {
+	msg := context.Message().(*warmUpDone)
+	if msg.ID != state.warmUpID {
+		// From a previous incarnation
+		return nil
+	}
+	for _, q := range state.warmUpQueries {
+		if q != nil {
+			_ = q.Close()
+		}
+	}
+	state.warmUpQueries = nil
+	if msg.err != nil {
+		return msg.err
+	}
+	state.warmingUp = false
+	if err := state.startQueries(context); err != nil {
+		return err
+	}
+	state.logger.Info("model warmed up")
+	return nil
+}
+
+// forwardQuery forwards the live query to the model, skipping the ticks already forwarded
+func (state *Modeler) forwardQuery(i int, q types.TickstoreQuery, last uint64) {
+	for q.Err() == nil {
+		for q.Next() {
+			tick, obj, _ := q.Read()
+			if last > 0 && tick <= last {
+				continue
+			}
+			if err := state.forward(i, tick, obj); err != nil {
+				state.logger.Error("error forwarding tick", log.Error(err))
+				_ = q.Close()
+			}
+		}
+	}
+}
+
+// restartQuery restarts the live query from the last tick forwarded
+func (state *Modeler) restartQuery(idx int) error {
+	q, last, err := state.liveQuery(idx)
+	if err != nil {
+		return err
+	}
+	_ = state.queries[idx].Close()
+	go state.forwardQuery(idx, q, last)
 	state.queries[idx] = q
 
 	return nil
@@ -201,14 +593,32 @@ func (state *Modeler) Clean(context actor.Context) error {
 		_ = q.Close()
 	}
 	state.queries = nil
+	for _, q := range state.warmUpQueries {
+		if q != nil {
+			_ = q.Close()
+		}
+	}
+	state.warmUpQueries = nil
 	if state.queryTicker != nil {
 		state.queryTicker.Stop()
 		state.queryTicker = nil
 	}
+	if state.publishTicker != nil {
+		state.publishTicker.Stop()
+		state.publishTicker = nil
+	}
+	if state.checkpointTicker != nil {
+		state.checkpointTicker.Stop()
+		state.checkpointTicker = nil
+	}
 	return nil
 }
 
 func (state *Modeler) checkQueries(context actor.Context) error {
+	if state.warmingUp {
+		// The live queries are not forwarded yet
+		return nil
+	}
 	for i, q := range state.queries {
 		if q.Err() != nil {
 			state.logger.Error("error on query", log.Error(q.Err()), log.String("selector", state.selectors[i]))
@@ -230,3 +640,118 @@ func (state *Modeler) checkQueries(context actor.Context) error {
 	}
 	return nil
 }
+
+func (state *Modeler) OnModelDataRequest(context actor.Context) error {
+	req := context.Message().(*messages.ModelDataRequest)
+	res := &messages.ModelDataResponse{
+		RequestID:  req.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		Success:    false,
+	}
+	_, hasPrice := state.model.(priceOutput)
+	_, hasScore := state.model.(scoreOutput)
+	if !hasPrice && !hasScore {
+		res.RejectionReason = messages.RejectionReason_UnsupportedRequest
+		context.Respond(res)
+		return nil
+	}
+	if len(req.IDs) == 0 || (req.Subscribe && req.Subscriber == nil) {
+		res.RejectionReason = messages.RejectionReason_InvalidRequest
+		context.Respond(res)
+		return nil
+	}
+	sub := &modelSubscription{
+		subscriber: req.Subscriber,
+		IDs:        req.IDs,
+		sampleSize: int(req.SampleSize),
+	}
+	tick := state.currentTick()
+	if state.ready() {
+		res.Ready = true
+		res.Tick = tick
+		res.Values = state.modelValues(sub, tick)
+	}
+	if req.Subscribe {
+		state.subscribers[req.RequestID] = sub
+		context.Watch(req.Subscriber)
+	}
+	res.Success = true
+	res.SeqNum = state.seqNum
+	context.Respond(res)
+	return nil
+}
+
+// ready returns whether the model is warmed up and ready
+func (state *Modeler) ready() bool {
+	return !state.warmingUp && state.model.Ready()
+}
+
+// currentTick returns the start of the current model period
+func (state *Modeler) currentTick() uint64 {
+	now := uint64(time.Now().UnixNano() / 1000000)
+	if state.frequency > 0 {
+		now -= now % state.frequency
+	}
+	return now
+}
+
+// modelValues returns the outputs of the model for the IDs of the subscription
+func (state *Modeler) modelValues(sub *modelSubscription, tick uint64) []*messages.ModelValue {
+	values := make([]*messages.ModelValue, 0, len(sub.IDs))
+	for _, ID := range sub.IDs {
+		v := &messages.ModelValue{ID: ID}
+		if m, ok := state.model.(priceOutput); ok {
+			if p, ok := m.GetPrice(ID); ok {
+				v.Price = wrapperspb.Double(p)
+			}
+		}
+		if m, ok := state.model.(scoreOutput); ok {
+			if sc, ok := m.GetScore(ID); ok {
+				v.Score = wrapperspb.Double(sc)
+			}
+		}
+		if m, ok := state.model.(sampleOutput); ok && sub.sampleSize > 0 {
+			samples := m.GetSamplePrices(ID, tick+state.frequency, sub.sampleSize)
+			// The model can reuse the samples
+			v.SamplePrices = append([]float64(nil), samples...)
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
+func (state *Modeler) onPublishTick(context actor.Context) error {
+	ready := state.ready()
+	// While not ready, only the change of readiness is published
+	changed := ready != state.lastReady
+	state.lastReady = ready
+	if len(state.subscribers) == 0 || (!ready && !changed) {
+		return nil
+	}
+	tick := state.currentTick()
+	state.seqNum += 1
+	for k, sub := range state.subscribers {
+		refresh := &messages.ModelDataIncrementalRefresh{
+			RequestID:  k,
+			ResponseID: uint64(time.Now().UnixNano()),
+			SeqNum:     state.seqNum,
+			Tick:       tick,
+			Ready:      ready,
+		}
+		if ready {
+			refresh.Values = state.modelValues(sub, tick)
+		}
+		context.Send(sub.subscriber, refresh)
+	}
+	return nil
+}
+
+func (state *Modeler) OnTerminated(context actor.Context) error {
+	msg := context.Message().(*actor.Terminated)
+	for k, sub := range state.subscribers {
+		if sub.subscriber.String() == msg.Who.String() {
+			delete(state.subscribers, k)
+		}
+	}
+	return nil
+}
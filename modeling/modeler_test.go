@@ -0,0 +1,418 @@
+package modeling
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/melaurent/gotickfile/v2"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	types "gitlab.com/alphaticks/tickstore-types"
+	"gitlab.com/alphaticks/tickstore-types/tickobjects"
+)
+
+// sliceQuery returns the ticks of the slice, without objects
+type sliceQuery struct {
+	ticks []uint64
+	idx   int
+}
+
+func (q *sliceQuery) SetNextDeadline(time.Time) {}
+
+func (q *sliceQuery) Next() bool {
+	if q.idx >= len(q.ticks) {
+		return false
+	}
+	q.idx += 1
+	return true
+}
+
+func (q *sliceQuery) Progress(end uint64) bool { return false }
+
+func (q *sliceQuery) Read() (uint64, tickobjects.TickObject, uint64) {
+	if q.idx == 0 {
+		return 0, nil, 0
+	}
+	return q.ticks[q.idx-1], nil, 0
+}
+
+func (q *sliceQuery) ReadDeltas() (uint64, *gotickfile.TickDeltas, uint64) {
+	tick, _, _ := q.Read()
+	return tick, nil, 0
+}
+
+func (q *sliceQuery) DeltaType() reflect.Type { return nil }
+func (q *sliceQuery) Tags() map[string]string { return nil }
+func (q *sliceQuery) Close() error            { return nil }
+
+func (q *sliceQuery) Err() error {
+	if q.idx >= len(q.ticks) {
+		return io.EOF
+	}
+	return nil
+}
+
+type sliceClient struct {
+	ticks map[string][]uint64
+}
+
+func (c *sliceClient) RegisterMeasurement(string, string) error { return nil }
+func (c *sliceClient) DeleteMeasurement(string, map[string]string, uint64, uint64) error {
+	return nil
+}
+func (c *sliceClient) GetLastEventTime(string, map[string]string) (uint64, error) { return 0, nil }
+func (c *sliceClient) NewTickWriter(string, map[string]string, time.Duration) (types.TickstoreWriter, error) {
+	return nil, nil
+}
+
+func (c *sliceClient) NewQuery(qs *types.QuerySettings) (types.TickstoreQuery, error) {
+	var ticks []uint64
+	for _, tick := range c.ticks[qs.Selector] {
+		if tick >= qs.From {
+			ticks = append(ticks, tick)
+		}
+	}
+	return &sliceQuery{ticks: ticks}, nil
+}
+
+type sliceHistory struct {
+	client *sliceClient
+}
+
+func (h *sliceHistory) GetClient(freq int64) (types.TickstoreClient, int64, error) {
+	return h.client, freq, nil
+}
+
+func (h *sliceHistory) Close() error { return nil }
+
+// replayModel records the ticks forwarded
+type replayModel struct {
+	sync.Mutex
+	forwarded [][2]uint64
+}
+
+func (m *replayModel) Forward(selector int, tick uint64, object interface{}) error {
+	m.Lock()
+	defer m.Unlock()
+	m.forwarded = append(m.forwarded, [2]uint64{uint64(selector), tick})
+	return nil
+}
+
+func (m *replayModel) Backward(uint64, interface{}) {}
+func (m *replayModel) Ready() bool                  { return true }
+func (m *replayModel) Frequency() uint64            { return 0 }
+func (m *replayModel) GetSelectors() []string       { return nil }
+func (m *replayModel) WarmUp(int) uint64            { return math.MaxUint64 }
+
+func (m *replayModel) GetPrice(ID uint64) (float64, bool) {
+	return 1, true
+}
+
+func (m *replayModel) waitForwarded(t *testing.T, n int) [][2]uint64 {
+	timeout := time.After(3 * time.Second)
+	for {
+		m.Lock()
+		forwarded := m.forwarded
+		m.Unlock()
+		if len(forwarded) >= n {
+			return forwarded
+		}
+		select {
+		case <-timeout:
+			t.Fatalf("timed-out waiting for ticks, got %d", len(forwarded))
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// snapshotModel snapshots the ticks forwarded
+type snapshotModel struct {
+	replayModel
+}
+
+func (m *snapshotModel) Snapshot() ([]byte, error) {
+	m.Lock()
+	defer m.Unlock()
+	return json.Marshal(m.forwarded)
+}
+
+func (m *snapshotModel) Restore(snapshot []byte) error {
+	m.Lock()
+	defer m.Unlock()
+	return json.Unmarshal(snapshot, &m.forwarded)
+}
+
+func TestModelerSubscription(t *testing.T) {
+	as := actor.NewActorSystem()
+	defer as.Shutdown()
+	modeler := as.Root.Spawn(actor.PropsFromProducer(NewModelerProducer(NewConstantPriceModel(10), nil, nil, nil, nil)))
+
+	refreshes := make(chan *messages.ModelDataIncrementalRefresh, 10)
+	subscriber := as.Root.Spawn(actor.PropsFromFunc(func(c actor.Context) {
+		if r, ok := c.Message().(*messages.ModelDataIncrementalRefresh); ok {
+			refreshes <- r
+		}
+	}))
+
+	res, err := as.Root.RequestFuture(modeler, &messages.ModelDataRequest{
+		RequestID: 1,
+		Subscribe: true,
+	}, time.Second).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.(*messages.ModelDataResponse).Success {
+		t.Fatalf("was expecting a rejection without IDs")
+	}
+
+	res, err = as.Root.RequestFuture(modeler, &messages.ModelDataRequest{
+		RequestID:  2,
+		Subscribe:  true,
+		Subscriber: subscriber,
+		IDs:        []uint64{1, 2},
+		SampleSize: 5,
+	}, time.Second).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	md := res.(*messages.ModelDataResponse)
+	if !md.Success {
+		t.Fatalf("subscription rejected: %s", md.RejectionReason.String())
+	}
+	if len(md.Values) != 2 || md.Values[0].Price.GetValue() != 10 || len(md.Values[0].SamplePrices) != 5 {
+		t.Fatalf("unexpected values %v", md.Values)
+	}
+	if md.Values[0].Score != nil {
+		t.Fatalf("was expecting no score from a price model")
+	}
+
+	select {
+	case r := <-refreshes:
+		if r.SeqNum != md.SeqNum+1 || r.RequestID != 2 {
+			t.Fatalf("unexpected refresh %v", r)
+		}
+		if len(r.Values) != 2 || r.Values[1].ID != 2 || r.Values[1].Price.GetValue() != 10 {
+			t.Fatalf("unexpected values %v", r.Values)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatalf("timed-out waiting for model tick")
+	}
+}
+
+func TestModelerWarmUp(t *testing.T) {
+	as := actor.NewActorSystem()
+	defer as.Shutdown()
+	model := &replayModel{}
+	history := &sliceHistory{client: &sliceClient{ticks: map[string][]uint64{
+		"a": {1, 3, 5},
+		"b": {2, 4},
+	}}}
+	live := &sliceClient{ticks: map[string][]uint64{
+		"a": {5, 6},
+		"b": {3, 7},
+	}}
+	modeler := as.Root.Spawn(actor.PropsFromProducer(NewModelerProducer(model, live, history, nil, []string{"a", "b"})))
+
+	forwarded := model.waitForwarded(t, 7)
+	// The history is replayed in tick order, then the live ticks not replayed
+	replayed := [][2]uint64{{0, 1}, {1, 2}, {0, 3}, {1, 4}, {0, 5}}
+	if !reflect.DeepEqual(forwarded[:5], replayed) {
+		t.Fatalf("unexpected replay %v", forwarded[:5])
+	}
+	liveTicks := map[[2]uint64]bool{forwarded[5]: true, forwarded[6]: true}
+	if len(forwarded) != 7 || !liveTicks[[2]uint64{0, 6}] || !liveTicks[[2]uint64{1, 7}] {
+		t.Fatalf("unexpected live ticks %v", forwarded[5:])
+	}
+
+	res, err := as.Root.RequestFuture(modeler, &messages.ModelDataRequest{
+		RequestID: 1,
+		IDs:       []uint64{1},
+	}, time.Second).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	md := res.(*messages.ModelDataResponse)
+	if !md.Success || !md.Ready || len(md.Values) != 1 {
+		t.Fatalf("unexpected response %v", md)
+	}
+}
+
+// failingModel fails to forward a tick once
+type failingModel struct {
+	replayModel
+	failTick uint64
+	failed   bool
+}
+
+func (m *failingModel) Forward(selector int, tick uint64, object interface{}) error {
+	m.Lock()
+	fail := tick == m.failTick && !m.failed
+	m.failed = m.failed || fail
+	m.Unlock()
+	if fail {
+		return fmt.Errorf("failed to forward %d", tick)
+	}
+	return m.replayModel.Forward(selector, tick, object)
+}
+
+func TestModelerRestart(t *testing.T) {
+	as := actor.NewActorSystem()
+	defer as.Shutdown()
+	history := &sliceHistory{client: &sliceClient{ticks: map[string][]uint64{
+		"a": {1, 3, 5},
+		"b": {2, 4},
+	}}}
+	live := &sliceClient{ticks: map[string][]uint64{
+		"a": {5, 6},
+		"b": {3, 7},
+	}}
+	// The replay fails on tick 4, the restart resumes it without replaying the first ticks
+	model := &failingModel{failTick: 4}
+	as.Root.Spawn(actor.PropsFromProducer(NewModelerProducer(model, live, history, nil, []string{"a", "b"})))
+	model.waitForwarded(t, 7)
+	// No tick forwarded twice
+	time.Sleep(100 * time.Millisecond)
+	model.Lock()
+	forwarded := model.forwarded
+	model.Unlock()
+	replayed := [][2]uint64{{0, 1}, {1, 2}, {0, 3}, {1, 4}, {0, 5}}
+	if len(forwarded) != 7 || !reflect.DeepEqual(forwarded[:5], replayed) {
+		t.Fatalf("unexpected ticks forwarded %v", forwarded)
+	}
+}
+
+func TestModelerCheckpoint(t *testing.T) {
+	as := actor.NewActorSystem()
+	defer as.Shutdown()
+	checkpoints, err := NewFileCheckpointStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	selectors := []string{"a", "b"}
+	live := &sliceClient{}
+	history := &sliceHistory{client: &sliceClient{ticks: map[string][]uint64{
+		"a": {1, 3, 5},
+		"b": {2, 4},
+	}}}
+	model := &snapshotModel{}
+	// Names are escaped in the file store
+	config := &CheckpointConfig{Store: checkpoints, Name: "models/test"}
+	modeler := as.Root.Spawn(actor.PropsFromProducer(NewModelerProducer(model, live, history, config, selectors)))
+	model.waitForwarded(t, 5)
+	// The checkpoint is saved on stop
+	if err := as.Root.StopFuture(modeler).Wait(); err != nil {
+		t.Fatal(err)
+	}
+	checkpoint, err := checkpoints.Load("models/test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if checkpoint == nil || !reflect.DeepEqual(checkpoint.LastTicks, []uint64{5, 4}) {
+		t.Fatalf("unexpected checkpoint %v", checkpoint)
+	}
+
+	// Only the ticks after the checkpoint are replayed
+	history.client.ticks = map[string][]uint64{
+		"a": {1, 3, 5, 8},
+		"b": {2, 4, 6},
+	}
+	model = &snapshotModel{}
+	// Restored under its name, whatever the ID of the actor
+	as.Root.Spawn(actor.PropsFromProducer(NewModelerProducer(model, live, history, config, selectors)))
+	forwarded := model.waitForwarded(t, 7)
+	expected := [][2]uint64{{0, 1}, {1, 2}, {0, 3}, {1, 4}, {0, 5}, {1, 6}, {0, 8}}
+	if !reflect.DeepEqual(forwarded, expected) {
+		t.Fatalf("unexpected ticks %v", forwarded)
+	}
+}
+
+// objectClient keeps the objects written, of a single series
+type objectClient struct {
+	sliceClient
+	ticks    []uint64
+	objects  []tickobjects.TickObject
+	selector string
+}
+
+func (c *objectClient) GetLastEventTime(string, map[string]string) (uint64, error) {
+	if len(c.ticks) == 0 {
+		return 0, nil
+	}
+	return c.ticks[len(c.ticks)-1], nil
+}
+
+func (c *objectClient) NewTickWriter(string, map[string]string, time.Duration) (types.TickstoreWriter, error) {
+	return &objectWriter{client: c}, nil
+}
+
+func (c *objectClient) NewQuery(qs *types.QuerySettings) (types.TickstoreQuery, error) {
+	c.selector = qs.Selector
+	q := &objectQuery{}
+	for i, tick := range c.ticks {
+		if tick >= qs.From {
+			q.ticks = append(q.ticks, tick)
+			q.objects = append(q.objects, c.objects[i])
+		}
+	}
+	return q, nil
+}
+
+type objectWriter struct {
+	client *objectClient
+}
+
+func (w *objectWriter) WriteObject(tick uint64, object tickobjects.TickObject) error {
+	w.client.ticks = append(w.client.ticks, tick)
+	w.client.objects = append(w.client.objects, object.Clone())
+	return nil
+}
+
+func (w *objectWriter) WriteDeltas(uint64, gotickfile.TickDeltas) error { return nil }
+func (w *objectWriter) Flush() error                                    { return nil }
+func (w *objectWriter) GetObject() tickobjects.TickObject               { return nil }
+func (w *objectWriter) Close() error                                    { return nil }
+
+type objectQuery struct {
+	sliceQuery
+	objects []tickobjects.TickObject
+}
+
+func (q *objectQuery) Read() (uint64, tickobjects.TickObject, uint64) {
+	if q.idx == 0 {
+		return 0, nil, 0
+	}
+	return q.ticks[q.idx-1], q.objects[q.idx-1], 0
+}
+
+func TestTickstoreCheckpointStore(t *testing.T) {
+	client := &objectClient{}
+	store, err := NewTickstoreCheckpointStore(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkpoint, err := store.Load("models.test")
+	if err != nil || checkpoint != nil {
+		t.Fatalf("was expecting no checkpoint, got %v %v", checkpoint, err)
+	}
+	for _, tick := range []uint64{5, 8} {
+		if err := store.Save("models.test", &Checkpoint{Time: tick, Selectors: []string{"a"}, LastTicks: []uint64{tick}, State: []byte{1}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkpoint, err = store.Load("models.test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if checkpoint == nil || checkpoint.Time != 8 || !reflect.DeepEqual(checkpoint.LastTicks, []uint64{8}) {
+		t.Fatalf("was expecting the last checkpoint, got %v", checkpoint)
+	}
+	if client.selector != `SELECT checkpoints WHERE name="^models\.test$"` {
+		t.Fatalf("unexpected selector %s", client.selector)
+	}
+}
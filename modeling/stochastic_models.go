@@ -0,0 +1,753 @@
+package modeling
+
+import (
+	"encoding/json"
+	"fmt"
+	"gitlab.com/alphaticks/tickstore-types/tickobjects"
+	"math"
+	"math/rand"
+	"reflect"
+	"sync"
+)
+
+const (
+	// minReturns is the number of returns needed before a price model is fitted
+	minReturns = 100
+	// minTradeEvents is the number of trades of each side needed before fitting the Hawkes processes
+	minTradeEvents = 50
+	// emIterations is the number of EM iterations of a Hawkes process fit
+	emIterations = 20
+	// maxBranchingRatio keeps the Hawkes processes stationary
+	maxBranchingRatio = 0.95
+	// maxSampleEvents bounds the number of trades simulated per sample
+	maxSampleEvents = 100000
+)
+
+// objectPrice returns the price of a trade or of a float object, like a mid price
+func objectPrice(object interface{}) (float64, error) {
+	switch o := object.(type) {
+	case tickobjects.TradeObject:
+		return o.Price(), nil
+	case tickobjects.Float64Object:
+		return o.Float64(), nil
+	default:
+		return 0, fmt.Errorf("unsupported object type %s", reflect.TypeOf(object))
+	}
+}
+
+// returnSampler samples the log returns of a price at a fixed frequency in ms
+type returnSampler struct {
+	freq  uint64
+	time  uint64 // End of the current interval
+	open  float64
+	price float64
+}
+
+// update sets the price at the tick, calling onReturn with the return of each interval closed
+func (s *returnSampler) update(tick uint64, price float64, onReturn func(r float64)) {
+	if price <= 0 || math.IsNaN(price) || math.IsInf(price, 0) {
+		return
+	}
+	if s.time == 0 {
+		s.time = tick - tick%s.freq + s.freq
+		s.open = price
+		s.price = price
+		return
+	}
+	for tick >= s.time {
+		onReturn(math.Log(s.price / s.open))
+		s.open = s.price
+		s.time += s.freq
+	}
+	s.price = price
+}
+
+// samplerSnapshot is the state of a return sampler, the frequency being a parameter of the model
+type samplerSnapshot struct {
+	Time  uint64
+	Open  float64
+	Price float64
+}
+
+func (s *returnSampler) snapshot() samplerSnapshot {
+	return samplerSnapshot{Time: s.time, Open: s.open, Price: s.price}
+}
+
+func (s *returnSampler) restore(snapshot samplerSnapshot) {
+	s.time = snapshot.Time
+	s.open = snapshot.Open
+	s.price = snapshot.Price
+}
+
+// steps returns the number of intervals ending before or at the time
+func (s *returnSampler) steps(time uint64) int {
+	if s.time == 0 || time < s.time {
+		return 0
+	}
+	return int((time-s.time)/s.freq) + 1
+}
+
+// pushReturn appends the return to the window, dropping the oldest
+func pushReturn(returns []float64, r float64, window int) []float64 {
+	returns = append(returns, r)
+	if len(returns) > window {
+		returns = returns[len(returns)-window:]
+	}
+	return returns
+}
+
+var (
+	_ Snapshotter = (*GARCHPriceModel)(nil)
+	_ Snapshotter = (*MertonPriceModel)(nil)
+	_ Snapshotter = (*HawkesTradeModel)(nil)
+)
+
+// GARCHPriceModel samples the price with a GARCH(1,1) volatility, fitted by
+// maximum likelihood on the window of returns at the model frequency.
+type GARCHPriceModel struct {
+	sync.Mutex
+	selector     string
+	window       int
+	sampler      returnSampler
+	returns      []float64
+	sinceFit     int
+	fitted       bool
+	omega        float64
+	alpha        float64
+	beta         float64
+	variance     float64 // Conditional variance of the next return
+	samplePrices []float64
+	sampleTime   uint64
+}
+
+func NewGARCHPriceModel(selector string, freq uint64, window int) *GARCHPriceModel {
+	return &GARCHPriceModel{
+		selector: selector,
+		window:   window,
+		sampler:  returnSampler{freq: freq},
+	}
+}
+
+func (m *GARCHPriceModel) Forward(_ int, tick uint64, object interface{}) error {
+	price, err := objectPrice(object)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	m.sampler.update(tick, price, m.onReturn)
+	return nil
+}
+
+func (m *GARCHPriceModel) onReturn(r float64) {
+	m.returns = pushReturn(m.returns, r, m.window)
+	if m.fitted {
+		m.variance = m.omega + m.alpha*r*r + m.beta*m.variance
+	}
+	m.sinceFit += 1
+	// Refit every tenth of the window
+	if len(m.returns) >= minReturns && (!m.fitted || m.sinceFit*10 >= m.window) {
+		m.fit()
+	}
+}
+
+// fit searches the parameters on a grid, with the long run variance targeted to the sample variance
+func (m *GARCHPriceModel) fit() {
+	var v float64
+	for _, r := range m.returns {
+		v += r * r
+	}
+	v /= float64(len(m.returns))
+	m.sinceFit = 0
+	m.fitted = true
+	if v == 0 {
+		m.omega, m.alpha, m.beta, m.variance = 0, 0, 0, 0
+		return
+	}
+	bestLL := math.Inf(-1)
+	for a := 0.01; a < 0.305; a += 0.01 {
+		for b := 0.5; b < 0.995; b += 0.01 {
+			if a+b >= 0.999 {
+				continue
+			}
+			w := v * (1 - a - b)
+			s2 := v
+			var ll float64
+			for _, r := range m.returns {
+				ll -= math.Log(s2) + r*r/s2
+				s2 = w + a*r*r + b*s2
+			}
+			if ll > bestLL {
+				bestLL = ll
+				m.omega, m.alpha, m.beta, m.variance = w, a, b, s2
+			}
+		}
+	}
+}
+
+func (m *GARCHPriceModel) Backward(_ uint64, _ interface{}) {
+
+}
+
+func (m *GARCHPriceModel) Ready() bool {
+	m.Lock()
+	defer m.Unlock()
+	return m.fitted
+}
+
+func (m *GARCHPriceModel) Frequency() uint64 {
+	return m.sampler.freq
+}
+
+func (m *GARCHPriceModel) GetSelectors() []string {
+	return []string{m.selector}
+}
+
+func (m *GARCHPriceModel) WarmUp(_ int) uint64 {
+	return uint64(m.window) * m.sampler.freq
+}
+
+func (m *GARCHPriceModel) GetParams() (omega, alpha, beta float64) {
+	m.Lock()
+	defer m.Unlock()
+	return m.omega, m.alpha, m.beta
+}
+
+type garchSnapshot struct {
+	Sampler  samplerSnapshot
+	Returns  []float64
+	SinceFit int
+	Fitted   bool
+	Omega    float64
+	Alpha    float64
+	Beta     float64
+	Variance float64
+}
+
+// Snapshot returns the window of returns and the fitted parameters
+func (m *GARCHPriceModel) Snapshot() ([]byte, error) {
+	m.Lock()
+	defer m.Unlock()
+	return json.Marshal(garchSnapshot{
+		Sampler:  m.sampler.snapshot(),
+		Returns:  m.returns,
+		SinceFit: m.sinceFit,
+		Fitted:   m.fitted,
+		Omega:    m.omega,
+		Alpha:    m.alpha,
+		Beta:     m.beta,
+		Variance: m.variance,
+	})
+}
+
+func (m *GARCHPriceModel) Restore(snapshot []byte) error {
+	var snap garchSnapshot
+	if err := json.Unmarshal(snapshot, &snap); err != nil {
+		return fmt.Errorf("error unmarshalling GARCH snapshot: %v", err)
+	}
+	m.Lock()
+	defer m.Unlock()
+	m.sampler.restore(snap.Sampler)
+	m.returns = snap.Returns
+	m.sinceFit = snap.SinceFit
+	m.fitted = snap.Fitted
+	m.omega, m.alpha, m.beta, m.variance = snap.Omega, snap.Alpha, snap.Beta, snap.Variance
+	m.samplePrices = nil
+	return nil
+}
+
+func (m *GARCHPriceModel) GetPrice(_ uint64) (float64, bool) {
+	m.Lock()
+	defer m.Unlock()
+	return m.sampler.price, m.sampler.price > 0
+}
+
+func (m *GARCHPriceModel) GetSamplePrices(_ uint64, time uint64, sampleSize int) []float64 {
+	m.Lock()
+	defer m.Unlock()
+	if m.samplePrices == nil || len(m.samplePrices) != sampleSize || m.sampleTime != time {
+		steps := m.sampler.steps(time)
+		m.samplePrices = make([]float64, sampleSize)
+		for i := 0; i < sampleSize; i++ {
+			p := m.sampler.price
+			s2 := m.variance
+			for j := 0; j < steps; j++ {
+				r := math.Sqrt(s2) * rand.NormFloat64()
+				p *= math.Exp(r - s2/2)
+				s2 = m.omega + m.alpha*r*r + m.beta*s2
+			}
+			m.samplePrices[i] = p
+		}
+		m.sampleTime = time
+	}
+	return m.samplePrices
+}
+
+// MertonPriceModel samples the price with a Merton jump-diffusion. The returns beyond
+// threshold times the diffusion volatility, estimated by bipower variation, are the
+// jumps, the diffusion is fitted on the others.
+type MertonPriceModel struct {
+	sync.Mutex
+	selector     string
+	window       int
+	threshold    float64
+	sampler      returnSampler
+	returns      []float64
+	fitted       bool
+	sigma        float64 // Diffusion volatility per interval
+	lambda       float64 // Jump probability per interval
+	jumpMean     float64
+	jumpStd      float64
+	samplePrices []float64
+	sampleTime   uint64
+}
+
+func NewMertonPriceModel(selector string, freq uint64, window int, threshold float64) *MertonPriceModel {
+	return &MertonPriceModel{
+		selector:  selector,
+		window:    window,
+		threshold: threshold,
+		sampler:   returnSampler{freq: freq},
+	}
+}
+
+func (m *MertonPriceModel) Forward(_ int, tick uint64, object interface{}) error {
+	price, err := objectPrice(object)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	m.sampler.update(tick, price, m.onReturn)
+	return nil
+}
+
+func (m *MertonPriceModel) onReturn(r float64) {
+	m.returns = pushReturn(m.returns, r, m.window)
+	if len(m.returns) >= minReturns {
+		m.fit()
+	}
+}
+
+func (m *MertonPriceModel) fit() {
+	n := len(m.returns)
+	var bv float64
+	for i := 1; i < n; i++ {
+		bv += math.Abs(m.returns[i]) * math.Abs(m.returns[i-1])
+	}
+	bv *= math.Pi / 2 / float64(n-1)
+	limit := m.threshold * math.Sqrt(bv)
+
+	var diffusion, jumps, jumpSum, jumpSum2 float64
+	for _, r := range m.returns {
+		if bv > 0 && math.Abs(r) > limit {
+			jumps += 1
+			jumpSum += r
+			jumpSum2 += r * r
+		} else {
+			diffusion += r * r
+		}
+	}
+	if jumps == float64(n) {
+		return
+	}
+	m.sigma = math.Sqrt(diffusion / (float64(n) - jumps))
+	m.lambda = jumps / float64(n)
+	m.jumpMean, m.jumpStd = 0, 0
+	if jumps > 0 {
+		m.jumpMean = jumpSum / jumps
+		m.jumpStd = math.Sqrt(math.Max(jumpSum2/jumps-m.jumpMean*m.jumpMean, 0))
+	}
+	m.fitted = true
+}
+
+func (m *MertonPriceModel) Backward(_ uint64, _ interface{}) {
+
+}
+
+func (m *MertonPriceModel) Ready() bool {
+	m.Lock()
+	defer m.Unlock()
+	return m.fitted
+}
+
+func (m *MertonPriceModel) Frequency() uint64 {
+	return m.sampler.freq
+}
+
+func (m *MertonPriceModel) GetSelectors() []string {
+	return []string{m.selector}
+}
+
+func (m *MertonPriceModel) WarmUp(_ int) uint64 {
+	return uint64(m.window) * m.sampler.freq
+}
+
+func (m *MertonPriceModel) GetParams() (sigma, lambda, jumpMean, jumpStd float64) {
+	m.Lock()
+	defer m.Unlock()
+	return m.sigma, m.lambda, m.jumpMean, m.jumpStd
+}
+
+type mertonSnapshot struct {
+	Sampler  samplerSnapshot
+	Returns  []float64
+	Fitted   bool
+	Sigma    float64
+	Lambda   float64
+	JumpMean float64
+	JumpStd  float64
+}
+
+// Snapshot returns the window of returns and the fitted parameters
+func (m *MertonPriceModel) Snapshot() ([]byte, error) {
+	m.Lock()
+	defer m.Unlock()
+	return json.Marshal(mertonSnapshot{
+		Sampler:  m.sampler.snapshot(),
+		Returns:  m.returns,
+		Fitted:   m.fitted,
+		Sigma:    m.sigma,
+		Lambda:   m.lambda,
+		JumpMean: m.jumpMean,
+		JumpStd:  m.jumpStd,
+	})
+}
+
+func (m *MertonPriceModel) Restore(snapshot []byte) error {
+	var snap mertonSnapshot
+	if err := json.Unmarshal(snapshot, &snap); err != nil {
+		return fmt.Errorf("error unmarshalling Merton snapshot: %v", err)
+	}
+	m.Lock()
+	defer m.Unlock()
+	m.sampler.restore(snap.Sampler)
+	m.returns = snap.Returns
+	m.fitted = snap.Fitted
+	m.sigma, m.lambda, m.jumpMean, m.jumpStd = snap.Sigma, snap.Lambda, snap.JumpMean, snap.JumpStd
+	m.samplePrices = nil
+	return nil
+}
+
+func (m *MertonPriceModel) GetPrice(_ uint64) (float64, bool) {
+	m.Lock()
+	defer m.Unlock()
+	return m.sampler.price, m.sampler.price > 0
+}
+
+func (m *MertonPriceModel) GetSamplePrices(_ uint64, time uint64, sampleSize int) []float64 {
+	m.Lock()
+	defer m.Unlock()
+	if m.samplePrices == nil || len(m.samplePrices) != sampleSize || m.sampleTime != time {
+		steps := m.sampler.steps(time)
+		// Compensated for the price to be a martingale
+		drift := -m.sigma*m.sigma/2 - m.lambda*(math.Exp(m.jumpMean+m.jumpStd*m.jumpStd/2)-1)
+		m.samplePrices = make([]float64, sampleSize)
+		for i := 0; i < sampleSize; i++ {
+			var r float64
+			for j := 0; j < steps; j++ {
+				r += drift + m.sigma*rand.NormFloat64()
+				for k := poisson(m.lambda); k > 0; k-- {
+					r += m.jumpMean + m.jumpStd*rand.NormFloat64()
+				}
+			}
+			m.samplePrices[i] = m.sampler.price * math.Exp(r)
+		}
+		m.sampleTime = time
+	}
+	return m.samplePrices
+}
+
+func poisson(lambda float64) int {
+	if lambda <= 0 {
+		return 0
+	}
+	l := math.Exp(-lambda)
+	k := 0
+	for p := rand.Float64(); p > l; p *= rand.Float64() {
+		k += 1
+	}
+	return k
+}
+
+// hawkesProcess is a self-exciting process of intensity mu + alpha*beta*sum(exp(-beta*(t-ti)))
+// over the past events, alpha being the branching ratio. The times are in seconds.
+type hawkesProcess struct {
+	times      []float64
+	sizes      []float64
+	mu         float64
+	alpha      float64
+	beta       float64
+	excitation float64 // sum(exp(-beta*(t-ti))) at the last event
+	fitted     bool
+}
+
+func (p *hawkesProcess) add(t, size, window float64) {
+	if n := len(p.times); n > 0 {
+		p.excitation *= math.Exp(-p.beta * (t - p.times[n-1]))
+	}
+	p.excitation += 1
+	p.times = append(p.times, t)
+	p.sizes = append(p.sizes, size)
+	i := 0
+	for i < len(p.times) && p.times[i] < t-window {
+		i += 1
+	}
+	p.times = p.times[i:]
+	p.sizes = p.sizes[i:]
+}
+
+// hawkesSnapshot is the state of a Hawkes process, the exported fields being marshalled
+type hawkesSnapshot struct {
+	Times      []float64
+	Sizes      []float64
+	Mu         float64
+	Alpha      float64
+	Beta       float64
+	Excitation float64
+	Fitted     bool
+}
+
+func (p *hawkesProcess) snapshot() hawkesSnapshot {
+	return hawkesSnapshot{
+		Times:      p.times,
+		Sizes:      p.sizes,
+		Mu:         p.mu,
+		Alpha:      p.alpha,
+		Beta:       p.beta,
+		Excitation: p.excitation,
+		Fitted:     p.fitted,
+	}
+}
+
+func (p *hawkesProcess) restore(snapshot hawkesSnapshot) {
+	p.times = snapshot.Times
+	p.sizes = snapshot.Sizes
+	p.mu, p.alpha, p.beta = snapshot.Mu, snapshot.Alpha, snapshot.Beta
+	p.excitation = snapshot.Excitation
+	p.fitted = snapshot.Fitted
+}
+
+// fit runs the EM iterations on the events up to now, the triggering
+// sums being computed recursively thanks to the exponential kernel.
+func (p *hawkesProcess) fit(now float64) {
+	n := len(p.times)
+	if n < minTradeEvents {
+		return
+	}
+	length := now - p.times[0]
+	if length <= 0 {
+		return
+	}
+	if !p.fitted {
+		p.mu = float64(n) / length / 2
+		p.alpha = 0.5
+		p.beta = 1
+	}
+	for it := 0; it < emIterations; it++ {
+		var A, B, background, triggered, triggeredDt, compensator float64
+		for i, t := range p.times {
+			if i > 0 {
+				d := t - p.times[i-1]
+				e := math.Exp(-p.beta * d)
+				B = e * (B + d*(A+1))
+				A = e * (A + 1)
+			}
+			trigger := p.alpha * p.beta * A
+			intensity := p.mu + trigger
+			background += p.mu / intensity
+			triggered += trigger / intensity
+			triggeredDt += p.alpha * p.beta * B / intensity
+			compensator += 1 - math.Exp(-p.beta*(now-t))
+		}
+		p.mu = background / length
+		if compensator > 0 {
+			p.alpha = math.Min(triggered/compensator, maxBranchingRatio)
+		}
+		if triggeredDt > 0 {
+			p.beta = triggered / triggeredDt
+		}
+	}
+	p.excitation = 0
+	last := p.times[n-1]
+	for _, t := range p.times {
+		p.excitation += math.Exp(-p.beta * (last - t))
+	}
+	p.fitted = true
+}
+
+// sample simulates the volume traded between now and the horizon, by thinning
+func (p *hawkesProcess) sample(now, horizon float64) float64 {
+	if horizon <= 0 || len(p.times) == 0 {
+		return 0
+	}
+	S := p.excitation * math.Exp(-p.beta*(now-p.times[len(p.times)-1]))
+	var t, volume float64
+	for events := 0; events < maxSampleEvents; {
+		bound := p.mu + p.alpha*p.beta*S
+		if bound <= 0 {
+			break
+		}
+		w := rand.ExpFloat64() / bound
+		t += w
+		if t > horizon {
+			break
+		}
+		S *= math.Exp(-p.beta * w)
+		if rand.Float64()*bound <= p.mu+p.alpha*p.beta*S {
+			S += 1
+			volume += p.sizes[rand.Intn(len(p.sizes))]
+			events += 1
+		}
+	}
+	return volume
+}
+
+// HawkesTradeModel samples the volume matched on each side with Hawkes processes of the buy
+// and sell trades, refitted at the model frequency, the sizes are drawn from the window.
+// It only sees the trades: the samples do not depend on the book, so the volume matched
+// at a price is the same whatever its depth and distance to the top of the book.
+type HawkesTradeModel struct {
+	sync.Mutex
+	selectors      []string // Buy trades then sell trades
+	freq           uint64
+	window         uint64
+	time           uint64
+	fitTime        uint64
+	buys           *hawkesProcess
+	sells          *hawkesProcess
+	sampleMatchAsk []float64
+	sampleAskTime  uint64
+	sampleMatchBid []float64
+	sampleBidTime  uint64
+}
+
+func NewHawkesTradeModel(buySelector, sellSelector string, freq, window uint64) *HawkesTradeModel {
+	return &HawkesTradeModel{
+		selectors: []string{buySelector, sellSelector},
+		freq:      freq,
+		window:    window,
+		buys:      &hawkesProcess{},
+		sells:     &hawkesProcess{},
+	}
+}
+
+func (m *HawkesTradeModel) Forward(selector int, tick uint64, object interface{}) error {
+	trade, ok := object.(tickobjects.TradeObject)
+	if !ok {
+		return fmt.Errorf("unsupported object type %s", reflect.TypeOf(object))
+	}
+	m.Lock()
+	defer m.Unlock()
+	p := m.buys
+	if selector == 1 {
+		p = m.sells
+	}
+	p.add(float64(tick)/1000, trade.Size(), float64(m.window)/1000)
+	if tick > m.time {
+		m.time = tick
+	}
+	if m.time >= m.fitTime+m.freq {
+		m.buys.fit(float64(m.time) / 1000)
+		m.sells.fit(float64(m.time) / 1000)
+		m.fitTime = m.time
+	}
+	return nil
+}
+
+func (m *HawkesTradeModel) Backward(_ uint64, _ interface{}) {
+
+}
+
+func (m *HawkesTradeModel) Ready() bool {
+	m.Lock()
+	defer m.Unlock()
+	return m.buys.fitted && m.sells.fitted
+}
+
+func (m *HawkesTradeModel) Frequency() uint64 {
+	return m.freq
+}
+
+func (m *HawkesTradeModel) GetSelectors() []string {
+	return m.selectors
+}
+
+func (m *HawkesTradeModel) WarmUp(_ int) uint64 {
+	return m.window
+}
+
+// GetParams returns mu, alpha and beta of the buy and sell processes
+func (m *HawkesTradeModel) GetParams() (buys, sells [3]float64) {
+	m.Lock()
+	defer m.Unlock()
+	return [3]float64{m.buys.mu, m.buys.alpha, m.buys.beta}, [3]float64{m.sells.mu, m.sells.alpha, m.sells.beta}
+}
+
+type hawkesTradeSnapshot struct {
+	Time    uint64
+	FitTime uint64
+	Buys    hawkesSnapshot
+	Sells   hawkesSnapshot
+}
+
+// Snapshot returns the trades of the window and the fitted processes
+func (m *HawkesTradeModel) Snapshot() ([]byte, error) {
+	m.Lock()
+	defer m.Unlock()
+	return json.Marshal(hawkesTradeSnapshot{
+		Time:    m.time,
+		FitTime: m.fitTime,
+		Buys:    m.buys.snapshot(),
+		Sells:   m.sells.snapshot(),
+	})
+}
+
+func (m *HawkesTradeModel) Restore(snapshot []byte) error {
+	var snap hawkesTradeSnapshot
+	if err := json.Unmarshal(snapshot, &snap); err != nil {
+		return fmt.Errorf("error unmarshalling Hawkes snapshot: %v", err)
+	}
+	m.Lock()
+	defer m.Unlock()
+	m.time = snap.Time
+	m.fitTime = snap.FitTime
+	m.buys.restore(snap.Buys)
+	m.sells.restore(snap.Sells)
+	m.sampleMatchAsk = nil
+	m.sampleMatchBid = nil
+	return nil
+}
+
+func (m *HawkesTradeModel) samples(p *hawkesProcess, time uint64, sampleSize int) []float64 {
+	samples := make([]float64, sampleSize)
+	if time <= m.time {
+		return samples
+	}
+	now := float64(m.time) / 1000
+	horizon := float64(time-m.time) / 1000
+	for i := range samples {
+		samples[i] = p.sample(now, horizon)
+	}
+	return samples
+}
+
+func (m *HawkesTradeModel) GetSampleMatchAsk(_ uint64, time uint64, sampleSize int) []float64 {
+	m.Lock()
+	defer m.Unlock()
+	if m.sampleMatchAsk == nil || len(m.sampleMatchAsk) != sampleSize || m.sampleAskTime != time {
+		m.sampleMatchAsk = m.samples(m.buys, time, sampleSize)
+		m.sampleAskTime = time
+	}
+	return m.sampleMatchAsk
+}
+
+func (m *HawkesTradeModel) GetSampleMatchBid(_ uint64, time uint64, sampleSize int) []float64 {
+	m.Lock()
+	defer m.Unlock()
+	if m.sampleMatchBid == nil || len(m.sampleMatchBid) != sampleSize || m.sampleBidTime != time {
+		m.sampleMatchBid = m.samples(m.sells, time, sampleSize)
+		m.sampleBidTime = time
+	}
+	return m.sampleMatchBid
+}
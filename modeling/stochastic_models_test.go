@@ -0,0 +1,229 @@
+package modeling
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"testing"
+
+	"gitlab.com/alphaticks/tickstore-types/tickobjects"
+)
+
+func TestGARCHPriceModel(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	model := NewGARCHPriceModel("", 1000, 3000)
+	omega, alpha, beta := 1e-6, 0.1, 0.85
+	price, s2 := 100., omega/(1-alpha-beta)
+	for i := 0; i < 4000; i++ {
+		if err := model.Forward(0, uint64(i)*1000, tickobjects.NewTrade(price, 1)); err != nil {
+			t.Fatal(err)
+		}
+		r := math.Sqrt(s2) * rng.NormFloat64()
+		price *= math.Exp(r)
+		s2 = omega + alpha*r*r + beta*s2
+	}
+	if !model.Ready() {
+		t.Fatalf("model not ready")
+	}
+	_, a, b := model.GetParams()
+	if math.Abs(a-alpha) > 0.05 || math.Abs(b-beta) > 0.07 {
+		t.Fatalf("unexpected fit alpha %f beta %f", a, b)
+	}
+	p, _ := model.GetPrice(0)
+	samples := model.GetSamplePrices(0, 4000*1000, 10000)
+	var mean float64
+	for _, s := range samples {
+		mean += s / float64(len(samples))
+	}
+	if math.Abs(mean/p-1) > 0.005 {
+		t.Fatalf("was expecting a martingale, got %f for %f", mean, p)
+	}
+}
+
+func TestMertonPriceModel(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	model := NewMertonPriceModel("", 1000, 10000, 4)
+	sigma, lambda, jumpMean, jumpStd := 0.001, 0.02, 0.01, 0.002
+	price := 100.
+	for i := 0; i < 10001; i++ {
+		if err := model.Forward(0, uint64(i)*1000, tickobjects.NewTrade(price, 1)); err != nil {
+			t.Fatal(err)
+		}
+		r := sigma * rng.NormFloat64()
+		if rng.Float64() < lambda {
+			r += jumpMean + jumpStd*rng.NormFloat64()
+		}
+		price *= math.Exp(r)
+	}
+	s, l, jm, js := model.GetParams()
+	if math.Abs(s-sigma) > 0.0001 || math.Abs(l-lambda) > 0.005 || math.Abs(jm-jumpMean) > 0.001 || math.Abs(js-jumpStd) > 0.001 {
+		t.Fatalf("unexpected fit sigma %f lambda %f jump mean %f jump std %f", s, l, jm, js)
+	}
+	samples := model.GetSamplePrices(0, 10000*1000+60000, 10000)
+	var mean float64
+	for _, s := range samples {
+		mean += s / float64(len(samples))
+	}
+	p, _ := model.GetPrice(0)
+	if math.Abs(mean/p-1) > 0.005 {
+		t.Fatalf("was expecting a martingale, got %f for %f", mean, p)
+	}
+}
+
+// simulateHawkes returns the event times of a Hawkes process, by thinning
+func simulateHawkes(rng *rand.Rand, mu, alpha, beta, horizon float64) []float64 {
+	var times []float64
+	var t, S float64
+	for {
+		bound := mu + alpha*beta*S
+		w := rng.ExpFloat64() / bound
+		t += w
+		if t > horizon {
+			return times
+		}
+		S *= math.Exp(-beta * w)
+		if rng.Float64()*bound <= mu+alpha*beta*S {
+			S += 1
+			times = append(times, t)
+		}
+	}
+}
+
+// newSellTrade builds a sell trade from its snapshot, its fields being unexported
+func newSellTrade(t *testing.T, price, size float64) *tickobjects.SellTrade {
+	w := &bytes.Buffer{}
+	_ = binary.Write(w, binary.LittleEndian, price)
+	_ = binary.Write(w, binary.LittleEndian, size)
+	trade := &tickobjects.SellTrade{}
+	if err := trade.FromSnapshot(w.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	return trade
+}
+
+func TestHawkesTradeModel(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	model := NewHawkesTradeModel("buys", "sells", 60000, 3600000)
+	buys := simulateHawkes(rng, 1, 0.5, 2, 3600)
+	sells := simulateHawkes(rng, 0.5, 0.2, 5, 3600)
+	for len(buys) > 0 || len(sells) > 0 {
+		if len(sells) == 0 || (len(buys) > 0 && buys[0] < sells[0]) {
+			if err := model.Forward(0, uint64(buys[0]*1000), tickobjects.NewBuyTrade(100, 1)); err != nil {
+				t.Fatal(err)
+			}
+			buys = buys[1:]
+		} else {
+			if err := model.Forward(1, uint64(sells[0]*1000), newSellTrade(t, 100, 2)); err != nil {
+				t.Fatal(err)
+			}
+			sells = sells[1:]
+		}
+	}
+	if !model.Ready() {
+		t.Fatalf("model not ready")
+	}
+	b, s := model.GetParams()
+	if math.Abs(b[0]-1) > 0.2 || math.Abs(b[1]-0.5) > 0.1 || math.Abs(b[2]-2) > 0.6 {
+		t.Fatalf("unexpected buy fit %v", b)
+	}
+	if math.Abs(s[0]-0.5) > 0.1 || math.Abs(s[1]-0.2) > 0.1 {
+		t.Fatalf("unexpected sell fit %v", s)
+	}
+	// Stationary volume over 100s: 100 * mu / (1 - alpha) * size
+	mean := func(samples []float64) float64 {
+		var m float64
+		for _, s := range samples {
+			m += s / float64(len(samples))
+		}
+		return m
+	}
+	asks := mean(model.GetSampleMatchAsk(0, 3600000+100000, 1000))
+	bids := mean(model.GetSampleMatchBid(0, 3600000+100000, 1000))
+	if math.Abs(asks-200) > 40 || math.Abs(bids-125) > 25 {
+		t.Fatalf("unexpected matched volumes %f %f", asks, bids)
+	}
+}
+
+// restoreSnapshot restores the snapshot of the model in the other, checking the round trip
+func restoreSnapshot(t *testing.T, model, restored Snapshotter) {
+	t.Helper()
+	snapshot, err := model.Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := restored.Restore(snapshot); err != nil {
+		t.Fatal(err)
+	}
+	again, err := restored.Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(snapshot, again) {
+		t.Fatalf("snapshot changed by the round trip")
+	}
+	if restored.Ready() != model.Ready() {
+		t.Fatalf("was expecting ready %t after restore", model.Ready())
+	}
+}
+
+func TestStochasticModelsSnapshot(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	prices := make([]float64, 600)
+	price := 100.
+	for i := range prices {
+		prices[i] = price
+		price *= math.Exp(0.001 * rng.NormFloat64())
+	}
+	forward := func(models []Model, from, to int) {
+		for i := from; i < to; i++ {
+			for _, m := range models {
+				if err := m.Forward(0, uint64(i)*1000, tickobjects.NewTrade(prices[i], 1)); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+	}
+
+	garch, garchRestored := NewGARCHPriceModel("", 1000, 300), NewGARCHPriceModel("", 1000, 300)
+	forward([]Model{garch}, 0, 400)
+	restoreSnapshot(t, garch, garchRestored)
+	forward([]Model{garch, garchRestored}, 400, 600)
+	w1, a1, b1 := garch.GetParams()
+	w2, a2, b2 := garchRestored.GetParams()
+	if w1 != w2 || a1 != a2 || b1 != b2 {
+		t.Fatalf("was expecting the same GARCH fit, got %f %f %f and %f %f %f", w1, a1, b1, w2, a2, b2)
+	}
+
+	merton, mertonRestored := NewMertonPriceModel("", 1000, 300, 4), NewMertonPriceModel("", 1000, 300, 4)
+	forward([]Model{merton}, 0, 400)
+	restoreSnapshot(t, merton, mertonRestored)
+	forward([]Model{merton, mertonRestored}, 400, 600)
+	s1, l1, _, _ := merton.GetParams()
+	s2, l2, _, _ := mertonRestored.GetParams()
+	if s1 != s2 || l1 != l2 {
+		t.Fatalf("was expecting the same Merton fit, got %f %f and %f %f", s1, l1, s2, l2)
+	}
+
+	hawkes, hawkesRestored := NewHawkesTradeModel("buys", "sells", 60000, 3600000), NewHawkesTradeModel("buys", "sells", 60000, 3600000)
+	times := simulateHawkes(rng, 1, 0.5, 2, 600)
+	for i, time := range times {
+		if i == len(times)/2 {
+			restoreSnapshot(t, hawkes, hawkesRestored)
+		}
+		models := []Model{hawkes}
+		if i >= len(times)/2 {
+			models = append(models, hawkesRestored)
+		}
+		for _, m := range models {
+			if err := m.Forward(i%2, uint64(time*1000), tickobjects.NewBuyTrade(100, 1)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	b1s, _ := hawkes.GetParams()
+	b2s, _ := hawkesRestored.GetParams()
+	if !hawkes.Ready() || b1s != b2s {
+		t.Fatalf("was expecting the same Hawkes fit, got %v and %v", b1s, b2s)
+	}
+}
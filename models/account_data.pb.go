@@ -32,6 +32,9 @@ type Account struct {
 	ApiCredentials   *models.APICredentials   `protobuf:"bytes,4,opt,name=api_credentials,json=apiCredentials,proto3" json:"api_credentials,omitempty"`
 	StarkCredentials *models.STARKCredentials `protobuf:"bytes,5,opt,name=stark_credentials,json=starkCredentials,proto3" json:"stark_credentials,omitempty"`
 	EcdsaCredentials *models.ECDSACredentials `protobuf:"bytes,6,opt,name=ecdsa_credentials,json=ecdsaCredentials,proto3" json:"ecdsa_credentials,omitempty"`
+	// On-chain wallets have a chain and an address in place of an exchange
+	Chain   *models.Chain `protobuf:"bytes,7,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address string        `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
 }
 
 func (x *Account) Reset() {
@@ -108,6 +111,20 @@ func (x *Account) GetEcdsaCredentials() *models.ECDSACredentials {
 	return nil
 }
 
+func (x *Account) GetChain() *models.Chain {
+	if x != nil {
+		return x.Chain
+	}
+	return nil
+}
+
+func (x *Account) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
 var File_account_data_proto protoreflect.FileDescriptor
 
 var file_account_data_proto_rawDesc = []byte{
@@ -116,7 +133,7 @@ var file_account_data_proto_rawDesc = []byte{
 	0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x74, 0x69,
 	0x63, 0x6b, 0x73, 0x2f, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x2f, 0x6d, 0x6f, 0x64,
 	0x65, 0x6c, 0x73, 0x2f, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xb8, 0x02, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
+	0x74, 0x6f, 0x22, 0xf7, 0x02, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
 	0x0a, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x12, 0x12, 0x0a, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
@@ -135,11 +152,15 @@ var file_account_data_proto_rawDesc = []byte{
 	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x45, 0x43, 0x44, 0x53, 0x41,
 	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x10, 0x65, 0x63, 0x64,
-	0x73, 0x61, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x42, 0x2c, 0x5a,
-	0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x73, 0x61, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x23, 0x0a,
+	0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x2c, 0x5a, 0x2a,
+	0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x74, 0x69, 0x63, 0x6b, 0x73, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -161,17 +182,19 @@ var file_account_data_proto_goTypes = []interface{}{
 	(*models.APICredentials)(nil),   // 2: models.APICredentials
 	(*models.STARKCredentials)(nil), // 3: models.STARKCredentials
 	(*models.ECDSACredentials)(nil), // 4: models.ECDSACredentials
+	(*models.Chain)(nil),            // 5: models.Chain
 }
 var file_account_data_proto_depIdxs = []int32{
 	1, // 0: models.Account.exchange:type_name -> models.Exchange
 	2, // 1: models.Account.api_credentials:type_name -> models.APICredentials
 	3, // 2: models.Account.stark_credentials:type_name -> models.STARKCredentials
 	4, // 3: models.Account.ecdsa_credentials:type_name -> models.ECDSACredentials
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	5, // 4: models.Account.chain:type_name -> models.Chain
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_account_data_proto_init() }
@@ -38,6 +38,11 @@ const (
 	StatType_OpenInterest            StatType = 9
 	StatType_FundingRate             StatType = 10
 	StatType_MarkPrice               StatType = 11
+	StatType_BookHealth              StatType = 12
+	// Gas stats of a chain, they have a chainID and no securityID
+	StatType_BaseFee          StatType = 13
+	StatType_PriorityFee      StatType = 14
+	StatType_BlockUtilization StatType = 15
 )
 
 // Enum value maps for StatType.
@@ -55,6 +60,10 @@ var (
 		9:  "OpenInterest",
 		10: "FundingRate",
 		11: "MarkPrice",
+		12: "BookHealth",
+		13: "BaseFee",
+		14: "PriorityFee",
+		15: "BlockUtilization",
 	}
 	StatType_value = map[string]int32{
 		"IndexValue":              0,
@@ -69,6 +78,10 @@ var (
 		"OpenInterest":            9,
 		"FundingRate":             10,
 		"MarkPrice":               11,
+		"BookHealth":              12,
+		"BaseFee":                 13,
+		"PriorityFee":             14,
+		"BlockUtilization":        15,
 	}
 )
 
@@ -148,6 +161,58 @@ func (OrderBookAggregation) EnumDescriptor() ([]byte, []int) {
 	return file_market_data_proto_rawDescGZIP(), []int{1}
 }
 
+type BarType int32
+
+const (
+	BarType_TimeBar   BarType = 0
+	BarType_TickBar   BarType = 1
+	BarType_VolumeBar BarType = 2
+	BarType_DollarBar BarType = 3
+)
+
+// Enum value maps for BarType.
+var (
+	BarType_name = map[int32]string{
+		0: "TimeBar",
+		1: "TickBar",
+		2: "VolumeBar",
+		3: "DollarBar",
+	}
+	BarType_value = map[string]int32{
+		"TimeBar":   0,
+		"TickBar":   1,
+		"VolumeBar": 2,
+		"DollarBar": 3,
+	}
+)
+
+func (x BarType) Enum() *BarType {
+	p := new(BarType)
+	*p = x
+	return p
+}
+
+func (x BarType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BarType) Descriptor() protoreflect.EnumDescriptor {
+	return file_market_data_proto_enumTypes[2].Descriptor()
+}
+
+func (BarType) Type() protoreflect.EnumType {
+	return &file_market_data_proto_enumTypes[2]
+}
+
+func (x BarType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BarType.Descriptor instead.
+func (BarType) EnumDescriptor() ([]byte, []int) {
+	return file_market_data_proto_rawDescGZIP(), []int{2}
+}
+
 type OBL1Update struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -416,6 +481,85 @@ func (x *OBL2Snapshot) GetLotPrecision() *wrapperspb.UInt64Value {
 	return nil
 }
 
+type VenueLevel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price      float64 `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity   float64 `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Bid        bool    `protobuf:"varint,3,opt,name=bid,proto3" json:"bid,omitempty"`
+	SecurityID uint64  `protobuf:"varint,4,opt,name=securityID,proto3" json:"securityID,omitempty"`
+	VenuePrice float64 `protobuf:"fixed64,5,opt,name=venue_price,json=venuePrice,proto3" json:"venue_price,omitempty"`
+}
+
+func (x *VenueLevel) Reset() {
+	*x = VenueLevel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_market_data_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VenueLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VenueLevel) ProtoMessage() {}
+
+func (x *VenueLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_market_data_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VenueLevel.ProtoReflect.Descriptor instead.
+func (*VenueLevel) Descriptor() ([]byte, []int) {
+	return file_market_data_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VenueLevel) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *VenueLevel) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *VenueLevel) GetBid() bool {
+	if x != nil {
+		return x.Bid
+	}
+	return false
+}
+
+func (x *VenueLevel) GetSecurityID() uint64 {
+	if x != nil {
+		return x.SecurityID
+	}
+	return 0
+}
+
+func (x *VenueLevel) GetVenuePrice() float64 {
+	if x != nil {
+		return x.VenuePrice
+	}
+	return 0
+}
+
 type OBL3Update struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -431,7 +575,7 @@ type OBL3Update struct {
 func (x *OBL3Update) Reset() {
 	*x = OBL3Update{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_market_data_proto_msgTypes[4]
+		mi := &file_market_data_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -444,7 +588,7 @@ func (x *OBL3Update) String() string {
 func (*OBL3Update) ProtoMessage() {}
 
 func (x *OBL3Update) ProtoReflect() protoreflect.Message {
-	mi := &file_market_data_proto_msgTypes[4]
+	mi := &file_market_data_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -457,7 +601,7 @@ func (x *OBL3Update) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OBL3Update.ProtoReflect.Descriptor instead.
 func (*OBL3Update) Descriptor() ([]byte, []int) {
-	return file_market_data_proto_rawDescGZIP(), []int{4}
+	return file_market_data_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *OBL3Update) GetBids() []*gorderbook_models.Order {
@@ -508,7 +652,7 @@ type OBL3Snapshot struct {
 func (x *OBL3Snapshot) Reset() {
 	*x = OBL3Snapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_market_data_proto_msgTypes[5]
+		mi := &file_market_data_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -521,7 +665,7 @@ func (x *OBL3Snapshot) String() string {
 func (*OBL3Snapshot) ProtoMessage() {}
 
 func (x *OBL3Snapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_market_data_proto_msgTypes[5]
+	mi := &file_market_data_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -534,7 +678,7 @@ func (x *OBL3Snapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OBL3Snapshot.ProtoReflect.Descriptor instead.
 func (*OBL3Snapshot) Descriptor() ([]byte, []int) {
-	return file_market_data_proto_rawDescGZIP(), []int{5}
+	return file_market_data_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *OBL3Snapshot) GetBids() []*gorderbook_models.Order {
@@ -581,7 +725,7 @@ type UPV3Snapshot struct {
 func (x *UPV3Snapshot) Reset() {
 	*x = UPV3Snapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_market_data_proto_msgTypes[6]
+		mi := &file_market_data_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -594,7 +738,7 @@ func (x *UPV3Snapshot) String() string {
 func (*UPV3Snapshot) ProtoMessage() {}
 
 func (x *UPV3Snapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_market_data_proto_msgTypes[6]
+	mi := &file_market_data_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -607,7 +751,7 @@ func (x *UPV3Snapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UPV3Snapshot.ProtoReflect.Descriptor instead.
 func (*UPV3Snapshot) Descriptor() ([]byte, []int) {
-	return file_market_data_proto_rawDescGZIP(), []int{6}
+	return file_market_data_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UPV3Snapshot) GetTicks() []*gorderbook_models.UPV3Tick {
@@ -721,7 +865,7 @@ type UPV3Update struct {
 func (x *UPV3Update) Reset() {
 	*x = UPV3Update{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_market_data_proto_msgTypes[7]
+		mi := &file_market_data_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -734,7 +878,7 @@ func (x *UPV3Update) String() string {
 func (*UPV3Update) ProtoMessage() {}
 
 func (x *UPV3Update) ProtoReflect() protoreflect.Message {
-	mi := &file_market_data_proto_msgTypes[7]
+	mi := &file_market_data_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -747,7 +891,7 @@ func (x *UPV3Update) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UPV3Update.ProtoReflect.Descriptor instead.
 func (*UPV3Update) Descriptor() ([]byte, []int) {
-	return file_market_data_proto_rawDescGZIP(), []int{7}
+	return file_market_data_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UPV3Update) GetInitialize() *gorderbook_models.UPV3Initialize {
@@ -828,12 +972,17 @@ type ProtocolAssetUpdate struct {
 	Transfers   []*gorderbook_models.AssetTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
 	BlockNumber uint64                             `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
 	BlockTime   *timestamppb.Timestamp             `protobuf:"bytes,3,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	// The transfers, published previously, were reverted by a chain reorg
+	Removed bool `protobuf:"varint,4,opt,name=removed,proto3" json:"removed,omitempty"`
+	// The token ids of the transfers of non-fungible and multi-token contracts, by transfer index,
+	// the value of multi-token transfers is the amount of the token transferred
+	TokenIds [][]byte `protobuf:"bytes,5,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`
 }
 
 func (x *ProtocolAssetUpdate) Reset() {
 	*x = ProtocolAssetUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_market_data_proto_msgTypes[8]
+		mi := &file_market_data_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -846,7 +995,7 @@ func (x *ProtocolAssetUpdate) String() string {
 func (*ProtocolAssetUpdate) ProtoMessage() {}
 
 func (x *ProtocolAssetUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_market_data_proto_msgTypes[8]
+	mi := &file_market_data_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -859,7 +1008,7 @@ func (x *ProtocolAssetUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtocolAssetUpdate.ProtoReflect.Descriptor instead.
 func (*ProtocolAssetUpdate) Descriptor() ([]byte, []int) {
-	return file_market_data_proto_rawDescGZIP(), []int{8}
+	return file_market_data_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ProtocolAssetUpdate) GetTransfers() []*gorderbook_models.AssetTransfer {
@@ -883,6 +1032,20 @@ func (x *ProtocolAssetUpdate) GetBlockTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ProtocolAssetUpdate) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+func (x *ProtocolAssetUpdate) GetTokenIds() [][]byte {
+	if x != nil {
+		return x.TokenIds
+	}
+	return nil
+}
+
 type Trade struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -896,7 +1059,7 @@ type Trade struct {
 func (x *Trade) Reset() {
 	*x = Trade{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_market_data_proto_msgTypes[9]
+		mi := &file_market_data_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -909,7 +1072,7 @@ func (x *Trade) String() string {
 func (*Trade) ProtoMessage() {}
 
 func (x *Trade) ProtoReflect() protoreflect.Message {
-	mi := &file_market_data_proto_msgTypes[9]
+	mi := &file_market_data_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,7 +1085,7 @@ func (x *Trade) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trade.ProtoReflect.Descriptor instead.
 func (*Trade) Descriptor() ([]byte, []int) {
-	return file_market_data_proto_rawDescGZIP(), []int{9}
+	return file_market_data_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Trade) GetPrice() float64 {
@@ -960,7 +1123,7 @@ type AggregatedTrade struct {
 func (x *AggregatedTrade) Reset() {
 	*x = AggregatedTrade{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_market_data_proto_msgTypes[10]
+		mi := &file_market_data_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -973,7 +1136,7 @@ func (x *AggregatedTrade) String() string {
 func (*AggregatedTrade) ProtoMessage() {}
 
 func (x *AggregatedTrade) ProtoReflect() protoreflect.Message {
-	mi := &file_market_data_proto_msgTypes[10]
+	mi := &file_market_data_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,7 +1149,7 @@ func (x *AggregatedTrade) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AggregatedTrade.ProtoReflect.Descriptor instead.
 func (*AggregatedTrade) Descriptor() ([]byte, []int) {
-	return file_market_data_proto_rawDescGZIP(), []int{10}
+	return file_market_data_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AggregatedTrade) GetBid() bool {
@@ -1032,7 +1195,7 @@ type Liquidation struct {
 func (x *Liquidation) Reset() {
 	*x = Liquidation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_market_data_proto_msgTypes[11]
+		mi := &file_market_data_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1045,7 +1208,7 @@ func (x *Liquidation) String() string {
 func (*Liquidation) ProtoMessage() {}
 
 func (x *Liquidation) ProtoReflect() protoreflect.Message {
-	mi := &file_market_data_proto_msgTypes[11]
+	mi := &file_market_data_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,7 +1221,7 @@ func (x *Liquidation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Liquidation.ProtoReflect.Descriptor instead.
 func (*Liquidation) Descriptor() ([]byte, []int) {
-	return file_market_data_proto_rawDescGZIP(), []int{11}
+	return file_market_data_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Liquidation) GetBid() bool {
@@ -1096,6 +1259,149 @@ func (x *Liquidation) GetQuantity() float64 {
 	return 0
 }
 
+type Bar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpenTime    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	CloseTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	Open        float64                `protobuf:"fixed64,3,opt,name=open,proto3" json:"open,omitempty"`
+	High        float64                `protobuf:"fixed64,4,opt,name=high,proto3" json:"high,omitempty"`
+	Low         float64                `protobuf:"fixed64,5,opt,name=low,proto3" json:"low,omitempty"`
+	Close       float64                `protobuf:"fixed64,6,opt,name=close,proto3" json:"close,omitempty"`
+	Volume      float64                `protobuf:"fixed64,7,opt,name=volume,proto3" json:"volume,omitempty"`
+	QuoteVolume float64                `protobuf:"fixed64,8,opt,name=quote_volume,json=quoteVolume,proto3" json:"quote_volume,omitempty"`
+	BuyVolume   float64                `protobuf:"fixed64,9,opt,name=buy_volume,json=buyVolume,proto3" json:"buy_volume,omitempty"`
+	SellVolume  float64                `protobuf:"fixed64,10,opt,name=sell_volume,json=sellVolume,proto3" json:"sell_volume,omitempty"`
+	BuyCount    uint64                 `protobuf:"varint,11,opt,name=buy_count,json=buyCount,proto3" json:"buy_count,omitempty"`
+	SellCount   uint64                 `protobuf:"varint,12,opt,name=sell_count,json=sellCount,proto3" json:"sell_count,omitempty"`
+	SecurityID  uint64                 `protobuf:"varint,13,opt,name=securityID,proto3" json:"securityID,omitempty"`
+}
+
+func (x *Bar) Reset() {
+	*x = Bar{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_market_data_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bar) ProtoMessage() {}
+
+func (x *Bar) ProtoReflect() protoreflect.Message {
+	mi := &file_market_data_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bar.ProtoReflect.Descriptor instead.
+func (*Bar) Descriptor() ([]byte, []int) {
+	return file_market_data_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Bar) GetOpenTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenTime
+	}
+	return nil
+}
+
+func (x *Bar) GetCloseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CloseTime
+	}
+	return nil
+}
+
+func (x *Bar) GetOpen() float64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *Bar) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *Bar) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *Bar) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *Bar) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *Bar) GetQuoteVolume() float64 {
+	if x != nil {
+		return x.QuoteVolume
+	}
+	return 0
+}
+
+func (x *Bar) GetBuyVolume() float64 {
+	if x != nil {
+		return x.BuyVolume
+	}
+	return 0
+}
+
+func (x *Bar) GetSellVolume() float64 {
+	if x != nil {
+		return x.SellVolume
+	}
+	return 0
+}
+
+func (x *Bar) GetBuyCount() uint64 {
+	if x != nil {
+		return x.BuyCount
+	}
+	return 0
+}
+
+func (x *Bar) GetSellCount() uint64 {
+	if x != nil {
+		return x.SellCount
+	}
+	return 0
+}
+
+func (x *Bar) GetSecurityID() uint64 {
+	if x != nil {
+		return x.SecurityID
+	}
+	return 0
+}
+
 type Stat struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1105,12 +1411,14 @@ type Stat struct {
 	StatType   StatType               `protobuf:"varint,2,opt,name=stat_type,json=statType,proto3,enum=models.StatType" json:"stat_type,omitempty"`
 	Value      float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
 	SecurityID uint64                 `protobuf:"varint,4,opt,name=securityID,proto3" json:"securityID,omitempty"`
+	// The ID of the chain, for the gas stats
+	ChainID uint32 `protobuf:"varint,5,opt,name=chainID,proto3" json:"chainID,omitempty"`
 }
 
 func (x *Stat) Reset() {
 	*x = Stat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_market_data_proto_msgTypes[12]
+		mi := &file_market_data_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1123,7 +1431,7 @@ func (x *Stat) String() string {
 func (*Stat) ProtoMessage() {}
 
 func (x *Stat) ProtoReflect() protoreflect.Message {
-	mi := &file_market_data_proto_msgTypes[12]
+	mi := &file_market_data_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1136,7 +1444,7 @@ func (x *Stat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Stat.ProtoReflect.Descriptor instead.
 func (*Stat) Descriptor() ([]byte, []int) {
-	return file_market_data_proto_rawDescGZIP(), []int{12}
+	return file_market_data_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Stat) GetTimestamp() *timestamppb.Timestamp {
@@ -1167,6 +1475,13 @@ func (x *Stat) GetSecurityID() uint64 {
 	return 0
 }
 
+func (x *Stat) GetChainID() uint32 {
+	if x != nil {
+		return x.ChainID
+	}
+	return 0
+}
+
 type Sale struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1182,7 +1497,7 @@ type Sale struct {
 func (x *Sale) Reset() {
 	*x = Sale{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_market_data_proto_msgTypes[13]
+		mi := &file_market_data_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1195,7 +1510,7 @@ func (x *Sale) String() string {
 func (*Sale) ProtoMessage() {}
 
 func (x *Sale) ProtoReflect() protoreflect.Message {
-	mi := &file_market_data_proto_msgTypes[13]
+	mi := &file_market_data_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1208,7 +1523,7 @@ func (x *Sale) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Sale.ProtoReflect.Descriptor instead.
 func (*Sale) Descriptor() ([]byte, []int) {
-	return file_market_data_proto_rawDescGZIP(), []int{13}
+	return file_market_data_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Sale) GetTransfer() []*gorderbook_models.AssetTransfer {
@@ -1304,192 +1619,241 @@ var file_market_data_proto_rawDesc = []byte{
 	0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6c, 0x6f,
-	0x74, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xaa, 0x02, 0x0a, 0x0a, 0x4f,
-	0x42, 0x4c, 0x33, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x62, 0x69, 0x64,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f,
-	0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x04, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
-	0x43, 0x0a, 0x0e, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x50, 0x72, 0x65, 0x63, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0d, 0x6c, 0x6f, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x63,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x74, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x0a, 0x56,
+	0x65, 0x6e, 0x75, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x62,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x62, 0x69, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x1f, 0x0a,
+	0x0b, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xaa,
+	0x02, 0x0a, 0x0a, 0x4f, 0x42, 0x4c, 0x33, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a,
+	0x04, 0x62, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x61,
+	0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x43, 0x0a, 0x0e, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x70, 0x72, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49,
-	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6c, 0x6f, 0x74, 0x50, 0x72,
-	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x0c, 0x4f, 0x42, 0x4c, 0x33,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62,
-	0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f,
-	0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x04,
-	0x61, 0x73, 0x6b, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xdc,
-	0x04, 0x0a, 0x0c, 0x55, 0x50, 0x56, 0x33, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
-	0x31, 0x0a, 0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x05, 0x74, 0x69, 0x63,
-	0x6b, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f,
-	0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x50, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x71, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x71, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x35,
-	0x0a, 0x17, 0x66, 0x65, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x5f, 0x67, 0x6c, 0x6f,
-	0x62, 0x61, 0x6c, 0x5f, 0x30, 0x78, 0x31, 0x32, 0x38, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x14, 0x66, 0x65, 0x65, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x30, 0x78, 0x31, 0x32, 0x38, 0x12, 0x35, 0x0a, 0x17, 0x66, 0x65, 0x65, 0x5f, 0x67, 0x72, 0x6f,
-	0x77, 0x74, 0x68, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x31, 0x78, 0x31, 0x32, 0x38,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x66, 0x65, 0x65, 0x47, 0x72, 0x6f, 0x77, 0x74,
-	0x68, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x31, 0x78, 0x31, 0x32, 0x38, 0x12, 0x26, 0x0a, 0x0f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x30, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46,
-	0x65, 0x65, 0x73, 0x30, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x31, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x31, 0x12, 0x3a, 0x0a, 0x1a,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x50,
+	0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0d, 0x6c, 0x6f, 0x74, 0x5f,
+	0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6c,
+	0x6f, 0x74, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x0c,
+	0x4f, 0x42, 0x4c, 0x33, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2c, 0x0a, 0x04,
+	0x62, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x73,
+	0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x22, 0xdc, 0x04, 0x0a, 0x0c, 0x55, 0x50, 0x56, 0x33, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x52,
+	0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x55, 0x50,
+	0x56, 0x33, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x71, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x71, 0x72, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x66, 0x65, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68,
+	0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x30, 0x78, 0x31, 0x32, 0x38, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x14, 0x66, 0x65, 0x65, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x47, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x30, 0x78, 0x31, 0x32, 0x38, 0x12, 0x35, 0x0a, 0x17, 0x66, 0x65, 0x65,
+	0x5f, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x31,
+	0x78, 0x31, 0x32, 0x38, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x66, 0x65, 0x65, 0x47,
+	0x72, 0x6f, 0x77, 0x74, 0x68, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x31, 0x78, 0x31, 0x32, 0x38,
+	0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x66, 0x65, 0x65,
+	0x73, 0x5f, 0x30, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x30, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x31, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x31,
+	0x12, 0x3a, 0x0a, 0x1a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x30, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x30, 0x12, 0x3a, 0x0a, 0x1a,
 	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b,
-	0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x30, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c,
+	0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c,
 	0x52, 0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x6f, 0x63, 0x6b,
-	0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x30, 0x12, 0x3a, 0x0a, 0x1a, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x31, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f,
-	0x74, 0x69, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x65, 0x65, 0x54,
-	0x69, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xc4, 0x04,
-	0x0a, 0x0a, 0x55, 0x50, 0x56, 0x33, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x0a,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12,
-	0x2f, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74,
-	0x12, 0x2f, 0x0a, 0x04, 0x62, 0x75, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x31, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08,
+	0x66, 0x65, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x66, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0xc4, 0x04, 0x0a, 0x0a, 0x55, 0x50, 0x56, 0x33, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x41, 0x0a, 0x0a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f,
+	0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x49, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x04,
+	0x6d, 0x69, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x62, 0x75, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x42, 0x75, 0x72, 0x6e, 0x52,
+	0x04, 0x62, 0x75, 0x72, 0x6e, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x53, 0x77, 0x61, 0x70,
+	0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x12, 0x32, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x46, 0x6c, 0x61, 0x73, 0x68, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x73, 0x68, 0x12, 0x4f, 0x0a, 0x10, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
 	0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x04, 0x62, 0x75, 0x72,
-	0x6e, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x53, 0x77, 0x61, 0x70, 0x52, 0x04, 0x73, 0x77,
-	0x61, 0x70, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x32, 0x0a, 0x05,
-	0x66, 0x6c, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x55, 0x50, 0x56, 0x33, 0x46, 0x6c, 0x61, 0x73, 0x68, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x73, 0x68,
-	0x12, 0x4f, 0x0a, 0x10, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x55,
-	0x50, 0x56, 0x33, 0x53, 0x65, 0x74, 0x46, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x52, 0x0e, 0x73, 0x65, 0x74, 0x46, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x12, 0x51, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x55, 0x50, 0x56, 0x33, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x22, 0xb3, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x09,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x39, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x05, 0x54, 0x72,
-	0x61, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x49, 0x44, 0x22, 0xa6, 0x01, 0x0a, 0x0f, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
-	0x61, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x64,
+	0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x53, 0x65, 0x74, 0x46, 0x65, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x0e, 0x73, 0x65, 0x74, 0x46, 0x65, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x51, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x38,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xea, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x3e, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x49, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44,
+	0x22, 0xa6, 0x01, 0x0a, 0x0f, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x03, 0x62, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x49, 0x44, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x0b, 0x4c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x62, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74,
 	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
-	0x74, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72,
-	0x65, 0x67, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0xa5,
-	0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10,
-	0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x62, 0x69, 0x64,
-	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xa5, 0x01, 0x0a, 0x04, 0x53, 0x74, 0x61, 0x74, 0x12,
-	0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2d, 0x0a, 0x09, 0x73, 0x74, 0x61,
-	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
-	0x73, 0x74, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x44, 0x22, 0xba,
-	0x01, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2a, 0xfb, 0x01, 0x0a, 0x08,
-	0x53, 0x74, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x6e,
-	0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x6c,
-	0x6f, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f,
-	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x10,
-	0x03, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x48, 0x69, 0x67, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x10, 0x04, 0x12, 0x1a,
-	0x0a, 0x16, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x4c, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x63, 0x65, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x72,
-	0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x57, 0x41, 0x50,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x6d, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x64, 0x65, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x10, 0x09, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x75, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x10, 0x0a, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x61,
-	0x72, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x10, 0x0b, 0x2a, 0x2e, 0x0a, 0x14, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x31, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x32, 0x10,
-	0x01, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x33, 0x10, 0x02, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74,
-	0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x74, 0x69, 0x63,
-	0x6b, 0x73, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x22, 0xa0, 0x03, 0x0a, 0x03, 0x42, 0x61, 0x72, 0x12, 0x37, 0x0a, 0x09, 0x6f, 0x70, 0x65,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6f, 0x70, 0x65,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x71, 0x75, 0x6f,
+	0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x79, 0x5f,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x62, 0x75,
+	0x79, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x5f,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x65,
+	0x6c, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x79, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x75, 0x79,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x49, 0x44, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x49, 0x44, 0x22, 0xbf, 0x01, 0x0a, 0x04, 0x53, 0x74, 0x61, 0x74, 0x12, 0x38, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2d, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x22, 0xba, 0x01, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x65, 0x12,
+	0x3c, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x67, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2a, 0xbf, 0x02, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x10, 0x00,
+	0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x67, 0x68, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x56, 0x57, 0x41, 0x50, 0x50, 0x72, 0x69, 0x63, 0x65, 0x10, 0x06, 0x12,
+	0x0d, 0x0a, 0x09, 0x49, 0x6d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x10, 0x07, 0x12, 0x0f,
+	0x0a, 0x0b, 0x54, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x10, 0x08, 0x12,
+	0x10, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x10,
+	0x09, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65,
+	0x10, 0x0a, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x61, 0x72, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x10,
+	0x0b, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x6f, 0x6f, 0x6b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x10,
+	0x0c, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x10, 0x0d, 0x12, 0x0f,
+	0x0a, 0x0b, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x10, 0x0e, 0x12,
+	0x14, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x10, 0x0f, 0x2a, 0x2e, 0x0a, 0x14, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f,
+	0x6f, 0x6b, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x06, 0x0a,
+	0x02, 0x4c, 0x31, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x32, 0x10, 0x01, 0x12, 0x06, 0x0a,
+	0x02, 0x4c, 0x33, 0x10, 0x02, 0x2a, 0x41, 0x0a, 0x07, 0x42, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x61, 0x72, 0x10, 0x00, 0x12, 0x0b, 0x0a,
+	0x07, 0x54, 0x69, 0x63, 0x6b, 0x42, 0x61, 0x72, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x42, 0x61, 0x72, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x6f, 0x6c,
+	0x6c, 0x61, 0x72, 0x42, 0x61, 0x72, 0x10, 0x03, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c,
+	0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x74, 0x69, 0x63, 0x6b,
+	0x73, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1504,85 +1868,90 @@ func file_market_data_proto_rawDescGZIP() []byte {
 	return file_market_data_proto_rawDescData
 }
 
-var file_market_data_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_market_data_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_market_data_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_market_data_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_market_data_proto_goTypes = []interface{}{
 	(StatType)(0),                                 // 0: models.StatType
 	(OrderBookAggregation)(0),                     // 1: models.OrderBookAggregation
-	(*OBL1Update)(nil),                            // 2: models.OBL1Update
-	(*OBL1Snapshot)(nil),                          // 3: models.OBL1Snapshot
-	(*OBL2Update)(nil),                            // 4: models.OBL2Update
-	(*OBL2Snapshot)(nil),                          // 5: models.OBL2Snapshot
-	(*OBL3Update)(nil),                            // 6: models.OBL3Update
-	(*OBL3Snapshot)(nil),                          // 7: models.OBL3Snapshot
-	(*UPV3Snapshot)(nil),                          // 8: models.UPV3Snapshot
-	(*UPV3Update)(nil),                            // 9: models.UPV3Update
-	(*ProtocolAssetUpdate)(nil),                   // 10: models.ProtocolAssetUpdate
-	(*Trade)(nil),                                 // 11: models.Trade
-	(*AggregatedTrade)(nil),                       // 12: models.AggregatedTrade
-	(*Liquidation)(nil),                           // 13: models.Liquidation
-	(*Stat)(nil),                                  // 14: models.Stat
-	(*Sale)(nil),                                  // 15: models.Sale
-	(*timestamppb.Timestamp)(nil),                 // 16: google.protobuf.Timestamp
-	(*gorderbook_models.OrderBookLevel)(nil),      // 17: gorderbook.models.OrderBookLevel
-	(*wrapperspb.UInt64Value)(nil),                // 18: google.protobuf.UInt64Value
-	(*gorderbook_models.Order)(nil),               // 19: gorderbook.models.Order
-	(*gorderbook_models.UPV3Tick)(nil),            // 20: gorderbook.models.UPV3Tick
-	(*gorderbook_models.UPV3Position)(nil),        // 21: gorderbook.models.UPV3Position
-	(*gorderbook_models.UPV3Initialize)(nil),      // 22: gorderbook.models.UPV3Initialize
-	(*gorderbook_models.UPV3Mint)(nil),            // 23: gorderbook.models.UPV3Mint
-	(*gorderbook_models.UPV3Burn)(nil),            // 24: gorderbook.models.UPV3Burn
-	(*gorderbook_models.UPV3Swap)(nil),            // 25: gorderbook.models.UPV3Swap
-	(*gorderbook_models.UPV3Collect)(nil),         // 26: gorderbook.models.UPV3Collect
-	(*gorderbook_models.UPV3Flash)(nil),           // 27: gorderbook.models.UPV3Flash
-	(*gorderbook_models.UPV3SetFeeProtocol)(nil),  // 28: gorderbook.models.UPV3SetFeeProtocol
-	(*gorderbook_models.UPV3CollectProtocol)(nil), // 29: gorderbook.models.UPV3CollectProtocol
-	(*gorderbook_models.AssetTransfer)(nil),       // 30: gorderbook.models.AssetTransfer
+	(BarType)(0),                                  // 2: models.BarType
+	(*OBL1Update)(nil),                            // 3: models.OBL1Update
+	(*OBL1Snapshot)(nil),                          // 4: models.OBL1Snapshot
+	(*OBL2Update)(nil),                            // 5: models.OBL2Update
+	(*OBL2Snapshot)(nil),                          // 6: models.OBL2Snapshot
+	(*VenueLevel)(nil),                            // 7: models.VenueLevel
+	(*OBL3Update)(nil),                            // 8: models.OBL3Update
+	(*OBL3Snapshot)(nil),                          // 9: models.OBL3Snapshot
+	(*UPV3Snapshot)(nil),                          // 10: models.UPV3Snapshot
+	(*UPV3Update)(nil),                            // 11: models.UPV3Update
+	(*ProtocolAssetUpdate)(nil),                   // 12: models.ProtocolAssetUpdate
+	(*Trade)(nil),                                 // 13: models.Trade
+	(*AggregatedTrade)(nil),                       // 14: models.AggregatedTrade
+	(*Liquidation)(nil),                           // 15: models.Liquidation
+	(*Bar)(nil),                                   // 16: models.Bar
+	(*Stat)(nil),                                  // 17: models.Stat
+	(*Sale)(nil),                                  // 18: models.Sale
+	(*timestamppb.Timestamp)(nil),                 // 19: google.protobuf.Timestamp
+	(*gorderbook_models.OrderBookLevel)(nil),      // 20: gorderbook.models.OrderBookLevel
+	(*wrapperspb.UInt64Value)(nil),                // 21: google.protobuf.UInt64Value
+	(*gorderbook_models.Order)(nil),               // 22: gorderbook.models.Order
+	(*gorderbook_models.UPV3Tick)(nil),            // 23: gorderbook.models.UPV3Tick
+	(*gorderbook_models.UPV3Position)(nil),        // 24: gorderbook.models.UPV3Position
+	(*gorderbook_models.UPV3Initialize)(nil),      // 25: gorderbook.models.UPV3Initialize
+	(*gorderbook_models.UPV3Mint)(nil),            // 26: gorderbook.models.UPV3Mint
+	(*gorderbook_models.UPV3Burn)(nil),            // 27: gorderbook.models.UPV3Burn
+	(*gorderbook_models.UPV3Swap)(nil),            // 28: gorderbook.models.UPV3Swap
+	(*gorderbook_models.UPV3Collect)(nil),         // 29: gorderbook.models.UPV3Collect
+	(*gorderbook_models.UPV3Flash)(nil),           // 30: gorderbook.models.UPV3Flash
+	(*gorderbook_models.UPV3SetFeeProtocol)(nil),  // 31: gorderbook.models.UPV3SetFeeProtocol
+	(*gorderbook_models.UPV3CollectProtocol)(nil), // 32: gorderbook.models.UPV3CollectProtocol
+	(*gorderbook_models.AssetTransfer)(nil),       // 33: gorderbook.models.AssetTransfer
 }
 var file_market_data_proto_depIdxs = []int32{
-	16, // 0: models.OBL1Update.timestamp:type_name -> google.protobuf.Timestamp
-	16, // 1: models.OBL1Snapshot.timestamp:type_name -> google.protobuf.Timestamp
-	17, // 2: models.OBL2Update.levels:type_name -> gorderbook.models.OrderBookLevel
-	16, // 3: models.OBL2Update.timestamp:type_name -> google.protobuf.Timestamp
-	17, // 4: models.OBL2Snapshot.bids:type_name -> gorderbook.models.OrderBookLevel
-	17, // 5: models.OBL2Snapshot.asks:type_name -> gorderbook.models.OrderBookLevel
-	16, // 6: models.OBL2Snapshot.timestamp:type_name -> google.protobuf.Timestamp
-	18, // 7: models.OBL2Snapshot.tick_precision:type_name -> google.protobuf.UInt64Value
-	18, // 8: models.OBL2Snapshot.lot_precision:type_name -> google.protobuf.UInt64Value
-	19, // 9: models.OBL3Update.bids:type_name -> gorderbook.models.Order
-	19, // 10: models.OBL3Update.asks:type_name -> gorderbook.models.Order
-	16, // 11: models.OBL3Update.timestamp:type_name -> google.protobuf.Timestamp
-	18, // 12: models.OBL3Update.tick_precision:type_name -> google.protobuf.UInt64Value
-	18, // 13: models.OBL3Update.lot_precision:type_name -> google.protobuf.UInt64Value
-	19, // 14: models.OBL3Snapshot.bids:type_name -> gorderbook.models.Order
-	19, // 15: models.OBL3Snapshot.asks:type_name -> gorderbook.models.Order
-	16, // 16: models.OBL3Snapshot.timestamp:type_name -> google.protobuf.Timestamp
-	20, // 17: models.UPV3Snapshot.ticks:type_name -> gorderbook.models.UPV3Tick
-	21, // 18: models.UPV3Snapshot.positions:type_name -> gorderbook.models.UPV3Position
-	16, // 19: models.UPV3Snapshot.timestamp:type_name -> google.protobuf.Timestamp
-	22, // 20: models.UPV3Update.initialize:type_name -> gorderbook.models.UPV3Initialize
-	23, // 21: models.UPV3Update.mint:type_name -> gorderbook.models.UPV3Mint
-	24, // 22: models.UPV3Update.burn:type_name -> gorderbook.models.UPV3Burn
-	25, // 23: models.UPV3Update.swap:type_name -> gorderbook.models.UPV3Swap
-	26, // 24: models.UPV3Update.collect:type_name -> gorderbook.models.UPV3Collect
-	27, // 25: models.UPV3Update.flash:type_name -> gorderbook.models.UPV3Flash
-	28, // 26: models.UPV3Update.set_fee_protocol:type_name -> gorderbook.models.UPV3SetFeeProtocol
-	29, // 27: models.UPV3Update.collect_protocol:type_name -> gorderbook.models.UPV3CollectProtocol
-	16, // 28: models.UPV3Update.timestamp:type_name -> google.protobuf.Timestamp
-	30, // 29: models.ProtocolAssetUpdate.transfers:type_name -> gorderbook.models.AssetTransfer
-	16, // 30: models.ProtocolAssetUpdate.block_time:type_name -> google.protobuf.Timestamp
-	16, // 31: models.AggregatedTrade.timestamp:type_name -> google.protobuf.Timestamp
-	11, // 32: models.AggregatedTrade.trades:type_name -> models.Trade
-	16, // 33: models.Liquidation.timestamp:type_name -> google.protobuf.Timestamp
-	16, // 34: models.Stat.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 35: models.Stat.stat_type:type_name -> models.StatType
-	30, // 36: models.Sale.transfer:type_name -> gorderbook.models.AssetTransfer
-	16, // 37: models.Sale.timestamp:type_name -> google.protobuf.Timestamp
-	38, // [38:38] is the sub-list for method output_type
-	38, // [38:38] is the sub-list for method input_type
-	38, // [38:38] is the sub-list for extension type_name
-	38, // [38:38] is the sub-list for extension extendee
-	0,  // [0:38] is the sub-list for field type_name
+	19, // 0: models.OBL1Update.timestamp:type_name -> google.protobuf.Timestamp
+	19, // 1: models.OBL1Snapshot.timestamp:type_name -> google.protobuf.Timestamp
+	20, // 2: models.OBL2Update.levels:type_name -> gorderbook.models.OrderBookLevel
+	19, // 3: models.OBL2Update.timestamp:type_name -> google.protobuf.Timestamp
+	20, // 4: models.OBL2Snapshot.bids:type_name -> gorderbook.models.OrderBookLevel
+	20, // 5: models.OBL2Snapshot.asks:type_name -> gorderbook.models.OrderBookLevel
+	19, // 6: models.OBL2Snapshot.timestamp:type_name -> google.protobuf.Timestamp
+	21, // 7: models.OBL2Snapshot.tick_precision:type_name -> google.protobuf.UInt64Value
+	21, // 8: models.OBL2Snapshot.lot_precision:type_name -> google.protobuf.UInt64Value
+	22, // 9: models.OBL3Update.bids:type_name -> gorderbook.models.Order
+	22, // 10: models.OBL3Update.asks:type_name -> gorderbook.models.Order
+	19, // 11: models.OBL3Update.timestamp:type_name -> google.protobuf.Timestamp
+	21, // 12: models.OBL3Update.tick_precision:type_name -> google.protobuf.UInt64Value
+	21, // 13: models.OBL3Update.lot_precision:type_name -> google.protobuf.UInt64Value
+	22, // 14: models.OBL3Snapshot.bids:type_name -> gorderbook.models.Order
+	22, // 15: models.OBL3Snapshot.asks:type_name -> gorderbook.models.Order
+	19, // 16: models.OBL3Snapshot.timestamp:type_name -> google.protobuf.Timestamp
+	23, // 17: models.UPV3Snapshot.ticks:type_name -> gorderbook.models.UPV3Tick
+	24, // 18: models.UPV3Snapshot.positions:type_name -> gorderbook.models.UPV3Position
+	19, // 19: models.UPV3Snapshot.timestamp:type_name -> google.protobuf.Timestamp
+	25, // 20: models.UPV3Update.initialize:type_name -> gorderbook.models.UPV3Initialize
+	26, // 21: models.UPV3Update.mint:type_name -> gorderbook.models.UPV3Mint
+	27, // 22: models.UPV3Update.burn:type_name -> gorderbook.models.UPV3Burn
+	28, // 23: models.UPV3Update.swap:type_name -> gorderbook.models.UPV3Swap
+	29, // 24: models.UPV3Update.collect:type_name -> gorderbook.models.UPV3Collect
+	30, // 25: models.UPV3Update.flash:type_name -> gorderbook.models.UPV3Flash
+	31, // 26: models.UPV3Update.set_fee_protocol:type_name -> gorderbook.models.UPV3SetFeeProtocol
+	32, // 27: models.UPV3Update.collect_protocol:type_name -> gorderbook.models.UPV3CollectProtocol
+	19, // 28: models.UPV3Update.timestamp:type_name -> google.protobuf.Timestamp
+	33, // 29: models.ProtocolAssetUpdate.transfers:type_name -> gorderbook.models.AssetTransfer
+	19, // 30: models.ProtocolAssetUpdate.block_time:type_name -> google.protobuf.Timestamp
+	19, // 31: models.AggregatedTrade.timestamp:type_name -> google.protobuf.Timestamp
+	13, // 32: models.AggregatedTrade.trades:type_name -> models.Trade
+	19, // 33: models.Liquidation.timestamp:type_name -> google.protobuf.Timestamp
+	19, // 34: models.Bar.open_time:type_name -> google.protobuf.Timestamp
+	19, // 35: models.Bar.close_time:type_name -> google.protobuf.Timestamp
+	19, // 36: models.Stat.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 37: models.Stat.stat_type:type_name -> models.StatType
+	33, // 38: models.Sale.transfer:type_name -> gorderbook.models.AssetTransfer
+	19, // 39: models.Sale.timestamp:type_name -> google.protobuf.Timestamp
+	40, // [40:40] is the sub-list for method output_type
+	40, // [40:40] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_market_data_proto_init() }
@@ -1640,7 +2009,7 @@ func file_market_data_proto_init() {
 			}
 		}
 		file_market_data_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OBL3Update); i {
+			switch v := v.(*VenueLevel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1652,7 +2021,7 @@ func file_market_data_proto_init() {
 			}
 		}
 		file_market_data_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OBL3Snapshot); i {
+			switch v := v.(*OBL3Update); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1664,7 +2033,7 @@ func file_market_data_proto_init() {
 			}
 		}
 		file_market_data_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UPV3Snapshot); i {
+			switch v := v.(*OBL3Snapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1676,7 +2045,7 @@ func file_market_data_proto_init() {
 			}
 		}
 		file_market_data_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UPV3Update); i {
+			switch v := v.(*UPV3Snapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1688,7 +2057,7 @@ func file_market_data_proto_init() {
 			}
 		}
 		file_market_data_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtocolAssetUpdate); i {
+			switch v := v.(*UPV3Update); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1700,7 +2069,7 @@ func file_market_data_proto_init() {
 			}
 		}
 		file_market_data_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Trade); i {
+			switch v := v.(*ProtocolAssetUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1712,7 +2081,7 @@ func file_market_data_proto_init() {
 			}
 		}
 		file_market_data_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AggregatedTrade); i {
+			switch v := v.(*Trade); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1724,7 +2093,7 @@ func file_market_data_proto_init() {
 			}
 		}
 		file_market_data_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Liquidation); i {
+			switch v := v.(*AggregatedTrade); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1736,7 +2105,7 @@ func file_market_data_proto_init() {
 			}
 		}
 		file_market_data_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stat); i {
+			switch v := v.(*Liquidation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1748,6 +2117,30 @@ func file_market_data_proto_init() {
 			}
 		}
 		file_market_data_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_market_data_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_market_data_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Sale); i {
 			case 0:
 				return &v.state
@@ -1765,8 +2158,8 @@ func file_market_data_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_market_data_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   14,
+			NumEnums:      3,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -3,10 +3,14 @@ package messages
 import (
 	"github.com/asynkron/protoactor-go/actor"
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	alphaModels "gitlab.com/alphaticks/alpha-connect/models"
 	"gitlab.com/alphaticks/xchanger/chains/svm"
 	"gitlab.com/alphaticks/xchanger/models"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"math/big"
 	"time"
 )
 
@@ -52,6 +56,104 @@ type EVMContractCallResponse struct {
 	RejectionReason RejectionReason
 }
 
+// EVMBalanceRequest fetches the native balance of an address at the given block
+type EVMBalanceRequest struct {
+	RequestID   uint64
+	Chain       *models.Chain
+	Address     common.Address
+	BlockNumber uint64
+}
+
+type EVMBalanceResponse struct {
+	RequestID       uint64
+	ResponseID      uint64
+	Balance         *big.Int
+	Success         bool
+	RejectionReason RejectionReason
+}
+
+// EVMTransactionRequest signs and submits a transaction from one of the executor's
+// accounts. The gas and fees are estimated when not set, the subscriber receives the
+// updates of the transaction until it is confirmed.
+type EVMTransactionRequest struct {
+	RequestID            uint64
+	Chain                *models.Chain
+	From                 common.Address
+	To                   *common.Address // Nil for a contract creation
+	Value                *big.Int
+	Data                 []byte
+	Gas                  uint64
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	Confirmations        uint64
+	Subscriber           *actor.PID
+}
+
+type EVMTransactionResponse struct {
+	RequestID       uint64
+	ResponseID      uint64
+	TxHash          common.Hash
+	Nonce           uint64
+	Success         bool
+	RejectionReason RejectionReason
+}
+
+// EVMTransactionReplaceRequest replaces a pending transaction with the same
+// transaction, or with an empty transfer to self when cancelling, with higher fees
+type EVMTransactionReplaceRequest struct {
+	RequestID uint64
+	Chain     *models.Chain
+	From      common.Address
+	Nonce     uint64
+	Cancel    bool
+}
+
+type EVMTransactionReplaceResponse struct {
+	RequestID       uint64
+	ResponseID      uint64
+	TxHash          common.Hash
+	Success         bool
+	RejectionReason RejectionReason
+}
+
+type EVMTransactionStatus int32
+
+const (
+	EVMTransactionPending EVMTransactionStatus = iota
+	EVMTransactionMined
+	EVMTransactionConfirmed
+	EVMTransactionReverted
+	EVMTransactionDropped
+)
+
+func (s EVMTransactionStatus) String() string {
+	switch s {
+	case EVMTransactionPending:
+		return "pending"
+	case EVMTransactionMined:
+		return "mined"
+	case EVMTransactionConfirmed:
+		return "confirmed"
+	case EVMTransactionReverted:
+		return "reverted"
+	case EVMTransactionDropped:
+		return "dropped"
+	default:
+		return "unknown"
+	}
+}
+
+// EVMTransactionUpdate is sent to the subscriber of a transaction when its status changes,
+// the hash being the one of the last replacement, or of the transaction mined with the nonce
+type EVMTransactionUpdate struct {
+	RequestID uint64
+	SeqNum    uint64
+	TxHash    common.Hash
+	Nonce     uint64
+	Status    EVMTransactionStatus
+	Receipt   *types.Receipt
+}
+
 type EVMLogsQueryRequest struct {
 	RequestID uint64
 	Chain     *models.Chain
@@ -68,10 +170,11 @@ type EVMLogsQueryResponse struct {
 }
 
 type EVMLogsSubscribeRequest struct {
-	RequestID  uint64
-	Chain      *models.Chain
-	Query      ethereum.FilterQuery
-	Subscriber *actor.PID
+	RequestID     uint64
+	Chain         *models.Chain
+	Query         ethereum.FilterQuery // Logs from FromBlock are replayed
+	Subscriber    *actor.PID
+	Confirmations uint64 // Number of blocks on top of a block before publishing its logs
 }
 
 type EVMLogsSubscribeResponse struct {
@@ -90,8 +193,258 @@ type EVMLogsSubscribeRefresh struct {
 
 type EVMLogs struct {
 	BlockNumber uint64
+	BlockHash   common.Hash
 	BlockTime   time.Time
 	Logs        []types.Log
+	Removed     bool // The logs, published previously, were reverted by a reorg
+}
+
+// EVMPendingTxSubscribeRequest subscribes to the pending transactions sent to the
+// contracts, the transactions are decoded with the ABI of their contract
+type EVMPendingTxSubscribeRequest struct {
+	RequestID  uint64
+	Chain      *models.Chain
+	Contracts  map[common.Address]*abi.ABI
+	Subscriber *actor.PID
+}
+
+type EVMPendingTxSubscribeResponse struct {
+	RequestID       uint64
+	ResponseID      uint64
+	Success         bool
+	RejectionReason RejectionReason
+	SeqNum          uint64
+}
+
+type EVMPendingTxSubscribeRefresh struct {
+	RequestID uint64
+	SeqNum    uint64
+	Intents   []*EVMTxIntent
+}
+
+// EVMTxIntent is a decoded pending transaction. It is published when seen in the
+// mempool, and again with the mined status once included in a block, or dropped.
+type EVMTxIntent struct {
+	TxHash      common.Hash
+	From        common.Address
+	To          common.Address
+	Nonce       uint64
+	Value       *big.Int
+	GasTipCap   *big.Int
+	GasFeeCap   *big.Int
+	Method      string
+	Args        map[string]interface{}
+	Status      EVMTransactionStatus
+	BlockNumber uint64 // The block including the transaction
+	SeenTime    time.Time
+}
+
+// EVMGasSubscribeRequest subscribes to the gas stats of a chain, published on each new block
+type EVMGasSubscribeRequest struct {
+	RequestID  uint64
+	Chain      *models.Chain
+	Subscriber *actor.PID
+}
+
+type EVMGasSubscribeResponse struct {
+	RequestID       uint64
+	ResponseID      uint64
+	Success         bool
+	RejectionReason RejectionReason
+	SeqNum          uint64
+	Gas             *EVMGasStats
+}
+
+// EVMGasSubscribeRefresh holds the gas stats of the last block, along with
+// their base fee, median priority fee (in gwei) and block utilization stats
+type EVMGasSubscribeRefresh struct {
+	RequestID uint64
+	SeqNum    uint64
+	Gas       *EVMGasStats
+	Stats     []*alphaModels.Stat
+}
+
+// EVMGasStats are the gas stats of the blocks of a window ending at the block number. The priority
+// fees are the medians over the window of the percentiles of the priority fees of each block.
+type EVMGasStats struct {
+	BlockNumber  uint64
+	BaseFee      *big.Int
+	NextBaseFee  *big.Int // The base fee of the next block
+	Percentiles  []float64
+	PriorityFees []*big.Int // The priority fee at each percentile
+	Utilization  float64    // Mean ratio of gas used to the gas limit
+}
+
+// ContractEventDataRequest subscribes to the events of a contract, decoded with its ABI.
+// All the events of the ABI are followed when no event name is given. An unsubscribe
+// request removes the subscription of the same request ID, contract and events.
+type ContractEventDataRequest struct {
+	RequestID   uint64
+	ChainID     uint32
+	Address     common.Address
+	ABI         string // The ABI JSON of the contract
+	Events      []string
+	Subscriber  *actor.PID
+	Unsubscribe bool
+}
+
+type ContractEventDataResponse struct {
+	RequestID       uint64
+	ResponseID      uint64
+	Success         bool
+	RejectionReason RejectionReason
+	SeqNum          uint64
+}
+
+// ContractEventDataIncrementalRefresh holds the events of a block, it
+// is sent without events as a heartbeat
+type ContractEventDataIncrementalRefresh struct {
+	RequestID   uint64
+	ResponseID  uint64
+	SeqNum      uint64
+	BlockNumber uint64
+	BlockTime   time.Time
+	Removed     bool // The events, published previously, were reverted by a reorg
+	Events      []*ContractEvent
+}
+
+type ContractEvent struct {
+	Name     string
+	Address  common.Address
+	TxHash   common.Hash
+	LogIndex uint
+	Values   map[string]interface{} // The indexed and non-indexed arguments by name
+}
+
+// BackfillRequest starts a job writing the transfers of a protocol asset, or the events of a
+// Uniswap V3 pool when the instrument is set, to the web3 store. The range is in blocks, inclusive,
+// or in time when no end block is given. The chain is the one of the asset or of the pool.
+type BackfillRequest struct {
+	RequestID  uint64
+	ProtocolID uint32
+	ChainID    uint32
+	AssetID    *wrapperspb.UInt32Value
+	Instrument *alphaModels.Instrument
+	StartBlock uint64
+	EndBlock   uint64
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+type BackfillResponse struct {
+	RequestID       uint64
+	ResponseID      uint64
+	Success         bool
+	RejectionReason RejectionReason
+	JobID           uint64
+}
+
+// BackfillStatusRequest returns the progress of a backfill job, of all the jobs when no ID is given
+type BackfillStatusRequest struct {
+	RequestID uint64
+	JobID     uint64
+}
+
+type BackfillStatusResponse struct {
+	RequestID       uint64
+	ResponseID      uint64
+	Success         bool
+	RejectionReason RejectionReason
+	Jobs            []*BackfillStatus
+}
+
+type BackfillStatus struct {
+	JobID      uint64
+	Key        string // Measurement and tags of the backfilled data
+	StartBlock uint64
+	EndBlock   uint64
+	NextBlock  uint64 // The next block to write, the blocks before are written
+	Done       bool
+	Error      string
+}
+
+// NFTMarketDataRequest subscribes to the listings, offers, cancellations and sales of
+// a collection, along with the book of its listings and offers and its stats
+type NFTMarketDataRequest struct {
+	RequestID                 uint64
+	MarketableProtocolAssetID uint64
+	Subscriber                *actor.PID
+}
+
+// NFTMarketDataResponse holds the book of the collection, the listings being the asks
+// and the offers the bids, with their price per token in ETH and number of tokens
+type NFTMarketDataResponse struct {
+	RequestID       uint64
+	ResponseID      uint64
+	Success         bool
+	RejectionReason RejectionReason
+	SeqNum          uint64
+	SnapshotL2      *alphaModels.OBL2Snapshot
+	Stats           *NFTCollectionStats
+}
+
+// NFTMarketDataIncrementalRefresh holds the events of the collection and the
+// resulting book update, it is sent empty as a heartbeat. The book is sent again
+// as a snapshot when reloaded, after a reconnection to the stream.
+// The book update is the same as the one of a MarketDataIncrementalRefresh, but the
+// refresh also holds the order events and the stats of the collection, which the
+// proto message of a security can't carry.
+type NFTMarketDataIncrementalRefresh struct {
+	RequestID  uint64
+	ResponseID uint64
+	SeqNum     uint64
+	Events     []*NFTMarketEvent
+	UpdateL2   *alphaModels.OBL2Update
+	SnapshotL2 *alphaModels.OBL2Snapshot // Replaces the book when set
+	Stats      *NFTCollectionStats
+}
+
+type NFTMarketEventType int32
+
+const (
+	NFTListing NFTMarketEventType = iota
+	NFTOffer
+	NFTCancellation
+	NFTSale
+)
+
+func (t NFTMarketEventType) String() string {
+	switch t {
+	case NFTListing:
+		return "listing"
+	case NFTOffer:
+		return "offer"
+	case NFTCancellation:
+		return "cancellation"
+	case NFTSale:
+		return "sale"
+	default:
+		return "unknown"
+	}
+}
+
+type NFTMarketEvent struct {
+	Type         NFTMarketEventType
+	OrderHash    string
+	TokenID      *big.Int // Nil for a collection offer
+	Maker        common.Address
+	Taker        common.Address
+	Price        *big.Int // Total price, in the smallest unit of the payment token
+	PaymentToken string
+	Quantity     uint64
+	Expiration   time.Time
+	TxHash       common.Hash // The transaction of a sale
+	Time         time.Time
+}
+
+type NFTCollectionStats struct {
+	FloorPrice   float64 // In ETH
+	OneDayVolume float64
+	OneDaySales  float64
+	TotalVolume  float64
+	TotalSupply  float64
+	NumOwners    float64
+	Time         time.Time
 }
 
 type SVMEventsQueryRequest struct {
@@ -283,6 +283,55 @@ func (RejectionReason) EnumDescriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{1}
 }
 
+type DeliveryPolicy int32
+
+const (
+	DeliveryPolicy_FullStream    DeliveryPolicy = 0
+	DeliveryPolicy_ConflatedBook DeliveryPolicy = 1
+	DeliveryPolicy_TopOfBook     DeliveryPolicy = 2
+)
+
+// Enum value maps for DeliveryPolicy.
+var (
+	DeliveryPolicy_name = map[int32]string{
+		0: "FullStream",
+		1: "ConflatedBook",
+		2: "TopOfBook",
+	}
+	DeliveryPolicy_value = map[string]int32{
+		"FullStream":    0,
+		"ConflatedBook": 1,
+		"TopOfBook":     2,
+	}
+)
+
+func (x DeliveryPolicy) Enum() *DeliveryPolicy {
+	p := new(DeliveryPolicy)
+	*p = x
+	return p
+}
+
+func (x DeliveryPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeliveryPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_executor_messages_proto_enumTypes[2].Descriptor()
+}
+
+func (DeliveryPolicy) Type() protoreflect.EnumType {
+	return &file_executor_messages_proto_enumTypes[2]
+}
+
+func (x DeliveryPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeliveryPolicy.Descriptor instead.
+func (DeliveryPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{2}
+}
+
 type ResponseType int32
 
 const (
@@ -313,11 +362,11 @@ func (x ResponseType) String() string {
 }
 
 func (ResponseType) Descriptor() protoreflect.EnumDescriptor {
-	return file_executor_messages_proto_enumTypes[2].Descriptor()
+	return file_executor_messages_proto_enumTypes[3].Descriptor()
 }
 
 func (ResponseType) Type() protoreflect.EnumType {
-	return &file_executor_messages_proto_enumTypes[2]
+	return &file_executor_messages_proto_enumTypes[3]
 }
 
 func (x ResponseType) Number() protoreflect.EnumNumber {
@@ -326,7 +375,7 @@ func (x ResponseType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ResponseType.Descriptor instead.
 func (ResponseType) EnumDescriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{2}
+	return file_executor_messages_proto_rawDescGZIP(), []int{3}
 }
 
 type FeeType int32
@@ -365,11 +414,11 @@ func (x FeeType) String() string {
 }
 
 func (FeeType) Descriptor() protoreflect.EnumDescriptor {
-	return file_executor_messages_proto_enumTypes[3].Descriptor()
+	return file_executor_messages_proto_enumTypes[4].Descriptor()
 }
 
 func (FeeType) Type() protoreflect.EnumType {
-	return &file_executor_messages_proto_enumTypes[3]
+	return &file_executor_messages_proto_enumTypes[4]
 }
 
 func (x FeeType) Number() protoreflect.EnumNumber {
@@ -378,7 +427,7 @@ func (x FeeType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FeeType.Descriptor instead.
 func (FeeType) EnumDescriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{3}
+	return file_executor_messages_proto_rawDescGZIP(), []int{4}
 }
 
 type FeeBasis int32
@@ -414,11 +463,11 @@ func (x FeeBasis) String() string {
 }
 
 func (FeeBasis) Descriptor() protoreflect.EnumDescriptor {
-	return file_executor_messages_proto_enumTypes[4].Descriptor()
+	return file_executor_messages_proto_enumTypes[5].Descriptor()
 }
 
 func (FeeBasis) Type() protoreflect.EnumType {
-	return &file_executor_messages_proto_enumTypes[4]
+	return &file_executor_messages_proto_enumTypes[5]
 }
 
 func (x FeeBasis) Number() protoreflect.EnumNumber {
@@ -427,7 +476,7 @@ func (x FeeBasis) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FeeBasis.Descriptor instead.
 func (FeeBasis) EnumDescriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{4}
+	return file_executor_messages_proto_rawDescGZIP(), []int{5}
 }
 
 type AccountMovementType int32
@@ -478,11 +527,11 @@ func (x AccountMovementType) String() string {
 }
 
 func (AccountMovementType) Descriptor() protoreflect.EnumDescriptor {
-	return file_executor_messages_proto_enumTypes[5].Descriptor()
+	return file_executor_messages_proto_enumTypes[6].Descriptor()
 }
 
 func (AccountMovementType) Type() protoreflect.EnumType {
-	return &file_executor_messages_proto_enumTypes[5]
+	return &file_executor_messages_proto_enumTypes[6]
 }
 
 func (x AccountMovementType) Number() protoreflect.EnumNumber {
@@ -491,7 +540,7 @@ func (x AccountMovementType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AccountMovementType.Descriptor instead.
 func (AccountMovementType) EnumDescriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{5}
+	return file_executor_messages_proto_rawDescGZIP(), []int{6}
 }
 
 type HistoricalOpenInterestsRequest struct {
@@ -1407,12 +1456,15 @@ type MarketDataRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID   uint64                      `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Subscribe   bool                        `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
-	Subscriber  *actor.PID                  `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
-	Instrument  *models.Instrument          `protobuf:"bytes,4,opt,name=instrument,proto3" json:"instrument,omitempty"`
-	Aggregation models.OrderBookAggregation `protobuf:"varint,5,opt,name=aggregation,proto3,enum=models.OrderBookAggregation" json:"aggregation,omitempty"`
-	Stats       []models.StatType           `protobuf:"varint,6,rep,packed,name=stats,proto3,enum=models.StatType" json:"stats,omitempty"`
+	RequestID          uint64                      `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Subscribe          bool                        `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	Subscriber         *actor.PID                  `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	Instrument         *models.Instrument          `protobuf:"bytes,4,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Aggregation        models.OrderBookAggregation `protobuf:"varint,5,opt,name=aggregation,proto3,enum=models.OrderBookAggregation" json:"aggregation,omitempty"`
+	Stats              []models.StatType           `protobuf:"varint,6,rep,packed,name=stats,proto3,enum=models.StatType" json:"stats,omitempty"`
+	DeliveryPolicy     DeliveryPolicy              `protobuf:"varint,7,opt,name=delivery_policy,json=deliveryPolicy,proto3,enum=messages.DeliveryPolicy" json:"delivery_policy,omitempty"`
+	Depth              uint32                      `protobuf:"varint,8,opt,name=depth,proto3" json:"depth,omitempty"`
+	ConflationInterval *durationpb.Duration        `protobuf:"bytes,9,opt,name=conflation_interval,json=conflationInterval,proto3" json:"conflation_interval,omitempty"`
 }
 
 func (x *MarketDataRequest) Reset() {
@@ -1489,6 +1541,27 @@ func (x *MarketDataRequest) GetStats() []models.StatType {
 	return nil
 }
 
+func (x *MarketDataRequest) GetDeliveryPolicy() DeliveryPolicy {
+	if x != nil {
+		return x.DeliveryPolicy
+	}
+	return DeliveryPolicy_FullStream
+}
+
+func (x *MarketDataRequest) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *MarketDataRequest) GetConflationInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ConflationInterval
+	}
+	return nil
+}
+
 type MarketDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1503,6 +1576,11 @@ type MarketDataResponse struct {
 	SeqNum          uint64                    `protobuf:"varint,7,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
 	Success         bool                      `protobuf:"varint,8,opt,name=success,proto3" json:"success,omitempty"`
 	RejectionReason RejectionReason           `protobuf:"varint,9,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	VenueLevels     []*models.VenueLevel      `protobuf:"bytes,10,rep,name=venue_levels,json=venueLevels,proto3" json:"venue_levels,omitempty"`
+	Stats           []*models.Stat            `protobuf:"bytes,11,rep,name=stats,proto3" json:"stats,omitempty"`
+	// A conflated snapshot replaces the book, the sequence numbers
+	// up to seq_num were conflated in it and are not a gap
+	Conflated bool `protobuf:"varint,12,opt,name=conflated,proto3" json:"conflated,omitempty"`
 }
 
 func (x *MarketDataResponse) Reset() {
@@ -1600,6 +1678,27 @@ func (x *MarketDataResponse) GetRejectionReason() RejectionReason {
 	return RejectionReason_Other
 }
 
+func (x *MarketDataResponse) GetVenueLevels() []*models.VenueLevel {
+	if x != nil {
+		return x.VenueLevels
+	}
+	return nil
+}
+
+func (x *MarketDataResponse) GetStats() []*models.Stat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *MarketDataResponse) GetConflated() bool {
+	if x != nil {
+		return x.Conflated
+	}
+	return false
+}
+
 type MarketDataIncrementalRefresh struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1614,6 +1713,7 @@ type MarketDataIncrementalRefresh struct {
 	Trades      []*models.AggregatedTrade `protobuf:"bytes,7,rep,name=trades,proto3" json:"trades,omitempty"`
 	Liquidation *models.Liquidation       `protobuf:"bytes,8,opt,name=liquidation,proto3" json:"liquidation,omitempty"`
 	Stats       []*models.Stat            `protobuf:"bytes,9,rep,name=stats,proto3" json:"stats,omitempty"`
+	VenueLevels []*models.VenueLevel      `protobuf:"bytes,10,rep,name=venue_levels,json=venueLevels,proto3" json:"venue_levels,omitempty"`
 }
 
 func (x *MarketDataIncrementalRefresh) Reset() {
@@ -1711,19 +1811,30 @@ func (x *MarketDataIncrementalRefresh) GetStats() []*models.Stat {
 	return nil
 }
 
-type UnipoolV3DataRequest struct {
+func (x *MarketDataIncrementalRefresh) GetVenueLevels() []*models.VenueLevel {
+	if x != nil {
+		return x.VenueLevels
+	}
+	return nil
+}
+
+type BarDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID  uint64             `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Subscribe  bool               `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
-	Subscriber *actor.PID         `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
-	Instrument *models.Instrument `protobuf:"bytes,4,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	RequestID   uint64               `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Subscribe   bool                 `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	Subscriber  *actor.PID           `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	Instrument  *models.Instrument   `protobuf:"bytes,4,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	BarType     models.BarType       `protobuf:"varint,5,opt,name=bar_type,json=barType,proto3,enum=models.BarType" json:"bar_type,omitempty"`
+	Interval    *durationpb.Duration `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	Threshold   float64              `protobuf:"fixed64,7,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Unsubscribe bool                 `protobuf:"varint,8,opt,name=unsubscribe,proto3" json:"unsubscribe,omitempty"`
 }
 
-func (x *UnipoolV3DataRequest) Reset() {
-	*x = UnipoolV3DataRequest{}
+func (x *BarDataRequest) Reset() {
+	*x = BarDataRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1731,13 +1842,13 @@ func (x *UnipoolV3DataRequest) Reset() {
 	}
 }
 
-func (x *UnipoolV3DataRequest) String() string {
+func (x *BarDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnipoolV3DataRequest) ProtoMessage() {}
+func (*BarDataRequest) ProtoMessage() {}
 
-func (x *UnipoolV3DataRequest) ProtoReflect() protoreflect.Message {
+func (x *BarDataRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1749,54 +1860,82 @@ func (x *UnipoolV3DataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnipoolV3DataRequest.ProtoReflect.Descriptor instead.
-func (*UnipoolV3DataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BarDataRequest.ProtoReflect.Descriptor instead.
+func (*BarDataRequest) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UnipoolV3DataRequest) GetRequestID() uint64 {
+func (x *BarDataRequest) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *UnipoolV3DataRequest) GetSubscribe() bool {
+func (x *BarDataRequest) GetSubscribe() bool {
 	if x != nil {
 		return x.Subscribe
 	}
 	return false
 }
 
-func (x *UnipoolV3DataRequest) GetSubscriber() *actor.PID {
+func (x *BarDataRequest) GetSubscriber() *actor.PID {
 	if x != nil {
 		return x.Subscriber
 	}
 	return nil
 }
 
-func (x *UnipoolV3DataRequest) GetInstrument() *models.Instrument {
+func (x *BarDataRequest) GetInstrument() *models.Instrument {
 	if x != nil {
 		return x.Instrument
 	}
 	return nil
 }
 
-type UnipoolV3DataResponse struct {
+func (x *BarDataRequest) GetBarType() models.BarType {
+	if x != nil {
+		return x.BarType
+	}
+	return models.BarType(0)
+}
+
+func (x *BarDataRequest) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *BarDataRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *BarDataRequest) GetUnsubscribe() bool {
+	if x != nil {
+		return x.Unsubscribe
+	}
+	return false
+}
+
+type BarDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64               `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64               `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	Update          []*models.UPV3Update `protobuf:"bytes,3,rep,name=update,proto3" json:"update,omitempty"`
-	SeqNum          uint64               `protobuf:"varint,4,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
-	Success         bool                 `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason RejectionReason      `protobuf:"varint,6,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	RequestID       uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64          `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	Current         *models.Bar     `protobuf:"bytes,3,opt,name=current,proto3" json:"current,omitempty"`
+	SeqNum          uint64          `protobuf:"varint,4,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
+	Success         bool            `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason `protobuf:"varint,6,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *UnipoolV3DataResponse) Reset() {
-	*x = UnipoolV3DataResponse{}
+func (x *BarDataResponse) Reset() {
+	*x = BarDataResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1804,13 +1943,13 @@ func (x *UnipoolV3DataResponse) Reset() {
 	}
 }
 
-func (x *UnipoolV3DataResponse) String() string {
+func (x *BarDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnipoolV3DataResponse) ProtoMessage() {}
+func (*BarDataResponse) ProtoMessage() {}
 
-func (x *UnipoolV3DataResponse) ProtoReflect() protoreflect.Message {
+func (x *BarDataResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1822,66 +1961,66 @@ func (x *UnipoolV3DataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnipoolV3DataResponse.ProtoReflect.Descriptor instead.
-func (*UnipoolV3DataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BarDataResponse.ProtoReflect.Descriptor instead.
+func (*BarDataResponse) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UnipoolV3DataResponse) GetRequestID() uint64 {
+func (x *BarDataResponse) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *UnipoolV3DataResponse) GetResponseID() uint64 {
+func (x *BarDataResponse) GetResponseID() uint64 {
 	if x != nil {
 		return x.ResponseID
 	}
 	return 0
 }
 
-func (x *UnipoolV3DataResponse) GetUpdate() []*models.UPV3Update {
+func (x *BarDataResponse) GetCurrent() *models.Bar {
 	if x != nil {
-		return x.Update
+		return x.Current
 	}
 	return nil
 }
 
-func (x *UnipoolV3DataResponse) GetSeqNum() uint64 {
+func (x *BarDataResponse) GetSeqNum() uint64 {
 	if x != nil {
 		return x.SeqNum
 	}
 	return 0
 }
 
-func (x *UnipoolV3DataResponse) GetSuccess() bool {
+func (x *BarDataResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *UnipoolV3DataResponse) GetRejectionReason() RejectionReason {
+func (x *BarDataResponse) GetRejectionReason() RejectionReason {
 	if x != nil {
 		return x.RejectionReason
 	}
 	return RejectionReason_Other
 }
 
-type UnipoolV3DataIncrementalRefresh struct {
+type BarDataIncrementalRefresh struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID  uint64             `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID uint64             `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	SeqNum     uint64             `protobuf:"varint,3,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
-	Update     *models.UPV3Update `protobuf:"bytes,4,opt,name=update,proto3" json:"update,omitempty"`
+	RequestID  uint64        `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID uint64        `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	SeqNum     uint64        `protobuf:"varint,3,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
+	Bars       []*models.Bar `protobuf:"bytes,4,rep,name=bars,proto3" json:"bars,omitempty"`
 }
 
-func (x *UnipoolV3DataIncrementalRefresh) Reset() {
-	*x = UnipoolV3DataIncrementalRefresh{}
+func (x *BarDataIncrementalRefresh) Reset() {
+	*x = BarDataIncrementalRefresh{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1889,13 +2028,13 @@ func (x *UnipoolV3DataIncrementalRefresh) Reset() {
 	}
 }
 
-func (x *UnipoolV3DataIncrementalRefresh) String() string {
+func (x *BarDataIncrementalRefresh) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnipoolV3DataIncrementalRefresh) ProtoMessage() {}
+func (*BarDataIncrementalRefresh) ProtoMessage() {}
 
-func (x *UnipoolV3DataIncrementalRefresh) ProtoReflect() protoreflect.Message {
+func (x *BarDataIncrementalRefresh) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1907,52 +2046,52 @@ func (x *UnipoolV3DataIncrementalRefresh) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnipoolV3DataIncrementalRefresh.ProtoReflect.Descriptor instead.
-func (*UnipoolV3DataIncrementalRefresh) Descriptor() ([]byte, []int) {
+// Deprecated: Use BarDataIncrementalRefresh.ProtoReflect.Descriptor instead.
+func (*BarDataIncrementalRefresh) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UnipoolV3DataIncrementalRefresh) GetRequestID() uint64 {
+func (x *BarDataIncrementalRefresh) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *UnipoolV3DataIncrementalRefresh) GetResponseID() uint64 {
+func (x *BarDataIncrementalRefresh) GetResponseID() uint64 {
 	if x != nil {
 		return x.ResponseID
 	}
 	return 0
 }
 
-func (x *UnipoolV3DataIncrementalRefresh) GetSeqNum() uint64 {
+func (x *BarDataIncrementalRefresh) GetSeqNum() uint64 {
 	if x != nil {
 		return x.SeqNum
 	}
 	return 0
 }
 
-func (x *UnipoolV3DataIncrementalRefresh) GetUpdate() *models.UPV3Update {
+func (x *BarDataIncrementalRefresh) GetBars() []*models.Bar {
 	if x != nil {
-		return x.Update
+		return x.Bars
 	}
 	return nil
 }
 
-type AccountDataRequest struct {
+type UnipoolV3DataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID  uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Subscribe  bool            `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
-	Subscriber *actor.PID      `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
-	Account    *models.Account `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	RequestID  uint64             `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Subscribe  bool               `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	Subscriber *actor.PID         `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	Instrument *models.Instrument `protobuf:"bytes,4,opt,name=instrument,proto3" json:"instrument,omitempty"`
 }
 
-func (x *AccountDataRequest) Reset() {
-	*x = AccountDataRequest{}
+func (x *UnipoolV3DataRequest) Reset() {
+	*x = UnipoolV3DataRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1960,13 +2099,13 @@ func (x *AccountDataRequest) Reset() {
 	}
 }
 
-func (x *AccountDataRequest) String() string {
+func (x *UnipoolV3DataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountDataRequest) ProtoMessage() {}
+func (*UnipoolV3DataRequest) ProtoMessage() {}
 
-func (x *AccountDataRequest) ProtoReflect() protoreflect.Message {
+func (x *UnipoolV3DataRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1978,59 +2117,54 @@ func (x *AccountDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountDataRequest.ProtoReflect.Descriptor instead.
-func (*AccountDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnipoolV3DataRequest.ProtoReflect.Descriptor instead.
+func (*UnipoolV3DataRequest) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *AccountDataRequest) GetRequestID() uint64 {
+func (x *UnipoolV3DataRequest) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *AccountDataRequest) GetSubscribe() bool {
+func (x *UnipoolV3DataRequest) GetSubscribe() bool {
 	if x != nil {
 		return x.Subscribe
 	}
 	return false
 }
 
-func (x *AccountDataRequest) GetSubscriber() *actor.PID {
+func (x *UnipoolV3DataRequest) GetSubscriber() *actor.PID {
 	if x != nil {
 		return x.Subscriber
 	}
 	return nil
 }
 
-func (x *AccountDataRequest) GetAccount() *models.Account {
+func (x *UnipoolV3DataRequest) GetInstrument() *models.Instrument {
 	if x != nil {
-		return x.Account
+		return x.Instrument
 	}
 	return nil
 }
 
-type AccountDataResponse struct {
+type UnipoolV3DataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64                  `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64                  `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	Securities      []*models.Security      `protobuf:"bytes,3,rep,name=securities,proto3" json:"securities,omitempty"`
-	Orders          []*models.Order         `protobuf:"bytes,4,rep,name=orders,proto3" json:"orders,omitempty"`
-	Positions       []*models.Position      `protobuf:"bytes,5,rep,name=positions,proto3" json:"positions,omitempty"`
-	Balances        []*models.Balance       `protobuf:"bytes,6,rep,name=balances,proto3" json:"balances,omitempty"`
-	MakerFee        *wrapperspb.DoubleValue `protobuf:"bytes,7,opt,name=maker_fee,json=makerFee,proto3" json:"maker_fee,omitempty"`
-	TakerFee        *wrapperspb.DoubleValue `protobuf:"bytes,8,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty"`
-	Success         bool                    `protobuf:"varint,9,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason RejectionReason         `protobuf:"varint,10,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
-	SeqNum          uint64                  `protobuf:"varint,11,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
+	RequestID       uint64               `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64               `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	Update          []*models.UPV3Update `protobuf:"bytes,3,rep,name=update,proto3" json:"update,omitempty"`
+	SeqNum          uint64               `protobuf:"varint,4,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
+	Success         bool                 `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason      `protobuf:"varint,6,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *AccountDataResponse) Reset() {
-	*x = AccountDataResponse{}
+func (x *UnipoolV3DataResponse) Reset() {
+	*x = UnipoolV3DataResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2038,13 +2172,13 @@ func (x *AccountDataResponse) Reset() {
 	}
 }
 
-func (x *AccountDataResponse) String() string {
+func (x *UnipoolV3DataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountDataResponse) ProtoMessage() {}
+func (*UnipoolV3DataResponse) ProtoMessage() {}
 
-func (x *AccountDataResponse) ProtoReflect() protoreflect.Message {
+func (x *UnipoolV3DataResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2056,100 +2190,66 @@ func (x *AccountDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountDataResponse.ProtoReflect.Descriptor instead.
-func (*AccountDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnipoolV3DataResponse.ProtoReflect.Descriptor instead.
+func (*UnipoolV3DataResponse) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *AccountDataResponse) GetRequestID() uint64 {
+func (x *UnipoolV3DataResponse) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *AccountDataResponse) GetResponseID() uint64 {
+func (x *UnipoolV3DataResponse) GetResponseID() uint64 {
 	if x != nil {
 		return x.ResponseID
 	}
 	return 0
 }
 
-func (x *AccountDataResponse) GetSecurities() []*models.Security {
+func (x *UnipoolV3DataResponse) GetUpdate() []*models.UPV3Update {
 	if x != nil {
-		return x.Securities
+		return x.Update
 	}
 	return nil
 }
 
-func (x *AccountDataResponse) GetOrders() []*models.Order {
+func (x *UnipoolV3DataResponse) GetSeqNum() uint64 {
 	if x != nil {
-		return x.Orders
+		return x.SeqNum
 	}
-	return nil
+	return 0
 }
 
-func (x *AccountDataResponse) GetPositions() []*models.Position {
+func (x *UnipoolV3DataResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Positions
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *AccountDataResponse) GetBalances() []*models.Balance {
+func (x *UnipoolV3DataResponse) GetRejectionReason() RejectionReason {
 	if x != nil {
-		return x.Balances
+		return x.RejectionReason
 	}
-	return nil
+	return RejectionReason_Other
 }
 
-func (x *AccountDataResponse) GetMakerFee() *wrapperspb.DoubleValue {
-	if x != nil {
-		return x.MakerFee
-	}
-	return nil
-}
-
-func (x *AccountDataResponse) GetTakerFee() *wrapperspb.DoubleValue {
-	if x != nil {
-		return x.TakerFee
-	}
-	return nil
-}
-
-func (x *AccountDataResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *AccountDataResponse) GetRejectionReason() RejectionReason {
-	if x != nil {
-		return x.RejectionReason
-	}
-	return RejectionReason_Other
-}
-
-func (x *AccountDataResponse) GetSeqNum() uint64 {
-	if x != nil {
-		return x.SeqNum
-	}
-	return 0
-}
-
-type AccountDataIncrementalRefresh struct {
+type UnipoolV3DataIncrementalRefresh struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID  uint64           `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID uint64           `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	Report     *ExecutionReport `protobuf:"bytes,3,opt,name=report,proto3" json:"report,omitempty"`
+	RequestID  uint64             `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID uint64             `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	SeqNum     uint64             `protobuf:"varint,3,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
+	Update     *models.UPV3Update `protobuf:"bytes,4,opt,name=update,proto3" json:"update,omitempty"`
 }
 
-func (x *AccountDataIncrementalRefresh) Reset() {
-	*x = AccountDataIncrementalRefresh{}
+func (x *UnipoolV3DataIncrementalRefresh) Reset() {
+	*x = UnipoolV3DataIncrementalRefresh{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2157,13 +2257,13 @@ func (x *AccountDataIncrementalRefresh) Reset() {
 	}
 }
 
-func (x *AccountDataIncrementalRefresh) String() string {
+func (x *UnipoolV3DataIncrementalRefresh) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountDataIncrementalRefresh) ProtoMessage() {}
+func (*UnipoolV3DataIncrementalRefresh) ProtoMessage() {}
 
-func (x *AccountDataIncrementalRefresh) ProtoReflect() protoreflect.Message {
+func (x *UnipoolV3DataIncrementalRefresh) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2175,47 +2275,58 @@ func (x *AccountDataIncrementalRefresh) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountDataIncrementalRefresh.ProtoReflect.Descriptor instead.
-func (*AccountDataIncrementalRefresh) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnipoolV3DataIncrementalRefresh.ProtoReflect.Descriptor instead.
+func (*UnipoolV3DataIncrementalRefresh) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *AccountDataIncrementalRefresh) GetRequestID() uint64 {
+func (x *UnipoolV3DataIncrementalRefresh) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *AccountDataIncrementalRefresh) GetResponseID() uint64 {
+func (x *UnipoolV3DataIncrementalRefresh) GetResponseID() uint64 {
 	if x != nil {
 		return x.ResponseID
 	}
 	return 0
 }
 
-func (x *AccountDataIncrementalRefresh) GetReport() *ExecutionReport {
+func (x *UnipoolV3DataIncrementalRefresh) GetSeqNum() uint64 {
 	if x != nil {
-		return x.Report
+		return x.SeqNum
+	}
+	return 0
+}
+
+func (x *UnipoolV3DataIncrementalRefresh) GetUpdate() *models.UPV3Update {
+	if x != nil {
+		return x.Update
 	}
 	return nil
 }
 
-type AccountMovement struct {
+// Simulates a swap against the pool state, split across the pools if more than one
+type UnipoolV3QuoteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Asset      *models1.Asset         `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	Change     float64                `protobuf:"fixed64,2,opt,name=change,proto3" json:"change,omitempty"`
-	Type       AccountMovementType    `protobuf:"varint,3,opt,name=type,proto3,enum=messages.AccountMovementType" json:"type,omitempty"`
-	Subtype    string                 `protobuf:"bytes,4,opt,name=subtype,proto3" json:"subtype,omitempty"`
-	MovementID string                 `protobuf:"bytes,5,opt,name=movementID,proto3" json:"movementID,omitempty"`
-	Time       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	RequestID uint64 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	// Pools of the same pair
+	Instruments []*models.Instrument `protobuf:"bytes,2,rep,name=instruments,proto3" json:"instruments,omitempty"`
+	ZeroForOne  bool                 `protobuf:"varint,3,opt,name=zero_for_one,json=zeroForOne,proto3" json:"zero_for_one,omitempty"`
+	ExactOutput bool                 `protobuf:"varint,4,opt,name=exact_output,json=exactOutput,proto3" json:"exact_output,omitempty"`
+	// The amount in, or the amount out for an exact output, big-endian
+	Amount []byte `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The number of parts the amount is split in when routing, 10 by default, 100 at most
+	RouteParts uint32 `protobuf:"varint,6,opt,name=route_parts,json=routeParts,proto3" json:"route_parts,omitempty"`
 }
 
-func (x *AccountMovement) Reset() {
-	*x = AccountMovement{}
+func (x *UnipoolV3QuoteRequest) Reset() {
+	*x = UnipoolV3QuoteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2223,13 +2334,13 @@ func (x *AccountMovement) Reset() {
 	}
 }
 
-func (x *AccountMovement) String() string {
+func (x *UnipoolV3QuoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountMovement) ProtoMessage() {}
+func (*UnipoolV3QuoteRequest) ProtoMessage() {}
 
-func (x *AccountMovement) ProtoReflect() protoreflect.Message {
+func (x *UnipoolV3QuoteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2241,64 +2352,69 @@ func (x *AccountMovement) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountMovement.ProtoReflect.Descriptor instead.
-func (*AccountMovement) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnipoolV3QuoteRequest.ProtoReflect.Descriptor instead.
+func (*UnipoolV3QuoteRequest) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *AccountMovement) GetAsset() *models1.Asset {
+func (x *UnipoolV3QuoteRequest) GetRequestID() uint64 {
 	if x != nil {
-		return x.Asset
+		return x.RequestID
 	}
-	return nil
+	return 0
 }
 
-func (x *AccountMovement) GetChange() float64 {
+func (x *UnipoolV3QuoteRequest) GetInstruments() []*models.Instrument {
 	if x != nil {
-		return x.Change
+		return x.Instruments
 	}
-	return 0
+	return nil
 }
 
-func (x *AccountMovement) GetType() AccountMovementType {
+func (x *UnipoolV3QuoteRequest) GetZeroForOne() bool {
 	if x != nil {
-		return x.Type
+		return x.ZeroForOne
 	}
-	return AccountMovementType_Unknown
+	return false
 }
 
-func (x *AccountMovement) GetSubtype() string {
+func (x *UnipoolV3QuoteRequest) GetExactOutput() bool {
 	if x != nil {
-		return x.Subtype
+		return x.ExactOutput
 	}
-	return ""
+	return false
 }
 
-func (x *AccountMovement) GetMovementID() string {
+func (x *UnipoolV3QuoteRequest) GetAmount() []byte {
 	if x != nil {
-		return x.MovementID
+		return x.Amount
 	}
-	return ""
+	return nil
 }
 
-func (x *AccountMovement) GetTime() *timestamppb.Timestamp {
+func (x *UnipoolV3QuoteRequest) GetRouteParts() uint32 {
 	if x != nil {
-		return x.Time
+		return x.RouteParts
 	}
-	return nil
+	return 0
 }
 
-type AccountInformationRequest struct {
+type UnipoolV3QuoteLeg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Account   *models.Account `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Instrument        *models.Instrument `protobuf:"bytes,1,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	AmountIn          []byte             `protobuf:"bytes,2,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	AmountOut         []byte             `protobuf:"bytes,3,opt,name=amount_out,json=amountOut,proto3" json:"amount_out,omitempty"`
+	SqrtPriceX96After []byte             `protobuf:"bytes,4,opt,name=sqrt_price_x96_after,json=sqrtPriceX96After,proto3" json:"sqrt_price_x96_after,omitempty"`
+	TickAfter         int32              `protobuf:"varint,5,opt,name=tick_after,json=tickAfter,proto3" json:"tick_after,omitempty"`
+	TicksCrossed      uint32             `protobuf:"varint,6,opt,name=ticks_crossed,json=ticksCrossed,proto3" json:"ticks_crossed,omitempty"`
+	PriceImpact       float64            `protobuf:"fixed64,7,opt,name=price_impact,json=priceImpact,proto3" json:"price_impact,omitempty"`
 }
 
-func (x *AccountInformationRequest) Reset() {
-	*x = AccountInformationRequest{}
+func (x *UnipoolV3QuoteLeg) Reset() {
+	*x = UnipoolV3QuoteLeg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2306,13 +2422,13 @@ func (x *AccountInformationRequest) Reset() {
 	}
 }
 
-func (x *AccountInformationRequest) String() string {
+func (x *UnipoolV3QuoteLeg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountInformationRequest) ProtoMessage() {}
+func (*UnipoolV3QuoteLeg) ProtoMessage() {}
 
-func (x *AccountInformationRequest) ProtoReflect() protoreflect.Message {
+func (x *UnipoolV3QuoteLeg) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2324,40 +2440,78 @@ func (x *AccountInformationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountInformationRequest.ProtoReflect.Descriptor instead.
-func (*AccountInformationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnipoolV3QuoteLeg.ProtoReflect.Descriptor instead.
+func (*UnipoolV3QuoteLeg) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AccountInformationRequest) GetRequestID() uint64 {
+func (x *UnipoolV3QuoteLeg) GetInstrument() *models.Instrument {
 	if x != nil {
-		return x.RequestID
+		return x.Instrument
 	}
-	return 0
+	return nil
 }
 
-func (x *AccountInformationRequest) GetAccount() *models.Account {
+func (x *UnipoolV3QuoteLeg) GetAmountIn() []byte {
 	if x != nil {
-		return x.Account
+		return x.AmountIn
 	}
 	return nil
 }
 
-type AccountInformationResponse struct {
+func (x *UnipoolV3QuoteLeg) GetAmountOut() []byte {
+	if x != nil {
+		return x.AmountOut
+	}
+	return nil
+}
+
+func (x *UnipoolV3QuoteLeg) GetSqrtPriceX96After() []byte {
+	if x != nil {
+		return x.SqrtPriceX96After
+	}
+	return nil
+}
+
+func (x *UnipoolV3QuoteLeg) GetTickAfter() int32 {
+	if x != nil {
+		return x.TickAfter
+	}
+	return 0
+}
+
+func (x *UnipoolV3QuoteLeg) GetTicksCrossed() uint32 {
+	if x != nil {
+		return x.TicksCrossed
+	}
+	return 0
+}
+
+func (x *UnipoolV3QuoteLeg) GetPriceImpact() float64 {
+	if x != nil {
+		return x.PriceImpact
+	}
+	return 0
+}
+
+type UnipoolV3QuoteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64                  `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64                  `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	MakerFee        *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=maker_fee,json=makerFee,proto3" json:"maker_fee,omitempty"`
-	TakerFee        *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty"`
-	Success         bool                    `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason RejectionReason         `protobuf:"varint,6,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	RequestID       uint64               `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64               `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	AmountIn        []byte               `protobuf:"bytes,3,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	AmountOut       []byte               `protobuf:"bytes,4,opt,name=amount_out,json=amountOut,proto3" json:"amount_out,omitempty"`
+	PriceImpact     float64              `protobuf:"fixed64,5,opt,name=price_impact,json=priceImpact,proto3" json:"price_impact,omitempty"`
+	TicksCrossed    uint32               `protobuf:"varint,6,opt,name=ticks_crossed,json=ticksCrossed,proto3" json:"ticks_crossed,omitempty"`
+	Legs            []*UnipoolV3QuoteLeg `protobuf:"bytes,7,rep,name=legs,proto3" json:"legs,omitempty"`
+	Success         bool                 `protobuf:"varint,8,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason      `protobuf:"varint,9,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *AccountInformationResponse) Reset() {
-	*x = AccountInformationResponse{}
+func (x *UnipoolV3QuoteResponse) Reset() {
+	*x = UnipoolV3QuoteResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2365,13 +2519,13 @@ func (x *AccountInformationResponse) Reset() {
 	}
 }
 
-func (x *AccountInformationResponse) String() string {
+func (x *UnipoolV3QuoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountInformationResponse) ProtoMessage() {}
+func (*UnipoolV3QuoteResponse) ProtoMessage() {}
 
-func (x *AccountInformationResponse) ProtoReflect() protoreflect.Message {
+func (x *UnipoolV3QuoteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2383,66 +2537,87 @@ func (x *AccountInformationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountInformationResponse.ProtoReflect.Descriptor instead.
-func (*AccountInformationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnipoolV3QuoteResponse.ProtoReflect.Descriptor instead.
+func (*UnipoolV3QuoteResponse) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *AccountInformationResponse) GetRequestID() uint64 {
+func (x *UnipoolV3QuoteResponse) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *AccountInformationResponse) GetResponseID() uint64 {
+func (x *UnipoolV3QuoteResponse) GetResponseID() uint64 {
 	if x != nil {
 		return x.ResponseID
 	}
 	return 0
 }
 
-func (x *AccountInformationResponse) GetMakerFee() *wrapperspb.DoubleValue {
+func (x *UnipoolV3QuoteResponse) GetAmountIn() []byte {
 	if x != nil {
-		return x.MakerFee
+		return x.AmountIn
 	}
 	return nil
 }
 
-func (x *AccountInformationResponse) GetTakerFee() *wrapperspb.DoubleValue {
+func (x *UnipoolV3QuoteResponse) GetAmountOut() []byte {
 	if x != nil {
-		return x.TakerFee
+		return x.AmountOut
 	}
 	return nil
 }
 
-func (x *AccountInformationResponse) GetSuccess() bool {
+func (x *UnipoolV3QuoteResponse) GetPriceImpact() float64 {
+	if x != nil {
+		return x.PriceImpact
+	}
+	return 0
+}
+
+func (x *UnipoolV3QuoteResponse) GetTicksCrossed() uint32 {
+	if x != nil {
+		return x.TicksCrossed
+	}
+	return 0
+}
+
+func (x *UnipoolV3QuoteResponse) GetLegs() []*UnipoolV3QuoteLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *UnipoolV3QuoteResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *AccountInformationResponse) GetRejectionReason() RejectionReason {
+func (x *UnipoolV3QuoteResponse) GetRejectionReason() RejectionReason {
 	if x != nil {
 		return x.RejectionReason
 	}
 	return RejectionReason_Other
 }
 
-type AccountMovementRequest struct {
+type AccountDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID uint64                 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Account   *models.Account        `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	Type      AccountMovementType    `protobuf:"varint,3,opt,name=type,proto3,enum=messages.AccountMovementType" json:"type,omitempty"`
-	Filter    *AccountMovementFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	RequestID  uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Subscribe  bool            `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	Subscriber *actor.PID      `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	Account    *models.Account `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (x *AccountMovementRequest) Reset() {
-	*x = AccountMovementRequest{}
+func (x *AccountDataRequest) Reset() {
+	*x = AccountDataRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2450,13 +2625,13 @@ func (x *AccountMovementRequest) Reset() {
 	}
 }
 
-func (x *AccountMovementRequest) String() string {
+func (x *AccountDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountMovementRequest) ProtoMessage() {}
+func (*AccountDataRequest) ProtoMessage() {}
 
-func (x *AccountMovementRequest) ProtoReflect() protoreflect.Message {
+func (x *AccountDataRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2468,51 +2643,59 @@ func (x *AccountMovementRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountMovementRequest.ProtoReflect.Descriptor instead.
-func (*AccountMovementRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountDataRequest.ProtoReflect.Descriptor instead.
+func (*AccountDataRequest) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *AccountMovementRequest) GetRequestID() uint64 {
+func (x *AccountDataRequest) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *AccountMovementRequest) GetAccount() *models.Account {
+func (x *AccountDataRequest) GetSubscribe() bool {
 	if x != nil {
-		return x.Account
+		return x.Subscribe
 	}
-	return nil
+	return false
 }
 
-func (x *AccountMovementRequest) GetType() AccountMovementType {
+func (x *AccountDataRequest) GetSubscriber() *actor.PID {
 	if x != nil {
-		return x.Type
+		return x.Subscriber
 	}
-	return AccountMovementType_Unknown
+	return nil
 }
 
-func (x *AccountMovementRequest) GetFilter() *AccountMovementFilter {
+func (x *AccountDataRequest) GetAccount() *models.Account {
 	if x != nil {
-		return x.Filter
+		return x.Account
 	}
 	return nil
 }
 
-type AccountMovementFilter struct {
+type AccountDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Instrument *models.Instrument     `protobuf:"bytes,1,opt,name=instrument,proto3" json:"instrument,omitempty"`
-	From       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	RequestID       uint64                  `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64                  `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	Securities      []*models.Security      `protobuf:"bytes,3,rep,name=securities,proto3" json:"securities,omitempty"`
+	Orders          []*models.Order         `protobuf:"bytes,4,rep,name=orders,proto3" json:"orders,omitempty"`
+	Positions       []*models.Position      `protobuf:"bytes,5,rep,name=positions,proto3" json:"positions,omitempty"`
+	Balances        []*models.Balance       `protobuf:"bytes,6,rep,name=balances,proto3" json:"balances,omitempty"`
+	MakerFee        *wrapperspb.DoubleValue `protobuf:"bytes,7,opt,name=maker_fee,json=makerFee,proto3" json:"maker_fee,omitempty"`
+	TakerFee        *wrapperspb.DoubleValue `protobuf:"bytes,8,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty"`
+	Success         bool                    `protobuf:"varint,9,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason         `protobuf:"varint,10,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	SeqNum          uint64                  `protobuf:"varint,11,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
 }
 
-func (x *AccountMovementFilter) Reset() {
-	*x = AccountMovementFilter{}
+func (x *AccountDataResponse) Reset() {
+	*x = AccountDataResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2520,13 +2703,13 @@ func (x *AccountMovementFilter) Reset() {
 	}
 }
 
-func (x *AccountMovementFilter) String() string {
+func (x *AccountDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountMovementFilter) ProtoMessage() {}
+func (*AccountDataResponse) ProtoMessage() {}
 
-func (x *AccountMovementFilter) ProtoReflect() protoreflect.Message {
+func (x *AccountDataResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2538,138 +2721,115 @@ func (x *AccountMovementFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountMovementFilter.ProtoReflect.Descriptor instead.
-func (*AccountMovementFilter) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountDataResponse.ProtoReflect.Descriptor instead.
+func (*AccountDataResponse) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *AccountMovementFilter) GetInstrument() *models.Instrument {
+func (x *AccountDataResponse) GetRequestID() uint64 {
 	if x != nil {
-		return x.Instrument
+		return x.RequestID
 	}
-	return nil
+	return 0
 }
 
-func (x *AccountMovementFilter) GetFrom() *timestamppb.Timestamp {
+func (x *AccountDataResponse) GetResponseID() uint64 {
 	if x != nil {
-		return x.From
+		return x.ResponseID
 	}
-	return nil
+	return 0
 }
 
-func (x *AccountMovementFilter) GetTo() *timestamppb.Timestamp {
+func (x *AccountDataResponse) GetSecurities() []*models.Security {
 	if x != nil {
-		return x.To
+		return x.Securities
 	}
 	return nil
 }
 
-type AccountMovementResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	RequestID       uint64             `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64             `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	Movements       []*AccountMovement `protobuf:"bytes,3,rep,name=movements,proto3" json:"movements,omitempty"`
-	Success         bool               `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason RejectionReason    `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
-}
-
-func (x *AccountMovementResponse) Reset() {
-	*x = AccountMovementResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AccountDataResponse) GetOrders() []*models.Order {
+	if x != nil {
+		return x.Orders
 	}
+	return nil
 }
 
-func (x *AccountMovementResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AccountMovementResponse) ProtoMessage() {}
-
-func (x *AccountMovementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AccountDataResponse) GetPositions() []*models.Position {
+	if x != nil {
+		return x.Positions
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AccountMovementResponse.ProtoReflect.Descriptor instead.
-func (*AccountMovementResponse) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{26}
+	return nil
 }
 
-func (x *AccountMovementResponse) GetRequestID() uint64 {
+func (x *AccountDataResponse) GetBalances() []*models.Balance {
 	if x != nil {
-		return x.RequestID
+		return x.Balances
 	}
-	return 0
+	return nil
 }
 
-func (x *AccountMovementResponse) GetResponseID() uint64 {
+func (x *AccountDataResponse) GetMakerFee() *wrapperspb.DoubleValue {
 	if x != nil {
-		return x.ResponseID
+		return x.MakerFee
 	}
-	return 0
+	return nil
 }
 
-func (x *AccountMovementResponse) GetMovements() []*AccountMovement {
+func (x *AccountDataResponse) GetTakerFee() *wrapperspb.DoubleValue {
 	if x != nil {
-		return x.Movements
+		return x.TakerFee
 	}
 	return nil
 }
 
-func (x *AccountMovementResponse) GetSuccess() bool {
+func (x *AccountDataResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *AccountMovementResponse) GetRejectionReason() RejectionReason {
+func (x *AccountDataResponse) GetRejectionReason() RejectionReason {
 	if x != nil {
 		return x.RejectionReason
 	}
 	return RejectionReason_Other
 }
 
-type TradeCaptureReportRequest struct {
+func (x *AccountDataResponse) GetSeqNum() uint64 {
+	if x != nil {
+		return x.SeqNum
+	}
+	return 0
+}
+
+type AccountDataIncrementalRefresh struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID uint64                    `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Account   *models.Account           `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	Filter    *TradeCaptureReportFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	RequestID  uint64           `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID uint64           `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	Report     *ExecutionReport `protobuf:"bytes,3,opt,name=report,proto3" json:"report,omitempty"`
 }
 
-func (x *TradeCaptureReportRequest) Reset() {
-	*x = TradeCaptureReportRequest{}
+func (x *AccountDataIncrementalRefresh) Reset() {
+	*x = AccountDataIncrementalRefresh{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[27]
+		mi := &file_executor_messages_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TradeCaptureReportRequest) String() string {
+func (x *AccountDataIncrementalRefresh) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TradeCaptureReportRequest) ProtoMessage() {}
+func (*AccountDataIncrementalRefresh) ProtoMessage() {}
 
-func (x *TradeCaptureReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[27]
+func (x *AccountDataIncrementalRefresh) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2680,63 +2840,62 @@ func (x *TradeCaptureReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TradeCaptureReportRequest.ProtoReflect.Descriptor instead.
-func (*TradeCaptureReportRequest) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use AccountDataIncrementalRefresh.ProtoReflect.Descriptor instead.
+func (*AccountDataIncrementalRefresh) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *TradeCaptureReportRequest) GetRequestID() uint64 {
+func (x *AccountDataIncrementalRefresh) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *TradeCaptureReportRequest) GetAccount() *models.Account {
+func (x *AccountDataIncrementalRefresh) GetResponseID() uint64 {
 	if x != nil {
-		return x.Account
+		return x.ResponseID
 	}
-	return nil
+	return 0
 }
 
-func (x *TradeCaptureReportRequest) GetFilter() *TradeCaptureReportFilter {
+func (x *AccountDataIncrementalRefresh) GetReport() *ExecutionReport {
 	if x != nil {
-		return x.Filter
+		return x.Report
 	}
 	return nil
 }
 
-type TradeCaptureReportFilter struct {
+type AccountMovement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderID       *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
-	ClientOrderID *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=client_orderID,json=clientOrderID,proto3" json:"client_orderID,omitempty"`
-	Instrument    *models.Instrument      `protobuf:"bytes,3,opt,name=instrument,proto3" json:"instrument,omitempty"`
-	Side          *SideValue              `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
-	From          *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
-	To            *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
-	FromID        *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=fromID,proto3" json:"fromID,omitempty"`
+	Asset      *models1.Asset         `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Change     float64                `protobuf:"fixed64,2,opt,name=change,proto3" json:"change,omitempty"`
+	Type       AccountMovementType    `protobuf:"varint,3,opt,name=type,proto3,enum=messages.AccountMovementType" json:"type,omitempty"`
+	Subtype    string                 `protobuf:"bytes,4,opt,name=subtype,proto3" json:"subtype,omitempty"`
+	MovementID string                 `protobuf:"bytes,5,opt,name=movementID,proto3" json:"movementID,omitempty"`
+	Time       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
 }
 
-func (x *TradeCaptureReportFilter) Reset() {
-	*x = TradeCaptureReportFilter{}
+func (x *AccountMovement) Reset() {
+	*x = AccountMovement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[28]
+		mi := &file_executor_messages_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TradeCaptureReportFilter) String() string {
+func (x *AccountMovement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TradeCaptureReportFilter) ProtoMessage() {}
+func (*AccountMovement) ProtoMessage() {}
 
-func (x *TradeCaptureReportFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[28]
+func (x *AccountMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2747,89 +2906,79 @@ func (x *TradeCaptureReportFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TradeCaptureReportFilter.ProtoReflect.Descriptor instead.
-func (*TradeCaptureReportFilter) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *TradeCaptureReportFilter) GetOrderID() *wrapperspb.StringValue {
-	if x != nil {
-		return x.OrderID
-	}
-	return nil
+// Deprecated: Use AccountMovement.ProtoReflect.Descriptor instead.
+func (*AccountMovement) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *TradeCaptureReportFilter) GetClientOrderID() *wrapperspb.StringValue {
+func (x *AccountMovement) GetAsset() *models1.Asset {
 	if x != nil {
-		return x.ClientOrderID
+		return x.Asset
 	}
 	return nil
 }
 
-func (x *TradeCaptureReportFilter) GetInstrument() *models.Instrument {
+func (x *AccountMovement) GetChange() float64 {
 	if x != nil {
-		return x.Instrument
+		return x.Change
 	}
-	return nil
+	return 0
 }
 
-func (x *TradeCaptureReportFilter) GetSide() *SideValue {
+func (x *AccountMovement) GetType() AccountMovementType {
 	if x != nil {
-		return x.Side
+		return x.Type
 	}
-	return nil
+	return AccountMovementType_Unknown
 }
 
-func (x *TradeCaptureReportFilter) GetFrom() *timestamppb.Timestamp {
+func (x *AccountMovement) GetSubtype() string {
 	if x != nil {
-		return x.From
+		return x.Subtype
 	}
-	return nil
+	return ""
 }
 
-func (x *TradeCaptureReportFilter) GetTo() *timestamppb.Timestamp {
+func (x *AccountMovement) GetMovementID() string {
 	if x != nil {
-		return x.To
+		return x.MovementID
 	}
-	return nil
+	return ""
 }
 
-func (x *TradeCaptureReportFilter) GetFromID() *wrapperspb.StringValue {
+func (x *AccountMovement) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FromID
+		return x.Time
 	}
 	return nil
 }
 
-type TradeCaptureReport struct {
+type AccountInformationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64                 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64                 `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	Trades          []*models.TradeCapture `protobuf:"bytes,3,rep,name=trades,proto3" json:"trades,omitempty"`
-	Success         bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason RejectionReason        `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	RequestID uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Account   *models.Account `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (x *TradeCaptureReport) Reset() {
-	*x = TradeCaptureReport{}
+func (x *AccountInformationRequest) Reset() {
+	*x = AccountInformationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[29]
+		mi := &file_executor_messages_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TradeCaptureReport) String() string {
+func (x *AccountInformationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TradeCaptureReport) ProtoMessage() {}
+func (*AccountInformationRequest) ProtoMessage() {}
 
-func (x *TradeCaptureReport) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[29]
+func (x *AccountInformationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2840,72 +2989,55 @@ func (x *TradeCaptureReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TradeCaptureReport.ProtoReflect.Descriptor instead.
-func (*TradeCaptureReport) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use AccountInformationRequest.ProtoReflect.Descriptor instead.
+func (*AccountInformationRequest) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *TradeCaptureReport) GetRequestID() uint64 {
+func (x *AccountInformationRequest) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *TradeCaptureReport) GetResponseID() uint64 {
-	if x != nil {
-		return x.ResponseID
-	}
-	return 0
-}
-
-func (x *TradeCaptureReport) GetTrades() []*models.TradeCapture {
+func (x *AccountInformationRequest) GetAccount() *models.Account {
 	if x != nil {
-		return x.Trades
+		return x.Account
 	}
 	return nil
 }
 
-func (x *TradeCaptureReport) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *TradeCaptureReport) GetRejectionReason() RejectionReason {
-	if x != nil {
-		return x.RejectionReason
-	}
-	return RejectionReason_Other
-}
-
-type SecurityDefinitionRequest struct {
+type AccountInformationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID  uint64             `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Instrument *models.Instrument `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	RequestID       uint64                  `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64                  `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	MakerFee        *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=maker_fee,json=makerFee,proto3" json:"maker_fee,omitempty"`
+	TakerFee        *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty"`
+	Success         bool                    `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason         `protobuf:"varint,6,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *SecurityDefinitionRequest) Reset() {
-	*x = SecurityDefinitionRequest{}
+func (x *AccountInformationResponse) Reset() {
+	*x = AccountInformationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[30]
+		mi := &file_executor_messages_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SecurityDefinitionRequest) String() string {
+func (x *AccountInformationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecurityDefinitionRequest) ProtoMessage() {}
+func (*AccountInformationResponse) ProtoMessage() {}
 
-func (x *SecurityDefinitionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[30]
+func (x *AccountInformationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2916,54 +3048,81 @@ func (x *SecurityDefinitionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecurityDefinitionRequest.ProtoReflect.Descriptor instead.
-func (*SecurityDefinitionRequest) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use AccountInformationResponse.ProtoReflect.Descriptor instead.
+func (*AccountInformationResponse) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *SecurityDefinitionRequest) GetRequestID() uint64 {
+func (x *AccountInformationResponse) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *SecurityDefinitionRequest) GetInstrument() *models.Instrument {
+func (x *AccountInformationResponse) GetResponseID() uint64 {
 	if x != nil {
-		return x.Instrument
+		return x.ResponseID
+	}
+	return 0
+}
+
+func (x *AccountInformationResponse) GetMakerFee() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.MakerFee
 	}
 	return nil
 }
 
-type SecurityDefinitionResponse struct {
+func (x *AccountInformationResponse) GetTakerFee() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.TakerFee
+	}
+	return nil
+}
+
+func (x *AccountInformationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AccountInformationResponse) GetRejectionReason() RejectionReason {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return RejectionReason_Other
+}
+
+type AccountMovementRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64           `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64           `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	Security        *models.Security `protobuf:"bytes,3,opt,name=security,proto3" json:"security,omitempty"`
-	Success         bool             `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason RejectionReason  `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	RequestID uint64                 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Account   *models.Account        `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Type      AccountMovementType    `protobuf:"varint,3,opt,name=type,proto3,enum=messages.AccountMovementType" json:"type,omitempty"`
+	Filter    *AccountMovementFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *SecurityDefinitionResponse) Reset() {
-	*x = SecurityDefinitionResponse{}
+func (x *AccountMovementRequest) Reset() {
+	*x = AccountMovementRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[31]
+		mi := &file_executor_messages_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SecurityDefinitionResponse) String() string {
+func (x *AccountMovementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecurityDefinitionResponse) ProtoMessage() {}
+func (*AccountMovementRequest) ProtoMessage() {}
 
-func (x *SecurityDefinitionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[31]
+func (x *AccountMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2974,73 +3133,66 @@ func (x *SecurityDefinitionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecurityDefinitionResponse.ProtoReflect.Descriptor instead.
-func (*SecurityDefinitionResponse) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use AccountMovementRequest.ProtoReflect.Descriptor instead.
+func (*AccountMovementRequest) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SecurityDefinitionResponse) GetRequestID() uint64 {
+func (x *AccountMovementRequest) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *SecurityDefinitionResponse) GetResponseID() uint64 {
-	if x != nil {
-		return x.ResponseID
-	}
-	return 0
-}
-
-func (x *SecurityDefinitionResponse) GetSecurity() *models.Security {
+func (x *AccountMovementRequest) GetAccount() *models.Account {
 	if x != nil {
-		return x.Security
+		return x.Account
 	}
 	return nil
 }
 
-func (x *SecurityDefinitionResponse) GetSuccess() bool {
+func (x *AccountMovementRequest) GetType() AccountMovementType {
 	if x != nil {
-		return x.Success
+		return x.Type
 	}
-	return false
+	return AccountMovementType_Unknown
 }
 
-func (x *SecurityDefinitionResponse) GetRejectionReason() RejectionReason {
+func (x *AccountMovementRequest) GetFilter() *AccountMovementFilter {
 	if x != nil {
-		return x.RejectionReason
+		return x.Filter
 	}
-	return RejectionReason_Other
+	return nil
 }
 
-type SecurityListRequest struct {
+type AccountMovementFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID  uint64     `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Subscribe  bool       `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
-	Subscriber *actor.PID `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	Instrument *models.Instrument     `protobuf:"bytes,1,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	From       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
 }
 
-func (x *SecurityListRequest) Reset() {
-	*x = SecurityListRequest{}
+func (x *AccountMovementFilter) Reset() {
+	*x = AccountMovementFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[32]
+		mi := &file_executor_messages_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SecurityListRequest) String() string {
+func (x *AccountMovementFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecurityListRequest) ProtoMessage() {}
+func (*AccountMovementFilter) ProtoMessage() {}
 
-func (x *SecurityListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[32]
+func (x *AccountMovementFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3051,61 +3203,61 @@ func (x *SecurityListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecurityListRequest.ProtoReflect.Descriptor instead.
-func (*SecurityListRequest) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use AccountMovementFilter.ProtoReflect.Descriptor instead.
+func (*AccountMovementFilter) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SecurityListRequest) GetRequestID() uint64 {
+func (x *AccountMovementFilter) GetInstrument() *models.Instrument {
 	if x != nil {
-		return x.RequestID
+		return x.Instrument
 	}
-	return 0
+	return nil
 }
 
-func (x *SecurityListRequest) GetSubscribe() bool {
+func (x *AccountMovementFilter) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Subscribe
+		return x.From
 	}
-	return false
+	return nil
 }
 
-func (x *SecurityListRequest) GetSubscriber() *actor.PID {
+func (x *AccountMovementFilter) GetTo() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Subscriber
+		return x.To
 	}
 	return nil
 }
 
-type SecurityList struct {
+type AccountMovementResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	RequestID       uint64             `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
 	ResponseID      uint64             `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	Securities      []*models.Security `protobuf:"bytes,3,rep,name=securities,proto3" json:"securities,omitempty"`
+	Movements       []*AccountMovement `protobuf:"bytes,3,rep,name=movements,proto3" json:"movements,omitempty"`
 	Success         bool               `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
 	RejectionReason RejectionReason    `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *SecurityList) Reset() {
-	*x = SecurityList{}
+func (x *AccountMovementResponse) Reset() {
+	*x = AccountMovementResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[33]
+		mi := &file_executor_messages_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SecurityList) String() string {
+func (x *AccountMovementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecurityList) ProtoMessage() {}
+func (*AccountMovementResponse) ProtoMessage() {}
 
-func (x *SecurityList) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[33]
+func (x *AccountMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3116,88 +3268,73 @@ func (x *SecurityList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecurityList.ProtoReflect.Descriptor instead.
-func (*SecurityList) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use AccountMovementResponse.ProtoReflect.Descriptor instead.
+func (*AccountMovementResponse) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *SecurityList) GetRequestID() uint64 {
+func (x *AccountMovementResponse) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *SecurityList) GetResponseID() uint64 {
+func (x *AccountMovementResponse) GetResponseID() uint64 {
 	if x != nil {
 		return x.ResponseID
 	}
 	return 0
 }
 
-func (x *SecurityList) GetSecurities() []*models.Security {
+func (x *AccountMovementResponse) GetMovements() []*AccountMovement {
 	if x != nil {
-		return x.Securities
+		return x.Movements
 	}
 	return nil
 }
 
-func (x *SecurityList) GetSuccess() bool {
+func (x *AccountMovementResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *SecurityList) GetRejectionReason() RejectionReason {
+func (x *AccountMovementResponse) GetRejectionReason() RejectionReason {
 	if x != nil {
 		return x.RejectionReason
 	}
 	return RejectionReason_Other
 }
 
-type ExecutionReport struct {
+type TradeCaptureReportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SeqNum          uint64                  `protobuf:"varint,1,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
-	OrderID         string                  `protobuf:"bytes,2,opt,name=orderID,proto3" json:"orderID,omitempty"`
-	ClientOrderID   *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=client_orderID,json=clientOrderID,proto3" json:"client_orderID,omitempty"`
-	ExecutionID     string                  `protobuf:"bytes,4,opt,name=executionID,proto3" json:"executionID,omitempty"`
-	ExecutionType   ExecutionType           `protobuf:"varint,5,opt,name=execution_type,json=executionType,proto3,enum=messages.ExecutionType" json:"execution_type,omitempty"`
-	OrderStatus     models.OrderStatus      `protobuf:"varint,6,opt,name=order_status,json=orderStatus,proto3,enum=models.OrderStatus" json:"order_status,omitempty"`
-	Instrument      *models.Instrument      `protobuf:"bytes,7,opt,name=instrument,proto3" json:"instrument,omitempty"`
-	LeavesQuantity  float64                 `protobuf:"fixed64,9,opt,name=leaves_quantity,json=leavesQuantity,proto3" json:"leaves_quantity,omitempty"`
-	CumQuantity     float64                 `protobuf:"fixed64,10,opt,name=cum_quantity,json=cumQuantity,proto3" json:"cum_quantity,omitempty"`
-	TransactionTime *timestamppb.Timestamp  `protobuf:"bytes,11,opt,name=transaction_time,json=transactionTime,proto3" json:"transaction_time,omitempty"`
-	TradeID         *wrapperspb.StringValue `protobuf:"bytes,12,opt,name=tradeID,proto3" json:"tradeID,omitempty"`
-	FillPrice       *wrapperspb.DoubleValue `protobuf:"bytes,13,opt,name=fill_price,json=fillPrice,proto3" json:"fill_price,omitempty"`
-	FillQuantity    *wrapperspb.DoubleValue `protobuf:"bytes,14,opt,name=fill_quantity,json=fillQuantity,proto3" json:"fill_quantity,omitempty"`
-	FeeAmount       *wrapperspb.DoubleValue `protobuf:"bytes,15,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
-	FeeCurrency     *models1.Asset          `protobuf:"bytes,16,opt,name=fee_currency,json=feeCurrency,proto3" json:"fee_currency,omitempty"`
-	FeeType         FeeType                 `protobuf:"varint,17,opt,name=fee_type,json=feeType,proto3,enum=messages.FeeType" json:"fee_type,omitempty"`
-	FeeBasis        FeeBasis                `protobuf:"varint,18,opt,name=fee_basis,json=feeBasis,proto3,enum=messages.FeeBasis" json:"fee_basis,omitempty"`
-	RejectionReason RejectionReason         `protobuf:"varint,19,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	RequestID uint64                    `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Account   *models.Account           `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Filter    *TradeCaptureReportFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *ExecutionReport) Reset() {
-	*x = ExecutionReport{}
+func (x *TradeCaptureReportRequest) Reset() {
+	*x = TradeCaptureReportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[34]
+		mi := &file_executor_messages_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExecutionReport) String() string {
+func (x *TradeCaptureReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutionReport) ProtoMessage() {}
+func (*TradeCaptureReportRequest) ProtoMessage() {}
 
-func (x *ExecutionReport) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[34]
+func (x *TradeCaptureReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3208,149 +3345,141 @@ func (x *ExecutionReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutionReport.ProtoReflect.Descriptor instead.
-func (*ExecutionReport) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use TradeCaptureReportRequest.ProtoReflect.Descriptor instead.
+func (*TradeCaptureReportRequest) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ExecutionReport) GetSeqNum() uint64 {
+func (x *TradeCaptureReportRequest) GetRequestID() uint64 {
 	if x != nil {
-		return x.SeqNum
+		return x.RequestID
 	}
 	return 0
 }
 
-func (x *ExecutionReport) GetOrderID() string {
+func (x *TradeCaptureReportRequest) GetAccount() *models.Account {
 	if x != nil {
-		return x.OrderID
+		return x.Account
 	}
-	return ""
+	return nil
 }
 
-func (x *ExecutionReport) GetClientOrderID() *wrapperspb.StringValue {
+func (x *TradeCaptureReportRequest) GetFilter() *TradeCaptureReportFilter {
 	if x != nil {
-		return x.ClientOrderID
+		return x.Filter
 	}
 	return nil
 }
 
-func (x *ExecutionReport) GetExecutionID() string {
-	if x != nil {
-		return x.ExecutionID
-	}
-	return ""
-}
+type TradeCaptureReportFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ExecutionReport) GetExecutionType() ExecutionType {
-	if x != nil {
-		return x.ExecutionType
-	}
-	return ExecutionType_New
+	OrderID       *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	ClientOrderID *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=client_orderID,json=clientOrderID,proto3" json:"client_orderID,omitempty"`
+	Instrument    *models.Instrument      `protobuf:"bytes,3,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Side          *SideValue              `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	From          *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	FromID        *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=fromID,proto3" json:"fromID,omitempty"`
 }
 
-func (x *ExecutionReport) GetOrderStatus() models.OrderStatus {
-	if x != nil {
-		return x.OrderStatus
+func (x *TradeCaptureReportFilter) Reset() {
+	*x = TradeCaptureReportFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_messages_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return models.OrderStatus(0)
 }
 
-func (x *ExecutionReport) GetInstrument() *models.Instrument {
-	if x != nil {
-		return x.Instrument
-	}
-	return nil
+func (x *TradeCaptureReportFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ExecutionReport) GetLeavesQuantity() float64 {
-	if x != nil {
-		return x.LeavesQuantity
-	}
-	return 0
-}
+func (*TradeCaptureReportFilter) ProtoMessage() {}
 
-func (x *ExecutionReport) GetCumQuantity() float64 {
-	if x != nil {
-		return x.CumQuantity
+func (x *TradeCaptureReportFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ExecutionReport) GetTransactionTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.TransactionTime
-	}
-	return nil
+// Deprecated: Use TradeCaptureReportFilter.ProtoReflect.Descriptor instead.
+func (*TradeCaptureReportFilter) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ExecutionReport) GetTradeID() *wrapperspb.StringValue {
+func (x *TradeCaptureReportFilter) GetOrderID() *wrapperspb.StringValue {
 	if x != nil {
-		return x.TradeID
+		return x.OrderID
 	}
 	return nil
 }
 
-func (x *ExecutionReport) GetFillPrice() *wrapperspb.DoubleValue {
+func (x *TradeCaptureReportFilter) GetClientOrderID() *wrapperspb.StringValue {
 	if x != nil {
-		return x.FillPrice
+		return x.ClientOrderID
 	}
 	return nil
 }
 
-func (x *ExecutionReport) GetFillQuantity() *wrapperspb.DoubleValue {
+func (x *TradeCaptureReportFilter) GetInstrument() *models.Instrument {
 	if x != nil {
-		return x.FillQuantity
+		return x.Instrument
 	}
 	return nil
 }
 
-func (x *ExecutionReport) GetFeeAmount() *wrapperspb.DoubleValue {
+func (x *TradeCaptureReportFilter) GetSide() *SideValue {
 	if x != nil {
-		return x.FeeAmount
+		return x.Side
 	}
 	return nil
 }
 
-func (x *ExecutionReport) GetFeeCurrency() *models1.Asset {
+func (x *TradeCaptureReportFilter) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FeeCurrency
+		return x.From
 	}
 	return nil
 }
 
-func (x *ExecutionReport) GetFeeType() FeeType {
-	if x != nil {
-		return x.FeeType
-	}
-	return FeeType_Regulatory
-}
-
-func (x *ExecutionReport) GetFeeBasis() FeeBasis {
+func (x *TradeCaptureReportFilter) GetTo() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FeeBasis
+		return x.To
 	}
-	return FeeBasis_Absolute
+	return nil
 }
 
-func (x *ExecutionReport) GetRejectionReason() RejectionReason {
+func (x *TradeCaptureReportFilter) GetFromID() *wrapperspb.StringValue {
 	if x != nil {
-		return x.RejectionReason
+		return x.FromID
 	}
-	return RejectionReason_Other
+	return nil
 }
 
-type AccountUpdate struct {
+type TradeCaptureReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type    AccountMovementType `protobuf:"varint,1,opt,name=type,proto3,enum=messages.AccountMovementType" json:"type,omitempty"`
-	Asset   *models1.Asset      `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Balance float64             `protobuf:"fixed64,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	RequestID       uint64                 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64                 `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	Trades          []*models.TradeCapture `protobuf:"bytes,3,rep,name=trades,proto3" json:"trades,omitempty"`
+	Success         bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason        `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *AccountUpdate) Reset() {
-	*x = AccountUpdate{}
+func (x *TradeCaptureReport) Reset() {
+	*x = TradeCaptureReport{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3358,13 +3487,13 @@ func (x *AccountUpdate) Reset() {
 	}
 }
 
-func (x *AccountUpdate) String() string {
+func (x *TradeCaptureReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountUpdate) ProtoMessage() {}
+func (*TradeCaptureReport) ProtoMessage() {}
 
-func (x *AccountUpdate) ProtoReflect() protoreflect.Message {
+func (x *TradeCaptureReport) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3376,42 +3505,57 @@ func (x *AccountUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountUpdate.ProtoReflect.Descriptor instead.
-func (*AccountUpdate) Descriptor() ([]byte, []int) {
+// Deprecated: Use TradeCaptureReport.ProtoReflect.Descriptor instead.
+func (*TradeCaptureReport) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *AccountUpdate) GetType() AccountMovementType {
+func (x *TradeCaptureReport) GetRequestID() uint64 {
 	if x != nil {
-		return x.Type
+		return x.RequestID
 	}
-	return AccountMovementType_Unknown
+	return 0
 }
 
-func (x *AccountUpdate) GetAsset() *models1.Asset {
+func (x *TradeCaptureReport) GetResponseID() uint64 {
 	if x != nil {
-		return x.Asset
+		return x.ResponseID
+	}
+	return 0
+}
+
+func (x *TradeCaptureReport) GetTrades() []*models.TradeCapture {
+	if x != nil {
+		return x.Trades
 	}
 	return nil
 }
 
-func (x *AccountUpdate) GetBalance() float64 {
+func (x *TradeCaptureReport) GetSuccess() bool {
 	if x != nil {
-		return x.Balance
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-type SideValue struct {
+func (x *TradeCaptureReport) GetRejectionReason() RejectionReason {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return RejectionReason_Other
+}
+
+type SecurityDefinitionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value models.Side `protobuf:"varint,1,opt,name=value,proto3,enum=models.Side" json:"value,omitempty"`
+	RequestID  uint64             `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Instrument *models.Instrument `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty"`
 }
 
-func (x *SideValue) Reset() {
-	*x = SideValue{}
+func (x *SecurityDefinitionRequest) Reset() {
+	*x = SecurityDefinitionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3419,13 +3563,13 @@ func (x *SideValue) Reset() {
 	}
 }
 
-func (x *SideValue) String() string {
+func (x *SecurityDefinitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SideValue) ProtoMessage() {}
+func (*SecurityDefinitionRequest) ProtoMessage() {}
 
-func (x *SideValue) ProtoReflect() protoreflect.Message {
+func (x *SecurityDefinitionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3437,28 +3581,39 @@ func (x *SideValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SideValue.ProtoReflect.Descriptor instead.
-func (*SideValue) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecurityDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*SecurityDefinitionRequest) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *SideValue) GetValue() models.Side {
+func (x *SecurityDefinitionRequest) GetRequestID() uint64 {
 	if x != nil {
-		return x.Value
+		return x.RequestID
 	}
-	return models.Side(0)
+	return 0
 }
 
-type OrderStatusValue struct {
+func (x *SecurityDefinitionRequest) GetInstrument() *models.Instrument {
+	if x != nil {
+		return x.Instrument
+	}
+	return nil
+}
+
+type SecurityDefinitionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value models.OrderStatus `protobuf:"varint,1,opt,name=value,proto3,enum=models.OrderStatus" json:"value,omitempty"`
+	RequestID       uint64           `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64           `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	Security        *models.Security `protobuf:"bytes,3,opt,name=security,proto3" json:"security,omitempty"`
+	Success         bool             `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason  `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *OrderStatusValue) Reset() {
-	*x = OrderStatusValue{}
+func (x *SecurityDefinitionResponse) Reset() {
+	*x = SecurityDefinitionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3466,13 +3621,13 @@ func (x *OrderStatusValue) Reset() {
 	}
 }
 
-func (x *OrderStatusValue) String() string {
+func (x *SecurityDefinitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderStatusValue) ProtoMessage() {}
+func (*SecurityDefinitionResponse) ProtoMessage() {}
 
-func (x *OrderStatusValue) ProtoReflect() protoreflect.Message {
+func (x *SecurityDefinitionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3484,134 +3639,73 @@ func (x *OrderStatusValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderStatusValue.ProtoReflect.Descriptor instead.
-func (*OrderStatusValue) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecurityDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*SecurityDefinitionResponse) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *OrderStatusValue) GetValue() models.OrderStatus {
+func (x *SecurityDefinitionResponse) GetRequestID() uint64 {
 	if x != nil {
-		return x.Value
+		return x.RequestID
 	}
-	return models.OrderStatus(0)
-}
-
-type OrderFilter struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	OrderID       *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
-	ClientOrderID *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=client_orderID,json=clientOrderID,proto3" json:"client_orderID,omitempty"`
-	Instrument    *models.Instrument      `protobuf:"bytes,3,opt,name=instrument,proto3" json:"instrument,omitempty"`
-	Side          *SideValue              `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
-	OrderStatus   *OrderStatusValue       `protobuf:"bytes,5,opt,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty"`
-	Open          *wrapperspb.BoolValue   `protobuf:"bytes,6,opt,name=open,proto3" json:"open,omitempty"`
+	return 0
 }
 
-func (x *OrderFilter) Reset() {
-	*x = OrderFilter{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[38]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SecurityDefinitionResponse) GetResponseID() uint64 {
+	if x != nil {
+		return x.ResponseID
 	}
+	return 0
 }
 
-func (x *OrderFilter) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*OrderFilter) ProtoMessage() {}
-
-func (x *OrderFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[38]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use OrderFilter.ProtoReflect.Descriptor instead.
-func (*OrderFilter) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *OrderFilter) GetOrderID() *wrapperspb.StringValue {
-	if x != nil {
-		return x.OrderID
-	}
-	return nil
-}
-
-func (x *OrderFilter) GetClientOrderID() *wrapperspb.StringValue {
-	if x != nil {
-		return x.ClientOrderID
-	}
-	return nil
-}
-
-func (x *OrderFilter) GetInstrument() *models.Instrument {
-	if x != nil {
-		return x.Instrument
-	}
-	return nil
-}
-
-func (x *OrderFilter) GetSide() *SideValue {
+func (x *SecurityDefinitionResponse) GetSecurity() *models.Security {
 	if x != nil {
-		return x.Side
+		return x.Security
 	}
 	return nil
 }
 
-func (x *OrderFilter) GetOrderStatus() *OrderStatusValue {
+func (x *SecurityDefinitionResponse) GetSuccess() bool {
 	if x != nil {
-		return x.OrderStatus
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *OrderFilter) GetOpen() *wrapperspb.BoolValue {
+func (x *SecurityDefinitionResponse) GetRejectionReason() RejectionReason {
 	if x != nil {
-		return x.Open
+		return x.RejectionReason
 	}
-	return nil
+	return RejectionReason_Other
 }
 
-type OrderStatusRequest struct {
+type SecurityListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID  uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Subscribe  bool            `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
-	Subscriber *actor.PID      `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
-	Account    *models.Account `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
-	Filter     *OrderFilter    `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	RequestID  uint64     `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Subscribe  bool       `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	Subscriber *actor.PID `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
 }
 
-func (x *OrderStatusRequest) Reset() {
-	*x = OrderStatusRequest{}
+func (x *SecurityListRequest) Reset() {
+	*x = SecurityListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[39]
+		mi := &file_executor_messages_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrderStatusRequest) String() string {
+func (x *SecurityListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderStatusRequest) ProtoMessage() {}
+func (*SecurityListRequest) ProtoMessage() {}
 
-func (x *OrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[39]
+func (x *SecurityListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3622,75 +3716,61 @@ func (x *OrderStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderStatusRequest.ProtoReflect.Descriptor instead.
-func (*OrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use SecurityListRequest.ProtoReflect.Descriptor instead.
+func (*SecurityListRequest) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *OrderStatusRequest) GetRequestID() uint64 {
+func (x *SecurityListRequest) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *OrderStatusRequest) GetSubscribe() bool {
+func (x *SecurityListRequest) GetSubscribe() bool {
 	if x != nil {
 		return x.Subscribe
 	}
 	return false
 }
 
-func (x *OrderStatusRequest) GetSubscriber() *actor.PID {
+func (x *SecurityListRequest) GetSubscriber() *actor.PID {
 	if x != nil {
 		return x.Subscriber
 	}
 	return nil
 }
 
-func (x *OrderStatusRequest) GetAccount() *models.Account {
-	if x != nil {
-		return x.Account
-	}
-	return nil
-}
-
-func (x *OrderStatusRequest) GetFilter() *OrderFilter {
-	if x != nil {
-		return x.Filter
-	}
-	return nil
-}
-
-type OrderList struct {
+type SecurityList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64          `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	Success         bool            `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	Orders          []*models.Order `protobuf:"bytes,4,rep,name=orders,proto3" json:"orders,omitempty"`
-	RejectionReason RejectionReason `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	RequestID       uint64             `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64             `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	Securities      []*models.Security `protobuf:"bytes,3,rep,name=securities,proto3" json:"securities,omitempty"`
+	Success         bool               `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason    `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *OrderList) Reset() {
-	*x = OrderList{}
+func (x *SecurityList) Reset() {
+	*x = SecurityList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[40]
+		mi := &file_executor_messages_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrderList) String() string {
+func (x *SecurityList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderList) ProtoMessage() {}
+func (*SecurityList) ProtoMessage() {}
 
-func (x *OrderList) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[40]
+func (x *SecurityList) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3701,75 +3781,88 @@ func (x *OrderList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderList.ProtoReflect.Descriptor instead.
-func (*OrderList) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use SecurityList.ProtoReflect.Descriptor instead.
+func (*SecurityList) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *OrderList) GetRequestID() uint64 {
+func (x *SecurityList) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *OrderList) GetResponseID() uint64 {
+func (x *SecurityList) GetResponseID() uint64 {
 	if x != nil {
 		return x.ResponseID
 	}
 	return 0
 }
 
-func (x *OrderList) GetSuccess() bool {
+func (x *SecurityList) GetSecurities() []*models.Security {
 	if x != nil {
-		return x.Success
+		return x.Securities
 	}
-	return false
+	return nil
 }
 
-func (x *OrderList) GetOrders() []*models.Order {
+func (x *SecurityList) GetSuccess() bool {
 	if x != nil {
-		return x.Orders
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *OrderList) GetRejectionReason() RejectionReason {
+func (x *SecurityList) GetRejectionReason() RejectionReason {
 	if x != nil {
 		return x.RejectionReason
 	}
 	return RejectionReason_Other
 }
 
-type PositionsRequest struct {
+type ExecutionReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID  uint64             `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Subscribe  bool               `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
-	Subscriber *actor.PID         `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
-	Instrument *models.Instrument `protobuf:"bytes,4,opt,name=instrument,proto3" json:"instrument,omitempty"`
-	Account    *models.Account    `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+	SeqNum          uint64                  `protobuf:"varint,1,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
+	OrderID         string                  `protobuf:"bytes,2,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	ClientOrderID   *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=client_orderID,json=clientOrderID,proto3" json:"client_orderID,omitempty"`
+	ExecutionID     string                  `protobuf:"bytes,4,opt,name=executionID,proto3" json:"executionID,omitempty"`
+	ExecutionType   ExecutionType           `protobuf:"varint,5,opt,name=execution_type,json=executionType,proto3,enum=messages.ExecutionType" json:"execution_type,omitempty"`
+	OrderStatus     models.OrderStatus      `protobuf:"varint,6,opt,name=order_status,json=orderStatus,proto3,enum=models.OrderStatus" json:"order_status,omitempty"`
+	Instrument      *models.Instrument      `protobuf:"bytes,7,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	LeavesQuantity  float64                 `protobuf:"fixed64,9,opt,name=leaves_quantity,json=leavesQuantity,proto3" json:"leaves_quantity,omitempty"`
+	CumQuantity     float64                 `protobuf:"fixed64,10,opt,name=cum_quantity,json=cumQuantity,proto3" json:"cum_quantity,omitempty"`
+	TransactionTime *timestamppb.Timestamp  `protobuf:"bytes,11,opt,name=transaction_time,json=transactionTime,proto3" json:"transaction_time,omitempty"`
+	TradeID         *wrapperspb.StringValue `protobuf:"bytes,12,opt,name=tradeID,proto3" json:"tradeID,omitempty"`
+	FillPrice       *wrapperspb.DoubleValue `protobuf:"bytes,13,opt,name=fill_price,json=fillPrice,proto3" json:"fill_price,omitempty"`
+	FillQuantity    *wrapperspb.DoubleValue `protobuf:"bytes,14,opt,name=fill_quantity,json=fillQuantity,proto3" json:"fill_quantity,omitempty"`
+	FeeAmount       *wrapperspb.DoubleValue `protobuf:"bytes,15,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	FeeCurrency     *models1.Asset          `protobuf:"bytes,16,opt,name=fee_currency,json=feeCurrency,proto3" json:"fee_currency,omitempty"`
+	FeeType         FeeType                 `protobuf:"varint,17,opt,name=fee_type,json=feeType,proto3,enum=messages.FeeType" json:"fee_type,omitempty"`
+	FeeBasis        FeeBasis                `protobuf:"varint,18,opt,name=fee_basis,json=feeBasis,proto3,enum=messages.FeeBasis" json:"fee_basis,omitempty"`
+	RejectionReason RejectionReason         `protobuf:"varint,19,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *PositionsRequest) Reset() {
-	*x = PositionsRequest{}
+func (x *ExecutionReport) Reset() {
+	*x = ExecutionReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[41]
+		mi := &file_executor_messages_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PositionsRequest) String() string {
+func (x *ExecutionReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PositionsRequest) ProtoMessage() {}
+func (*ExecutionReport) ProtoMessage() {}
 
-func (x *PositionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[41]
+func (x *ExecutionReport) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3780,162 +3873,164 @@ func (x *PositionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PositionsRequest.ProtoReflect.Descriptor instead.
-func (*PositionsRequest) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ExecutionReport.ProtoReflect.Descriptor instead.
+func (*ExecutionReport) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *PositionsRequest) GetRequestID() uint64 {
+func (x *ExecutionReport) GetSeqNum() uint64 {
 	if x != nil {
-		return x.RequestID
+		return x.SeqNum
 	}
 	return 0
 }
 
-func (x *PositionsRequest) GetSubscribe() bool {
+func (x *ExecutionReport) GetOrderID() string {
 	if x != nil {
-		return x.Subscribe
+		return x.OrderID
 	}
-	return false
+	return ""
 }
 
-func (x *PositionsRequest) GetSubscriber() *actor.PID {
+func (x *ExecutionReport) GetClientOrderID() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Subscriber
+		return x.ClientOrderID
 	}
 	return nil
 }
 
-func (x *PositionsRequest) GetInstrument() *models.Instrument {
+func (x *ExecutionReport) GetExecutionID() string {
 	if x != nil {
-		return x.Instrument
+		return x.ExecutionID
 	}
-	return nil
+	return ""
 }
 
-func (x *PositionsRequest) GetAccount() *models.Account {
+func (x *ExecutionReport) GetExecutionType() ExecutionType {
 	if x != nil {
-		return x.Account
+		return x.ExecutionType
 	}
-	return nil
-}
-
-type PositionList struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	RequestID       uint64                 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64                 `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	Positions       []*models.Position     `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions,omitempty"`
-	Time            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
-	Success         bool                   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason RejectionReason        `protobuf:"varint,6,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	return ExecutionType_New
 }
 
-func (x *PositionList) Reset() {
-	*x = PositionList{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[42]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExecutionReport) GetOrderStatus() models.OrderStatus {
+	if x != nil {
+		return x.OrderStatus
 	}
+	return models.OrderStatus(0)
 }
 
-func (x *PositionList) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExecutionReport) GetInstrument() *models.Instrument {
+	if x != nil {
+		return x.Instrument
+	}
+	return nil
 }
 
-func (*PositionList) ProtoMessage() {}
+func (x *ExecutionReport) GetLeavesQuantity() float64 {
+	if x != nil {
+		return x.LeavesQuantity
+	}
+	return 0
+}
 
-func (x *PositionList) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[42]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ExecutionReport) GetCumQuantity() float64 {
+	if x != nil {
+		return x.CumQuantity
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use PositionList.ProtoReflect.Descriptor instead.
-func (*PositionList) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{42}
+func (x *ExecutionReport) GetTransactionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransactionTime
+	}
+	return nil
 }
 
-func (x *PositionList) GetRequestID() uint64 {
+func (x *ExecutionReport) GetTradeID() *wrapperspb.StringValue {
 	if x != nil {
-		return x.RequestID
+		return x.TradeID
 	}
-	return 0
+	return nil
 }
 
-func (x *PositionList) GetResponseID() uint64 {
+func (x *ExecutionReport) GetFillPrice() *wrapperspb.DoubleValue {
 	if x != nil {
-		return x.ResponseID
+		return x.FillPrice
 	}
-	return 0
+	return nil
 }
 
-func (x *PositionList) GetPositions() []*models.Position {
+func (x *ExecutionReport) GetFillQuantity() *wrapperspb.DoubleValue {
 	if x != nil {
-		return x.Positions
+		return x.FillQuantity
 	}
 	return nil
 }
 
-func (x *PositionList) GetTime() *timestamppb.Timestamp {
+func (x *ExecutionReport) GetFeeAmount() *wrapperspb.DoubleValue {
 	if x != nil {
-		return x.Time
+		return x.FeeAmount
 	}
 	return nil
 }
 
-func (x *PositionList) GetSuccess() bool {
+func (x *ExecutionReport) GetFeeCurrency() *models1.Asset {
 	if x != nil {
-		return x.Success
+		return x.FeeCurrency
 	}
-	return false
+	return nil
 }
 
-func (x *PositionList) GetRejectionReason() RejectionReason {
+func (x *ExecutionReport) GetFeeType() FeeType {
+	if x != nil {
+		return x.FeeType
+	}
+	return FeeType_Regulatory
+}
+
+func (x *ExecutionReport) GetFeeBasis() FeeBasis {
+	if x != nil {
+		return x.FeeBasis
+	}
+	return FeeBasis_Absolute
+}
+
+func (x *ExecutionReport) GetRejectionReason() RejectionReason {
 	if x != nil {
 		return x.RejectionReason
 	}
 	return RejectionReason_Other
 }
 
-type BalancesRequest struct {
+type AccountUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID  uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Subscribe  bool            `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
-	Subscriber *actor.PID      `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
-	Asset      *models1.Asset  `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
-	Account    *models.Account `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+	Type    AccountMovementType `protobuf:"varint,1,opt,name=type,proto3,enum=messages.AccountMovementType" json:"type,omitempty"`
+	Asset   *models1.Asset      `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Balance float64             `protobuf:"fixed64,3,opt,name=balance,proto3" json:"balance,omitempty"`
 }
 
-func (x *BalancesRequest) Reset() {
-	*x = BalancesRequest{}
+func (x *AccountUpdate) Reset() {
+	*x = AccountUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[43]
+		mi := &file_executor_messages_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BalancesRequest) String() string {
+func (x *AccountUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BalancesRequest) ProtoMessage() {}
+func (*AccountUpdate) ProtoMessage() {}
 
-func (x *BalancesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[43]
+func (x *AccountUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3946,75 +4041,57 @@ func (x *BalancesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BalancesRequest.ProtoReflect.Descriptor instead.
-func (*BalancesRequest) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *BalancesRequest) GetRequestID() uint64 {
-	if x != nil {
-		return x.RequestID
-	}
-	return 0
-}
-
-func (x *BalancesRequest) GetSubscribe() bool {
-	if x != nil {
-		return x.Subscribe
-	}
-	return false
+// Deprecated: Use AccountUpdate.ProtoReflect.Descriptor instead.
+func (*AccountUpdate) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *BalancesRequest) GetSubscriber() *actor.PID {
+func (x *AccountUpdate) GetType() AccountMovementType {
 	if x != nil {
-		return x.Subscriber
+		return x.Type
 	}
-	return nil
+	return AccountMovementType_Unknown
 }
 
-func (x *BalancesRequest) GetAsset() *models1.Asset {
+func (x *AccountUpdate) GetAsset() *models1.Asset {
 	if x != nil {
 		return x.Asset
 	}
 	return nil
 }
 
-func (x *BalancesRequest) GetAccount() *models.Account {
+func (x *AccountUpdate) GetBalance() float64 {
 	if x != nil {
-		return x.Account
+		return x.Balance
 	}
-	return nil
+	return 0
 }
 
-type BalanceList struct {
+type SideValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64            `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64            `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	Balances        []*models.Balance `protobuf:"bytes,3,rep,name=balances,proto3" json:"balances,omitempty"`
-	Success         bool              `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason RejectionReason   `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	Value models.Side `protobuf:"varint,1,opt,name=value,proto3,enum=models.Side" json:"value,omitempty"`
 }
 
-func (x *BalanceList) Reset() {
-	*x = BalanceList{}
+func (x *SideValue) Reset() {
+	*x = SideValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[44]
+		mi := &file_executor_messages_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BalanceList) String() string {
+func (x *SideValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BalanceList) ProtoMessage() {}
+func (*SideValue) ProtoMessage() {}
 
-func (x *BalanceList) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[44]
+func (x *SideValue) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4025,79 +4102,95 @@ func (x *BalanceList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BalanceList.ProtoReflect.Descriptor instead.
-func (*BalanceList) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use SideValue.ProtoReflect.Descriptor instead.
+func (*SideValue) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *BalanceList) GetRequestID() uint64 {
+func (x *SideValue) GetValue() models.Side {
 	if x != nil {
-		return x.RequestID
+		return x.Value
 	}
-	return 0
+	return models.Side(0)
 }
 
-func (x *BalanceList) GetResponseID() uint64 {
-	if x != nil {
-		return x.ResponseID
-	}
-	return 0
+type OrderStatusValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value models.OrderStatus `protobuf:"varint,1,opt,name=value,proto3,enum=models.OrderStatus" json:"value,omitempty"`
 }
 
-func (x *BalanceList) GetBalances() []*models.Balance {
-	if x != nil {
-		return x.Balances
+func (x *OrderStatusValue) Reset() {
+	*x = OrderStatusValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_messages_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *BalanceList) GetSuccess() bool {
-	if x != nil {
-		return x.Success
+func (x *OrderStatusValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusValue) ProtoMessage() {}
+
+func (x *OrderStatusValue) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *BalanceList) GetRejectionReason() RejectionReason {
+// Deprecated: Use OrderStatusValue.ProtoReflect.Descriptor instead.
+func (*OrderStatusValue) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *OrderStatusValue) GetValue() models.OrderStatus {
 	if x != nil {
-		return x.RejectionReason
+		return x.Value
 	}
-	return RejectionReason_Other
+	return models.OrderStatus(0)
 }
 
-type NewOrder struct {
+type OrderFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientOrderID         string                        `protobuf:"bytes,1,opt,name=client_orderID,json=clientOrderID,proto3" json:"client_orderID,omitempty"`
-	Instrument            *models.Instrument            `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty"`
-	OrderType             models.OrderType              `protobuf:"varint,5,opt,name=order_type,json=orderType,proto3,enum=models.OrderType" json:"order_type,omitempty"`
-	OrderSide             models.Side                   `protobuf:"varint,6,opt,name=order_side,json=orderSide,proto3,enum=models.Side" json:"order_side,omitempty"`
-	TimeInForce           models.TimeInForce            `protobuf:"varint,7,opt,name=time_in_force,json=timeInForce,proto3,enum=models.TimeInForce" json:"time_in_force,omitempty"`
-	Quantity              float64                       `protobuf:"fixed64,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Price                 *wrapperspb.DoubleValue       `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"`
-	ExecutionInstructions []models.ExecutionInstruction `protobuf:"varint,10,rep,packed,name=execution_instructions,json=executionInstructions,proto3,enum=models.ExecutionInstruction" json:"execution_instructions,omitempty"`
-	Tag                   string                        `protobuf:"bytes,11,opt,name=tag,proto3" json:"tag,omitempty"`
+	OrderID       *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	ClientOrderID *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=client_orderID,json=clientOrderID,proto3" json:"client_orderID,omitempty"`
+	Instrument    *models.Instrument      `protobuf:"bytes,3,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Side          *SideValue              `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	OrderStatus   *OrderStatusValue       `protobuf:"bytes,5,opt,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty"`
+	Open          *wrapperspb.BoolValue   `protobuf:"bytes,6,opt,name=open,proto3" json:"open,omitempty"`
 }
 
-func (x *NewOrder) Reset() {
-	*x = NewOrder{}
+func (x *OrderFilter) Reset() {
+	*x = OrderFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[45]
+		mi := &file_executor_messages_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NewOrder) String() string {
+func (x *OrderFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewOrder) ProtoMessage() {}
+func (*OrderFilter) ProtoMessage() {}
 
-func (x *NewOrder) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[45]
+func (x *OrderFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4108,104 +4201,82 @@ func (x *NewOrder) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewOrder.ProtoReflect.Descriptor instead.
-func (*NewOrder) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use OrderFilter.ProtoReflect.Descriptor instead.
+func (*OrderFilter) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *NewOrder) GetClientOrderID() string {
-	if x != nil {
-		return x.ClientOrderID
-	}
-	return ""
-}
-
-func (x *NewOrder) GetInstrument() *models.Instrument {
+func (x *OrderFilter) GetOrderID() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Instrument
+		return x.OrderID
 	}
 	return nil
 }
 
-func (x *NewOrder) GetOrderType() models.OrderType {
-	if x != nil {
-		return x.OrderType
-	}
-	return models.OrderType(0)
-}
-
-func (x *NewOrder) GetOrderSide() models.Side {
-	if x != nil {
-		return x.OrderSide
-	}
-	return models.Side(0)
-}
-
-func (x *NewOrder) GetTimeInForce() models.TimeInForce {
+func (x *OrderFilter) GetClientOrderID() *wrapperspb.StringValue {
 	if x != nil {
-		return x.TimeInForce
+		return x.ClientOrderID
 	}
-	return models.TimeInForce(0)
+	return nil
 }
 
-func (x *NewOrder) GetQuantity() float64 {
+func (x *OrderFilter) GetInstrument() *models.Instrument {
 	if x != nil {
-		return x.Quantity
+		return x.Instrument
 	}
-	return 0
+	return nil
 }
 
-func (x *NewOrder) GetPrice() *wrapperspb.DoubleValue {
+func (x *OrderFilter) GetSide() *SideValue {
 	if x != nil {
-		return x.Price
+		return x.Side
 	}
 	return nil
 }
 
-func (x *NewOrder) GetExecutionInstructions() []models.ExecutionInstruction {
+func (x *OrderFilter) GetOrderStatus() *OrderStatusValue {
 	if x != nil {
-		return x.ExecutionInstructions
+		return x.OrderStatus
 	}
 	return nil
 }
 
-func (x *NewOrder) GetTag() string {
+func (x *OrderFilter) GetOpen() *wrapperspb.BoolValue {
 	if x != nil {
-		return x.Tag
+		return x.Open
 	}
-	return ""
+	return nil
 }
 
-type NewOrderSingleRequest struct {
+type OrderStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64                 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Account         *models.Account        `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	Order           *NewOrder              `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
-	ResponseType    ResponseType           `protobuf:"varint,4,opt,name=response_type,json=responseType,proto3,enum=messages.ResponseType" json:"response_type,omitempty"`
-	Expire          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expire,proto3" json:"expire,omitempty"`
-	RequestPriority int32                  `protobuf:"varint,6,opt,name=request_priority,json=requestPriority,proto3" json:"request_priority,omitempty"`
+	RequestID  uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Subscribe  bool            `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	Subscriber *actor.PID      `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	Account    *models.Account `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Filter     *OrderFilter    `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *NewOrderSingleRequest) Reset() {
-	*x = NewOrderSingleRequest{}
+func (x *OrderStatusRequest) Reset() {
+	*x = OrderStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[46]
+		mi := &file_executor_messages_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NewOrderSingleRequest) String() string {
+func (x *OrderStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewOrderSingleRequest) ProtoMessage() {}
+func (*OrderStatusRequest) ProtoMessage() {}
 
-func (x *NewOrderSingleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[46]
+func (x *OrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4216,87 +4287,75 @@ func (x *NewOrderSingleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewOrderSingleRequest.ProtoReflect.Descriptor instead.
-func (*NewOrderSingleRequest) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use OrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*OrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *NewOrderSingleRequest) GetRequestID() uint64 {
+func (x *OrderStatusRequest) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *NewOrderSingleRequest) GetAccount() *models.Account {
+func (x *OrderStatusRequest) GetSubscribe() bool {
 	if x != nil {
-		return x.Account
+		return x.Subscribe
 	}
-	return nil
+	return false
 }
 
-func (x *NewOrderSingleRequest) GetOrder() *NewOrder {
+func (x *OrderStatusRequest) GetSubscriber() *actor.PID {
 	if x != nil {
-		return x.Order
+		return x.Subscriber
 	}
 	return nil
 }
 
-func (x *NewOrderSingleRequest) GetResponseType() ResponseType {
-	if x != nil {
-		return x.ResponseType
-	}
-	return ResponseType_Ack
-}
-
-func (x *NewOrderSingleRequest) GetExpire() *timestamppb.Timestamp {
+func (x *OrderStatusRequest) GetAccount() *models.Account {
 	if x != nil {
-		return x.Expire
+		return x.Account
 	}
 	return nil
 }
 
-func (x *NewOrderSingleRequest) GetRequestPriority() int32 {
+func (x *OrderStatusRequest) GetFilter() *OrderFilter {
 	if x != nil {
-		return x.RequestPriority
+		return x.Filter
 	}
-	return 0
+	return nil
 }
 
-type NewOrderSingleResponse struct {
+type OrderList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64               `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64               `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	Success         bool                 `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	OrderID         string               `protobuf:"bytes,4,opt,name=orderID,proto3" json:"orderID,omitempty"`
-	OrderStatus     models.OrderStatus   `protobuf:"varint,5,opt,name=order_status,json=orderStatus,proto3,enum=models.OrderStatus" json:"order_status,omitempty"`
-	LeavesQuantity  float64              `protobuf:"fixed64,6,opt,name=leaves_quantity,json=leavesQuantity,proto3" json:"leaves_quantity,omitempty"`
-	CumQuantity     float64              `protobuf:"fixed64,7,opt,name=cum_quantity,json=cumQuantity,proto3" json:"cum_quantity,omitempty"`
-	RejectionReason RejectionReason      `protobuf:"varint,8,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
-	RateLimitDelay  *durationpb.Duration `protobuf:"bytes,9,opt,name=rate_limit_delay,json=rateLimitDelay,proto3" json:"rate_limit_delay,omitempty"`
-	NetworkRtt      *durationpb.Duration `protobuf:"bytes,10,opt,name=network_rtt,json=networkRtt,proto3" json:"network_rtt,omitempty"`
+	RequestID       uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64          `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	Success         bool            `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Orders          []*models.Order `protobuf:"bytes,4,rep,name=orders,proto3" json:"orders,omitempty"`
+	RejectionReason RejectionReason `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *NewOrderSingleResponse) Reset() {
-	*x = NewOrderSingleResponse{}
+func (x *OrderList) Reset() {
+	*x = OrderList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_executor_messages_proto_msgTypes[47]
+		mi := &file_executor_messages_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NewOrderSingleResponse) String() string {
+func (x *OrderList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewOrderSingleResponse) ProtoMessage() {}
+func (*OrderList) ProtoMessage() {}
 
-func (x *NewOrderSingleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_executor_messages_proto_msgTypes[47]
+func (x *OrderList) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4307,93 +4366,140 @@ func (x *NewOrderSingleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewOrderSingleResponse.ProtoReflect.Descriptor instead.
-func (*NewOrderSingleResponse) Descriptor() ([]byte, []int) {
-	return file_executor_messages_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use OrderList.ProtoReflect.Descriptor instead.
+func (*OrderList) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *NewOrderSingleResponse) GetRequestID() uint64 {
+func (x *OrderList) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *NewOrderSingleResponse) GetResponseID() uint64 {
+func (x *OrderList) GetResponseID() uint64 {
 	if x != nil {
 		return x.ResponseID
 	}
 	return 0
 }
 
-func (x *NewOrderSingleResponse) GetSuccess() bool {
+func (x *OrderList) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *NewOrderSingleResponse) GetOrderID() string {
+func (x *OrderList) GetOrders() []*models.Order {
 	if x != nil {
-		return x.OrderID
+		return x.Orders
 	}
-	return ""
+	return nil
 }
 
-func (x *NewOrderSingleResponse) GetOrderStatus() models.OrderStatus {
+func (x *OrderList) GetRejectionReason() RejectionReason {
 	if x != nil {
-		return x.OrderStatus
+		return x.RejectionReason
 	}
-	return models.OrderStatus(0)
+	return RejectionReason_Other
 }
 
-func (x *NewOrderSingleResponse) GetLeavesQuantity() float64 {
+type PositionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestID  uint64             `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Subscribe  bool               `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	Subscriber *actor.PID         `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	Instrument *models.Instrument `protobuf:"bytes,4,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Account    *models.Account    `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *PositionsRequest) Reset() {
+	*x = PositionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_messages_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionsRequest) ProtoMessage() {}
+
+func (x *PositionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionsRequest.ProtoReflect.Descriptor instead.
+func (*PositionsRequest) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PositionsRequest) GetRequestID() uint64 {
 	if x != nil {
-		return x.LeavesQuantity
+		return x.RequestID
 	}
 	return 0
 }
 
-func (x *NewOrderSingleResponse) GetCumQuantity() float64 {
+func (x *PositionsRequest) GetSubscribe() bool {
 	if x != nil {
-		return x.CumQuantity
+		return x.Subscribe
 	}
-	return 0
+	return false
 }
 
-func (x *NewOrderSingleResponse) GetRejectionReason() RejectionReason {
+func (x *PositionsRequest) GetSubscriber() *actor.PID {
 	if x != nil {
-		return x.RejectionReason
+		return x.Subscriber
 	}
-	return RejectionReason_Other
+	return nil
 }
 
-func (x *NewOrderSingleResponse) GetRateLimitDelay() *durationpb.Duration {
+func (x *PositionsRequest) GetInstrument() *models.Instrument {
 	if x != nil {
-		return x.RateLimitDelay
+		return x.Instrument
 	}
 	return nil
 }
 
-func (x *NewOrderSingleResponse) GetNetworkRtt() *durationpb.Duration {
+func (x *PositionsRequest) GetAccount() *models.Account {
 	if x != nil {
-		return x.NetworkRtt
+		return x.Account
 	}
 	return nil
 }
 
-type NewOrderBulkRequest struct {
+type PositionList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Account   *models.Account `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	Orders    []*NewOrder     `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
+	RequestID       uint64                 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64                 `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	Positions       []*models.Position     `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions,omitempty"`
+	Time            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	Success         bool                   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason        `protobuf:"varint,6,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *NewOrderBulkRequest) Reset() {
-	*x = NewOrderBulkRequest{}
+func (x *PositionList) Reset() {
+	*x = PositionList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4401,13 +4507,13 @@ func (x *NewOrderBulkRequest) Reset() {
 	}
 }
 
-func (x *NewOrderBulkRequest) String() string {
+func (x *PositionList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewOrderBulkRequest) ProtoMessage() {}
+func (*PositionList) ProtoMessage() {}
 
-func (x *NewOrderBulkRequest) ProtoReflect() protoreflect.Message {
+func (x *PositionList) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4419,46 +4525,67 @@ func (x *NewOrderBulkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewOrderBulkRequest.ProtoReflect.Descriptor instead.
-func (*NewOrderBulkRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PositionList.ProtoReflect.Descriptor instead.
+func (*PositionList) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *NewOrderBulkRequest) GetRequestID() uint64 {
+func (x *PositionList) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *NewOrderBulkRequest) GetAccount() *models.Account {
+func (x *PositionList) GetResponseID() uint64 {
 	if x != nil {
-		return x.Account
+		return x.ResponseID
+	}
+	return 0
+}
+
+func (x *PositionList) GetPositions() []*models.Position {
+	if x != nil {
+		return x.Positions
 	}
 	return nil
 }
 
-func (x *NewOrderBulkRequest) GetOrders() []*NewOrder {
+func (x *PositionList) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Orders
+		return x.Time
 	}
 	return nil
 }
 
-type NewOrderBulkResponse struct {
+func (x *PositionList) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PositionList) GetRejectionReason() RejectionReason {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return RejectionReason_Other
+}
+
+type BalancesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64          `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	OrderIDs        []string        `protobuf:"bytes,3,rep,name=orderIDs,proto3" json:"orderIDs,omitempty"`
-	Success         bool            `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason RejectionReason `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	RequestID  uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Subscribe  bool            `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	Subscriber *actor.PID      `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	Asset      *models1.Asset  `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Account    *models.Account `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (x *NewOrderBulkResponse) Reset() {
-	*x = NewOrderBulkResponse{}
+func (x *BalancesRequest) Reset() {
+	*x = BalancesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4466,13 +4593,13 @@ func (x *NewOrderBulkResponse) Reset() {
 	}
 }
 
-func (x *NewOrderBulkResponse) String() string {
+func (x *BalancesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewOrderBulkResponse) ProtoMessage() {}
+func (*BalancesRequest) ProtoMessage() {}
 
-func (x *NewOrderBulkResponse) ProtoReflect() protoreflect.Message {
+func (x *BalancesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4484,59 +4611,60 @@ func (x *NewOrderBulkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewOrderBulkResponse.ProtoReflect.Descriptor instead.
-func (*NewOrderBulkResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BalancesRequest.ProtoReflect.Descriptor instead.
+func (*BalancesRequest) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *NewOrderBulkResponse) GetRequestID() uint64 {
+func (x *BalancesRequest) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *NewOrderBulkResponse) GetResponseID() uint64 {
+func (x *BalancesRequest) GetSubscribe() bool {
 	if x != nil {
-		return x.ResponseID
+		return x.Subscribe
 	}
-	return 0
+	return false
 }
 
-func (x *NewOrderBulkResponse) GetOrderIDs() []string {
+func (x *BalancesRequest) GetSubscriber() *actor.PID {
 	if x != nil {
-		return x.OrderIDs
+		return x.Subscriber
 	}
 	return nil
 }
 
-func (x *NewOrderBulkResponse) GetSuccess() bool {
+func (x *BalancesRequest) GetAsset() *models1.Asset {
 	if x != nil {
-		return x.Success
+		return x.Asset
 	}
-	return false
+	return nil
 }
 
-func (x *NewOrderBulkResponse) GetRejectionReason() RejectionReason {
+func (x *BalancesRequest) GetAccount() *models.Account {
 	if x != nil {
-		return x.RejectionReason
+		return x.Account
 	}
-	return RejectionReason_Other
+	return nil
 }
 
-type OrderUpdate struct {
+type BalanceList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderID           *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
-	OrigClientOrderID *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=orig_client_orderID,json=origClientOrderID,proto3" json:"orig_client_orderID,omitempty"`
-	Quantity          *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Price             *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	RequestID       uint64            `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64            `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	Balances        []*models.Balance `protobuf:"bytes,3,rep,name=balances,proto3" json:"balances,omitempty"`
+	Success         bool              `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason   `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *OrderUpdate) Reset() {
-	*x = OrderUpdate{}
+func (x *BalanceList) Reset() {
+	*x = BalanceList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4544,13 +4672,13 @@ func (x *OrderUpdate) Reset() {
 	}
 }
 
-func (x *OrderUpdate) String() string {
+func (x *BalanceList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderUpdate) ProtoMessage() {}
+func (*BalanceList) ProtoMessage() {}
 
-func (x *OrderUpdate) ProtoReflect() protoreflect.Message {
+func (x *BalanceList) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4562,52 +4690,64 @@ func (x *OrderUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderUpdate.ProtoReflect.Descriptor instead.
-func (*OrderUpdate) Descriptor() ([]byte, []int) {
+// Deprecated: Use BalanceList.ProtoReflect.Descriptor instead.
+func (*BalanceList) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *OrderUpdate) GetOrderID() *wrapperspb.StringValue {
+func (x *BalanceList) GetRequestID() uint64 {
 	if x != nil {
-		return x.OrderID
+		return x.RequestID
 	}
-	return nil
+	return 0
 }
 
-func (x *OrderUpdate) GetOrigClientOrderID() *wrapperspb.StringValue {
+func (x *BalanceList) GetResponseID() uint64 {
 	if x != nil {
-		return x.OrigClientOrderID
+		return x.ResponseID
 	}
-	return nil
+	return 0
 }
 
-func (x *OrderUpdate) GetQuantity() *wrapperspb.DoubleValue {
+func (x *BalanceList) GetBalances() []*models.Balance {
 	if x != nil {
-		return x.Quantity
+		return x.Balances
 	}
 	return nil
 }
 
-func (x *OrderUpdate) GetPrice() *wrapperspb.DoubleValue {
+func (x *BalanceList) GetSuccess() bool {
 	if x != nil {
-		return x.Price
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type OrderReplaceRequest struct {
+func (x *BalanceList) GetRejectionReason() RejectionReason {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return RejectionReason_Other
+}
+
+type NewOrder struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID  uint64             `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Instrument *models.Instrument `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty"`
-	Account    *models.Account    `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
-	Update     *OrderUpdate       `protobuf:"bytes,4,opt,name=update,proto3" json:"update,omitempty"`
+	ClientOrderID         string                        `protobuf:"bytes,1,opt,name=client_orderID,json=clientOrderID,proto3" json:"client_orderID,omitempty"`
+	Instrument            *models.Instrument            `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	OrderType             models.OrderType              `protobuf:"varint,5,opt,name=order_type,json=orderType,proto3,enum=models.OrderType" json:"order_type,omitempty"`
+	OrderSide             models.Side                   `protobuf:"varint,6,opt,name=order_side,json=orderSide,proto3,enum=models.Side" json:"order_side,omitempty"`
+	TimeInForce           models.TimeInForce            `protobuf:"varint,7,opt,name=time_in_force,json=timeInForce,proto3,enum=models.TimeInForce" json:"time_in_force,omitempty"`
+	Quantity              float64                       `protobuf:"fixed64,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price                 *wrapperspb.DoubleValue       `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"`
+	ExecutionInstructions []models.ExecutionInstruction `protobuf:"varint,10,rep,packed,name=execution_instructions,json=executionInstructions,proto3,enum=models.ExecutionInstruction" json:"execution_instructions,omitempty"`
+	Tag                   string                        `protobuf:"bytes,11,opt,name=tag,proto3" json:"tag,omitempty"`
 }
 
-func (x *OrderReplaceRequest) Reset() {
-	*x = OrderReplaceRequest{}
+func (x *NewOrder) Reset() {
+	*x = NewOrder{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4615,13 +4755,13 @@ func (x *OrderReplaceRequest) Reset() {
 	}
 }
 
-func (x *OrderReplaceRequest) String() string {
+func (x *NewOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderReplaceRequest) ProtoMessage() {}
+func (*NewOrder) ProtoMessage() {}
 
-func (x *OrderReplaceRequest) ProtoReflect() protoreflect.Message {
+func (x *NewOrder) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4633,53 +4773,89 @@ func (x *OrderReplaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderReplaceRequest.ProtoReflect.Descriptor instead.
-func (*OrderReplaceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use NewOrder.ProtoReflect.Descriptor instead.
+func (*NewOrder) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *OrderReplaceRequest) GetRequestID() uint64 {
+func (x *NewOrder) GetClientOrderID() string {
 	if x != nil {
-		return x.RequestID
+		return x.ClientOrderID
 	}
-	return 0
+	return ""
 }
 
-func (x *OrderReplaceRequest) GetInstrument() *models.Instrument {
+func (x *NewOrder) GetInstrument() *models.Instrument {
 	if x != nil {
 		return x.Instrument
 	}
 	return nil
 }
 
-func (x *OrderReplaceRequest) GetAccount() *models.Account {
+func (x *NewOrder) GetOrderType() models.OrderType {
 	if x != nil {
-		return x.Account
+		return x.OrderType
+	}
+	return models.OrderType(0)
+}
+
+func (x *NewOrder) GetOrderSide() models.Side {
+	if x != nil {
+		return x.OrderSide
+	}
+	return models.Side(0)
+}
+
+func (x *NewOrder) GetTimeInForce() models.TimeInForce {
+	if x != nil {
+		return x.TimeInForce
+	}
+	return models.TimeInForce(0)
+}
+
+func (x *NewOrder) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *NewOrder) GetPrice() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Price
 	}
 	return nil
 }
 
-func (x *OrderReplaceRequest) GetUpdate() *OrderUpdate {
+func (x *NewOrder) GetExecutionInstructions() []models.ExecutionInstruction {
 	if x != nil {
-		return x.Update
+		return x.ExecutionInstructions
 	}
 	return nil
 }
 
-type OrderReplaceResponse struct {
+func (x *NewOrder) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type NewOrderSingleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64          `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	OrderID         string          `protobuf:"bytes,3,opt,name=orderID,proto3" json:"orderID,omitempty"`
-	Success         bool            `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason RejectionReason `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	RequestID       uint64                 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Account         *models.Account        `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Order           *NewOrder              `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
+	ResponseType    ResponseType           `protobuf:"varint,4,opt,name=response_type,json=responseType,proto3,enum=messages.ResponseType" json:"response_type,omitempty"`
+	Expire          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expire,proto3" json:"expire,omitempty"`
+	RequestPriority int32                  `protobuf:"varint,6,opt,name=request_priority,json=requestPriority,proto3" json:"request_priority,omitempty"`
 }
 
-func (x *OrderReplaceResponse) Reset() {
-	*x = OrderReplaceResponse{}
+func (x *NewOrderSingleRequest) Reset() {
+	*x = NewOrderSingleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4687,13 +4863,13 @@ func (x *OrderReplaceResponse) Reset() {
 	}
 }
 
-func (x *OrderReplaceResponse) String() string {
+func (x *NewOrderSingleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderReplaceResponse) ProtoMessage() {}
+func (*NewOrderSingleRequest) ProtoMessage() {}
 
-func (x *OrderReplaceResponse) ProtoReflect() protoreflect.Message {
+func (x *NewOrderSingleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4705,59 +4881,72 @@ func (x *OrderReplaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderReplaceResponse.ProtoReflect.Descriptor instead.
-func (*OrderReplaceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use NewOrderSingleRequest.ProtoReflect.Descriptor instead.
+func (*NewOrderSingleRequest) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *OrderReplaceResponse) GetRequestID() uint64 {
+func (x *NewOrderSingleRequest) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *OrderReplaceResponse) GetResponseID() uint64 {
+func (x *NewOrderSingleRequest) GetAccount() *models.Account {
 	if x != nil {
-		return x.ResponseID
+		return x.Account
 	}
-	return 0
+	return nil
 }
 
-func (x *OrderReplaceResponse) GetOrderID() string {
+func (x *NewOrderSingleRequest) GetOrder() *NewOrder {
 	if x != nil {
-		return x.OrderID
+		return x.Order
 	}
-	return ""
+	return nil
 }
 
-func (x *OrderReplaceResponse) GetSuccess() bool {
+func (x *NewOrderSingleRequest) GetResponseType() ResponseType {
 	if x != nil {
-		return x.Success
+		return x.ResponseType
 	}
-	return false
+	return ResponseType_Ack
 }
 
-func (x *OrderReplaceResponse) GetRejectionReason() RejectionReason {
+func (x *NewOrderSingleRequest) GetExpire() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RejectionReason
+		return x.Expire
 	}
-	return RejectionReason_Other
+	return nil
 }
 
-type OrderBulkReplaceRequest struct {
+func (x *NewOrderSingleRequest) GetRequestPriority() int32 {
+	if x != nil {
+		return x.RequestPriority
+	}
+	return 0
+}
+
+type NewOrderSingleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID  uint64             `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Instrument *models.Instrument `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty"`
-	Account    *models.Account    `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
-	Updates    []*OrderUpdate     `protobuf:"bytes,4,rep,name=updates,proto3" json:"updates,omitempty"`
+	RequestID       uint64               `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64               `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	Success         bool                 `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	OrderID         string               `protobuf:"bytes,4,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	OrderStatus     models.OrderStatus   `protobuf:"varint,5,opt,name=order_status,json=orderStatus,proto3,enum=models.OrderStatus" json:"order_status,omitempty"`
+	LeavesQuantity  float64              `protobuf:"fixed64,6,opt,name=leaves_quantity,json=leavesQuantity,proto3" json:"leaves_quantity,omitempty"`
+	CumQuantity     float64              `protobuf:"fixed64,7,opt,name=cum_quantity,json=cumQuantity,proto3" json:"cum_quantity,omitempty"`
+	RejectionReason RejectionReason      `protobuf:"varint,8,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	RateLimitDelay  *durationpb.Duration `protobuf:"bytes,9,opt,name=rate_limit_delay,json=rateLimitDelay,proto3" json:"rate_limit_delay,omitempty"`
+	NetworkRtt      *durationpb.Duration `protobuf:"bytes,10,opt,name=network_rtt,json=networkRtt,proto3" json:"network_rtt,omitempty"`
 }
 
-func (x *OrderBulkReplaceRequest) Reset() {
-	*x = OrderBulkReplaceRequest{}
+func (x *NewOrderSingleResponse) Reset() {
+	*x = NewOrderSingleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4765,13 +4954,13 @@ func (x *OrderBulkReplaceRequest) Reset() {
 	}
 }
 
-func (x *OrderBulkReplaceRequest) String() string {
+func (x *NewOrderSingleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderBulkReplaceRequest) ProtoMessage() {}
+func (*NewOrderSingleResponse) ProtoMessage() {}
 
-func (x *OrderBulkReplaceRequest) ProtoReflect() protoreflect.Message {
+func (x *NewOrderSingleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4783,52 +4972,93 @@ func (x *OrderBulkReplaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderBulkReplaceRequest.ProtoReflect.Descriptor instead.
-func (*OrderBulkReplaceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use NewOrderSingleResponse.ProtoReflect.Descriptor instead.
+func (*NewOrderSingleResponse) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *OrderBulkReplaceRequest) GetRequestID() uint64 {
+func (x *NewOrderSingleResponse) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *OrderBulkReplaceRequest) GetInstrument() *models.Instrument {
+func (x *NewOrderSingleResponse) GetResponseID() uint64 {
 	if x != nil {
-		return x.Instrument
+		return x.ResponseID
 	}
-	return nil
+	return 0
 }
 
-func (x *OrderBulkReplaceRequest) GetAccount() *models.Account {
+func (x *NewOrderSingleResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Account
+		return x.Success
+	}
+	return false
+}
+
+func (x *NewOrderSingleResponse) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *NewOrderSingleResponse) GetOrderStatus() models.OrderStatus {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return models.OrderStatus(0)
+}
+
+func (x *NewOrderSingleResponse) GetLeavesQuantity() float64 {
+	if x != nil {
+		return x.LeavesQuantity
+	}
+	return 0
+}
+
+func (x *NewOrderSingleResponse) GetCumQuantity() float64 {
+	if x != nil {
+		return x.CumQuantity
+	}
+	return 0
+}
+
+func (x *NewOrderSingleResponse) GetRejectionReason() RejectionReason {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return RejectionReason_Other
+}
+
+func (x *NewOrderSingleResponse) GetRateLimitDelay() *durationpb.Duration {
+	if x != nil {
+		return x.RateLimitDelay
 	}
 	return nil
 }
 
-func (x *OrderBulkReplaceRequest) GetUpdates() []*OrderUpdate {
+func (x *NewOrderSingleResponse) GetNetworkRtt() *durationpb.Duration {
 	if x != nil {
-		return x.Updates
+		return x.NetworkRtt
 	}
 	return nil
 }
 
-type OrderBulkReplaceResponse struct {
+type NewOrderBulkRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64          `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	Success         bool            `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason RejectionReason `protobuf:"varint,4,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	RequestID uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Account   *models.Account `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Orders    []*NewOrder     `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"`
 }
 
-func (x *OrderBulkReplaceResponse) Reset() {
-	*x = OrderBulkReplaceResponse{}
+func (x *NewOrderBulkRequest) Reset() {
+	*x = NewOrderBulkRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4836,13 +5066,13 @@ func (x *OrderBulkReplaceResponse) Reset() {
 	}
 }
 
-func (x *OrderBulkReplaceResponse) String() string {
+func (x *NewOrderBulkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderBulkReplaceResponse) ProtoMessage() {}
+func (*NewOrderBulkRequest) ProtoMessage() {}
 
-func (x *OrderBulkReplaceResponse) ProtoReflect() protoreflect.Message {
+func (x *NewOrderBulkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4854,55 +5084,46 @@ func (x *OrderBulkReplaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderBulkReplaceResponse.ProtoReflect.Descriptor instead.
-func (*OrderBulkReplaceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use NewOrderBulkRequest.ProtoReflect.Descriptor instead.
+func (*NewOrderBulkRequest) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *OrderBulkReplaceResponse) GetRequestID() uint64 {
+func (x *NewOrderBulkRequest) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *OrderBulkReplaceResponse) GetResponseID() uint64 {
-	if x != nil {
-		return x.ResponseID
-	}
-	return 0
-}
-
-func (x *OrderBulkReplaceResponse) GetSuccess() bool {
+func (x *NewOrderBulkRequest) GetAccount() *models.Account {
 	if x != nil {
-		return x.Success
+		return x.Account
 	}
-	return false
+	return nil
 }
 
-func (x *OrderBulkReplaceResponse) GetRejectionReason() RejectionReason {
+func (x *NewOrderBulkRequest) GetOrders() []*NewOrder {
 	if x != nil {
-		return x.RejectionReason
+		return x.Orders
 	}
-	return RejectionReason_Other
+	return nil
 }
 
-type OrderCancelRequest struct {
+type NewOrderBulkResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64                  `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	OrderID         *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=orderID,proto3" json:"orderID,omitempty"`
-	ClientOrderID   *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=client_orderID,json=clientOrderID,proto3" json:"client_orderID,omitempty"`
-	Instrument      *models.Instrument      `protobuf:"bytes,4,opt,name=instrument,proto3" json:"instrument,omitempty"`
-	Account         *models.Account         `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
-	ResponseType    ResponseType            `protobuf:"varint,6,opt,name=response_type,json=responseType,proto3,enum=messages.ResponseType" json:"response_type,omitempty"`
-	RequestPriority int32                   `protobuf:"varint,7,opt,name=request_priority,json=requestPriority,proto3" json:"request_priority,omitempty"`
+	RequestID       uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64          `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	OrderIDs        []string        `protobuf:"bytes,3,rep,name=orderIDs,proto3" json:"orderIDs,omitempty"`
+	Success         bool            `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *OrderCancelRequest) Reset() {
-	*x = OrderCancelRequest{}
+func (x *NewOrderBulkResponse) Reset() {
+	*x = NewOrderBulkResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4910,13 +5131,13 @@ func (x *OrderCancelRequest) Reset() {
 	}
 }
 
-func (x *OrderCancelRequest) String() string {
+func (x *NewOrderBulkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderCancelRequest) ProtoMessage() {}
+func (*NewOrderBulkResponse) ProtoMessage() {}
 
-func (x *OrderCancelRequest) ProtoReflect() protoreflect.Message {
+func (x *NewOrderBulkResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4928,75 +5149,59 @@ func (x *OrderCancelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderCancelRequest.ProtoReflect.Descriptor instead.
-func (*OrderCancelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use NewOrderBulkResponse.ProtoReflect.Descriptor instead.
+func (*NewOrderBulkResponse) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *OrderCancelRequest) GetRequestID() uint64 {
+func (x *NewOrderBulkResponse) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *OrderCancelRequest) GetOrderID() *wrapperspb.StringValue {
-	if x != nil {
-		return x.OrderID
-	}
-	return nil
-}
-
-func (x *OrderCancelRequest) GetClientOrderID() *wrapperspb.StringValue {
-	if x != nil {
-		return x.ClientOrderID
-	}
-	return nil
-}
-
-func (x *OrderCancelRequest) GetInstrument() *models.Instrument {
+func (x *NewOrderBulkResponse) GetResponseID() uint64 {
 	if x != nil {
-		return x.Instrument
+		return x.ResponseID
 	}
-	return nil
+	return 0
 }
 
-func (x *OrderCancelRequest) GetAccount() *models.Account {
+func (x *NewOrderBulkResponse) GetOrderIDs() []string {
 	if x != nil {
-		return x.Account
+		return x.OrderIDs
 	}
 	return nil
 }
 
-func (x *OrderCancelRequest) GetResponseType() ResponseType {
+func (x *NewOrderBulkResponse) GetSuccess() bool {
 	if x != nil {
-		return x.ResponseType
+		return x.Success
 	}
-	return ResponseType_Ack
+	return false
 }
 
-func (x *OrderCancelRequest) GetRequestPriority() int32 {
+func (x *NewOrderBulkResponse) GetRejectionReason() RejectionReason {
 	if x != nil {
-		return x.RequestPriority
+		return x.RejectionReason
 	}
-	return 0
+	return RejectionReason_Other
 }
 
-type OrderCancelResponse struct {
+type OrderUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64               `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64               `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	Success         bool                 `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason RejectionReason      `protobuf:"varint,4,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
-	RateLimitDelay  *durationpb.Duration `protobuf:"bytes,5,opt,name=rate_limit_delay,json=rateLimitDelay,proto3" json:"rate_limit_delay,omitempty"`
-	NetworkRtt      *durationpb.Duration `protobuf:"bytes,6,opt,name=network_rtt,json=networkRtt,proto3" json:"network_rtt,omitempty"`
+	OrderID           *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	OrigClientOrderID *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=orig_client_orderID,json=origClientOrderID,proto3" json:"orig_client_orderID,omitempty"`
+	Quantity          *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price             *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
 }
 
-func (x *OrderCancelResponse) Reset() {
-	*x = OrderCancelResponse{}
+func (x *OrderUpdate) Reset() {
+	*x = OrderUpdate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5004,13 +5209,13 @@ func (x *OrderCancelResponse) Reset() {
 	}
 }
 
-func (x *OrderCancelResponse) String() string {
+func (x *OrderUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderCancelResponse) ProtoMessage() {}
+func (*OrderUpdate) ProtoMessage() {}
 
-func (x *OrderCancelResponse) ProtoReflect() protoreflect.Message {
+func (x *OrderUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5022,65 +5227,52 @@ func (x *OrderCancelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderCancelResponse.ProtoReflect.Descriptor instead.
-func (*OrderCancelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderUpdate.ProtoReflect.Descriptor instead.
+func (*OrderUpdate) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *OrderCancelResponse) GetRequestID() uint64 {
+func (x *OrderUpdate) GetOrderID() *wrapperspb.StringValue {
 	if x != nil {
-		return x.RequestID
+		return x.OrderID
 	}
-	return 0
+	return nil
 }
 
-func (x *OrderCancelResponse) GetResponseID() uint64 {
+func (x *OrderUpdate) GetOrigClientOrderID() *wrapperspb.StringValue {
 	if x != nil {
-		return x.ResponseID
-	}
-	return 0
-}
-
-func (x *OrderCancelResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *OrderCancelResponse) GetRejectionReason() RejectionReason {
-	if x != nil {
-		return x.RejectionReason
+		return x.OrigClientOrderID
 	}
-	return RejectionReason_Other
+	return nil
 }
 
-func (x *OrderCancelResponse) GetRateLimitDelay() *durationpb.Duration {
+func (x *OrderUpdate) GetQuantity() *wrapperspb.DoubleValue {
 	if x != nil {
-		return x.RateLimitDelay
+		return x.Quantity
 	}
 	return nil
 }
 
-func (x *OrderCancelResponse) GetNetworkRtt() *durationpb.Duration {
+func (x *OrderUpdate) GetPrice() *wrapperspb.DoubleValue {
 	if x != nil {
-		return x.NetworkRtt
+		return x.Price
 	}
 	return nil
 }
 
-type OrderMassCancelRequest struct {
+type OrderReplaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Account   *models.Account `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	Filter    *OrderFilter    `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	RequestID  uint64             `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Instrument *models.Instrument `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Account    *models.Account    `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Update     *OrderUpdate       `protobuf:"bytes,4,opt,name=update,proto3" json:"update,omitempty"`
 }
 
-func (x *OrderMassCancelRequest) Reset() {
-	*x = OrderMassCancelRequest{}
+func (x *OrderReplaceRequest) Reset() {
+	*x = OrderReplaceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5088,13 +5280,13 @@ func (x *OrderMassCancelRequest) Reset() {
 	}
 }
 
-func (x *OrderMassCancelRequest) String() string {
+func (x *OrderReplaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderMassCancelRequest) ProtoMessage() {}
+func (*OrderReplaceRequest) ProtoMessage() {}
 
-func (x *OrderMassCancelRequest) ProtoReflect() protoreflect.Message {
+func (x *OrderReplaceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5106,45 +5298,53 @@ func (x *OrderMassCancelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderMassCancelRequest.ProtoReflect.Descriptor instead.
-func (*OrderMassCancelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderReplaceRequest.ProtoReflect.Descriptor instead.
+func (*OrderReplaceRequest) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *OrderMassCancelRequest) GetRequestID() uint64 {
+func (x *OrderReplaceRequest) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *OrderMassCancelRequest) GetAccount() *models.Account {
+func (x *OrderReplaceRequest) GetInstrument() *models.Instrument {
+	if x != nil {
+		return x.Instrument
+	}
+	return nil
+}
+
+func (x *OrderReplaceRequest) GetAccount() *models.Account {
 	if x != nil {
 		return x.Account
 	}
 	return nil
 }
 
-func (x *OrderMassCancelRequest) GetFilter() *OrderFilter {
+func (x *OrderReplaceRequest) GetUpdate() *OrderUpdate {
 	if x != nil {
-		return x.Filter
+		return x.Update
 	}
 	return nil
 }
 
-type OrderMassCancelResponse struct {
+type OrderReplaceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	RequestID       uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
 	ResponseID      uint64          `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	Success         bool            `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason RejectionReason `protobuf:"varint,4,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	OrderID         string          `protobuf:"bytes,3,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Success         bool            `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *OrderMassCancelResponse) Reset() {
-	*x = OrderMassCancelResponse{}
+func (x *OrderReplaceResponse) Reset() {
+	*x = OrderReplaceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5152,13 +5352,13 @@ func (x *OrderMassCancelResponse) Reset() {
 	}
 }
 
-func (x *OrderMassCancelResponse) String() string {
+func (x *OrderReplaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderMassCancelResponse) ProtoMessage() {}
+func (*OrderReplaceResponse) ProtoMessage() {}
 
-func (x *OrderMassCancelResponse) ProtoReflect() protoreflect.Message {
+func (x *OrderReplaceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5170,54 +5370,59 @@ func (x *OrderMassCancelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderMassCancelResponse.ProtoReflect.Descriptor instead.
-func (*OrderMassCancelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderReplaceResponse.ProtoReflect.Descriptor instead.
+func (*OrderReplaceResponse) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *OrderMassCancelResponse) GetRequestID() uint64 {
+func (x *OrderReplaceResponse) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *OrderMassCancelResponse) GetResponseID() uint64 {
+func (x *OrderReplaceResponse) GetResponseID() uint64 {
 	if x != nil {
 		return x.ResponseID
 	}
 	return 0
 }
 
-func (x *OrderMassCancelResponse) GetSuccess() bool {
+func (x *OrderReplaceResponse) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *OrderReplaceResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *OrderMassCancelResponse) GetRejectionReason() RejectionReason {
+func (x *OrderReplaceResponse) GetRejectionReason() RejectionReason {
 	if x != nil {
 		return x.RejectionReason
 	}
 	return RejectionReason_Other
 }
 
-type HistoricalProtocolAssetTransferRequest struct {
+type OrderBulkReplaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID  uint64                  `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	AssetID    *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=assetID,proto3" json:"assetID,omitempty"`
-	ChainID    uint32                  `protobuf:"varint,3,opt,name=chainID,proto3" json:"chainID,omitempty"`
-	ProtocolID uint32                  `protobuf:"varint,4,opt,name=protocolID,proto3" json:"protocolID,omitempty"`
-	Start      uint64                  `protobuf:"varint,5,opt,name=start,proto3" json:"start,omitempty"`
-	Stop       uint64                  `protobuf:"varint,6,opt,name=stop,proto3" json:"stop,omitempty"`
+	RequestID  uint64             `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Instrument *models.Instrument `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Account    *models.Account    `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Updates    []*OrderUpdate     `protobuf:"bytes,4,rep,name=updates,proto3" json:"updates,omitempty"`
 }
 
-func (x *HistoricalProtocolAssetTransferRequest) Reset() {
-	*x = HistoricalProtocolAssetTransferRequest{}
+func (x *OrderBulkReplaceRequest) Reset() {
+	*x = OrderBulkReplaceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5225,13 +5430,13 @@ func (x *HistoricalProtocolAssetTransferRequest) Reset() {
 	}
 }
 
-func (x *HistoricalProtocolAssetTransferRequest) String() string {
+func (x *OrderBulkReplaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HistoricalProtocolAssetTransferRequest) ProtoMessage() {}
+func (*OrderBulkReplaceRequest) ProtoMessage() {}
 
-func (x *HistoricalProtocolAssetTransferRequest) ProtoReflect() protoreflect.Message {
+func (x *OrderBulkReplaceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5243,68 +5448,52 @@ func (x *HistoricalProtocolAssetTransferRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HistoricalProtocolAssetTransferRequest.ProtoReflect.Descriptor instead.
-func (*HistoricalProtocolAssetTransferRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderBulkReplaceRequest.ProtoReflect.Descriptor instead.
+func (*OrderBulkReplaceRequest) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *HistoricalProtocolAssetTransferRequest) GetRequestID() uint64 {
+func (x *OrderBulkReplaceRequest) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *HistoricalProtocolAssetTransferRequest) GetAssetID() *wrapperspb.UInt32Value {
+func (x *OrderBulkReplaceRequest) GetInstrument() *models.Instrument {
 	if x != nil {
-		return x.AssetID
+		return x.Instrument
 	}
 	return nil
 }
 
-func (x *HistoricalProtocolAssetTransferRequest) GetChainID() uint32 {
-	if x != nil {
-		return x.ChainID
-	}
-	return 0
-}
-
-func (x *HistoricalProtocolAssetTransferRequest) GetProtocolID() uint32 {
-	if x != nil {
-		return x.ProtocolID
-	}
-	return 0
-}
-
-func (x *HistoricalProtocolAssetTransferRequest) GetStart() uint64 {
+func (x *OrderBulkReplaceRequest) GetAccount() *models.Account {
 	if x != nil {
-		return x.Start
+		return x.Account
 	}
-	return 0
+	return nil
 }
 
-func (x *HistoricalProtocolAssetTransferRequest) GetStop() uint64 {
+func (x *OrderBulkReplaceRequest) GetUpdates() []*OrderUpdate {
 	if x != nil {
-		return x.Stop
+		return x.Updates
 	}
-	return 0
+	return nil
 }
 
-type HistoricalProtocolAssetTransferResponse struct {
+type OrderBulkReplaceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64                        `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64                        `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	Update          []*models.ProtocolAssetUpdate `protobuf:"bytes,3,rep,name=update,proto3" json:"update,omitempty"`
-	SeqNum          uint64                        `protobuf:"varint,4,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
-	Success         bool                          `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason RejectionReason               `protobuf:"varint,6,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	RequestID       uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64          `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	Success         bool            `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason `protobuf:"varint,4,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *HistoricalProtocolAssetTransferResponse) Reset() {
-	*x = HistoricalProtocolAssetTransferResponse{}
+func (x *OrderBulkReplaceResponse) Reset() {
+	*x = OrderBulkReplaceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5312,13 +5501,13 @@ func (x *HistoricalProtocolAssetTransferResponse) Reset() {
 	}
 }
 
-func (x *HistoricalProtocolAssetTransferResponse) String() string {
+func (x *OrderBulkReplaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HistoricalProtocolAssetTransferResponse) ProtoMessage() {}
+func (*OrderBulkReplaceResponse) ProtoMessage() {}
 
-func (x *HistoricalProtocolAssetTransferResponse) ProtoReflect() protoreflect.Message {
+func (x *OrderBulkReplaceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5330,68 +5519,55 @@ func (x *HistoricalProtocolAssetTransferResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HistoricalProtocolAssetTransferResponse.ProtoReflect.Descriptor instead.
-func (*HistoricalProtocolAssetTransferResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderBulkReplaceResponse.ProtoReflect.Descriptor instead.
+func (*OrderBulkReplaceResponse) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *HistoricalProtocolAssetTransferResponse) GetRequestID() uint64 {
+func (x *OrderBulkReplaceResponse) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *HistoricalProtocolAssetTransferResponse) GetResponseID() uint64 {
+func (x *OrderBulkReplaceResponse) GetResponseID() uint64 {
 	if x != nil {
 		return x.ResponseID
 	}
 	return 0
 }
 
-func (x *HistoricalProtocolAssetTransferResponse) GetUpdate() []*models.ProtocolAssetUpdate {
-	if x != nil {
-		return x.Update
-	}
-	return nil
-}
-
-func (x *HistoricalProtocolAssetTransferResponse) GetSeqNum() uint64 {
-	if x != nil {
-		return x.SeqNum
-	}
-	return 0
-}
-
-func (x *HistoricalProtocolAssetTransferResponse) GetSuccess() bool {
+func (x *OrderBulkReplaceResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *HistoricalProtocolAssetTransferResponse) GetRejectionReason() RejectionReason {
+func (x *OrderBulkReplaceResponse) GetRejectionReason() RejectionReason {
 	if x != nil {
 		return x.RejectionReason
 	}
 	return RejectionReason_Other
 }
 
-type ProtocolAssetDataRequest struct {
+type OrderCancelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID  uint64                  `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Subscribe  bool                    `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
-	Subscriber *actor.PID              `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
-	AssetID    *wrapperspb.UInt32Value `protobuf:"bytes,4,opt,name=assetID,proto3" json:"assetID,omitempty"`
-	ProtocolID uint32                  `protobuf:"varint,5,opt,name=protocolID,proto3" json:"protocolID,omitempty"`
-	ChainID    uint32                  `protobuf:"varint,6,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	RequestID       uint64                  `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	OrderID         *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	ClientOrderID   *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=client_orderID,json=clientOrderID,proto3" json:"client_orderID,omitempty"`
+	Instrument      *models.Instrument      `protobuf:"bytes,4,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Account         *models.Account         `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+	ResponseType    ResponseType            `protobuf:"varint,6,opt,name=response_type,json=responseType,proto3,enum=messages.ResponseType" json:"response_type,omitempty"`
+	RequestPriority int32                   `protobuf:"varint,7,opt,name=request_priority,json=requestPriority,proto3" json:"request_priority,omitempty"`
 }
 
-func (x *ProtocolAssetDataRequest) Reset() {
-	*x = ProtocolAssetDataRequest{}
+func (x *OrderCancelRequest) Reset() {
+	*x = OrderCancelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5399,13 +5575,13 @@ func (x *ProtocolAssetDataRequest) Reset() {
 	}
 }
 
-func (x *ProtocolAssetDataRequest) String() string {
+func (x *OrderCancelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtocolAssetDataRequest) ProtoMessage() {}
+func (*OrderCancelRequest) ProtoMessage() {}
 
-func (x *ProtocolAssetDataRequest) ProtoReflect() protoreflect.Message {
+func (x *OrderCancelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5417,67 +5593,75 @@ func (x *ProtocolAssetDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtocolAssetDataRequest.ProtoReflect.Descriptor instead.
-func (*ProtocolAssetDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderCancelRequest.ProtoReflect.Descriptor instead.
+func (*OrderCancelRequest) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ProtocolAssetDataRequest) GetRequestID() uint64 {
+func (x *OrderCancelRequest) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *ProtocolAssetDataRequest) GetSubscribe() bool {
+func (x *OrderCancelRequest) GetOrderID() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Subscribe
+		return x.OrderID
 	}
-	return false
+	return nil
 }
 
-func (x *ProtocolAssetDataRequest) GetSubscriber() *actor.PID {
+func (x *OrderCancelRequest) GetClientOrderID() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Subscriber
+		return x.ClientOrderID
 	}
 	return nil
 }
 
-func (x *ProtocolAssetDataRequest) GetAssetID() *wrapperspb.UInt32Value {
+func (x *OrderCancelRequest) GetInstrument() *models.Instrument {
 	if x != nil {
-		return x.AssetID
+		return x.Instrument
 	}
 	return nil
 }
 
-func (x *ProtocolAssetDataRequest) GetProtocolID() uint32 {
+func (x *OrderCancelRequest) GetAccount() *models.Account {
 	if x != nil {
-		return x.ProtocolID
+		return x.Account
 	}
-	return 0
+	return nil
 }
 
-func (x *ProtocolAssetDataRequest) GetChainID() uint32 {
+func (x *OrderCancelRequest) GetResponseType() ResponseType {
 	if x != nil {
-		return x.ChainID
+		return x.ResponseType
+	}
+	return ResponseType_Ack
+}
+
+func (x *OrderCancelRequest) GetRequestPriority() int32 {
+	if x != nil {
+		return x.RequestPriority
 	}
 	return 0
 }
 
-type ProtocolAssetDataResponse struct {
+type OrderCancelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64          `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	SeqNum          uint64          `protobuf:"varint,3,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
-	Success         bool            `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason RejectionReason `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	RequestID       uint64               `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64               `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	Success         bool                 `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason      `protobuf:"varint,4,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	RateLimitDelay  *durationpb.Duration `protobuf:"bytes,5,opt,name=rate_limit_delay,json=rateLimitDelay,proto3" json:"rate_limit_delay,omitempty"`
+	NetworkRtt      *durationpb.Duration `protobuf:"bytes,6,opt,name=network_rtt,json=networkRtt,proto3" json:"network_rtt,omitempty"`
 }
 
-func (x *ProtocolAssetDataResponse) Reset() {
-	*x = ProtocolAssetDataResponse{}
+func (x *OrderCancelResponse) Reset() {
+	*x = OrderCancelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5485,13 +5669,13 @@ func (x *ProtocolAssetDataResponse) Reset() {
 	}
 }
 
-func (x *ProtocolAssetDataResponse) String() string {
+func (x *OrderCancelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtocolAssetDataResponse) ProtoMessage() {}
+func (*OrderCancelResponse) ProtoMessage() {}
 
-func (x *ProtocolAssetDataResponse) ProtoReflect() protoreflect.Message {
+func (x *OrderCancelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5503,59 +5687,65 @@ func (x *ProtocolAssetDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtocolAssetDataResponse.ProtoReflect.Descriptor instead.
-func (*ProtocolAssetDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderCancelResponse.ProtoReflect.Descriptor instead.
+func (*OrderCancelResponse) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ProtocolAssetDataResponse) GetRequestID() uint64 {
+func (x *OrderCancelResponse) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *ProtocolAssetDataResponse) GetResponseID() uint64 {
+func (x *OrderCancelResponse) GetResponseID() uint64 {
 	if x != nil {
 		return x.ResponseID
 	}
 	return 0
 }
 
-func (x *ProtocolAssetDataResponse) GetSeqNum() uint64 {
-	if x != nil {
-		return x.SeqNum
-	}
-	return 0
-}
-
-func (x *ProtocolAssetDataResponse) GetSuccess() bool {
+func (x *OrderCancelResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ProtocolAssetDataResponse) GetRejectionReason() RejectionReason {
+func (x *OrderCancelResponse) GetRejectionReason() RejectionReason {
 	if x != nil {
 		return x.RejectionReason
 	}
 	return RejectionReason_Other
 }
 
-type ProtocolAssetDataIncrementalRefresh struct {
+func (x *OrderCancelResponse) GetRateLimitDelay() *durationpb.Duration {
+	if x != nil {
+		return x.RateLimitDelay
+	}
+	return nil
+}
+
+func (x *OrderCancelResponse) GetNetworkRtt() *durationpb.Duration {
+	if x != nil {
+		return x.NetworkRtt
+	}
+	return nil
+}
+
+type OrderMassCancelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID  uint64                      `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID uint64                      `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	SeqNum     uint64                      `protobuf:"varint,3,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
-	Update     *models.ProtocolAssetUpdate `protobuf:"bytes,4,opt,name=update,proto3" json:"update,omitempty"`
+	RequestID uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Account   *models.Account `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Filter    *OrderFilter    `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *ProtocolAssetDataIncrementalRefresh) Reset() {
-	*x = ProtocolAssetDataIncrementalRefresh{}
+func (x *OrderMassCancelRequest) Reset() {
+	*x = OrderMassCancelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5563,13 +5753,13 @@ func (x *ProtocolAssetDataIncrementalRefresh) Reset() {
 	}
 }
 
-func (x *ProtocolAssetDataIncrementalRefresh) String() string {
+func (x *OrderMassCancelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtocolAssetDataIncrementalRefresh) ProtoMessage() {}
+func (*OrderMassCancelRequest) ProtoMessage() {}
 
-func (x *ProtocolAssetDataIncrementalRefresh) ProtoReflect() protoreflect.Message {
+func (x *OrderMassCancelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5581,50 +5771,45 @@ func (x *ProtocolAssetDataIncrementalRefresh) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtocolAssetDataIncrementalRefresh.ProtoReflect.Descriptor instead.
-func (*ProtocolAssetDataIncrementalRefresh) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderMassCancelRequest.ProtoReflect.Descriptor instead.
+func (*OrderMassCancelRequest) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ProtocolAssetDataIncrementalRefresh) GetRequestID() uint64 {
+func (x *OrderMassCancelRequest) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *ProtocolAssetDataIncrementalRefresh) GetResponseID() uint64 {
-	if x != nil {
-		return x.ResponseID
-	}
-	return 0
-}
-
-func (x *ProtocolAssetDataIncrementalRefresh) GetSeqNum() uint64 {
+func (x *OrderMassCancelRequest) GetAccount() *models.Account {
 	if x != nil {
-		return x.SeqNum
+		return x.Account
 	}
-	return 0
+	return nil
 }
 
-func (x *ProtocolAssetDataIncrementalRefresh) GetUpdate() *models.ProtocolAssetUpdate {
+func (x *OrderMassCancelRequest) GetFilter() *OrderFilter {
 	if x != nil {
-		return x.Update
+		return x.Filter
 	}
 	return nil
 }
 
-type ProtocolAssetDefinitionRequest struct {
+type OrderMassCancelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ProtocolAssetID uint64 `protobuf:"varint,2,opt,name=protocol_assetID,json=protocolAssetID,proto3" json:"protocol_assetID,omitempty"`
+	RequestID       uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64          `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	Success         bool            `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason `protobuf:"varint,4,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *ProtocolAssetDefinitionRequest) Reset() {
-	*x = ProtocolAssetDefinitionRequest{}
+func (x *OrderMassCancelResponse) Reset() {
+	*x = OrderMassCancelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5632,13 +5817,13 @@ func (x *ProtocolAssetDefinitionRequest) Reset() {
 	}
 }
 
-func (x *ProtocolAssetDefinitionRequest) String() string {
+func (x *OrderMassCancelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtocolAssetDefinitionRequest) ProtoMessage() {}
+func (*OrderMassCancelResponse) ProtoMessage() {}
 
-func (x *ProtocolAssetDefinitionRequest) ProtoReflect() protoreflect.Message {
+func (x *OrderMassCancelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5650,39 +5835,54 @@ func (x *ProtocolAssetDefinitionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtocolAssetDefinitionRequest.ProtoReflect.Descriptor instead.
-func (*ProtocolAssetDefinitionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderMassCancelResponse.ProtoReflect.Descriptor instead.
+func (*OrderMassCancelResponse) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ProtocolAssetDefinitionRequest) GetRequestID() uint64 {
+func (x *OrderMassCancelResponse) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *ProtocolAssetDefinitionRequest) GetProtocolAssetID() uint64 {
+func (x *OrderMassCancelResponse) GetResponseID() uint64 {
 	if x != nil {
-		return x.ProtocolAssetID
+		return x.ResponseID
 	}
 	return 0
 }
 
-type ProtocolAssetDefinitionResponse struct {
+func (x *OrderMassCancelResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *OrderMassCancelResponse) GetRejectionReason() RejectionReason {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return RejectionReason_Other
+}
+
+type HistoricalProtocolAssetTransferRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID       uint64                `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64                `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	ProtocolAsset   *models.ProtocolAsset `protobuf:"bytes,3,opt,name=protocol_asset,json=protocolAsset,proto3" json:"protocol_asset,omitempty"`
-	Success         bool                  `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason RejectionReason       `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	RequestID  uint64                  `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	AssetID    *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=assetID,proto3" json:"assetID,omitempty"`
+	ChainID    uint32                  `protobuf:"varint,3,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	ProtocolID uint32                  `protobuf:"varint,4,opt,name=protocolID,proto3" json:"protocolID,omitempty"`
+	Start      uint64                  `protobuf:"varint,5,opt,name=start,proto3" json:"start,omitempty"`
+	Stop       uint64                  `protobuf:"varint,6,opt,name=stop,proto3" json:"stop,omitempty"`
 }
 
-func (x *ProtocolAssetDefinitionResponse) Reset() {
-	*x = ProtocolAssetDefinitionResponse{}
+func (x *HistoricalProtocolAssetTransferRequest) Reset() {
+	*x = HistoricalProtocolAssetTransferRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5690,13 +5890,13 @@ func (x *ProtocolAssetDefinitionResponse) Reset() {
 	}
 }
 
-func (x *ProtocolAssetDefinitionResponse) String() string {
+func (x *HistoricalProtocolAssetTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtocolAssetDefinitionResponse) ProtoMessage() {}
+func (*HistoricalProtocolAssetTransferRequest) ProtoMessage() {}
 
-func (x *ProtocolAssetDefinitionResponse) ProtoReflect() protoreflect.Message {
+func (x *HistoricalProtocolAssetTransferRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5708,58 +5908,68 @@ func (x *ProtocolAssetDefinitionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtocolAssetDefinitionResponse.ProtoReflect.Descriptor instead.
-func (*ProtocolAssetDefinitionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HistoricalProtocolAssetTransferRequest.ProtoReflect.Descriptor instead.
+func (*HistoricalProtocolAssetTransferRequest) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ProtocolAssetDefinitionResponse) GetRequestID() uint64 {
+func (x *HistoricalProtocolAssetTransferRequest) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *ProtocolAssetDefinitionResponse) GetResponseID() uint64 {
+func (x *HistoricalProtocolAssetTransferRequest) GetAssetID() *wrapperspb.UInt32Value {
 	if x != nil {
-		return x.ResponseID
+		return x.AssetID
+	}
+	return nil
+}
+
+func (x *HistoricalProtocolAssetTransferRequest) GetChainID() uint32 {
+	if x != nil {
+		return x.ChainID
 	}
 	return 0
 }
 
-func (x *ProtocolAssetDefinitionResponse) GetProtocolAsset() *models.ProtocolAsset {
+func (x *HistoricalProtocolAssetTransferRequest) GetProtocolID() uint32 {
 	if x != nil {
-		return x.ProtocolAsset
+		return x.ProtocolID
 	}
-	return nil
+	return 0
 }
 
-func (x *ProtocolAssetDefinitionResponse) GetSuccess() bool {
+func (x *HistoricalProtocolAssetTransferRequest) GetStart() uint64 {
 	if x != nil {
-		return x.Success
+		return x.Start
 	}
-	return false
+	return 0
 }
 
-func (x *ProtocolAssetDefinitionResponse) GetRejectionReason() RejectionReason {
+func (x *HistoricalProtocolAssetTransferRequest) GetStop() uint64 {
 	if x != nil {
-		return x.RejectionReason
+		return x.Stop
 	}
-	return RejectionReason_Other
+	return 0
 }
 
-type ProtocolAssetListRequest struct {
+type HistoricalProtocolAssetTransferResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID  uint64     `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Subscribe  bool       `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
-	Subscriber *actor.PID `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	RequestID       uint64                        `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64                        `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	Update          []*models.ProtocolAssetUpdate `protobuf:"bytes,3,rep,name=update,proto3" json:"update,omitempty"`
+	SeqNum          uint64                        `protobuf:"varint,4,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
+	Success         bool                          `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason               `protobuf:"varint,6,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *ProtocolAssetListRequest) Reset() {
-	*x = ProtocolAssetListRequest{}
+func (x *HistoricalProtocolAssetTransferResponse) Reset() {
+	*x = HistoricalProtocolAssetTransferResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5767,13 +5977,13 @@ func (x *ProtocolAssetListRequest) Reset() {
 	}
 }
 
-func (x *ProtocolAssetListRequest) String() string {
+func (x *HistoricalProtocolAssetTransferResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtocolAssetListRequest) ProtoMessage() {}
+func (*HistoricalProtocolAssetTransferResponse) ProtoMessage() {}
 
-func (x *ProtocolAssetListRequest) ProtoReflect() protoreflect.Message {
+func (x *HistoricalProtocolAssetTransferResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5785,46 +5995,68 @@ func (x *ProtocolAssetListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtocolAssetListRequest.ProtoReflect.Descriptor instead.
-func (*ProtocolAssetListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HistoricalProtocolAssetTransferResponse.ProtoReflect.Descriptor instead.
+func (*HistoricalProtocolAssetTransferResponse) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ProtocolAssetListRequest) GetRequestID() uint64 {
+func (x *HistoricalProtocolAssetTransferResponse) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *ProtocolAssetListRequest) GetSubscribe() bool {
+func (x *HistoricalProtocolAssetTransferResponse) GetResponseID() uint64 {
 	if x != nil {
-		return x.Subscribe
+		return x.ResponseID
 	}
-	return false
+	return 0
 }
 
-func (x *ProtocolAssetListRequest) GetSubscriber() *actor.PID {
+func (x *HistoricalProtocolAssetTransferResponse) GetUpdate() []*models.ProtocolAssetUpdate {
 	if x != nil {
-		return x.Subscriber
+		return x.Update
 	}
 	return nil
 }
 
-type ProtocolAssetList struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *HistoricalProtocolAssetTransferResponse) GetSeqNum() uint64 {
+	if x != nil {
+		return x.SeqNum
+	}
+	return 0
+}
 
-	RequestID       uint64                  `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID      uint64                  `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	ProtocolAssets  []*models.ProtocolAsset `protobuf:"bytes,3,rep,name=protocol_assets,json=protocolAssets,proto3" json:"protocol_assets,omitempty"`
-	Success         bool                    `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason RejectionReason         `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+func (x *HistoricalProtocolAssetTransferResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
 }
 
-func (x *ProtocolAssetList) Reset() {
-	*x = ProtocolAssetList{}
+func (x *HistoricalProtocolAssetTransferResponse) GetRejectionReason() RejectionReason {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return RejectionReason_Other
+}
+
+type ProtocolAssetDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestID  uint64                  `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Subscribe  bool                    `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	Subscriber *actor.PID              `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	AssetID    *wrapperspb.UInt32Value `protobuf:"bytes,4,opt,name=assetID,proto3" json:"assetID,omitempty"`
+	ProtocolID uint32                  `protobuf:"varint,5,opt,name=protocolID,proto3" json:"protocolID,omitempty"`
+	ChainID    uint32                  `protobuf:"varint,6,opt,name=chainID,proto3" json:"chainID,omitempty"`
+}
+
+func (x *ProtocolAssetDataRequest) Reset() {
+	*x = ProtocolAssetDataRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5832,13 +6064,13 @@ func (x *ProtocolAssetList) Reset() {
 	}
 }
 
-func (x *ProtocolAssetList) String() string {
+func (x *ProtocolAssetDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtocolAssetList) ProtoMessage() {}
+func (*ProtocolAssetDataRequest) ProtoMessage() {}
 
-func (x *ProtocolAssetList) ProtoReflect() protoreflect.Message {
+func (x *ProtocolAssetDataRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5850,58 +6082,67 @@ func (x *ProtocolAssetList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtocolAssetList.ProtoReflect.Descriptor instead.
-func (*ProtocolAssetList) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProtocolAssetDataRequest.ProtoReflect.Descriptor instead.
+func (*ProtocolAssetDataRequest) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ProtocolAssetList) GetRequestID() uint64 {
+func (x *ProtocolAssetDataRequest) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *ProtocolAssetList) GetResponseID() uint64 {
+func (x *ProtocolAssetDataRequest) GetSubscribe() bool {
 	if x != nil {
-		return x.ResponseID
+		return x.Subscribe
 	}
-	return 0
+	return false
 }
 
-func (x *ProtocolAssetList) GetProtocolAssets() []*models.ProtocolAsset {
+func (x *ProtocolAssetDataRequest) GetSubscriber() *actor.PID {
 	if x != nil {
-		return x.ProtocolAssets
+		return x.Subscriber
 	}
 	return nil
 }
 
-func (x *ProtocolAssetList) GetSuccess() bool {
+func (x *ProtocolAssetDataRequest) GetAssetID() *wrapperspb.UInt32Value {
 	if x != nil {
-		return x.Success
+		return x.AssetID
 	}
-	return false
+	return nil
 }
 
-func (x *ProtocolAssetList) GetRejectionReason() RejectionReason {
+func (x *ProtocolAssetDataRequest) GetProtocolID() uint32 {
 	if x != nil {
-		return x.RejectionReason
+		return x.ProtocolID
 	}
-	return RejectionReason_Other
+	return 0
 }
 
-type MarketableProtocolAssetListRequest struct {
+func (x *ProtocolAssetDataRequest) GetChainID() uint32 {
+	if x != nil {
+		return x.ChainID
+	}
+	return 0
+}
+
+type ProtocolAssetDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID  uint64     `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Subscribe  bool       `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
-	Subscriber *actor.PID `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	RequestID       uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64          `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	SeqNum          uint64          `protobuf:"varint,3,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
+	Success         bool            `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *MarketableProtocolAssetListRequest) Reset() {
-	*x = MarketableProtocolAssetListRequest{}
+func (x *ProtocolAssetDataResponse) Reset() {
+	*x = ProtocolAssetDataResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5909,13 +6150,13 @@ func (x *MarketableProtocolAssetListRequest) Reset() {
 	}
 }
 
-func (x *MarketableProtocolAssetListRequest) String() string {
+func (x *ProtocolAssetDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketableProtocolAssetListRequest) ProtoMessage() {}
+func (*ProtocolAssetDataResponse) ProtoMessage() {}
 
-func (x *MarketableProtocolAssetListRequest) ProtoReflect() protoreflect.Message {
+func (x *ProtocolAssetDataResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5927,46 +6168,59 @@ func (x *MarketableProtocolAssetListRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketableProtocolAssetListRequest.ProtoReflect.Descriptor instead.
-func (*MarketableProtocolAssetListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProtocolAssetDataResponse.ProtoReflect.Descriptor instead.
+func (*ProtocolAssetDataResponse) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *MarketableProtocolAssetListRequest) GetRequestID() uint64 {
+func (x *ProtocolAssetDataResponse) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *MarketableProtocolAssetListRequest) GetSubscribe() bool {
+func (x *ProtocolAssetDataResponse) GetResponseID() uint64 {
 	if x != nil {
-		return x.Subscribe
+		return x.ResponseID
+	}
+	return 0
+}
+
+func (x *ProtocolAssetDataResponse) GetSeqNum() uint64 {
+	if x != nil {
+		return x.SeqNum
+	}
+	return 0
+}
+
+func (x *ProtocolAssetDataResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
 	}
 	return false
 }
 
-func (x *MarketableProtocolAssetListRequest) GetSubscriber() *actor.PID {
+func (x *ProtocolAssetDataResponse) GetRejectionReason() RejectionReason {
 	if x != nil {
-		return x.Subscriber
+		return x.RejectionReason
 	}
-	return nil
+	return RejectionReason_Other
 }
 
-type MarketableProtocolAssetList struct {
+type ProtocolAssetDataIncrementalRefresh struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID                uint64                            `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID               uint64                            `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	MarketableProtocolAssets []*models.MarketableProtocolAsset `protobuf:"bytes,3,rep,name=marketable_protocol_assets,json=marketableProtocolAssets,proto3" json:"marketable_protocol_assets,omitempty"`
-	Success                  bool                              `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason          RejectionReason                   `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	RequestID  uint64                      `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID uint64                      `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	SeqNum     uint64                      `protobuf:"varint,3,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
+	Update     *models.ProtocolAssetUpdate `protobuf:"bytes,4,opt,name=update,proto3" json:"update,omitempty"`
 }
 
-func (x *MarketableProtocolAssetList) Reset() {
-	*x = MarketableProtocolAssetList{}
+func (x *ProtocolAssetDataIncrementalRefresh) Reset() {
+	*x = ProtocolAssetDataIncrementalRefresh{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5974,13 +6228,13 @@ func (x *MarketableProtocolAssetList) Reset() {
 	}
 }
 
-func (x *MarketableProtocolAssetList) String() string {
+func (x *ProtocolAssetDataIncrementalRefresh) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketableProtocolAssetList) ProtoMessage() {}
+func (*ProtocolAssetDataIncrementalRefresh) ProtoMessage() {}
 
-func (x *MarketableProtocolAssetList) ProtoReflect() protoreflect.Message {
+func (x *ProtocolAssetDataIncrementalRefresh) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5992,57 +6246,50 @@ func (x *MarketableProtocolAssetList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketableProtocolAssetList.ProtoReflect.Descriptor instead.
-func (*MarketableProtocolAssetList) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProtocolAssetDataIncrementalRefresh.ProtoReflect.Descriptor instead.
+func (*ProtocolAssetDataIncrementalRefresh) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *MarketableProtocolAssetList) GetRequestID() uint64 {
+func (x *ProtocolAssetDataIncrementalRefresh) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *MarketableProtocolAssetList) GetResponseID() uint64 {
+func (x *ProtocolAssetDataIncrementalRefresh) GetResponseID() uint64 {
 	if x != nil {
 		return x.ResponseID
 	}
 	return 0
 }
 
-func (x *MarketableProtocolAssetList) GetMarketableProtocolAssets() []*models.MarketableProtocolAsset {
-	if x != nil {
-		return x.MarketableProtocolAssets
-	}
-	return nil
-}
-
-func (x *MarketableProtocolAssetList) GetSuccess() bool {
+func (x *ProtocolAssetDataIncrementalRefresh) GetSeqNum() uint64 {
 	if x != nil {
-		return x.Success
+		return x.SeqNum
 	}
-	return false
+	return 0
 }
 
-func (x *MarketableProtocolAssetList) GetRejectionReason() RejectionReason {
+func (x *ProtocolAssetDataIncrementalRefresh) GetUpdate() *models.ProtocolAssetUpdate {
 	if x != nil {
-		return x.RejectionReason
+		return x.Update
 	}
-	return RejectionReason_Other
+	return nil
 }
 
-type MarketableProtocolAssetDefinitionRequest struct {
+type ProtocolAssetDefinitionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID                 uint64 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	MarketableProtocolAssetID uint64 `protobuf:"varint,2,opt,name=marketable_protocol_assetID,json=marketableProtocolAssetID,proto3" json:"marketable_protocol_assetID,omitempty"`
+	RequestID       uint64 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ProtocolAssetID uint64 `protobuf:"varint,2,opt,name=protocol_assetID,json=protocolAssetID,proto3" json:"protocol_assetID,omitempty"`
 }
 
-func (x *MarketableProtocolAssetDefinitionRequest) Reset() {
-	*x = MarketableProtocolAssetDefinitionRequest{}
+func (x *ProtocolAssetDefinitionRequest) Reset() {
+	*x = ProtocolAssetDefinitionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6050,13 +6297,13 @@ func (x *MarketableProtocolAssetDefinitionRequest) Reset() {
 	}
 }
 
-func (x *MarketableProtocolAssetDefinitionRequest) String() string {
+func (x *ProtocolAssetDefinitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketableProtocolAssetDefinitionRequest) ProtoMessage() {}
+func (*ProtocolAssetDefinitionRequest) ProtoMessage() {}
 
-func (x *MarketableProtocolAssetDefinitionRequest) ProtoReflect() protoreflect.Message {
+func (x *ProtocolAssetDefinitionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6068,39 +6315,39 @@ func (x *MarketableProtocolAssetDefinitionRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketableProtocolAssetDefinitionRequest.ProtoReflect.Descriptor instead.
-func (*MarketableProtocolAssetDefinitionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProtocolAssetDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*ProtocolAssetDefinitionRequest) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *MarketableProtocolAssetDefinitionRequest) GetRequestID() uint64 {
+func (x *ProtocolAssetDefinitionRequest) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *MarketableProtocolAssetDefinitionRequest) GetMarketableProtocolAssetID() uint64 {
+func (x *ProtocolAssetDefinitionRequest) GetProtocolAssetID() uint64 {
 	if x != nil {
-		return x.MarketableProtocolAssetID
+		return x.ProtocolAssetID
 	}
 	return 0
 }
 
-type MarketableProtocolAssetDefinitionResponse struct {
+type ProtocolAssetDefinitionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestID               uint64                          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	ResponseID              uint64                          `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
-	MarketableProtocolAsset *models.MarketableProtocolAsset `protobuf:"bytes,3,opt,name=marketable_protocol_asset,json=marketableProtocolAsset,proto3" json:"marketable_protocol_asset,omitempty"`
-	Success                 bool                            `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	RejectionReason         RejectionReason                 `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	RequestID       uint64                `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64                `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	ProtocolAsset   *models.ProtocolAsset `protobuf:"bytes,3,opt,name=protocol_asset,json=protocolAsset,proto3" json:"protocol_asset,omitempty"`
+	Success         bool                  `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason       `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
 }
 
-func (x *MarketableProtocolAssetDefinitionResponse) Reset() {
-	*x = MarketableProtocolAssetDefinitionResponse{}
+func (x *ProtocolAssetDefinitionResponse) Reset() {
+	*x = ProtocolAssetDefinitionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_executor_messages_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6108,13 +6355,13 @@ func (x *MarketableProtocolAssetDefinitionResponse) Reset() {
 	}
 }
 
-func (x *MarketableProtocolAssetDefinitionResponse) String() string {
+func (x *ProtocolAssetDefinitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketableProtocolAssetDefinitionResponse) ProtoMessage() {}
+func (*ProtocolAssetDefinitionResponse) ProtoMessage() {}
 
-func (x *MarketableProtocolAssetDefinitionResponse) ProtoReflect() protoreflect.Message {
+func (x *ProtocolAssetDefinitionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_executor_messages_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6126,62 +6373,825 @@ func (x *MarketableProtocolAssetDefinitionResponse) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketableProtocolAssetDefinitionResponse.ProtoReflect.Descriptor instead.
-func (*MarketableProtocolAssetDefinitionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProtocolAssetDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*ProtocolAssetDefinitionResponse) Descriptor() ([]byte, []int) {
 	return file_executor_messages_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *MarketableProtocolAssetDefinitionResponse) GetRequestID() uint64 {
+func (x *ProtocolAssetDefinitionResponse) GetRequestID() uint64 {
 	if x != nil {
 		return x.RequestID
 	}
 	return 0
 }
 
-func (x *MarketableProtocolAssetDefinitionResponse) GetResponseID() uint64 {
+func (x *ProtocolAssetDefinitionResponse) GetResponseID() uint64 {
 	if x != nil {
 		return x.ResponseID
 	}
 	return 0
 }
 
-func (x *MarketableProtocolAssetDefinitionResponse) GetMarketableProtocolAsset() *models.MarketableProtocolAsset {
+func (x *ProtocolAssetDefinitionResponse) GetProtocolAsset() *models.ProtocolAsset {
 	if x != nil {
-		return x.MarketableProtocolAsset
+		return x.ProtocolAsset
 	}
 	return nil
 }
 
-func (x *MarketableProtocolAssetDefinitionResponse) GetSuccess() bool {
+func (x *ProtocolAssetDefinitionResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *MarketableProtocolAssetDefinitionResponse) GetRejectionReason() RejectionReason {
+func (x *ProtocolAssetDefinitionResponse) GetRejectionReason() RejectionReason {
 	if x != nil {
 		return x.RejectionReason
 	}
 	return RejectionReason_Other
 }
 
-var File_executor_messages_proto protoreflect.FileDescriptor
+type ProtocolAssetListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_executor_messages_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x34, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x2f, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x72, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3c, 0x67, 0x69, 0x74, 0x6c,
-	0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x74, 0x69, 0x63, 0x6b,
+	RequestID  uint64     `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Subscribe  bool       `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	Subscriber *actor.PID `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+}
+
+func (x *ProtocolAssetListRequest) Reset() {
+	*x = ProtocolAssetListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_messages_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolAssetListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolAssetListRequest) ProtoMessage() {}
+
+func (x *ProtocolAssetListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtocolAssetListRequest.ProtoReflect.Descriptor instead.
+func (*ProtocolAssetListRequest) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ProtocolAssetListRequest) GetRequestID() uint64 {
+	if x != nil {
+		return x.RequestID
+	}
+	return 0
+}
+
+func (x *ProtocolAssetListRequest) GetSubscribe() bool {
+	if x != nil {
+		return x.Subscribe
+	}
+	return false
+}
+
+func (x *ProtocolAssetListRequest) GetSubscriber() *actor.PID {
+	if x != nil {
+		return x.Subscriber
+	}
+	return nil
+}
+
+type ProtocolAssetList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestID       uint64                  `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64                  `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	ProtocolAssets  []*models.ProtocolAsset `protobuf:"bytes,3,rep,name=protocol_assets,json=protocolAssets,proto3" json:"protocol_assets,omitempty"`
+	Success         bool                    `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason         `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+}
+
+func (x *ProtocolAssetList) Reset() {
+	*x = ProtocolAssetList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_messages_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolAssetList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolAssetList) ProtoMessage() {}
+
+func (x *ProtocolAssetList) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtocolAssetList.ProtoReflect.Descriptor instead.
+func (*ProtocolAssetList) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ProtocolAssetList) GetRequestID() uint64 {
+	if x != nil {
+		return x.RequestID
+	}
+	return 0
+}
+
+func (x *ProtocolAssetList) GetResponseID() uint64 {
+	if x != nil {
+		return x.ResponseID
+	}
+	return 0
+}
+
+func (x *ProtocolAssetList) GetProtocolAssets() []*models.ProtocolAsset {
+	if x != nil {
+		return x.ProtocolAssets
+	}
+	return nil
+}
+
+func (x *ProtocolAssetList) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ProtocolAssetList) GetRejectionReason() RejectionReason {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return RejectionReason_Other
+}
+
+type MarketableProtocolAssetListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestID  uint64     `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Subscribe  bool       `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	Subscriber *actor.PID `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+}
+
+func (x *MarketableProtocolAssetListRequest) Reset() {
+	*x = MarketableProtocolAssetListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_messages_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketableProtocolAssetListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketableProtocolAssetListRequest) ProtoMessage() {}
+
+func (x *MarketableProtocolAssetListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketableProtocolAssetListRequest.ProtoReflect.Descriptor instead.
+func (*MarketableProtocolAssetListRequest) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *MarketableProtocolAssetListRequest) GetRequestID() uint64 {
+	if x != nil {
+		return x.RequestID
+	}
+	return 0
+}
+
+func (x *MarketableProtocolAssetListRequest) GetSubscribe() bool {
+	if x != nil {
+		return x.Subscribe
+	}
+	return false
+}
+
+func (x *MarketableProtocolAssetListRequest) GetSubscriber() *actor.PID {
+	if x != nil {
+		return x.Subscriber
+	}
+	return nil
+}
+
+type MarketableProtocolAssetList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestID                uint64                            `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID               uint64                            `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	MarketableProtocolAssets []*models.MarketableProtocolAsset `protobuf:"bytes,3,rep,name=marketable_protocol_assets,json=marketableProtocolAssets,proto3" json:"marketable_protocol_assets,omitempty"`
+	Success                  bool                              `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason          RejectionReason                   `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+}
+
+func (x *MarketableProtocolAssetList) Reset() {
+	*x = MarketableProtocolAssetList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_messages_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketableProtocolAssetList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketableProtocolAssetList) ProtoMessage() {}
+
+func (x *MarketableProtocolAssetList) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketableProtocolAssetList.ProtoReflect.Descriptor instead.
+func (*MarketableProtocolAssetList) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *MarketableProtocolAssetList) GetRequestID() uint64 {
+	if x != nil {
+		return x.RequestID
+	}
+	return 0
+}
+
+func (x *MarketableProtocolAssetList) GetResponseID() uint64 {
+	if x != nil {
+		return x.ResponseID
+	}
+	return 0
+}
+
+func (x *MarketableProtocolAssetList) GetMarketableProtocolAssets() []*models.MarketableProtocolAsset {
+	if x != nil {
+		return x.MarketableProtocolAssets
+	}
+	return nil
+}
+
+func (x *MarketableProtocolAssetList) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MarketableProtocolAssetList) GetRejectionReason() RejectionReason {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return RejectionReason_Other
+}
+
+type MarketableProtocolAssetDefinitionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestID                 uint64 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	MarketableProtocolAssetID uint64 `protobuf:"varint,2,opt,name=marketable_protocol_assetID,json=marketableProtocolAssetID,proto3" json:"marketable_protocol_assetID,omitempty"`
+}
+
+func (x *MarketableProtocolAssetDefinitionRequest) Reset() {
+	*x = MarketableProtocolAssetDefinitionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_messages_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketableProtocolAssetDefinitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketableProtocolAssetDefinitionRequest) ProtoMessage() {}
+
+func (x *MarketableProtocolAssetDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketableProtocolAssetDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*MarketableProtocolAssetDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *MarketableProtocolAssetDefinitionRequest) GetRequestID() uint64 {
+	if x != nil {
+		return x.RequestID
+	}
+	return 0
+}
+
+func (x *MarketableProtocolAssetDefinitionRequest) GetMarketableProtocolAssetID() uint64 {
+	if x != nil {
+		return x.MarketableProtocolAssetID
+	}
+	return 0
+}
+
+type MarketableProtocolAssetDefinitionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestID               uint64                          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID              uint64                          `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	MarketableProtocolAsset *models.MarketableProtocolAsset `protobuf:"bytes,3,opt,name=marketable_protocol_asset,json=marketableProtocolAsset,proto3" json:"marketable_protocol_asset,omitempty"`
+	Success                 bool                            `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason         RejectionReason                 `protobuf:"varint,5,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+}
+
+func (x *MarketableProtocolAssetDefinitionResponse) Reset() {
+	*x = MarketableProtocolAssetDefinitionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_messages_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketableProtocolAssetDefinitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketableProtocolAssetDefinitionResponse) ProtoMessage() {}
+
+func (x *MarketableProtocolAssetDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketableProtocolAssetDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*MarketableProtocolAssetDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *MarketableProtocolAssetDefinitionResponse) GetRequestID() uint64 {
+	if x != nil {
+		return x.RequestID
+	}
+	return 0
+}
+
+func (x *MarketableProtocolAssetDefinitionResponse) GetResponseID() uint64 {
+	if x != nil {
+		return x.ResponseID
+	}
+	return 0
+}
+
+func (x *MarketableProtocolAssetDefinitionResponse) GetMarketableProtocolAsset() *models.MarketableProtocolAsset {
+	if x != nil {
+		return x.MarketableProtocolAsset
+	}
+	return nil
+}
+
+func (x *MarketableProtocolAssetDefinitionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MarketableProtocolAssetDefinitionResponse) GetRejectionReason() RejectionReason {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return RejectionReason_Other
+}
+
+// Subscribes to the outputs of the model of a modeler, published on each model tick
+type ModelDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestID  uint64     `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Subscribe  bool       `protobuf:"varint,2,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	Subscriber *actor.PID `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	IDs        []uint64   `protobuf:"varint,4,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
+	// The size of the price samples, no sample if zero
+	SampleSize uint32 `protobuf:"varint,5,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+}
+
+func (x *ModelDataRequest) Reset() {
+	*x = ModelDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_messages_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelDataRequest) ProtoMessage() {}
+
+func (x *ModelDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelDataRequest.ProtoReflect.Descriptor instead.
+func (*ModelDataRequest) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ModelDataRequest) GetRequestID() uint64 {
+	if x != nil {
+		return x.RequestID
+	}
+	return 0
+}
+
+func (x *ModelDataRequest) GetSubscribe() bool {
+	if x != nil {
+		return x.Subscribe
+	}
+	return false
+}
+
+func (x *ModelDataRequest) GetSubscriber() *actor.PID {
+	if x != nil {
+		return x.Subscriber
+	}
+	return nil
+}
+
+func (x *ModelDataRequest) GetIDs() []uint64 {
+	if x != nil {
+		return x.IDs
+	}
+	return nil
+}
+
+func (x *ModelDataRequest) GetSampleSize() uint32 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+type ModelDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestID       uint64          `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID      uint64          `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	Success         bool            `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	RejectionReason RejectionReason `protobuf:"varint,4,opt,name=rejection_reason,json=rejectionReason,proto3,enum=messages.RejectionReason" json:"rejection_reason,omitempty"`
+	SeqNum          uint64          `protobuf:"varint,5,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
+	Tick            uint64          `protobuf:"varint,6,opt,name=tick,proto3" json:"tick,omitempty"`
+	Values          []*ModelValue   `protobuf:"bytes,7,rep,name=values,proto3" json:"values,omitempty"`
+	// False while the model warms up, no values are sent then
+	Ready bool `protobuf:"varint,8,opt,name=ready,proto3" json:"ready,omitempty"`
+}
+
+func (x *ModelDataResponse) Reset() {
+	*x = ModelDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_messages_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelDataResponse) ProtoMessage() {}
+
+func (x *ModelDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelDataResponse.ProtoReflect.Descriptor instead.
+func (*ModelDataResponse) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ModelDataResponse) GetRequestID() uint64 {
+	if x != nil {
+		return x.RequestID
+	}
+	return 0
+}
+
+func (x *ModelDataResponse) GetResponseID() uint64 {
+	if x != nil {
+		return x.ResponseID
+	}
+	return 0
+}
+
+func (x *ModelDataResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ModelDataResponse) GetRejectionReason() RejectionReason {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return RejectionReason_Other
+}
+
+func (x *ModelDataResponse) GetSeqNum() uint64 {
+	if x != nil {
+		return x.SeqNum
+	}
+	return 0
+}
+
+func (x *ModelDataResponse) GetTick() uint64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *ModelDataResponse) GetValues() []*ModelValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *ModelDataResponse) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+type ModelDataIncrementalRefresh struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestID  uint64        `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	ResponseID uint64        `protobuf:"varint,2,opt,name=responseID,proto3" json:"responseID,omitempty"`
+	SeqNum     uint64        `protobuf:"varint,3,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
+	Tick       uint64        `protobuf:"varint,4,opt,name=tick,proto3" json:"tick,omitempty"`
+	Values     []*ModelValue `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty"`
+	Ready      bool          `protobuf:"varint,6,opt,name=ready,proto3" json:"ready,omitempty"`
+}
+
+func (x *ModelDataIncrementalRefresh) Reset() {
+	*x = ModelDataIncrementalRefresh{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_messages_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelDataIncrementalRefresh) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelDataIncrementalRefresh) ProtoMessage() {}
+
+func (x *ModelDataIncrementalRefresh) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelDataIncrementalRefresh.ProtoReflect.Descriptor instead.
+func (*ModelDataIncrementalRefresh) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ModelDataIncrementalRefresh) GetRequestID() uint64 {
+	if x != nil {
+		return x.RequestID
+	}
+	return 0
+}
+
+func (x *ModelDataIncrementalRefresh) GetResponseID() uint64 {
+	if x != nil {
+		return x.ResponseID
+	}
+	return 0
+}
+
+func (x *ModelDataIncrementalRefresh) GetSeqNum() uint64 {
+	if x != nil {
+		return x.SeqNum
+	}
+	return 0
+}
+
+func (x *ModelDataIncrementalRefresh) GetTick() uint64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *ModelDataIncrementalRefresh) GetValues() []*ModelValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *ModelDataIncrementalRefresh) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+// The outputs of the model for an ID, those the model doesn't provide are not set
+type ModelValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID    uint64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Price *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	Score *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=score,proto3" json:"score,omitempty"`
+	// Samples of the price at the next model tick
+	SamplePrices []float64 `protobuf:"fixed64,4,rep,packed,name=sample_prices,json=samplePrices,proto3" json:"sample_prices,omitempty"`
+}
+
+func (x *ModelValue) Reset() {
+	*x = ModelValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_executor_messages_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelValue) ProtoMessage() {}
+
+func (x *ModelValue) ProtoReflect() protoreflect.Message {
+	mi := &file_executor_messages_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelValue.ProtoReflect.Descriptor instead.
+func (*ModelValue) Descriptor() ([]byte, []int) {
+	return file_executor_messages_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ModelValue) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *ModelValue) GetPrice() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ModelValue) GetScore() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Score
+	}
+	return nil
+}
+
+func (x *ModelValue) GetSamplePrices() []float64 {
+	if x != nil {
+		return x.SamplePrices
+	}
+	return nil
+}
+
+var File_executor_messages_proto protoreflect.FileDescriptor
+
+var file_executor_messages_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x34, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x2f, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x72, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3c, 0x67, 0x69, 0x74, 0x6c,
+	0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x74, 0x69, 0x63, 0x6b,
 	0x73, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f,
 	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x61,
 	0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3e, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62,
@@ -6362,8 +7372,8 @@ var file_executor_messages_proto_rawDesc = []byte{
 	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
 	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
 	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72,
-	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x97,
-	0x02, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xbc,
+	0x03, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
 	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18,
@@ -6380,614 +7390,762 @@ var file_executor_messages_proto_rawDesc = []byte{
 	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32,
 	0x10, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x9e, 0x03, 0x0a, 0x12, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a,
-	0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x34, 0x0a,
-	0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x42, 0x4c, 0x31, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x4c, 0x31, 0x12, 0x34, 0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c,
-	0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x4f, 0x42, 0x4c, 0x32, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x0a, 0x73,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x32, 0x12, 0x34, 0x0a, 0x0a, 0x73, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x33, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x42, 0x4c, 0x33, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x33, 0x12,
-	0x2f, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74,
+	0x68, 0x12, 0x4a, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x97, 0x04,
+	0x0a, 0x12, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x49, 0x44, 0x12, 0x34, 0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x31,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x4f, 0x42, 0x4c, 0x31, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x0a, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x31, 0x12, 0x34, 0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x4c, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x42, 0x4c, 0x32, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x32, 0x12, 0x34,
+	0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x33, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x42, 0x4c, 0x33,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x4c, 0x33, 0x12, 0x2f, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x35,
+	0x0a, 0x0c, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x65,
+	0x6e, 0x75, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0b, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x22, 0xc8, 0x03, 0x0a, 0x1c, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x12,
+	0x2e, 0x0a, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x42, 0x4c, 0x31, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x31, 0x12,
+	0x2e, 0x0a, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x42, 0x4c, 0x32, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x32, 0x12,
+	0x2e, 0x0a, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x33, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x42, 0x4c, 0x33, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x33, 0x12,
+	0x2f, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x17, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
 	0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73,
-	0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x91, 0x03, 0x0a, 0x1c, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f,
-	0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e, 0x75,
-	0x6d, 0x12, 0x2e, 0x0a, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x31, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x42, 0x4c,
-	0x31, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
-	0x31, 0x12, 0x2e, 0x0a, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x32, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x42, 0x4c,
-	0x32, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
-	0x32, 0x12, 0x2e, 0x0a, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x33, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x42, 0x4c,
-	0x33, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
-	0x33, 0x12, 0x2f, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65,
-	0x67, 0x61, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xb2, 0x01,
-	0x0a, 0x14, 0x55, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x56, 0x33, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50,
-	0x49, 0x44, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x32,
-	0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x22, 0xfa, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x56, 0x33,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x06, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75,
-	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f,
-	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
-	0xa4, 0x01, 0x0a, 0x1f, 0x55, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x56, 0x33, 0x44, 0x61, 0x74,
-	0x61, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49,
-	0x44, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x2a, 0x0a, 0x06, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0xf8, 0x03, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x0c, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0b, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x73, 0x22, 0xcf, 0x02, 0x0a, 0x0e, 0x42, 0x61, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x49,
+	0x44, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x32, 0x0a,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x2a, 0x0a, 0x08, 0x62, 0x61, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x42, 0x61, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x62, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x22, 0xef, 0x01, 0x0a, 0x0f, 0x42, 0x61, 0x72, 0x44, 0x61, 0x74, 0x61,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x73, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12,
-	0x2e, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x2b, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x09,
-	0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6d,
-	0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x74, 0x61, 0x6b, 0x65, 0x72,
-	0x5f, 0x66, 0x65, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x46,
-	0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10,
-	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x22, 0x90, 0x01, 0x0a, 0x1d,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x63, 0x72, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x06, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xeb,
-	0x01, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
-	0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x04,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x64, 0x0a, 0x19,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0xb0, 0x02, 0x0a, 0x1a, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12,
-	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12,
-	0x39, 0x0a, 0x09, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x08, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x74, 0x61,
-	0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x74, 0x61, 0x6b,
-	0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xcd, 0x01, 0x0a, 0x16, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x25, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x42, 0x61, 0x72, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x93, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x49, 0x44, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x1f, 0x0a, 0x04, 0x62,
+	0x61, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x42, 0x61, 0x72, 0x52, 0x04, 0x62, 0x61, 0x72, 0x73, 0x22, 0xb2, 0x01, 0x0a,
+	0x14, 0x55, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x56, 0x33, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x49,
+	0x44, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x32, 0x0a,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0xfa, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x56, 0x33, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x06, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xa4,
+	0x01, 0x0a, 0x1f, 0x55, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x56, 0x33, 0x44, 0x61, 0x74, 0x61,
+	0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x2a, 0x0a, 0x06, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x55, 0x50, 0x56, 0x33, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x69, 0x70, 0x6f, 0x6f,
+	0x6c, 0x56, 0x33, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x34, 0x0a,
+	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x5f,
+	0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x7a, 0x65, 0x72, 0x6f, 0x46,
+	0x6f, 0x72, 0x4f, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x78, 0x61,
+	0x63, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74,
+	0x73, 0x22, 0x9b, 0x02, 0x0a, 0x11, 0x55, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x56, 0x33, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x4c, 0x65, 0x67, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x73, 0x71, 0x72, 0x74, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x78, 0x39, 0x36, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x73, 0x71, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x58, 0x39, 0x36, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x63, 0x6b,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x69,
+	0x63, 0x6b, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x73,
+	0x5f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x74, 0x69, 0x63, 0x6b, 0x73, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x22,
+	0xeb, 0x02, 0x0a, 0x16, 0x55, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x56, 0x33, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x4f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x73,
+	0x5f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x74, 0x69, 0x63, 0x6b, 0x73, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x04,
+	0x6c, 0x65, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x56, 0x33, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x4c, 0x65, 0x67, 0x52, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xa7, 0x01,
+	0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x49, 0x44,
+	0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf8, 0x03, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x30, 0x0a,
+	0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x25, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x39,
+	0x0a, 0x09, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x08, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e,
+	0x75, 0x6d, 0x22, 0x90, 0x01, 0x0a, 0x1d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x49, 0x44, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xeb, 0x01, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0x64, 0x0a, 0x19, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x29,
 	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x06,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
-	0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xa7, 0x01, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
-	0x32, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb0, 0x02, 0x0a, 0x1a, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x39, 0x0a, 0x09, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5f,
+	0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65,
+	0x65, 0x12, 0x39, 0x0a, 0x09, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x08, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xcd, 0x01, 0x0a,
+	0x16, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xa7, 0x01, 0x0a,
+	0x15, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xf0, 0x01, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x37, 0x0a, 0x09, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09,
+	0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x19, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x86, 0x03, 0x0a,
+	0x18, 0x54, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x69,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73,
+	0x69, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66,
-	0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x22,
-	0xf0, 0x01, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x37, 0x0a, 0x09, 0x6d, 0x6f, 0x76,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
-	0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x34, 0x0a, 0x06, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x66,
+	0x72, 0x6f, 0x6d, 0x49, 0x44, 0x22, 0xe0, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x64, 0x65, 0x43,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x06, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x6d, 0x0a, 0x19, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xe8, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10,
 	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f,
 	0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x70, 0x74,
-	0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x29,
-	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72,
-	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x86, 0x03, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x64, 0x65, 0x43,
-	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x12, 0x36, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x32, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x64,
-	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x04,
-	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02,
-	0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x72, 0x6f, 0x6d,
-	0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x44, 0x22, 0xe0,
-	0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72,
-	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x22, 0x6d, 0x0a, 0x19, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x44, 0x65, 0x66,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x0a,
-	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x6f, 0x6e, 0x22, 0x7d, 0x0a, 0x13, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x22, 0xde, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x30, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0xb6, 0x07, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20,
+	0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x3e, 0x0a, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x36, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x6d, 0x5f, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x75, 0x6d,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x36, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x12, 0x3b, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x6c, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x6c, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x6c, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x66, 0x65, 0x65, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x0c, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2c, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x46, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x65, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x69,
+	0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x46, 0x65, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65,
+	0x42, 0x61, 0x73, 0x69, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x0d,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f,
+	0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x23, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22,
+	0x2f, 0x0a, 0x09, 0x53, 0x69, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x3d, 0x0a, 0x10, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xd6, 0x02, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x36, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x0a,
+	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
 	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x22, 0xe8, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x44, 0x65, 0x66,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x27, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x22, 0xd6, 0x01, 0x0a, 0x12, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x0a, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x22, 0xd0, 0x01, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12,
 	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a,
 	0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2c, 0x0a,
-	0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x52, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x7d, 0x0a, 0x13, 0x53,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x2a,
-	0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x0a,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x22, 0xde, 0x01, 0x0a, 0x0c, 0x53,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x0a, 0x73, 0x65, 0x63,
-	0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52,
-	0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xb6, 0x07, 0x0a, 0x0f,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x3e, 0x0a, 0x0e, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x13, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49,
-	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x5f,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
-	0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x21,
-	0x0a, 0x0c, 0x63, 0x75, 0x6d, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x75, 0x6d, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x45, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x49, 0x44, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44,
-	0x12, 0x3b, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a,
-	0x0d, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x12, 0x3b, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x09, 0x66, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a,
-	0x0c, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
-	0x2c, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x65, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a,
-	0x09, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x65, 0x65, 0x42,
-	0x61, 0x73, 0x69, 0x73, 0x52, 0x08, 0x66, 0x65, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x12, 0x44,
+	0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x44,
 	0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61,
 	0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x2f, 0x0a, 0x09, 0x53, 0x69, 0x64, 0x65,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x69,
-	0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3d, 0x0a, 0x10, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x29, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xd6, 0x02, 0x0a, 0x0b, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44,
-	0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69,
-	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x69, 0x64,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x69,
-	0x64, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x2e, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6f, 0x70, 0x65,
-	0x6e, 0x22, 0xd6, 0x01, 0x0a, 0x12, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x2e, 0x50, 0x49, 0x44, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
-	0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xd0, 0x01, 0x0a, 0x09, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x25, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65,
-	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xd9, 0x01,
-	0x0a, 0x10, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x2a,
-	0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x0a,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e,
-	0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29,
-	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8c, 0x02, 0x0a, 0x0c, 0x50, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xc9, 0x01, 0x0a, 0x0f, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd8, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x49, 0x44, 0x12, 0x2b, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0xd9, 0x01, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x8c, 0x02, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x2e, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
 	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20,
 	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
 	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f,
 	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
-	0xb4, 0x03, 0x0a, 0x08, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x12, 0x37, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69,
-	0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72,
-	0x63, 0x65, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
-	0x53, 0x0a, 0x16, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0e, 0x32,
-	0x1c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0xa6, 0x02, 0x0a, 0x15, 0x4e, 0x65, 0x77, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x29,
-	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x32, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
-	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22,
-	0xd5, 0x03, 0x0a, 0x16, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x0c,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x5f, 0x71,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6c,
-	0x65, 0x61, 0x76, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a,
-	0x0c, 0x63, 0x75, 0x6d, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x75, 0x6d, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0xc9, 0x01, 0x0a, 0x0f, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
+	0x2a, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x49, 0x44, 0x52,
+	0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x05, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd8, 0x01, 0x0a, 0x0b,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x08, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
 	0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
 	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x10, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x61, 0x74,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x3a, 0x0a, 0x0b, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x72, 0x74, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x52, 0x74, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x77, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xb4, 0x03, 0x0a, 0x08, 0x4e, 0x65, 0x77, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30,
+	0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x2b, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x69,
+	0x64, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x12, 0x37, 0x0a,
+	0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x49,
+	0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x16, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x61, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0xa6, 0x02,
+	0x0a, 0x15, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x28, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0d, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xd5, 0x03, 0x0a, 0x16, 0x4e, 0x65, 0x77, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6c,
+	0x65, 0x61, 0x76, 0x65, 0x73, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x6d, 0x5f, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x75, 0x6d, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x43, 0x0a,
+	0x10, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x12, 0x3a, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x72, 0x74,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x74, 0x74, 0x22, 0x8a,
+	0x01, 0x0a, 0x13, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2a, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x14,
+	0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x81,
+	0x02, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x36,
+	0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x4c, 0x0a, 0x13, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x32,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x13, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x14, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x29, 0x0a,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x14, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
 	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
 	0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
 	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65,
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x81, 0x02, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x4c, 0x0a, 0x13, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x38, 0x0a,
-	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x71,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x13,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xc7, 0x01, 0x0a, 0x17, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
 	0x44, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18,
@@ -6996,449 +8154,481 @@ var file_executor_messages_proto_rawDesc = []byte{
 	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
 	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x2d, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22,
-	0xce, 0x01, 0x0a, 0x14, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65,
-	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
-	0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x22, 0xc7, 0x01, 0x0a, 0x17, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e,
-	0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29,
-	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x18, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x12, 0x2f, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x22, 0xb8, 0x01, 0x0a, 0x18, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xf6, 0x02, 0x0a,
+	0x12, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x12, 0x36, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x32,
+	0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a,
+	0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xb4, 0x02, 0x0a, 0x13, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x10, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0e, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x12, 0x3a, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x72, 0x74, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x74, 0x74, 0x22, 0x90, 0x01, 0x0a,
+	0x16, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xf6, 0x02, 0x0a, 0x12, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70,
-	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xb4,
-	0x02, 0x0a, 0x13, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44,
-	0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x10, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x61, 0x74, 0x65, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x3a, 0x0a, 0x0b, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x72, 0x74, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x52, 0x74, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x16, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d,
-	0x61, 0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x29,
-	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xb7, 0x01, 0x0a, 0x17, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x4d, 0x61, 0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
+	0xb7, 0x01, 0x0a, 0x17, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x73, 0x73, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xe2, 0x01, 0x0a, 0x26, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
 	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x49, 0x44, 0x12, 0x36, 0x0a, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74,
+	0x6f, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x22, 0x95,
+	0x02, 0x0a, 0x27, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xf4, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
+	0x2a, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x49, 0x44, 0x52,
+	0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x07, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49,
+	0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x22, 0xd2, 0x01,
+	0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e,
+	0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10,
 	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f,
 	0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x22, 0xe2, 0x01, 0x0a, 0x26, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61,
-	0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x07, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
-	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a,
-	0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x44, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x22, 0x95, 0x02, 0x0a, 0x27, 0x48, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49,
-	0x44, 0x12, 0x33, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x6f, 0x6e, 0x22, 0xb1, 0x01, 0x0a, 0x23, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f,
+	0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e, 0x75,
+	0x6d, 0x12, 0x33, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
 	0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75,
-	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f,
-	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
-	0xf4, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x69, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49,
+	0x44, 0x22, 0xfd, 0x01, 0x0a, 0x1f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x49, 0x44, 0x12, 0x3c, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x82, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x22, 0xf1, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
 	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x44, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x22, 0xd2, 0x01, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x49, 0x44, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xb1, 0x01, 0x0a, 0x23,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x3e, 0x0a, 0x0f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x22, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x2a, 0x0a,
+	0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x0a, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x22, 0x9a, 0x02, 0x0a, 0x1b, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x5d, 0x0a, 0x1a, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x18, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x28, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49,
-	0x44, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x33, 0x0a, 0x06, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22,
-	0x69, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12,
-	0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x44, 0x22, 0xfd, 0x01, 0x0a, 0x1f, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x66, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x3c, 0x0a, 0x0e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0d, 0x70, 0x72, 0x6f,
+	0x44, 0x12, 0x3e, 0x0a, 0x1b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49,
+	0x44, 0x22, 0xa6, 0x02, 0x0a, 0x29, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x5b, 0x0a,
+	0x19, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x17, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f,
 	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
 	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
 	0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
 	0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
 	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
 	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x82, 0x01, 0x0a, 0x18, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e,
-	0x50, 0x49, 0x44, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x22,
-	0xf1, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x49, 0x44, 0x12, 0x3e, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a,
-	0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x22, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x72, 0x22, 0x9a, 0x02, 0x0a, 0x1b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
-	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44,
-	0x12, 0x5d, 0x0a, 0x1a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x18, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f,
-	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
-	0x88, 0x01, 0x0a, 0x28, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x3e, 0x0a, 0x1b, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x19, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x44, 0x22, 0xa6, 0x02, 0x0a, 0x29, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x5b, 0x0a, 0x19, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x17, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a,
-	0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x2a, 0xfb, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x65, 0x77, 0x10, 0x00, 0x12, 0x08,
-	0x0a, 0x04, 0x44, 0x6f, 0x6e, 0x65, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x65, 0x64, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x64, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x70, 0x70,
-	0x65, 0x64, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x10,
-	0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x77, 0x10,
-	0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x10,
-	0x09, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x10, 0x0a, 0x12, 0x12,
-	0x0a, 0x0e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x10, 0x0b, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x64, 0x65, 0x10, 0x0c, 0x12, 0x0f, 0x0a,
-	0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x0d, 0x12, 0x0e,
-	0x0a, 0x0a, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x10, 0x0e, 0x12, 0x11,
-	0x0a, 0x0d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x10,
-	0x0f, 0x2a, 0xbf, 0x07, 0x0a, 0x0f, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x10, 0x00,
-	0x12, 0x11, 0x0a, 0x0d, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x44, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x55, 0x6e,
-	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x68,
-	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x10, 0x03, 0x12, 0x15,
-	0x0a, 0x11, 0x49, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x75, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x10, 0x06, 0x12, 0x12, 0x0a,
-	0x0e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x10,
-	0x07, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x6f, 0x6f, 0x4c, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
-	0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x10, 0x09, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x53,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x10, 0x0b, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x10, 0x0c, 0x12, 0x14, 0x0a, 0x10,
-	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x10, 0x0d, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x10, 0x0e, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64,
-	0x10, 0x0f, 0x12, 0x1b, 0x0a, 0x17, 0x55, 0x6e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x10, 0x12,
-	0x15, 0x0a, 0x11, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x10, 0x11, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x54, 0x54, 0x50, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x10, 0x12, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x10, 0x13, 0x12, 0x16,
-	0x0a, 0x12, 0x4e, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x10, 0x14, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x6e, 0x73, 0x75, 0x70, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x10, 0x15, 0x12, 0x18, 0x0a,
-	0x14, 0x55, 0x6e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x10, 0x16, 0x12, 0x1f, 0x0a, 0x1b, 0x55, 0x6e, 0x73, 0x75, 0x70,
-	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x49,
-	0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x10, 0x17, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x6e, 0x73, 0x75,
-	0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x10, 0x18,
-	0x12, 0x12, 0x0a, 0x0e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x10, 0x19, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x45,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x1a, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x72, 0x61,
-	0x70, 0x68, 0x51, 0x4c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x1b, 0x12, 0x0c, 0x0a, 0x08, 0x41,
-	0x42, 0x49, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x1c, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x50, 0x43,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x1d, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
-	0x77, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x10, 0x1e, 0x12, 0x18, 0x0a, 0x14,
-	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x10, 0x1f, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
-	0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x10, 0x20,
-	0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x10, 0x21, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x50, 0x43, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x10, 0x22, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x10, 0x23, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x10, 0x24, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x44, 0x69, 0x73, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x10, 0x25, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x4a, 0x75, 0x6d, 0x70, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10,
-	0x26, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x49, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x10, 0x27, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x50, 0x52, 0x61,
-	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x10,
-	0x28, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x29,
-	0x12, 0x11, 0x0a, 0x0d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x10, 0x2a, 0x2a, 0x23, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x63, 0x6b, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x10, 0x01, 0x2a, 0x49, 0x0a, 0x07, 0x46, 0x65, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
-	0x79, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x61, 0x78, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
-	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x10,
-	0x02, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x65, 0x65,
-	0x73, 0x10, 0x03, 0x2a, 0x35, 0x0a, 0x08, 0x46, 0x65, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x12,
-	0x0c, 0x0a, 0x08, 0x41, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x10, 0x00, 0x12, 0x0b, 0x0a,
-	0x07, 0x50, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x10, 0x02, 0x2a, 0x90, 0x01, 0x0a, 0x13, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12,
-	0x0e, 0x0a, 0x0a, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x10, 0x01, 0x12,
-	0x0c, 0x0a, 0x08, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x02, 0x12, 0x0b, 0x0a,
-	0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x57,
-	0x65, 0x6c, 0x63, 0x6f, 0x6d, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x10, 0x07, 0x32, 0xad, 0x08,
-	0x0a, 0x10, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x6f, 0x72, 0x12, 0x55, 0x0a, 0x0a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x52, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x00, 0x30, 0x01, 0x12, 0x58, 0x0a, 0x0b, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x63,
-	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x61, 0x0a, 0x12, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x44,
-	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xad, 0x01, 0x0a, 0x10, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x0a, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x49, 0x44, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xa2, 0x02, 0x0a, 0x11, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17,
+	0x0a, 0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x22,
+	0xcc, 0x01, 0x0a, 0x1b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x63,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x44, 0x12, 0x17, 0x0a,
+	0x07, 0x73, 0x65, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x73, 0x65, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x22, 0xa9,
+	0x01, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x32, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x2a, 0xfb, 0x01, 0x0a, 0x0d, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03,
+	0x4e, 0x65, 0x77, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x6f, 0x6e, 0x65, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x10, 0x02, 0x12, 0x0c, 0x0a,
+	0x08, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x10, 0x04, 0x12, 0x0b,
+	0x0a, 0x07, 0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x4e, 0x65, 0x77, 0x10, 0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x10, 0x09, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x10, 0x0a, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x10, 0x0b, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x10, 0x0c, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x10, 0x0d, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x10, 0x0e, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x10, 0x0f, 0x2a, 0xbf, 0x07, 0x0a, 0x0f, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05,
+	0x4f, 0x74, 0x68, 0x65, 0x72, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x44, 0x10,
+	0x02, 0x12, 0x22, 0x0a, 0x1e, 0x55, 0x6e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x63, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x10, 0x05,
+	0x12, 0x12, 0x0a, 0x0e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0x07, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x6f, 0x6f, 0x4c,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x10, 0x08, 0x12, 0x10, 0x0a,
+	0x0c, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x10, 0x09, 0x12,
+	0x18, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x69, 0x66,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x10, 0x0b, 0x12,
+	0x12, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x10, 0x0c, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41,
+	0x50, 0x49, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x0d, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x10, 0x0e, 0x12, 0x1c, 0x0a, 0x18, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45,
+	0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x10, 0x0f, 0x12, 0x1b, 0x0a, 0x17, 0x55, 0x6e, 0x73,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x10, 0x10, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x10, 0x11, 0x12, 0x0d, 0x0a,
+	0x09, 0x48, 0x54, 0x54, 0x50, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x12, 0x12, 0x17, 0x0a, 0x13,
+	0x4e, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x10, 0x13, 0x12, 0x16, 0x0a, 0x12, 0x4e, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x10, 0x14, 0x12, 0x15, 0x0a,
+	0x11, 0x55, 0x6e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x10, 0x15, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x6e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x10, 0x16, 0x12, 0x1f,
+	0x0a, 0x1b, 0x55, 0x6e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x10, 0x17, 0x12,
+	0x16, 0x0a, 0x12, 0x55, 0x6e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x10, 0x18, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0x19, 0x12, 0x13, 0x0a, 0x0f, 0x55,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x1a,
+	0x12, 0x10, 0x0a, 0x0c, 0x47, 0x72, 0x61, 0x70, 0x68, 0x51, 0x4c, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x10, 0x1b, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x42, 0x49, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x1c,
+	0x12, 0x0c, 0x0a, 0x08, 0x52, 0x50, 0x43, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x1d, 0x12, 0x13,
+	0x0a, 0x0f, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x10, 0x1e, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x10, 0x1f, 0x12, 0x18, 0x0a,
+	0x14, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x10, 0x20, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x10, 0x21, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x50, 0x43,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x10, 0x22, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x10, 0x23, 0x12, 0x12, 0x0a, 0x0e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x10, 0x24, 0x12,
+	0x1b, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x10, 0x25, 0x12, 0x1a, 0x0a, 0x16,
+	0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4a, 0x75, 0x6d, 0x70, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x26, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x10, 0x27, 0x12,
+	0x17, 0x0a, 0x13, 0x49, 0x50, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x78,
+	0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x10, 0x28, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x61, 0x6b, 0x65,
+	0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x29, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x10, 0x2a, 0x2a, 0x42, 0x0a, 0x0e, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0e, 0x0a, 0x0a,
+	0x46, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x10, 0x01, 0x12,
+	0x0d, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x4f, 0x66, 0x42, 0x6f, 0x6f, 0x6b, 0x10, 0x02, 0x2a, 0x23,
+	0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07,
+	0x0a, 0x03, 0x41, 0x63, 0x6b, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x10, 0x01, 0x2a, 0x49, 0x0a, 0x07, 0x46, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e,
+	0x0a, 0x0a, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x10, 0x00, 0x12, 0x07,
+	0x0a, 0x03, 0x54, 0x61, 0x78, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x65, 0x65, 0x73, 0x10, 0x03, 0x2a, 0x35,
+	0x0a, 0x08, 0x46, 0x65, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x62,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x65, 0x72, 0x55,
+	0x6e, 0x69, 0x74, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x10, 0x02, 0x2a, 0x90, 0x01, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x75,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x6c, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x50, 0x6e, 0x6c, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x57, 0x65, 0x6c, 0x63, 0x6f, 0x6d,
+	0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x10, 0x07, 0x32, 0xad, 0x08, 0x0a, 0x10, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x55, 0x0a,
+	0x0a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x49, 0x6e,
+	0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x58, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x00, 0x30, 0x01, 0x12, 0x61,
+	0x0a, 0x12, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x44, 0x65, 0x66,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x45, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
 	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3d, 0x0a,
-	0x06, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x09,
-	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12,
-	0x3e, 0x0a, 0x08, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12,
-	0x55, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c,
-	0x65, 0x12, 0x1f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x77,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x65,
-	0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x12, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x75, 0x6c,
-	0x6b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x22, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x12, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x73, 0x73,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x20, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x73, 0x73, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x35, 0x5a,
-	0x33, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x09, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x08, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x4e, 0x65,
+	0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x75, 0x6c,
+	0x6b, 0x12, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x77,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x12, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4c, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12,
+	0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58,
+	0x0a, 0x0f, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x12, 0x20, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x4d, 0x61, 0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x73, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x6c,
+	0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x74, 0x69, 0x63, 0x6b,
+	0x73, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7453,340 +8643,376 @@ func file_executor_messages_proto_rawDescGZIP() []byte {
 	return file_executor_messages_proto_rawDescData
 }
 
-var file_executor_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_executor_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
+var file_executor_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_executor_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
 var file_executor_messages_proto_goTypes = []interface{}{
 	(ExecutionType)(0),                                // 0: messages.ExecutionType
 	(RejectionReason)(0),                              // 1: messages.RejectionReason
-	(ResponseType)(0),                                 // 2: messages.ResponseType
-	(FeeType)(0),                                      // 3: messages.FeeType
-	(FeeBasis)(0),                                     // 4: messages.FeeBasis
-	(AccountMovementType)(0),                          // 5: messages.AccountMovementType
-	(*HistoricalOpenInterestsRequest)(nil),            // 6: messages.HistoricalOpenInterestsRequest
-	(*HistoricalOpenInterestsResponse)(nil),           // 7: messages.HistoricalOpenInterestsResponse
-	(*HistoricalFundingRatesRequest)(nil),             // 8: messages.HistoricalFundingRatesRequest
-	(*HistoricalFundingRatesResponse)(nil),            // 9: messages.HistoricalFundingRatesResponse
-	(*HistoricalLiquidationsRequest)(nil),             // 10: messages.HistoricalLiquidationsRequest
-	(*HistoricalLiquidationsResponse)(nil),            // 11: messages.HistoricalLiquidationsResponse
-	(*HistoricalUnipoolV3DataRequest)(nil),            // 12: messages.HistoricalUnipoolV3DataRequest
-	(*HistoricalUnipoolV3DataResponse)(nil),           // 13: messages.HistoricalUnipoolV3DataResponse
-	(*HistoricalSalesRequest)(nil),                    // 14: messages.HistoricalSalesRequest
-	(*HistoricalSalesResponse)(nil),                   // 15: messages.HistoricalSalesResponse
-	(*MarketStatisticsRequest)(nil),                   // 16: messages.MarketStatisticsRequest
-	(*MarketStatisticsResponse)(nil),                  // 17: messages.MarketStatisticsResponse
-	(*MarketDataRequest)(nil),                         // 18: messages.MarketDataRequest
-	(*MarketDataResponse)(nil),                        // 19: messages.MarketDataResponse
-	(*MarketDataIncrementalRefresh)(nil),              // 20: messages.MarketDataIncrementalRefresh
-	(*UnipoolV3DataRequest)(nil),                      // 21: messages.UnipoolV3DataRequest
-	(*UnipoolV3DataResponse)(nil),                     // 22: messages.UnipoolV3DataResponse
-	(*UnipoolV3DataIncrementalRefresh)(nil),           // 23: messages.UnipoolV3DataIncrementalRefresh
-	(*AccountDataRequest)(nil),                        // 24: messages.AccountDataRequest
-	(*AccountDataResponse)(nil),                       // 25: messages.AccountDataResponse
-	(*AccountDataIncrementalRefresh)(nil),             // 26: messages.AccountDataIncrementalRefresh
-	(*AccountMovement)(nil),                           // 27: messages.AccountMovement
-	(*AccountInformationRequest)(nil),                 // 28: messages.AccountInformationRequest
-	(*AccountInformationResponse)(nil),                // 29: messages.AccountInformationResponse
-	(*AccountMovementRequest)(nil),                    // 30: messages.AccountMovementRequest
-	(*AccountMovementFilter)(nil),                     // 31: messages.AccountMovementFilter
-	(*AccountMovementResponse)(nil),                   // 32: messages.AccountMovementResponse
-	(*TradeCaptureReportRequest)(nil),                 // 33: messages.TradeCaptureReportRequest
-	(*TradeCaptureReportFilter)(nil),                  // 34: messages.TradeCaptureReportFilter
-	(*TradeCaptureReport)(nil),                        // 35: messages.TradeCaptureReport
-	(*SecurityDefinitionRequest)(nil),                 // 36: messages.SecurityDefinitionRequest
-	(*SecurityDefinitionResponse)(nil),                // 37: messages.SecurityDefinitionResponse
-	(*SecurityListRequest)(nil),                       // 38: messages.SecurityListRequest
-	(*SecurityList)(nil),                              // 39: messages.SecurityList
-	(*ExecutionReport)(nil),                           // 40: messages.ExecutionReport
-	(*AccountUpdate)(nil),                             // 41: messages.AccountUpdate
-	(*SideValue)(nil),                                 // 42: messages.SideValue
-	(*OrderStatusValue)(nil),                          // 43: messages.OrderStatusValue
-	(*OrderFilter)(nil),                               // 44: messages.OrderFilter
-	(*OrderStatusRequest)(nil),                        // 45: messages.OrderStatusRequest
-	(*OrderList)(nil),                                 // 46: messages.OrderList
-	(*PositionsRequest)(nil),                          // 47: messages.PositionsRequest
-	(*PositionList)(nil),                              // 48: messages.PositionList
-	(*BalancesRequest)(nil),                           // 49: messages.BalancesRequest
-	(*BalanceList)(nil),                               // 50: messages.BalanceList
-	(*NewOrder)(nil),                                  // 51: messages.NewOrder
-	(*NewOrderSingleRequest)(nil),                     // 52: messages.NewOrderSingleRequest
-	(*NewOrderSingleResponse)(nil),                    // 53: messages.NewOrderSingleResponse
-	(*NewOrderBulkRequest)(nil),                       // 54: messages.NewOrderBulkRequest
-	(*NewOrderBulkResponse)(nil),                      // 55: messages.NewOrderBulkResponse
-	(*OrderUpdate)(nil),                               // 56: messages.OrderUpdate
-	(*OrderReplaceRequest)(nil),                       // 57: messages.OrderReplaceRequest
-	(*OrderReplaceResponse)(nil),                      // 58: messages.OrderReplaceResponse
-	(*OrderBulkReplaceRequest)(nil),                   // 59: messages.OrderBulkReplaceRequest
-	(*OrderBulkReplaceResponse)(nil),                  // 60: messages.OrderBulkReplaceResponse
-	(*OrderCancelRequest)(nil),                        // 61: messages.OrderCancelRequest
-	(*OrderCancelResponse)(nil),                       // 62: messages.OrderCancelResponse
-	(*OrderMassCancelRequest)(nil),                    // 63: messages.OrderMassCancelRequest
-	(*OrderMassCancelResponse)(nil),                   // 64: messages.OrderMassCancelResponse
-	(*HistoricalProtocolAssetTransferRequest)(nil),    // 65: messages.HistoricalProtocolAssetTransferRequest
-	(*HistoricalProtocolAssetTransferResponse)(nil),   // 66: messages.HistoricalProtocolAssetTransferResponse
-	(*ProtocolAssetDataRequest)(nil),                  // 67: messages.ProtocolAssetDataRequest
-	(*ProtocolAssetDataResponse)(nil),                 // 68: messages.ProtocolAssetDataResponse
-	(*ProtocolAssetDataIncrementalRefresh)(nil),       // 69: messages.ProtocolAssetDataIncrementalRefresh
-	(*ProtocolAssetDefinitionRequest)(nil),            // 70: messages.ProtocolAssetDefinitionRequest
-	(*ProtocolAssetDefinitionResponse)(nil),           // 71: messages.ProtocolAssetDefinitionResponse
-	(*ProtocolAssetListRequest)(nil),                  // 72: messages.ProtocolAssetListRequest
-	(*ProtocolAssetList)(nil),                         // 73: messages.ProtocolAssetList
-	(*MarketableProtocolAssetListRequest)(nil),        // 74: messages.MarketableProtocolAssetListRequest
-	(*MarketableProtocolAssetList)(nil),               // 75: messages.MarketableProtocolAssetList
-	(*MarketableProtocolAssetDefinitionRequest)(nil),  // 76: messages.MarketableProtocolAssetDefinitionRequest
-	(*MarketableProtocolAssetDefinitionResponse)(nil), // 77: messages.MarketableProtocolAssetDefinitionResponse
-	(*models.Instrument)(nil),                         // 78: models.Instrument
-	(*timestamppb.Timestamp)(nil),                     // 79: google.protobuf.Timestamp
-	(*models.Stat)(nil),                               // 80: models.Stat
-	(*models.Liquidation)(nil),                        // 81: models.Liquidation
-	(*models.UPV3Update)(nil),                         // 82: models.UPV3Update
-	(*models.Sale)(nil),                               // 83: models.Sale
-	(models.StatType)(0),                              // 84: models.StatType
-	(*actor.PID)(nil),                                 // 85: actor.PID
-	(models.OrderBookAggregation)(0),                  // 86: models.OrderBookAggregation
-	(*models.OBL1Snapshot)(nil),                       // 87: models.OBL1Snapshot
-	(*models.OBL2Snapshot)(nil),                       // 88: models.OBL2Snapshot
-	(*models.OBL3Snapshot)(nil),                       // 89: models.OBL3Snapshot
-	(*models.AggregatedTrade)(nil),                    // 90: models.AggregatedTrade
-	(*models.OBL1Update)(nil),                         // 91: models.OBL1Update
-	(*models.OBL2Update)(nil),                         // 92: models.OBL2Update
-	(*models.OBL3Update)(nil),                         // 93: models.OBL3Update
-	(*models.Account)(nil),                            // 94: models.Account
-	(*models.Security)(nil),                           // 95: models.Security
-	(*models.Order)(nil),                              // 96: models.Order
-	(*models.Position)(nil),                           // 97: models.Position
-	(*models.Balance)(nil),                            // 98: models.Balance
-	(*wrapperspb.DoubleValue)(nil),                    // 99: google.protobuf.DoubleValue
-	(*models1.Asset)(nil),                             // 100: models.Asset
-	(*wrapperspb.StringValue)(nil),                    // 101: google.protobuf.StringValue
-	(*models.TradeCapture)(nil),                       // 102: models.TradeCapture
-	(models.OrderStatus)(0),                           // 103: models.OrderStatus
-	(models.Side)(0),                                  // 104: models.Side
-	(*wrapperspb.BoolValue)(nil),                      // 105: google.protobuf.BoolValue
-	(models.OrderType)(0),                             // 106: models.OrderType
-	(models.TimeInForce)(0),                           // 107: models.TimeInForce
-	(models.ExecutionInstruction)(0),                  // 108: models.ExecutionInstruction
-	(*durationpb.Duration)(nil),                       // 109: google.protobuf.Duration
-	(*wrapperspb.UInt32Value)(nil),                    // 110: google.protobuf.UInt32Value
-	(*models.ProtocolAssetUpdate)(nil),                // 111: models.ProtocolAssetUpdate
-	(*models.ProtocolAsset)(nil),                      // 112: models.ProtocolAsset
-	(*models.MarketableProtocolAsset)(nil),            // 113: models.MarketableProtocolAsset
+	(DeliveryPolicy)(0),                               // 2: messages.DeliveryPolicy
+	(ResponseType)(0),                                 // 3: messages.ResponseType
+	(FeeType)(0),                                      // 4: messages.FeeType
+	(FeeBasis)(0),                                     // 5: messages.FeeBasis
+	(AccountMovementType)(0),                          // 6: messages.AccountMovementType
+	(*HistoricalOpenInterestsRequest)(nil),            // 7: messages.HistoricalOpenInterestsRequest
+	(*HistoricalOpenInterestsResponse)(nil),           // 8: messages.HistoricalOpenInterestsResponse
+	(*HistoricalFundingRatesRequest)(nil),             // 9: messages.HistoricalFundingRatesRequest
+	(*HistoricalFundingRatesResponse)(nil),            // 10: messages.HistoricalFundingRatesResponse
+	(*HistoricalLiquidationsRequest)(nil),             // 11: messages.HistoricalLiquidationsRequest
+	(*HistoricalLiquidationsResponse)(nil),            // 12: messages.HistoricalLiquidationsResponse
+	(*HistoricalUnipoolV3DataRequest)(nil),            // 13: messages.HistoricalUnipoolV3DataRequest
+	(*HistoricalUnipoolV3DataResponse)(nil),           // 14: messages.HistoricalUnipoolV3DataResponse
+	(*HistoricalSalesRequest)(nil),                    // 15: messages.HistoricalSalesRequest
+	(*HistoricalSalesResponse)(nil),                   // 16: messages.HistoricalSalesResponse
+	(*MarketStatisticsRequest)(nil),                   // 17: messages.MarketStatisticsRequest
+	(*MarketStatisticsResponse)(nil),                  // 18: messages.MarketStatisticsResponse
+	(*MarketDataRequest)(nil),                         // 19: messages.MarketDataRequest
+	(*MarketDataResponse)(nil),                        // 20: messages.MarketDataResponse
+	(*MarketDataIncrementalRefresh)(nil),              // 21: messages.MarketDataIncrementalRefresh
+	(*BarDataRequest)(nil),                            // 22: messages.BarDataRequest
+	(*BarDataResponse)(nil),                           // 23: messages.BarDataResponse
+	(*BarDataIncrementalRefresh)(nil),                 // 24: messages.BarDataIncrementalRefresh
+	(*UnipoolV3DataRequest)(nil),                      // 25: messages.UnipoolV3DataRequest
+	(*UnipoolV3DataResponse)(nil),                     // 26: messages.UnipoolV3DataResponse
+	(*UnipoolV3DataIncrementalRefresh)(nil),           // 27: messages.UnipoolV3DataIncrementalRefresh
+	(*UnipoolV3QuoteRequest)(nil),                     // 28: messages.UnipoolV3QuoteRequest
+	(*UnipoolV3QuoteLeg)(nil),                         // 29: messages.UnipoolV3QuoteLeg
+	(*UnipoolV3QuoteResponse)(nil),                    // 30: messages.UnipoolV3QuoteResponse
+	(*AccountDataRequest)(nil),                        // 31: messages.AccountDataRequest
+	(*AccountDataResponse)(nil),                       // 32: messages.AccountDataResponse
+	(*AccountDataIncrementalRefresh)(nil),             // 33: messages.AccountDataIncrementalRefresh
+	(*AccountMovement)(nil),                           // 34: messages.AccountMovement
+	(*AccountInformationRequest)(nil),                 // 35: messages.AccountInformationRequest
+	(*AccountInformationResponse)(nil),                // 36: messages.AccountInformationResponse
+	(*AccountMovementRequest)(nil),                    // 37: messages.AccountMovementRequest
+	(*AccountMovementFilter)(nil),                     // 38: messages.AccountMovementFilter
+	(*AccountMovementResponse)(nil),                   // 39: messages.AccountMovementResponse
+	(*TradeCaptureReportRequest)(nil),                 // 40: messages.TradeCaptureReportRequest
+	(*TradeCaptureReportFilter)(nil),                  // 41: messages.TradeCaptureReportFilter
+	(*TradeCaptureReport)(nil),                        // 42: messages.TradeCaptureReport
+	(*SecurityDefinitionRequest)(nil),                 // 43: messages.SecurityDefinitionRequest
+	(*SecurityDefinitionResponse)(nil),                // 44: messages.SecurityDefinitionResponse
+	(*SecurityListRequest)(nil),                       // 45: messages.SecurityListRequest
+	(*SecurityList)(nil),                              // 46: messages.SecurityList
+	(*ExecutionReport)(nil),                           // 47: messages.ExecutionReport
+	(*AccountUpdate)(nil),                             // 48: messages.AccountUpdate
+	(*SideValue)(nil),                                 // 49: messages.SideValue
+	(*OrderStatusValue)(nil),                          // 50: messages.OrderStatusValue
+	(*OrderFilter)(nil),                               // 51: messages.OrderFilter
+	(*OrderStatusRequest)(nil),                        // 52: messages.OrderStatusRequest
+	(*OrderList)(nil),                                 // 53: messages.OrderList
+	(*PositionsRequest)(nil),                          // 54: messages.PositionsRequest
+	(*PositionList)(nil),                              // 55: messages.PositionList
+	(*BalancesRequest)(nil),                           // 56: messages.BalancesRequest
+	(*BalanceList)(nil),                               // 57: messages.BalanceList
+	(*NewOrder)(nil),                                  // 58: messages.NewOrder
+	(*NewOrderSingleRequest)(nil),                     // 59: messages.NewOrderSingleRequest
+	(*NewOrderSingleResponse)(nil),                    // 60: messages.NewOrderSingleResponse
+	(*NewOrderBulkRequest)(nil),                       // 61: messages.NewOrderBulkRequest
+	(*NewOrderBulkResponse)(nil),                      // 62: messages.NewOrderBulkResponse
+	(*OrderUpdate)(nil),                               // 63: messages.OrderUpdate
+	(*OrderReplaceRequest)(nil),                       // 64: messages.OrderReplaceRequest
+	(*OrderReplaceResponse)(nil),                      // 65: messages.OrderReplaceResponse
+	(*OrderBulkReplaceRequest)(nil),                   // 66: messages.OrderBulkReplaceRequest
+	(*OrderBulkReplaceResponse)(nil),                  // 67: messages.OrderBulkReplaceResponse
+	(*OrderCancelRequest)(nil),                        // 68: messages.OrderCancelRequest
+	(*OrderCancelResponse)(nil),                       // 69: messages.OrderCancelResponse
+	(*OrderMassCancelRequest)(nil),                    // 70: messages.OrderMassCancelRequest
+	(*OrderMassCancelResponse)(nil),                   // 71: messages.OrderMassCancelResponse
+	(*HistoricalProtocolAssetTransferRequest)(nil),    // 72: messages.HistoricalProtocolAssetTransferRequest
+	(*HistoricalProtocolAssetTransferResponse)(nil),   // 73: messages.HistoricalProtocolAssetTransferResponse
+	(*ProtocolAssetDataRequest)(nil),                  // 74: messages.ProtocolAssetDataRequest
+	(*ProtocolAssetDataResponse)(nil),                 // 75: messages.ProtocolAssetDataResponse
+	(*ProtocolAssetDataIncrementalRefresh)(nil),       // 76: messages.ProtocolAssetDataIncrementalRefresh
+	(*ProtocolAssetDefinitionRequest)(nil),            // 77: messages.ProtocolAssetDefinitionRequest
+	(*ProtocolAssetDefinitionResponse)(nil),           // 78: messages.ProtocolAssetDefinitionResponse
+	(*ProtocolAssetListRequest)(nil),                  // 79: messages.ProtocolAssetListRequest
+	(*ProtocolAssetList)(nil),                         // 80: messages.ProtocolAssetList
+	(*MarketableProtocolAssetListRequest)(nil),        // 81: messages.MarketableProtocolAssetListRequest
+	(*MarketableProtocolAssetList)(nil),               // 82: messages.MarketableProtocolAssetList
+	(*MarketableProtocolAssetDefinitionRequest)(nil),  // 83: messages.MarketableProtocolAssetDefinitionRequest
+	(*MarketableProtocolAssetDefinitionResponse)(nil), // 84: messages.MarketableProtocolAssetDefinitionResponse
+	(*ModelDataRequest)(nil),                          // 85: messages.ModelDataRequest
+	(*ModelDataResponse)(nil),                         // 86: messages.ModelDataResponse
+	(*ModelDataIncrementalRefresh)(nil),               // 87: messages.ModelDataIncrementalRefresh
+	(*ModelValue)(nil),                                // 88: messages.ModelValue
+	(*models.Instrument)(nil),                         // 89: models.Instrument
+	(*timestamppb.Timestamp)(nil),                     // 90: google.protobuf.Timestamp
+	(*models.Stat)(nil),                               // 91: models.Stat
+	(*models.Liquidation)(nil),                        // 92: models.Liquidation
+	(*models.UPV3Update)(nil),                         // 93: models.UPV3Update
+	(*models.Sale)(nil),                               // 94: models.Sale
+	(models.StatType)(0),                              // 95: models.StatType
+	(*actor.PID)(nil),                                 // 96: actor.PID
+	(models.OrderBookAggregation)(0),                  // 97: models.OrderBookAggregation
+	(*durationpb.Duration)(nil),                       // 98: google.protobuf.Duration
+	(*models.OBL1Snapshot)(nil),                       // 99: models.OBL1Snapshot
+	(*models.OBL2Snapshot)(nil),                       // 100: models.OBL2Snapshot
+	(*models.OBL3Snapshot)(nil),                       // 101: models.OBL3Snapshot
+	(*models.AggregatedTrade)(nil),                    // 102: models.AggregatedTrade
+	(*models.VenueLevel)(nil),                         // 103: models.VenueLevel
+	(*models.OBL1Update)(nil),                         // 104: models.OBL1Update
+	(*models.OBL2Update)(nil),                         // 105: models.OBL2Update
+	(*models.OBL3Update)(nil),                         // 106: models.OBL3Update
+	(models.BarType)(0),                               // 107: models.BarType
+	(*models.Bar)(nil),                                // 108: models.Bar
+	(*models.Account)(nil),                            // 109: models.Account
+	(*models.Security)(nil),                           // 110: models.Security
+	(*models.Order)(nil),                              // 111: models.Order
+	(*models.Position)(nil),                           // 112: models.Position
+	(*models.Balance)(nil),                            // 113: models.Balance
+	(*wrapperspb.DoubleValue)(nil),                    // 114: google.protobuf.DoubleValue
+	(*models1.Asset)(nil),                             // 115: models.Asset
+	(*wrapperspb.StringValue)(nil),                    // 116: google.protobuf.StringValue
+	(*models.TradeCapture)(nil),                       // 117: models.TradeCapture
+	(models.OrderStatus)(0),                           // 118: models.OrderStatus
+	(models.Side)(0),                                  // 119: models.Side
+	(*wrapperspb.BoolValue)(nil),                      // 120: google.protobuf.BoolValue
+	(models.OrderType)(0),                             // 121: models.OrderType
+	(models.TimeInForce)(0),                           // 122: models.TimeInForce
+	(models.ExecutionInstruction)(0),                  // 123: models.ExecutionInstruction
+	(*wrapperspb.UInt32Value)(nil),                    // 124: google.protobuf.UInt32Value
+	(*models.ProtocolAssetUpdate)(nil),                // 125: models.ProtocolAssetUpdate
+	(*models.ProtocolAsset)(nil),                      // 126: models.ProtocolAsset
+	(*models.MarketableProtocolAsset)(nil),            // 127: models.MarketableProtocolAsset
 }
 var file_executor_messages_proto_depIdxs = []int32{
-	78,  // 0: messages.HistoricalOpenInterestsRequest.instrument:type_name -> models.Instrument
-	79,  // 1: messages.HistoricalOpenInterestsRequest.from:type_name -> google.protobuf.Timestamp
-	79,  // 2: messages.HistoricalOpenInterestsRequest.to:type_name -> google.protobuf.Timestamp
-	80,  // 3: messages.HistoricalOpenInterestsResponse.interests:type_name -> models.Stat
+	89,  // 0: messages.HistoricalOpenInterestsRequest.instrument:type_name -> models.Instrument
+	90,  // 1: messages.HistoricalOpenInterestsRequest.from:type_name -> google.protobuf.Timestamp
+	90,  // 2: messages.HistoricalOpenInterestsRequest.to:type_name -> google.protobuf.Timestamp
+	91,  // 3: messages.HistoricalOpenInterestsResponse.interests:type_name -> models.Stat
 	1,   // 4: messages.HistoricalOpenInterestsResponse.rejection_reason:type_name -> messages.RejectionReason
-	78,  // 5: messages.HistoricalFundingRatesRequest.instrument:type_name -> models.Instrument
-	79,  // 6: messages.HistoricalFundingRatesRequest.from:type_name -> google.protobuf.Timestamp
-	79,  // 7: messages.HistoricalFundingRatesRequest.to:type_name -> google.protobuf.Timestamp
-	80,  // 8: messages.HistoricalFundingRatesResponse.rates:type_name -> models.Stat
+	89,  // 5: messages.HistoricalFundingRatesRequest.instrument:type_name -> models.Instrument
+	90,  // 6: messages.HistoricalFundingRatesRequest.from:type_name -> google.protobuf.Timestamp
+	90,  // 7: messages.HistoricalFundingRatesRequest.to:type_name -> google.protobuf.Timestamp
+	91,  // 8: messages.HistoricalFundingRatesResponse.rates:type_name -> models.Stat
 	1,   // 9: messages.HistoricalFundingRatesResponse.rejection_reason:type_name -> messages.RejectionReason
-	78,  // 10: messages.HistoricalLiquidationsRequest.instrument:type_name -> models.Instrument
-	79,  // 11: messages.HistoricalLiquidationsRequest.from:type_name -> google.protobuf.Timestamp
-	79,  // 12: messages.HistoricalLiquidationsRequest.to:type_name -> google.protobuf.Timestamp
-	81,  // 13: messages.HistoricalLiquidationsResponse.liquidations:type_name -> models.Liquidation
+	89,  // 10: messages.HistoricalLiquidationsRequest.instrument:type_name -> models.Instrument
+	90,  // 11: messages.HistoricalLiquidationsRequest.from:type_name -> google.protobuf.Timestamp
+	90,  // 12: messages.HistoricalLiquidationsRequest.to:type_name -> google.protobuf.Timestamp
+	92,  // 13: messages.HistoricalLiquidationsResponse.liquidations:type_name -> models.Liquidation
 	1,   // 14: messages.HistoricalLiquidationsResponse.rejection_reason:type_name -> messages.RejectionReason
-	78,  // 15: messages.HistoricalUnipoolV3DataRequest.instrument:type_name -> models.Instrument
-	82,  // 16: messages.HistoricalUnipoolV3DataResponse.events:type_name -> models.UPV3Update
+	89,  // 15: messages.HistoricalUnipoolV3DataRequest.instrument:type_name -> models.Instrument
+	93,  // 16: messages.HistoricalUnipoolV3DataResponse.events:type_name -> models.UPV3Update
 	1,   // 17: messages.HistoricalUnipoolV3DataResponse.rejection_reason:type_name -> messages.RejectionReason
-	79,  // 18: messages.HistoricalSalesRequest.from:type_name -> google.protobuf.Timestamp
-	79,  // 19: messages.HistoricalSalesRequest.to:type_name -> google.protobuf.Timestamp
-	83,  // 20: messages.HistoricalSalesResponse.sale:type_name -> models.Sale
+	90,  // 18: messages.HistoricalSalesRequest.from:type_name -> google.protobuf.Timestamp
+	90,  // 19: messages.HistoricalSalesRequest.to:type_name -> google.protobuf.Timestamp
+	94,  // 20: messages.HistoricalSalesResponse.sale:type_name -> models.Sale
 	1,   // 21: messages.HistoricalSalesResponse.rejection_reason:type_name -> messages.RejectionReason
-	78,  // 22: messages.MarketStatisticsRequest.instrument:type_name -> models.Instrument
-	84,  // 23: messages.MarketStatisticsRequest.statistics:type_name -> models.StatType
-	80,  // 24: messages.MarketStatisticsResponse.statistics:type_name -> models.Stat
+	89,  // 22: messages.MarketStatisticsRequest.instrument:type_name -> models.Instrument
+	95,  // 23: messages.MarketStatisticsRequest.statistics:type_name -> models.StatType
+	91,  // 24: messages.MarketStatisticsResponse.statistics:type_name -> models.Stat
 	1,   // 25: messages.MarketStatisticsResponse.rejection_reason:type_name -> messages.RejectionReason
-	85,  // 26: messages.MarketDataRequest.subscriber:type_name -> actor.PID
-	78,  // 27: messages.MarketDataRequest.instrument:type_name -> models.Instrument
-	86,  // 28: messages.MarketDataRequest.aggregation:type_name -> models.OrderBookAggregation
-	84,  // 29: messages.MarketDataRequest.stats:type_name -> models.StatType
-	87,  // 30: messages.MarketDataResponse.snapshotL1:type_name -> models.OBL1Snapshot
-	88,  // 31: messages.MarketDataResponse.snapshotL2:type_name -> models.OBL2Snapshot
-	89,  // 32: messages.MarketDataResponse.snapshotL3:type_name -> models.OBL3Snapshot
-	90,  // 33: messages.MarketDataResponse.trades:type_name -> models.AggregatedTrade
-	1,   // 34: messages.MarketDataResponse.rejection_reason:type_name -> messages.RejectionReason
-	91,  // 35: messages.MarketDataIncrementalRefresh.updateL1:type_name -> models.OBL1Update
-	92,  // 36: messages.MarketDataIncrementalRefresh.updateL2:type_name -> models.OBL2Update
-	93,  // 37: messages.MarketDataIncrementalRefresh.updateL3:type_name -> models.OBL3Update
-	90,  // 38: messages.MarketDataIncrementalRefresh.trades:type_name -> models.AggregatedTrade
-	81,  // 39: messages.MarketDataIncrementalRefresh.liquidation:type_name -> models.Liquidation
-	80,  // 40: messages.MarketDataIncrementalRefresh.stats:type_name -> models.Stat
-	85,  // 41: messages.UnipoolV3DataRequest.subscriber:type_name -> actor.PID
-	78,  // 42: messages.UnipoolV3DataRequest.instrument:type_name -> models.Instrument
-	82,  // 43: messages.UnipoolV3DataResponse.update:type_name -> models.UPV3Update
-	1,   // 44: messages.UnipoolV3DataResponse.rejection_reason:type_name -> messages.RejectionReason
-	82,  // 45: messages.UnipoolV3DataIncrementalRefresh.update:type_name -> models.UPV3Update
-	85,  // 46: messages.AccountDataRequest.subscriber:type_name -> actor.PID
-	94,  // 47: messages.AccountDataRequest.account:type_name -> models.Account
-	95,  // 48: messages.AccountDataResponse.securities:type_name -> models.Security
-	96,  // 49: messages.AccountDataResponse.orders:type_name -> models.Order
-	97,  // 50: messages.AccountDataResponse.positions:type_name -> models.Position
-	98,  // 51: messages.AccountDataResponse.balances:type_name -> models.Balance
-	99,  // 52: messages.AccountDataResponse.maker_fee:type_name -> google.protobuf.DoubleValue
-	99,  // 53: messages.AccountDataResponse.taker_fee:type_name -> google.protobuf.DoubleValue
-	1,   // 54: messages.AccountDataResponse.rejection_reason:type_name -> messages.RejectionReason
-	40,  // 55: messages.AccountDataIncrementalRefresh.report:type_name -> messages.ExecutionReport
-	100, // 56: messages.AccountMovement.asset:type_name -> models.Asset
-	5,   // 57: messages.AccountMovement.type:type_name -> messages.AccountMovementType
-	79,  // 58: messages.AccountMovement.time:type_name -> google.protobuf.Timestamp
-	94,  // 59: messages.AccountInformationRequest.account:type_name -> models.Account
-	99,  // 60: messages.AccountInformationResponse.maker_fee:type_name -> google.protobuf.DoubleValue
-	99,  // 61: messages.AccountInformationResponse.taker_fee:type_name -> google.protobuf.DoubleValue
-	1,   // 62: messages.AccountInformationResponse.rejection_reason:type_name -> messages.RejectionReason
-	94,  // 63: messages.AccountMovementRequest.account:type_name -> models.Account
-	5,   // 64: messages.AccountMovementRequest.type:type_name -> messages.AccountMovementType
-	31,  // 65: messages.AccountMovementRequest.filter:type_name -> messages.AccountMovementFilter
-	78,  // 66: messages.AccountMovementFilter.instrument:type_name -> models.Instrument
-	79,  // 67: messages.AccountMovementFilter.from:type_name -> google.protobuf.Timestamp
-	79,  // 68: messages.AccountMovementFilter.to:type_name -> google.protobuf.Timestamp
-	27,  // 69: messages.AccountMovementResponse.movements:type_name -> messages.AccountMovement
-	1,   // 70: messages.AccountMovementResponse.rejection_reason:type_name -> messages.RejectionReason
-	94,  // 71: messages.TradeCaptureReportRequest.account:type_name -> models.Account
-	34,  // 72: messages.TradeCaptureReportRequest.filter:type_name -> messages.TradeCaptureReportFilter
-	101, // 73: messages.TradeCaptureReportFilter.orderID:type_name -> google.protobuf.StringValue
-	101, // 74: messages.TradeCaptureReportFilter.client_orderID:type_name -> google.protobuf.StringValue
-	78,  // 75: messages.TradeCaptureReportFilter.instrument:type_name -> models.Instrument
-	42,  // 76: messages.TradeCaptureReportFilter.side:type_name -> messages.SideValue
-	79,  // 77: messages.TradeCaptureReportFilter.from:type_name -> google.protobuf.Timestamp
-	79,  // 78: messages.TradeCaptureReportFilter.to:type_name -> google.protobuf.Timestamp
-	101, // 79: messages.TradeCaptureReportFilter.fromID:type_name -> google.protobuf.StringValue
-	102, // 80: messages.TradeCaptureReport.trades:type_name -> models.TradeCapture
-	1,   // 81: messages.TradeCaptureReport.rejection_reason:type_name -> messages.RejectionReason
-	78,  // 82: messages.SecurityDefinitionRequest.instrument:type_name -> models.Instrument
-	95,  // 83: messages.SecurityDefinitionResponse.security:type_name -> models.Security
-	1,   // 84: messages.SecurityDefinitionResponse.rejection_reason:type_name -> messages.RejectionReason
-	85,  // 85: messages.SecurityListRequest.subscriber:type_name -> actor.PID
-	95,  // 86: messages.SecurityList.securities:type_name -> models.Security
-	1,   // 87: messages.SecurityList.rejection_reason:type_name -> messages.RejectionReason
-	101, // 88: messages.ExecutionReport.client_orderID:type_name -> google.protobuf.StringValue
-	0,   // 89: messages.ExecutionReport.execution_type:type_name -> messages.ExecutionType
-	103, // 90: messages.ExecutionReport.order_status:type_name -> models.OrderStatus
-	78,  // 91: messages.ExecutionReport.instrument:type_name -> models.Instrument
-	79,  // 92: messages.ExecutionReport.transaction_time:type_name -> google.protobuf.Timestamp
-	101, // 93: messages.ExecutionReport.tradeID:type_name -> google.protobuf.StringValue
-	99,  // 94: messages.ExecutionReport.fill_price:type_name -> google.protobuf.DoubleValue
-	99,  // 95: messages.ExecutionReport.fill_quantity:type_name -> google.protobuf.DoubleValue
-	99,  // 96: messages.ExecutionReport.fee_amount:type_name -> google.protobuf.DoubleValue
-	100, // 97: messages.ExecutionReport.fee_currency:type_name -> models.Asset
-	3,   // 98: messages.ExecutionReport.fee_type:type_name -> messages.FeeType
-	4,   // 99: messages.ExecutionReport.fee_basis:type_name -> messages.FeeBasis
-	1,   // 100: messages.ExecutionReport.rejection_reason:type_name -> messages.RejectionReason
-	5,   // 101: messages.AccountUpdate.type:type_name -> messages.AccountMovementType
-	100, // 102: messages.AccountUpdate.asset:type_name -> models.Asset
-	104, // 103: messages.SideValue.value:type_name -> models.Side
-	103, // 104: messages.OrderStatusValue.value:type_name -> models.OrderStatus
-	101, // 105: messages.OrderFilter.orderID:type_name -> google.protobuf.StringValue
-	101, // 106: messages.OrderFilter.client_orderID:type_name -> google.protobuf.StringValue
-	78,  // 107: messages.OrderFilter.instrument:type_name -> models.Instrument
-	42,  // 108: messages.OrderFilter.side:type_name -> messages.SideValue
-	43,  // 109: messages.OrderFilter.order_status:type_name -> messages.OrderStatusValue
-	105, // 110: messages.OrderFilter.open:type_name -> google.protobuf.BoolValue
-	85,  // 111: messages.OrderStatusRequest.subscriber:type_name -> actor.PID
-	94,  // 112: messages.OrderStatusRequest.account:type_name -> models.Account
-	44,  // 113: messages.OrderStatusRequest.filter:type_name -> messages.OrderFilter
-	96,  // 114: messages.OrderList.orders:type_name -> models.Order
-	1,   // 115: messages.OrderList.rejection_reason:type_name -> messages.RejectionReason
-	85,  // 116: messages.PositionsRequest.subscriber:type_name -> actor.PID
-	78,  // 117: messages.PositionsRequest.instrument:type_name -> models.Instrument
-	94,  // 118: messages.PositionsRequest.account:type_name -> models.Account
-	97,  // 119: messages.PositionList.positions:type_name -> models.Position
-	79,  // 120: messages.PositionList.time:type_name -> google.protobuf.Timestamp
-	1,   // 121: messages.PositionList.rejection_reason:type_name -> messages.RejectionReason
-	85,  // 122: messages.BalancesRequest.subscriber:type_name -> actor.PID
-	100, // 123: messages.BalancesRequest.asset:type_name -> models.Asset
-	94,  // 124: messages.BalancesRequest.account:type_name -> models.Account
-	98,  // 125: messages.BalanceList.balances:type_name -> models.Balance
-	1,   // 126: messages.BalanceList.rejection_reason:type_name -> messages.RejectionReason
-	78,  // 127: messages.NewOrder.instrument:type_name -> models.Instrument
-	106, // 128: messages.NewOrder.order_type:type_name -> models.OrderType
-	104, // 129: messages.NewOrder.order_side:type_name -> models.Side
-	107, // 130: messages.NewOrder.time_in_force:type_name -> models.TimeInForce
-	99,  // 131: messages.NewOrder.price:type_name -> google.protobuf.DoubleValue
-	108, // 132: messages.NewOrder.execution_instructions:type_name -> models.ExecutionInstruction
-	94,  // 133: messages.NewOrderSingleRequest.account:type_name -> models.Account
-	51,  // 134: messages.NewOrderSingleRequest.order:type_name -> messages.NewOrder
-	2,   // 135: messages.NewOrderSingleRequest.response_type:type_name -> messages.ResponseType
-	79,  // 136: messages.NewOrderSingleRequest.expire:type_name -> google.protobuf.Timestamp
-	103, // 137: messages.NewOrderSingleResponse.order_status:type_name -> models.OrderStatus
-	1,   // 138: messages.NewOrderSingleResponse.rejection_reason:type_name -> messages.RejectionReason
-	109, // 139: messages.NewOrderSingleResponse.rate_limit_delay:type_name -> google.protobuf.Duration
-	109, // 140: messages.NewOrderSingleResponse.network_rtt:type_name -> google.protobuf.Duration
-	94,  // 141: messages.NewOrderBulkRequest.account:type_name -> models.Account
-	51,  // 142: messages.NewOrderBulkRequest.orders:type_name -> messages.NewOrder
-	1,   // 143: messages.NewOrderBulkResponse.rejection_reason:type_name -> messages.RejectionReason
-	101, // 144: messages.OrderUpdate.orderID:type_name -> google.protobuf.StringValue
-	101, // 145: messages.OrderUpdate.orig_client_orderID:type_name -> google.protobuf.StringValue
-	99,  // 146: messages.OrderUpdate.quantity:type_name -> google.protobuf.DoubleValue
-	99,  // 147: messages.OrderUpdate.price:type_name -> google.protobuf.DoubleValue
-	78,  // 148: messages.OrderReplaceRequest.instrument:type_name -> models.Instrument
-	94,  // 149: messages.OrderReplaceRequest.account:type_name -> models.Account
-	56,  // 150: messages.OrderReplaceRequest.update:type_name -> messages.OrderUpdate
-	1,   // 151: messages.OrderReplaceResponse.rejection_reason:type_name -> messages.RejectionReason
-	78,  // 152: messages.OrderBulkReplaceRequest.instrument:type_name -> models.Instrument
-	94,  // 153: messages.OrderBulkReplaceRequest.account:type_name -> models.Account
-	56,  // 154: messages.OrderBulkReplaceRequest.updates:type_name -> messages.OrderUpdate
-	1,   // 155: messages.OrderBulkReplaceResponse.rejection_reason:type_name -> messages.RejectionReason
-	101, // 156: messages.OrderCancelRequest.orderID:type_name -> google.protobuf.StringValue
-	101, // 157: messages.OrderCancelRequest.client_orderID:type_name -> google.protobuf.StringValue
-	78,  // 158: messages.OrderCancelRequest.instrument:type_name -> models.Instrument
-	94,  // 159: messages.OrderCancelRequest.account:type_name -> models.Account
-	2,   // 160: messages.OrderCancelRequest.response_type:type_name -> messages.ResponseType
-	1,   // 161: messages.OrderCancelResponse.rejection_reason:type_name -> messages.RejectionReason
-	109, // 162: messages.OrderCancelResponse.rate_limit_delay:type_name -> google.protobuf.Duration
-	109, // 163: messages.OrderCancelResponse.network_rtt:type_name -> google.protobuf.Duration
-	94,  // 164: messages.OrderMassCancelRequest.account:type_name -> models.Account
-	44,  // 165: messages.OrderMassCancelRequest.filter:type_name -> messages.OrderFilter
-	1,   // 166: messages.OrderMassCancelResponse.rejection_reason:type_name -> messages.RejectionReason
-	110, // 167: messages.HistoricalProtocolAssetTransferRequest.assetID:type_name -> google.protobuf.UInt32Value
-	111, // 168: messages.HistoricalProtocolAssetTransferResponse.update:type_name -> models.ProtocolAssetUpdate
-	1,   // 169: messages.HistoricalProtocolAssetTransferResponse.rejection_reason:type_name -> messages.RejectionReason
-	85,  // 170: messages.ProtocolAssetDataRequest.subscriber:type_name -> actor.PID
-	110, // 171: messages.ProtocolAssetDataRequest.assetID:type_name -> google.protobuf.UInt32Value
-	1,   // 172: messages.ProtocolAssetDataResponse.rejection_reason:type_name -> messages.RejectionReason
-	111, // 173: messages.ProtocolAssetDataIncrementalRefresh.update:type_name -> models.ProtocolAssetUpdate
-	112, // 174: messages.ProtocolAssetDefinitionResponse.protocol_asset:type_name -> models.ProtocolAsset
-	1,   // 175: messages.ProtocolAssetDefinitionResponse.rejection_reason:type_name -> messages.RejectionReason
-	85,  // 176: messages.ProtocolAssetListRequest.subscriber:type_name -> actor.PID
-	112, // 177: messages.ProtocolAssetList.protocol_assets:type_name -> models.ProtocolAsset
-	1,   // 178: messages.ProtocolAssetList.rejection_reason:type_name -> messages.RejectionReason
-	85,  // 179: messages.MarketableProtocolAssetListRequest.subscriber:type_name -> actor.PID
-	113, // 180: messages.MarketableProtocolAssetList.marketable_protocol_assets:type_name -> models.MarketableProtocolAsset
-	1,   // 181: messages.MarketableProtocolAssetList.rejection_reason:type_name -> messages.RejectionReason
-	113, // 182: messages.MarketableProtocolAssetDefinitionResponse.marketable_protocol_asset:type_name -> models.MarketableProtocolAsset
-	1,   // 183: messages.MarketableProtocolAssetDefinitionResponse.rejection_reason:type_name -> messages.RejectionReason
-	18,  // 184: messages.ExchangeExecutor.MarketData:input_type -> messages.MarketDataRequest
-	24,  // 185: messages.ExchangeExecutor.AccountData:input_type -> messages.AccountDataRequest
-	36,  // 186: messages.ExchangeExecutor.SecurityDefinition:input_type -> messages.SecurityDefinitionRequest
-	38,  // 187: messages.ExchangeExecutor.Securities:input_type -> messages.SecurityListRequest
-	45,  // 188: messages.ExchangeExecutor.Orders:input_type -> messages.OrderStatusRequest
-	47,  // 189: messages.ExchangeExecutor.Positions:input_type -> messages.PositionsRequest
-	49,  // 190: messages.ExchangeExecutor.Balances:input_type -> messages.BalancesRequest
-	52,  // 191: messages.ExchangeExecutor.NewOrderSingle:input_type -> messages.NewOrderSingleRequest
-	54,  // 192: messages.ExchangeExecutor.NewOrderBulk:input_type -> messages.NewOrderBulkRequest
-	57,  // 193: messages.ExchangeExecutor.OrderReplace:input_type -> messages.OrderReplaceRequest
-	59,  // 194: messages.ExchangeExecutor.OrderBulkReplace:input_type -> messages.OrderBulkReplaceRequest
-	61,  // 195: messages.ExchangeExecutor.OrderCancel:input_type -> messages.OrderCancelRequest
-	63,  // 196: messages.ExchangeExecutor.OrderMassCancel:input_type -> messages.OrderMassCancelRequest
-	20,  // 197: messages.ExchangeExecutor.MarketData:output_type -> messages.MarketDataIncrementalRefresh
-	26,  // 198: messages.ExchangeExecutor.AccountData:output_type -> messages.AccountDataIncrementalRefresh
-	37,  // 199: messages.ExchangeExecutor.SecurityDefinition:output_type -> messages.SecurityDefinitionResponse
-	39,  // 200: messages.ExchangeExecutor.Securities:output_type -> messages.SecurityList
-	46,  // 201: messages.ExchangeExecutor.Orders:output_type -> messages.OrderList
-	48,  // 202: messages.ExchangeExecutor.Positions:output_type -> messages.PositionList
-	50,  // 203: messages.ExchangeExecutor.Balances:output_type -> messages.BalanceList
-	53,  // 204: messages.ExchangeExecutor.NewOrderSingle:output_type -> messages.NewOrderSingleResponse
-	55,  // 205: messages.ExchangeExecutor.NewOrderBulk:output_type -> messages.NewOrderBulkResponse
-	58,  // 206: messages.ExchangeExecutor.OrderReplace:output_type -> messages.OrderReplaceResponse
-	60,  // 207: messages.ExchangeExecutor.OrderBulkReplace:output_type -> messages.OrderBulkReplaceResponse
-	62,  // 208: messages.ExchangeExecutor.OrderCancel:output_type -> messages.OrderCancelResponse
-	64,  // 209: messages.ExchangeExecutor.OrderMassCancel:output_type -> messages.OrderMassCancelResponse
-	197, // [197:210] is the sub-list for method output_type
-	184, // [184:197] is the sub-list for method input_type
-	184, // [184:184] is the sub-list for extension type_name
-	184, // [184:184] is the sub-list for extension extendee
-	0,   // [0:184] is the sub-list for field type_name
+	96,  // 26: messages.MarketDataRequest.subscriber:type_name -> actor.PID
+	89,  // 27: messages.MarketDataRequest.instrument:type_name -> models.Instrument
+	97,  // 28: messages.MarketDataRequest.aggregation:type_name -> models.OrderBookAggregation
+	95,  // 29: messages.MarketDataRequest.stats:type_name -> models.StatType
+	2,   // 30: messages.MarketDataRequest.delivery_policy:type_name -> messages.DeliveryPolicy
+	98,  // 31: messages.MarketDataRequest.conflation_interval:type_name -> google.protobuf.Duration
+	99,  // 32: messages.MarketDataResponse.snapshotL1:type_name -> models.OBL1Snapshot
+	100, // 33: messages.MarketDataResponse.snapshotL2:type_name -> models.OBL2Snapshot
+	101, // 34: messages.MarketDataResponse.snapshotL3:type_name -> models.OBL3Snapshot
+	102, // 35: messages.MarketDataResponse.trades:type_name -> models.AggregatedTrade
+	1,   // 36: messages.MarketDataResponse.rejection_reason:type_name -> messages.RejectionReason
+	103, // 37: messages.MarketDataResponse.venue_levels:type_name -> models.VenueLevel
+	91,  // 38: messages.MarketDataResponse.stats:type_name -> models.Stat
+	104, // 39: messages.MarketDataIncrementalRefresh.updateL1:type_name -> models.OBL1Update
+	105, // 40: messages.MarketDataIncrementalRefresh.updateL2:type_name -> models.OBL2Update
+	106, // 41: messages.MarketDataIncrementalRefresh.updateL3:type_name -> models.OBL3Update
+	102, // 42: messages.MarketDataIncrementalRefresh.trades:type_name -> models.AggregatedTrade
+	92,  // 43: messages.MarketDataIncrementalRefresh.liquidation:type_name -> models.Liquidation
+	91,  // 44: messages.MarketDataIncrementalRefresh.stats:type_name -> models.Stat
+	103, // 45: messages.MarketDataIncrementalRefresh.venue_levels:type_name -> models.VenueLevel
+	96,  // 46: messages.BarDataRequest.subscriber:type_name -> actor.PID
+	89,  // 47: messages.BarDataRequest.instrument:type_name -> models.Instrument
+	107, // 48: messages.BarDataRequest.bar_type:type_name -> models.BarType
+	98,  // 49: messages.BarDataRequest.interval:type_name -> google.protobuf.Duration
+	108, // 50: messages.BarDataResponse.current:type_name -> models.Bar
+	1,   // 51: messages.BarDataResponse.rejection_reason:type_name -> messages.RejectionReason
+	108, // 52: messages.BarDataIncrementalRefresh.bars:type_name -> models.Bar
+	96,  // 53: messages.UnipoolV3DataRequest.subscriber:type_name -> actor.PID
+	89,  // 54: messages.UnipoolV3DataRequest.instrument:type_name -> models.Instrument
+	93,  // 55: messages.UnipoolV3DataResponse.update:type_name -> models.UPV3Update
+	1,   // 56: messages.UnipoolV3DataResponse.rejection_reason:type_name -> messages.RejectionReason
+	93,  // 57: messages.UnipoolV3DataIncrementalRefresh.update:type_name -> models.UPV3Update
+	89,  // 58: messages.UnipoolV3QuoteRequest.instruments:type_name -> models.Instrument
+	89,  // 59: messages.UnipoolV3QuoteLeg.instrument:type_name -> models.Instrument
+	29,  // 60: messages.UnipoolV3QuoteResponse.legs:type_name -> messages.UnipoolV3QuoteLeg
+	1,   // 61: messages.UnipoolV3QuoteResponse.rejection_reason:type_name -> messages.RejectionReason
+	96,  // 62: messages.AccountDataRequest.subscriber:type_name -> actor.PID
+	109, // 63: messages.AccountDataRequest.account:type_name -> models.Account
+	110, // 64: messages.AccountDataResponse.securities:type_name -> models.Security
+	111, // 65: messages.AccountDataResponse.orders:type_name -> models.Order
+	112, // 66: messages.AccountDataResponse.positions:type_name -> models.Position
+	113, // 67: messages.AccountDataResponse.balances:type_name -> models.Balance
+	114, // 68: messages.AccountDataResponse.maker_fee:type_name -> google.protobuf.DoubleValue
+	114, // 69: messages.AccountDataResponse.taker_fee:type_name -> google.protobuf.DoubleValue
+	1,   // 70: messages.AccountDataResponse.rejection_reason:type_name -> messages.RejectionReason
+	47,  // 71: messages.AccountDataIncrementalRefresh.report:type_name -> messages.ExecutionReport
+	115, // 72: messages.AccountMovement.asset:type_name -> models.Asset
+	6,   // 73: messages.AccountMovement.type:type_name -> messages.AccountMovementType
+	90,  // 74: messages.AccountMovement.time:type_name -> google.protobuf.Timestamp
+	109, // 75: messages.AccountInformationRequest.account:type_name -> models.Account
+	114, // 76: messages.AccountInformationResponse.maker_fee:type_name -> google.protobuf.DoubleValue
+	114, // 77: messages.AccountInformationResponse.taker_fee:type_name -> google.protobuf.DoubleValue
+	1,   // 78: messages.AccountInformationResponse.rejection_reason:type_name -> messages.RejectionReason
+	109, // 79: messages.AccountMovementRequest.account:type_name -> models.Account
+	6,   // 80: messages.AccountMovementRequest.type:type_name -> messages.AccountMovementType
+	38,  // 81: messages.AccountMovementRequest.filter:type_name -> messages.AccountMovementFilter
+	89,  // 82: messages.AccountMovementFilter.instrument:type_name -> models.Instrument
+	90,  // 83: messages.AccountMovementFilter.from:type_name -> google.protobuf.Timestamp
+	90,  // 84: messages.AccountMovementFilter.to:type_name -> google.protobuf.Timestamp
+	34,  // 85: messages.AccountMovementResponse.movements:type_name -> messages.AccountMovement
+	1,   // 86: messages.AccountMovementResponse.rejection_reason:type_name -> messages.RejectionReason
+	109, // 87: messages.TradeCaptureReportRequest.account:type_name -> models.Account
+	41,  // 88: messages.TradeCaptureReportRequest.filter:type_name -> messages.TradeCaptureReportFilter
+	116, // 89: messages.TradeCaptureReportFilter.orderID:type_name -> google.protobuf.StringValue
+	116, // 90: messages.TradeCaptureReportFilter.client_orderID:type_name -> google.protobuf.StringValue
+	89,  // 91: messages.TradeCaptureReportFilter.instrument:type_name -> models.Instrument
+	49,  // 92: messages.TradeCaptureReportFilter.side:type_name -> messages.SideValue
+	90,  // 93: messages.TradeCaptureReportFilter.from:type_name -> google.protobuf.Timestamp
+	90,  // 94: messages.TradeCaptureReportFilter.to:type_name -> google.protobuf.Timestamp
+	116, // 95: messages.TradeCaptureReportFilter.fromID:type_name -> google.protobuf.StringValue
+	117, // 96: messages.TradeCaptureReport.trades:type_name -> models.TradeCapture
+	1,   // 97: messages.TradeCaptureReport.rejection_reason:type_name -> messages.RejectionReason
+	89,  // 98: messages.SecurityDefinitionRequest.instrument:type_name -> models.Instrument
+	110, // 99: messages.SecurityDefinitionResponse.security:type_name -> models.Security
+	1,   // 100: messages.SecurityDefinitionResponse.rejection_reason:type_name -> messages.RejectionReason
+	96,  // 101: messages.SecurityListRequest.subscriber:type_name -> actor.PID
+	110, // 102: messages.SecurityList.securities:type_name -> models.Security
+	1,   // 103: messages.SecurityList.rejection_reason:type_name -> messages.RejectionReason
+	116, // 104: messages.ExecutionReport.client_orderID:type_name -> google.protobuf.StringValue
+	0,   // 105: messages.ExecutionReport.execution_type:type_name -> messages.ExecutionType
+	118, // 106: messages.ExecutionReport.order_status:type_name -> models.OrderStatus
+	89,  // 107: messages.ExecutionReport.instrument:type_name -> models.Instrument
+	90,  // 108: messages.ExecutionReport.transaction_time:type_name -> google.protobuf.Timestamp
+	116, // 109: messages.ExecutionReport.tradeID:type_name -> google.protobuf.StringValue
+	114, // 110: messages.ExecutionReport.fill_price:type_name -> google.protobuf.DoubleValue
+	114, // 111: messages.ExecutionReport.fill_quantity:type_name -> google.protobuf.DoubleValue
+	114, // 112: messages.ExecutionReport.fee_amount:type_name -> google.protobuf.DoubleValue
+	115, // 113: messages.ExecutionReport.fee_currency:type_name -> models.Asset
+	4,   // 114: messages.ExecutionReport.fee_type:type_name -> messages.FeeType
+	5,   // 115: messages.ExecutionReport.fee_basis:type_name -> messages.FeeBasis
+	1,   // 116: messages.ExecutionReport.rejection_reason:type_name -> messages.RejectionReason
+	6,   // 117: messages.AccountUpdate.type:type_name -> messages.AccountMovementType
+	115, // 118: messages.AccountUpdate.asset:type_name -> models.Asset
+	119, // 119: messages.SideValue.value:type_name -> models.Side
+	118, // 120: messages.OrderStatusValue.value:type_name -> models.OrderStatus
+	116, // 121: messages.OrderFilter.orderID:type_name -> google.protobuf.StringValue
+	116, // 122: messages.OrderFilter.client_orderID:type_name -> google.protobuf.StringValue
+	89,  // 123: messages.OrderFilter.instrument:type_name -> models.Instrument
+	49,  // 124: messages.OrderFilter.side:type_name -> messages.SideValue
+	50,  // 125: messages.OrderFilter.order_status:type_name -> messages.OrderStatusValue
+	120, // 126: messages.OrderFilter.open:type_name -> google.protobuf.BoolValue
+	96,  // 127: messages.OrderStatusRequest.subscriber:type_name -> actor.PID
+	109, // 128: messages.OrderStatusRequest.account:type_name -> models.Account
+	51,  // 129: messages.OrderStatusRequest.filter:type_name -> messages.OrderFilter
+	111, // 130: messages.OrderList.orders:type_name -> models.Order
+	1,   // 131: messages.OrderList.rejection_reason:type_name -> messages.RejectionReason
+	96,  // 132: messages.PositionsRequest.subscriber:type_name -> actor.PID
+	89,  // 133: messages.PositionsRequest.instrument:type_name -> models.Instrument
+	109, // 134: messages.PositionsRequest.account:type_name -> models.Account
+	112, // 135: messages.PositionList.positions:type_name -> models.Position
+	90,  // 136: messages.PositionList.time:type_name -> google.protobuf.Timestamp
+	1,   // 137: messages.PositionList.rejection_reason:type_name -> messages.RejectionReason
+	96,  // 138: messages.BalancesRequest.subscriber:type_name -> actor.PID
+	115, // 139: messages.BalancesRequest.asset:type_name -> models.Asset
+	109, // 140: messages.BalancesRequest.account:type_name -> models.Account
+	113, // 141: messages.BalanceList.balances:type_name -> models.Balance
+	1,   // 142: messages.BalanceList.rejection_reason:type_name -> messages.RejectionReason
+	89,  // 143: messages.NewOrder.instrument:type_name -> models.Instrument
+	121, // 144: messages.NewOrder.order_type:type_name -> models.OrderType
+	119, // 145: messages.NewOrder.order_side:type_name -> models.Side
+	122, // 146: messages.NewOrder.time_in_force:type_name -> models.TimeInForce
+	114, // 147: messages.NewOrder.price:type_name -> google.protobuf.DoubleValue
+	123, // 148: messages.NewOrder.execution_instructions:type_name -> models.ExecutionInstruction
+	109, // 149: messages.NewOrderSingleRequest.account:type_name -> models.Account
+	58,  // 150: messages.NewOrderSingleRequest.order:type_name -> messages.NewOrder
+	3,   // 151: messages.NewOrderSingleRequest.response_type:type_name -> messages.ResponseType
+	90,  // 152: messages.NewOrderSingleRequest.expire:type_name -> google.protobuf.Timestamp
+	118, // 153: messages.NewOrderSingleResponse.order_status:type_name -> models.OrderStatus
+	1,   // 154: messages.NewOrderSingleResponse.rejection_reason:type_name -> messages.RejectionReason
+	98,  // 155: messages.NewOrderSingleResponse.rate_limit_delay:type_name -> google.protobuf.Duration
+	98,  // 156: messages.NewOrderSingleResponse.network_rtt:type_name -> google.protobuf.Duration
+	109, // 157: messages.NewOrderBulkRequest.account:type_name -> models.Account
+	58,  // 158: messages.NewOrderBulkRequest.orders:type_name -> messages.NewOrder
+	1,   // 159: messages.NewOrderBulkResponse.rejection_reason:type_name -> messages.RejectionReason
+	116, // 160: messages.OrderUpdate.orderID:type_name -> google.protobuf.StringValue
+	116, // 161: messages.OrderUpdate.orig_client_orderID:type_name -> google.protobuf.StringValue
+	114, // 162: messages.OrderUpdate.quantity:type_name -> google.protobuf.DoubleValue
+	114, // 163: messages.OrderUpdate.price:type_name -> google.protobuf.DoubleValue
+	89,  // 164: messages.OrderReplaceRequest.instrument:type_name -> models.Instrument
+	109, // 165: messages.OrderReplaceRequest.account:type_name -> models.Account
+	63,  // 166: messages.OrderReplaceRequest.update:type_name -> messages.OrderUpdate
+	1,   // 167: messages.OrderReplaceResponse.rejection_reason:type_name -> messages.RejectionReason
+	89,  // 168: messages.OrderBulkReplaceRequest.instrument:type_name -> models.Instrument
+	109, // 169: messages.OrderBulkReplaceRequest.account:type_name -> models.Account
+	63,  // 170: messages.OrderBulkReplaceRequest.updates:type_name -> messages.OrderUpdate
+	1,   // 171: messages.OrderBulkReplaceResponse.rejection_reason:type_name -> messages.RejectionReason
+	116, // 172: messages.OrderCancelRequest.orderID:type_name -> google.protobuf.StringValue
+	116, // 173: messages.OrderCancelRequest.client_orderID:type_name -> google.protobuf.StringValue
+	89,  // 174: messages.OrderCancelRequest.instrument:type_name -> models.Instrument
+	109, // 175: messages.OrderCancelRequest.account:type_name -> models.Account
+	3,   // 176: messages.OrderCancelRequest.response_type:type_name -> messages.ResponseType
+	1,   // 177: messages.OrderCancelResponse.rejection_reason:type_name -> messages.RejectionReason
+	98,  // 178: messages.OrderCancelResponse.rate_limit_delay:type_name -> google.protobuf.Duration
+	98,  // 179: messages.OrderCancelResponse.network_rtt:type_name -> google.protobuf.Duration
+	109, // 180: messages.OrderMassCancelRequest.account:type_name -> models.Account
+	51,  // 181: messages.OrderMassCancelRequest.filter:type_name -> messages.OrderFilter
+	1,   // 182: messages.OrderMassCancelResponse.rejection_reason:type_name -> messages.RejectionReason
+	124, // 183: messages.HistoricalProtocolAssetTransferRequest.assetID:type_name -> google.protobuf.UInt32Value
+	125, // 184: messages.HistoricalProtocolAssetTransferResponse.update:type_name -> models.ProtocolAssetUpdate
+	1,   // 185: messages.HistoricalProtocolAssetTransferResponse.rejection_reason:type_name -> messages.RejectionReason
+	96,  // 186: messages.ProtocolAssetDataRequest.subscriber:type_name -> actor.PID
+	124, // 187: messages.ProtocolAssetDataRequest.assetID:type_name -> google.protobuf.UInt32Value
+	1,   // 188: messages.ProtocolAssetDataResponse.rejection_reason:type_name -> messages.RejectionReason
+	125, // 189: messages.ProtocolAssetDataIncrementalRefresh.update:type_name -> models.ProtocolAssetUpdate
+	126, // 190: messages.ProtocolAssetDefinitionResponse.protocol_asset:type_name -> models.ProtocolAsset
+	1,   // 191: messages.ProtocolAssetDefinitionResponse.rejection_reason:type_name -> messages.RejectionReason
+	96,  // 192: messages.ProtocolAssetListRequest.subscriber:type_name -> actor.PID
+	126, // 193: messages.ProtocolAssetList.protocol_assets:type_name -> models.ProtocolAsset
+	1,   // 194: messages.ProtocolAssetList.rejection_reason:type_name -> messages.RejectionReason
+	96,  // 195: messages.MarketableProtocolAssetListRequest.subscriber:type_name -> actor.PID
+	127, // 196: messages.MarketableProtocolAssetList.marketable_protocol_assets:type_name -> models.MarketableProtocolAsset
+	1,   // 197: messages.MarketableProtocolAssetList.rejection_reason:type_name -> messages.RejectionReason
+	127, // 198: messages.MarketableProtocolAssetDefinitionResponse.marketable_protocol_asset:type_name -> models.MarketableProtocolAsset
+	1,   // 199: messages.MarketableProtocolAssetDefinitionResponse.rejection_reason:type_name -> messages.RejectionReason
+	96,  // 200: messages.ModelDataRequest.subscriber:type_name -> actor.PID
+	1,   // 201: messages.ModelDataResponse.rejection_reason:type_name -> messages.RejectionReason
+	88,  // 202: messages.ModelDataResponse.values:type_name -> messages.ModelValue
+	88,  // 203: messages.ModelDataIncrementalRefresh.values:type_name -> messages.ModelValue
+	114, // 204: messages.ModelValue.price:type_name -> google.protobuf.DoubleValue
+	114, // 205: messages.ModelValue.score:type_name -> google.protobuf.DoubleValue
+	19,  // 206: messages.ExchangeExecutor.MarketData:input_type -> messages.MarketDataRequest
+	31,  // 207: messages.ExchangeExecutor.AccountData:input_type -> messages.AccountDataRequest
+	43,  // 208: messages.ExchangeExecutor.SecurityDefinition:input_type -> messages.SecurityDefinitionRequest
+	45,  // 209: messages.ExchangeExecutor.Securities:input_type -> messages.SecurityListRequest
+	52,  // 210: messages.ExchangeExecutor.Orders:input_type -> messages.OrderStatusRequest
+	54,  // 211: messages.ExchangeExecutor.Positions:input_type -> messages.PositionsRequest
+	56,  // 212: messages.ExchangeExecutor.Balances:input_type -> messages.BalancesRequest
+	59,  // 213: messages.ExchangeExecutor.NewOrderSingle:input_type -> messages.NewOrderSingleRequest
+	61,  // 214: messages.ExchangeExecutor.NewOrderBulk:input_type -> messages.NewOrderBulkRequest
+	64,  // 215: messages.ExchangeExecutor.OrderReplace:input_type -> messages.OrderReplaceRequest
+	66,  // 216: messages.ExchangeExecutor.OrderBulkReplace:input_type -> messages.OrderBulkReplaceRequest
+	68,  // 217: messages.ExchangeExecutor.OrderCancel:input_type -> messages.OrderCancelRequest
+	70,  // 218: messages.ExchangeExecutor.OrderMassCancel:input_type -> messages.OrderMassCancelRequest
+	21,  // 219: messages.ExchangeExecutor.MarketData:output_type -> messages.MarketDataIncrementalRefresh
+	33,  // 220: messages.ExchangeExecutor.AccountData:output_type -> messages.AccountDataIncrementalRefresh
+	44,  // 221: messages.ExchangeExecutor.SecurityDefinition:output_type -> messages.SecurityDefinitionResponse
+	46,  // 222: messages.ExchangeExecutor.Securities:output_type -> messages.SecurityList
+	53,  // 223: messages.ExchangeExecutor.Orders:output_type -> messages.OrderList
+	55,  // 224: messages.ExchangeExecutor.Positions:output_type -> messages.PositionList
+	57,  // 225: messages.ExchangeExecutor.Balances:output_type -> messages.BalanceList
+	60,  // 226: messages.ExchangeExecutor.NewOrderSingle:output_type -> messages.NewOrderSingleResponse
+	62,  // 227: messages.ExchangeExecutor.NewOrderBulk:output_type -> messages.NewOrderBulkResponse
+	65,  // 228: messages.ExchangeExecutor.OrderReplace:output_type -> messages.OrderReplaceResponse
+	67,  // 229: messages.ExchangeExecutor.OrderBulkReplace:output_type -> messages.OrderBulkReplaceResponse
+	69,  // 230: messages.ExchangeExecutor.OrderCancel:output_type -> messages.OrderCancelResponse
+	71,  // 231: messages.ExchangeExecutor.OrderMassCancel:output_type -> messages.OrderMassCancelResponse
+	219, // [219:232] is the sub-list for method output_type
+	206, // [206:219] is the sub-list for method input_type
+	206, // [206:206] is the sub-list for extension type_name
+	206, // [206:206] is the sub-list for extension extendee
+	0,   // [0:206] is the sub-list for field type_name
 }
 
 func init() { file_executor_messages_proto_init() }
@@ -7976,7 +9202,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnipoolV3DataRequest); i {
+			switch v := v.(*BarDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7988,7 +9214,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnipoolV3DataResponse); i {
+			switch v := v.(*BarDataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8000,7 +9226,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnipoolV3DataIncrementalRefresh); i {
+			switch v := v.(*BarDataIncrementalRefresh); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8012,7 +9238,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountDataRequest); i {
+			switch v := v.(*UnipoolV3DataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8024,7 +9250,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountDataResponse); i {
+			switch v := v.(*UnipoolV3DataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8036,7 +9262,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountDataIncrementalRefresh); i {
+			switch v := v.(*UnipoolV3DataIncrementalRefresh); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8048,7 +9274,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountMovement); i {
+			switch v := v.(*UnipoolV3QuoteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8060,7 +9286,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountInformationRequest); i {
+			switch v := v.(*UnipoolV3QuoteLeg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8072,7 +9298,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountInformationResponse); i {
+			switch v := v.(*UnipoolV3QuoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8084,7 +9310,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountMovementRequest); i {
+			switch v := v.(*AccountDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8096,7 +9322,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountMovementFilter); i {
+			switch v := v.(*AccountDataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8108,7 +9334,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountMovementResponse); i {
+			switch v := v.(*AccountDataIncrementalRefresh); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8120,7 +9346,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TradeCaptureReportRequest); i {
+			switch v := v.(*AccountMovement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8132,7 +9358,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TradeCaptureReportFilter); i {
+			switch v := v.(*AccountInformationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8144,7 +9370,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TradeCaptureReport); i {
+			switch v := v.(*AccountInformationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8156,7 +9382,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecurityDefinitionRequest); i {
+			switch v := v.(*AccountMovementRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8168,7 +9394,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecurityDefinitionResponse); i {
+			switch v := v.(*AccountMovementFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8180,7 +9406,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecurityListRequest); i {
+			switch v := v.(*AccountMovementResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8192,7 +9418,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecurityList); i {
+			switch v := v.(*TradeCaptureReportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8204,7 +9430,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecutionReport); i {
+			switch v := v.(*TradeCaptureReportFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8216,7 +9442,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountUpdate); i {
+			switch v := v.(*TradeCaptureReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8228,7 +9454,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SideValue); i {
+			switch v := v.(*SecurityDefinitionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8240,7 +9466,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderStatusValue); i {
+			switch v := v.(*SecurityDefinitionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8252,7 +9478,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderFilter); i {
+			switch v := v.(*SecurityListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8264,7 +9490,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderStatusRequest); i {
+			switch v := v.(*SecurityList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8276,7 +9502,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderList); i {
+			switch v := v.(*ExecutionReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8288,7 +9514,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PositionsRequest); i {
+			switch v := v.(*AccountUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8300,7 +9526,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PositionList); i {
+			switch v := v.(*SideValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8312,7 +9538,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BalancesRequest); i {
+			switch v := v.(*OrderStatusValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8324,7 +9550,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BalanceList); i {
+			switch v := v.(*OrderFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8336,7 +9562,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewOrder); i {
+			switch v := v.(*OrderStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8348,7 +9574,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewOrderSingleRequest); i {
+			switch v := v.(*OrderList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8360,7 +9586,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewOrderSingleResponse); i {
+			switch v := v.(*PositionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8372,7 +9598,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewOrderBulkRequest); i {
+			switch v := v.(*PositionList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8384,7 +9610,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewOrderBulkResponse); i {
+			switch v := v.(*BalancesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8396,7 +9622,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderUpdate); i {
+			switch v := v.(*BalanceList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8408,7 +9634,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderReplaceRequest); i {
+			switch v := v.(*NewOrder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8420,7 +9646,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderReplaceResponse); i {
+			switch v := v.(*NewOrderSingleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8432,7 +9658,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderBulkReplaceRequest); i {
+			switch v := v.(*NewOrderSingleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8444,7 +9670,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderBulkReplaceResponse); i {
+			switch v := v.(*NewOrderBulkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8456,7 +9682,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderCancelRequest); i {
+			switch v := v.(*NewOrderBulkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8468,7 +9694,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderCancelResponse); i {
+			switch v := v.(*OrderUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8480,7 +9706,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderMassCancelRequest); i {
+			switch v := v.(*OrderReplaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8492,7 +9718,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderMassCancelResponse); i {
+			switch v := v.(*OrderReplaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8504,7 +9730,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HistoricalProtocolAssetTransferRequest); i {
+			switch v := v.(*OrderBulkReplaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8516,7 +9742,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HistoricalProtocolAssetTransferResponse); i {
+			switch v := v.(*OrderBulkReplaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8528,7 +9754,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtocolAssetDataRequest); i {
+			switch v := v.(*OrderCancelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8540,7 +9766,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtocolAssetDataResponse); i {
+			switch v := v.(*OrderCancelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8552,7 +9778,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtocolAssetDataIncrementalRefresh); i {
+			switch v := v.(*OrderMassCancelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8564,7 +9790,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtocolAssetDefinitionRequest); i {
+			switch v := v.(*OrderMassCancelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8576,7 +9802,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtocolAssetDefinitionResponse); i {
+			switch v := v.(*HistoricalProtocolAssetTransferRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8588,7 +9814,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtocolAssetListRequest); i {
+			switch v := v.(*HistoricalProtocolAssetTransferResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8600,7 +9826,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtocolAssetList); i {
+			switch v := v.(*ProtocolAssetDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8612,7 +9838,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketableProtocolAssetListRequest); i {
+			switch v := v.(*ProtocolAssetDataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8624,7 +9850,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketableProtocolAssetList); i {
+			switch v := v.(*ProtocolAssetDataIncrementalRefresh); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8636,7 +9862,7 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketableProtocolAssetDefinitionRequest); i {
+			switch v := v.(*ProtocolAssetDefinitionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8648,6 +9874,78 @@ func file_executor_messages_proto_init() {
 			}
 		}
 		file_executor_messages_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolAssetDefinitionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_messages_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolAssetListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_messages_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolAssetList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_messages_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketableProtocolAssetListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_messages_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketableProtocolAssetList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_messages_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketableProtocolAssetDefinitionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_messages_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarketableProtocolAssetDefinitionResponse); i {
 			case 0:
 				return &v.state
@@ -8659,14 +9957,62 @@ func file_executor_messages_proto_init() {
 				return nil
 			}
 		}
+		file_executor_messages_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_messages_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_messages_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelDataIncrementalRefresh); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_executor_messages_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_executor_messages_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   72,
+			NumEnums:      7,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -914,6 +914,7 @@ type Position struct {
 	Cost             float64                 `protobuf:"fixed64,5,opt,name=cost,proto3" json:"cost,omitempty"`
 	MarkPrice        *wrapperspb.DoubleValue `protobuf:"bytes,6,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
 	MaxNotionalValue *wrapperspb.DoubleValue `protobuf:"bytes,7,opt,name=max_notional_value,json=maxNotionalValue,proto3" json:"max_notional_value,omitempty"`
+	Lp               *LPPosition             `protobuf:"bytes,8,opt,name=lp,proto3" json:"lp,omitempty"`
 }
 
 func (x *Position) Reset() {
@@ -997,6 +998,166 @@ func (x *Position) GetMaxNotionalValue() *wrapperspb.DoubleValue {
 	return nil
 }
 
+func (x *Position) GetLp() *LPPosition {
+	if x != nil {
+		return x.Lp
+	}
+	return nil
+}
+
+// Liquidity provided to a concentrated liquidity pool, amounts are in token units
+// and the price is the price of token0 in token1
+type LPPosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token0     *models.Asset `protobuf:"bytes,2,opt,name=token0,proto3" json:"token0,omitempty"`
+	Token1     *models.Asset `protobuf:"bytes,3,opt,name=token1,proto3" json:"token1,omitempty"`
+	TickLower  int32         `protobuf:"varint,4,opt,name=tick_lower,json=tickLower,proto3" json:"tick_lower,omitempty"`
+	TickUpper  int32         `protobuf:"varint,5,opt,name=tick_upper,json=tickUpper,proto3" json:"tick_upper,omitempty"`
+	Liquidity  float64       `protobuf:"fixed64,6,opt,name=liquidity,proto3" json:"liquidity,omitempty"`
+	Price      float64       `protobuf:"fixed64,7,opt,name=price,proto3" json:"price,omitempty"`
+	Amount0    float64       `protobuf:"fixed64,8,opt,name=amount0,proto3" json:"amount0,omitempty"`
+	Amount1    float64       `protobuf:"fixed64,9,opt,name=amount1,proto3" json:"amount1,omitempty"`
+	Fees0      float64       `protobuf:"fixed64,10,opt,name=fees0,proto3" json:"fees0,omitempty"`
+	Fees1      float64       `protobuf:"fixed64,11,opt,name=fees1,proto3" json:"fees1,omitempty"`
+	Deposited0 float64       `protobuf:"fixed64,12,opt,name=deposited0,proto3" json:"deposited0,omitempty"`
+	Deposited1 float64       `protobuf:"fixed64,13,opt,name=deposited1,proto3" json:"deposited1,omitempty"`
+	Pnl        float64       `protobuf:"fixed64,14,opt,name=pnl,proto3" json:"pnl,omitempty"`
+}
+
+func (x *LPPosition) Reset() {
+	*x = LPPosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_security_data_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LPPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LPPosition) ProtoMessage() {}
+
+func (x *LPPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_security_data_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LPPosition.ProtoReflect.Descriptor instead.
+func (*LPPosition) Descriptor() ([]byte, []int) {
+	return file_security_data_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LPPosition) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LPPosition) GetToken0() *models.Asset {
+	if x != nil {
+		return x.Token0
+	}
+	return nil
+}
+
+func (x *LPPosition) GetToken1() *models.Asset {
+	if x != nil {
+		return x.Token1
+	}
+	return nil
+}
+
+func (x *LPPosition) GetTickLower() int32 {
+	if x != nil {
+		return x.TickLower
+	}
+	return 0
+}
+
+func (x *LPPosition) GetTickUpper() int32 {
+	if x != nil {
+		return x.TickUpper
+	}
+	return 0
+}
+
+func (x *LPPosition) GetLiquidity() float64 {
+	if x != nil {
+		return x.Liquidity
+	}
+	return 0
+}
+
+func (x *LPPosition) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *LPPosition) GetAmount0() float64 {
+	if x != nil {
+		return x.Amount0
+	}
+	return 0
+}
+
+func (x *LPPosition) GetAmount1() float64 {
+	if x != nil {
+		return x.Amount1
+	}
+	return 0
+}
+
+func (x *LPPosition) GetFees0() float64 {
+	if x != nil {
+		return x.Fees0
+	}
+	return 0
+}
+
+func (x *LPPosition) GetFees1() float64 {
+	if x != nil {
+		return x.Fees1
+	}
+	return 0
+}
+
+func (x *LPPosition) GetDeposited0() float64 {
+	if x != nil {
+		return x.Deposited0
+	}
+	return 0
+}
+
+func (x *LPPosition) GetDeposited1() float64 {
+	if x != nil {
+		return x.Deposited1
+	}
+	return 0
+}
+
+func (x *LPPosition) GetPnl() float64 {
+	if x != nil {
+		return x.Pnl
+	}
+	return 0
+}
+
 type Balance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1010,7 +1171,7 @@ type Balance struct {
 func (x *Balance) Reset() {
 	*x = Balance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_security_data_proto_msgTypes[4]
+		mi := &file_security_data_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1023,7 +1184,7 @@ func (x *Balance) String() string {
 func (*Balance) ProtoMessage() {}
 
 func (x *Balance) ProtoReflect() protoreflect.Message {
-	mi := &file_security_data_proto_msgTypes[4]
+	mi := &file_security_data_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1036,7 +1197,7 @@ func (x *Balance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Balance.ProtoReflect.Descriptor instead.
 func (*Balance) Descriptor() ([]byte, []int) {
-	return file_security_data_proto_rawDescGZIP(), []int{4}
+	return file_security_data_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Balance) GetAccount() string {
@@ -1082,7 +1243,7 @@ type TradeCapture struct {
 func (x *TradeCapture) Reset() {
 	*x = TradeCapture{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_security_data_proto_msgTypes[5]
+		mi := &file_security_data_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1095,7 +1256,7 @@ func (x *TradeCapture) String() string {
 func (*TradeCapture) ProtoMessage() {}
 
 func (x *TradeCapture) ProtoReflect() protoreflect.Message {
-	mi := &file_security_data_proto_msgTypes[5]
+	mi := &file_security_data_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1108,7 +1269,7 @@ func (x *TradeCapture) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TradeCapture.ProtoReflect.Descriptor instead.
 func (*TradeCapture) Descriptor() ([]byte, []int) {
-	return file_security_data_proto_rawDescGZIP(), []int{5}
+	return file_security_data_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TradeCapture) GetSide() Side {
@@ -1354,7 +1515,7 @@ var file_security_data_proto_rawDesc = []byte{
 	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
 	0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x22, 0xa7, 0x02, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x6d, 0x65, 0x22, 0xcb, 0x02, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
 	0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e, 0x73,
 	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
@@ -1372,104 +1533,131 @@ var file_security_data_proto_rawDesc = []byte{
 	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
 	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x4e,
-	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x64, 0x0a, 0x07,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
-	0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x22, 0xb6, 0x04, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x70, 0x74,
-	0x75, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52,
-	0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38,
-	0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x49, 0x44, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f,
-	0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a, 0x02,
+	0x6c, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x4c, 0x50, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6c, 0x70,
+	0x22, 0x8e, 0x03, 0x0a, 0x0a, 0x4c, 0x50, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x25, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x30, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x30, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x31,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x31, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x69, 0x63, 0x6b, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x70, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x30, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x30, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x31, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x73, 0x30, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x66, 0x65, 0x65, 0x73, 0x30, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x65, 0x65,
+	0x73, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x66, 0x65, 0x65, 0x73, 0x31, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64, 0x30, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64, 0x30, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64, 0x31, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64, 0x31, 0x12,
+	0x10, 0x0a, 0x03, 0x70, 0x6e, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x6e,
+	0x6c, 0x22, 0x64, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xb6, 0x04, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x5f, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x43, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x49, 0x44, 0x12, 0x45, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x2a, 0x83, 0x01, 0x0a, 0x10,
-	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x00,
-	0x12, 0x0b, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0f, 0x0a,
-	0x0b, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x0c,
-	0x0a, 0x08, 0x45, 0x6e, 0x64, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04,
-	0x48, 0x61, 0x6c, 0x74, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x72, 0x65, 0x61,
-	0x6b, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x10,
-	0x07, 0x2a, 0x58, 0x0a, 0x14, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x74, 0x65, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x64, 0x75, 0x63,
-	0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x4f, 0x6e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x10, 0x02, 0x2a, 0x4a, 0x0a, 0x09, 0x54,
-	0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x65, 0x67, 0x75,
-	0x6c, 0x61, 0x72, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x10, 0x01,
-	0x12, 0x17, 0x0a, 0x13, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x50,
-	0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x10, 0x03, 0x2a, 0x19, 0x0a, 0x04, 0x53, 0x69, 0x64, 0x65, 0x12,
-	0x07, 0x0a, 0x03, 0x42, 0x75, 0x79, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x65, 0x6c, 0x6c,
-	0x10, 0x01, 0x2a, 0xfb, 0x01, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x65, 0x77, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x6c, 0x79, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x10, 0x01,
-	0x12, 0x0a, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04,
-	0x44, 0x6f, 0x6e, 0x65, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x65, 0x64, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64,
-	0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64,
-	0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0x08,
-	0x12, 0x0d, 0x0a, 0x09, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x10, 0x09, 0x12,
-	0x0e, 0x0a, 0x0a, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x77, 0x10, 0x0a, 0x12,
-	0x0e, 0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x10, 0x0b, 0x12,
-	0x0b, 0x0a, 0x07, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x10, 0x0c, 0x12, 0x12, 0x0a, 0x0e,
-	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x10, 0x0d,
-	0x12, 0x0b, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x10, 0x0e, 0x12, 0x11, 0x0a,
-	0x0d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x10, 0x0f,
-	0x2a, 0x7b, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a,
-	0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x10, 0x02, 0x12, 0x0d,
-	0x0a, 0x09, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x10, 0x03, 0x12, 0x12, 0x0a,
-	0x0e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x49, 0x66, 0x54, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x64, 0x10,
-	0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x66, 0x54, 0x6f, 0x75,
-	0x63, 0x68, 0x65, 0x64, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x69,
-	0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x10, 0x06, 0x2a, 0x75, 0x0a,
-	0x0b, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x0b, 0x0a, 0x07,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x47, 0x6f, 0x6f,
-	0x64, 0x54, 0x69, 0x6c, 0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x10, 0x01, 0x12, 0x10, 0x0a,
-	0x0c, 0x41, 0x74, 0x54, 0x68, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12,
-	0x15, 0x0a, 0x11, 0x49, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x6c, 0x4f, 0x72,
-	0x4b, 0x69, 0x6c, 0x6c, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x4f, 0x6e,
-	0x6c, 0x79, 0x10, 0x05, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x2f, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x2d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x45, 0x0a, 0x10, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x2a, 0x83, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x54, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x6e, 0x64, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x10,
+	0x03, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x61, 0x6c, 0x74, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x41,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x10, 0x05, 0x12, 0x09, 0x0a,
+	0x05, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x10, 0x07, 0x2a, 0x58, 0x0a, 0x14, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x18, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x4e,
+	0x6f, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x52, 0x65, 0x64, 0x75, 0x63, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4f, 0x6e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x10, 0x02,
+	0x2a, 0x4a, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x46, 0x6f, 0x72, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x10, 0x02, 0x12, 0x0c,
+	0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x10, 0x03, 0x2a, 0x19, 0x0a, 0x04,
+	0x53, 0x69, 0x64, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x75, 0x79, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x53, 0x65, 0x6c, 0x6c, 0x10, 0x01, 0x2a, 0xfb, 0x01, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x65, 0x77, 0x10, 0x00,
+	0x12, 0x13, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x6c, 0x79, 0x46, 0x69, 0x6c,
+	0x6c, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x10,
+	0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x6f, 0x6e, 0x65, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x64, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x74,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x10, 0x09, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4e,
+	0x65, 0x77, 0x10, 0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x10, 0x0b, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x10,
+	0x0c, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x10, 0x0d, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x10, 0x0e, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c,
+	0x6c, 0x65, 0x64, 0x10, 0x0f, 0x2a, 0x7b, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x10, 0x00, 0x12, 0x09,
+	0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x74, 0x6f,
+	0x70, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x49, 0x66, 0x54, 0x6f, 0x75,
+	0x63, 0x68, 0x65, 0x64, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x49, 0x66, 0x54, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x64, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x54,
+	0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x10, 0x06, 0x2a, 0x75, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x47, 0x6f, 0x6f, 0x64, 0x54, 0x69, 0x6c, 0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x74, 0x54, 0x68, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74,
+	0x65, 0x4f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x46,
+	0x69, 0x6c, 0x6c, 0x4f, 0x72, 0x4b, 0x69, 0x6c, 0x6c, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x50,
+	0x6f, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x05, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74,
+	0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x74, 0x69, 0x63,
+	0x6b, 0x73, 0x2f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1485,7 +1673,7 @@ func file_security_data_proto_rawDescGZIP() []byte {
 }
 
 var file_security_data_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_security_data_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_security_data_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_security_data_proto_goTypes = []interface{}{
 	(InstrumentStatus)(0),          // 0: models.InstrumentStatus
 	(ExecutionInstruction)(0),      // 1: models.ExecutionInstruction
@@ -1498,69 +1686,73 @@ var file_security_data_proto_goTypes = []interface{}{
 	(*Security)(nil),               // 8: models.Security
 	(*Order)(nil),                  // 9: models.Order
 	(*Position)(nil),               // 10: models.Position
-	(*Balance)(nil),                // 11: models.Balance
-	(*TradeCapture)(nil),           // 12: models.TradeCapture
-	(*wrapperspb.UInt64Value)(nil), // 13: google.protobuf.UInt64Value
-	(*models.Exchange)(nil),        // 14: models.Exchange
-	(*wrapperspb.StringValue)(nil), // 15: google.protobuf.StringValue
-	(*models.Asset)(nil),           // 16: models.Asset
-	(*wrapperspb.DoubleValue)(nil), // 17: google.protobuf.DoubleValue
-	(*timestamppb.Timestamp)(nil),  // 18: google.protobuf.Timestamp
-	(*models.Protocol)(nil),        // 19: models.Protocol
-	(*models.Chain)(nil),           // 20: models.Chain
+	(*LPPosition)(nil),             // 11: models.LPPosition
+	(*Balance)(nil),                // 12: models.Balance
+	(*TradeCapture)(nil),           // 13: models.TradeCapture
+	(*wrapperspb.UInt64Value)(nil), // 14: google.protobuf.UInt64Value
+	(*models.Exchange)(nil),        // 15: models.Exchange
+	(*wrapperspb.StringValue)(nil), // 16: google.protobuf.StringValue
+	(*models.Asset)(nil),           // 17: models.Asset
+	(*wrapperspb.DoubleValue)(nil), // 18: google.protobuf.DoubleValue
+	(*timestamppb.Timestamp)(nil),  // 19: google.protobuf.Timestamp
+	(*models.Protocol)(nil),        // 20: models.Protocol
+	(*models.Chain)(nil),           // 21: models.Chain
 }
 var file_security_data_proto_depIdxs = []int32{
-	13, // 0: models.Instrument.securityID:type_name -> google.protobuf.UInt64Value
-	14, // 1: models.Instrument.exchange:type_name -> models.Exchange
-	15, // 2: models.Instrument.symbol:type_name -> google.protobuf.StringValue
-	14, // 3: models.Security.exchange:type_name -> models.Exchange
-	16, // 4: models.Security.underlying:type_name -> models.Asset
-	16, // 5: models.Security.quote_currency:type_name -> models.Asset
+	14, // 0: models.Instrument.securityID:type_name -> google.protobuf.UInt64Value
+	15, // 1: models.Instrument.exchange:type_name -> models.Exchange
+	16, // 2: models.Instrument.symbol:type_name -> google.protobuf.StringValue
+	15, // 3: models.Security.exchange:type_name -> models.Exchange
+	17, // 4: models.Security.underlying:type_name -> models.Asset
+	17, // 5: models.Security.quote_currency:type_name -> models.Asset
 	0,  // 6: models.Security.status:type_name -> models.InstrumentStatus
-	17, // 7: models.Security.min_price_increment:type_name -> google.protobuf.DoubleValue
-	17, // 8: models.Security.round_lot:type_name -> google.protobuf.DoubleValue
-	17, // 9: models.Security.maker_fee:type_name -> google.protobuf.DoubleValue
-	17, // 10: models.Security.taker_fee:type_name -> google.protobuf.DoubleValue
-	17, // 11: models.Security.multiplier:type_name -> google.protobuf.DoubleValue
-	18, // 12: models.Security.maturity_date:type_name -> google.protobuf.Timestamp
-	15, // 13: models.Security.securitySubType:type_name -> google.protobuf.StringValue
-	17, // 14: models.Security.max_limit_quantity:type_name -> google.protobuf.DoubleValue
-	17, // 15: models.Security.max_market_quantity:type_name -> google.protobuf.DoubleValue
-	17, // 16: models.Security.min_limit_quantity:type_name -> google.protobuf.DoubleValue
-	17, // 17: models.Security.min_market_quantity:type_name -> google.protobuf.DoubleValue
-	18, // 18: models.Security.creation_date:type_name -> google.protobuf.Timestamp
-	13, // 19: models.Security.creation_block:type_name -> google.protobuf.UInt64Value
-	19, // 20: models.Security.protocol:type_name -> models.Protocol
-	20, // 21: models.Security.chain:type_name -> models.Chain
-	17, // 22: models.Security.price:type_name -> google.protobuf.DoubleValue
-	17, // 23: models.Security.strike_price:type_name -> google.protobuf.DoubleValue
-	16, // 24: models.Security.strike_currency:type_name -> models.Asset
+	18, // 7: models.Security.min_price_increment:type_name -> google.protobuf.DoubleValue
+	18, // 8: models.Security.round_lot:type_name -> google.protobuf.DoubleValue
+	18, // 9: models.Security.maker_fee:type_name -> google.protobuf.DoubleValue
+	18, // 10: models.Security.taker_fee:type_name -> google.protobuf.DoubleValue
+	18, // 11: models.Security.multiplier:type_name -> google.protobuf.DoubleValue
+	19, // 12: models.Security.maturity_date:type_name -> google.protobuf.Timestamp
+	16, // 13: models.Security.securitySubType:type_name -> google.protobuf.StringValue
+	18, // 14: models.Security.max_limit_quantity:type_name -> google.protobuf.DoubleValue
+	18, // 15: models.Security.max_market_quantity:type_name -> google.protobuf.DoubleValue
+	18, // 16: models.Security.min_limit_quantity:type_name -> google.protobuf.DoubleValue
+	18, // 17: models.Security.min_market_quantity:type_name -> google.protobuf.DoubleValue
+	19, // 18: models.Security.creation_date:type_name -> google.protobuf.Timestamp
+	14, // 19: models.Security.creation_block:type_name -> google.protobuf.UInt64Value
+	20, // 20: models.Security.protocol:type_name -> models.Protocol
+	21, // 21: models.Security.chain:type_name -> models.Chain
+	18, // 22: models.Security.price:type_name -> google.protobuf.DoubleValue
+	18, // 23: models.Security.strike_price:type_name -> google.protobuf.DoubleValue
+	17, // 24: models.Security.strike_currency:type_name -> models.Asset
 	7,  // 25: models.Order.instrument:type_name -> models.Instrument
 	4,  // 26: models.Order.order_status:type_name -> models.OrderStatus
 	5,  // 27: models.Order.order_type:type_name -> models.OrderType
 	3,  // 28: models.Order.side:type_name -> models.Side
 	6,  // 29: models.Order.time_in_force:type_name -> models.TimeInForce
-	17, // 30: models.Order.price:type_name -> google.protobuf.DoubleValue
+	18, // 30: models.Order.price:type_name -> google.protobuf.DoubleValue
 	1,  // 31: models.Order.execution_instructions:type_name -> models.ExecutionInstruction
-	18, // 32: models.Order.creation_time:type_name -> google.protobuf.Timestamp
-	18, // 33: models.Order.last_event_time:type_name -> google.protobuf.Timestamp
+	19, // 32: models.Order.creation_time:type_name -> google.protobuf.Timestamp
+	19, // 33: models.Order.last_event_time:type_name -> google.protobuf.Timestamp
 	7,  // 34: models.Position.instrument:type_name -> models.Instrument
-	17, // 35: models.Position.mark_price:type_name -> google.protobuf.DoubleValue
-	17, // 36: models.Position.max_notional_value:type_name -> google.protobuf.DoubleValue
-	16, // 37: models.Balance.asset:type_name -> models.Asset
-	3,  // 38: models.TradeCapture.side:type_name -> models.Side
-	2,  // 39: models.TradeCapture.type:type_name -> models.TradeType
-	16, // 40: models.TradeCapture.commission_asset:type_name -> models.Asset
-	7,  // 41: models.TradeCapture.instrument:type_name -> models.Instrument
-	15, // 42: models.TradeCapture.trade_LinkID:type_name -> google.protobuf.StringValue
-	15, // 43: models.TradeCapture.orderID:type_name -> google.protobuf.StringValue
-	15, // 44: models.TradeCapture.client_orderID:type_name -> google.protobuf.StringValue
-	18, // 45: models.TradeCapture.transaction_time:type_name -> google.protobuf.Timestamp
-	46, // [46:46] is the sub-list for method output_type
-	46, // [46:46] is the sub-list for method input_type
-	46, // [46:46] is the sub-list for extension type_name
-	46, // [46:46] is the sub-list for extension extendee
-	0,  // [0:46] is the sub-list for field type_name
+	18, // 35: models.Position.mark_price:type_name -> google.protobuf.DoubleValue
+	18, // 36: models.Position.max_notional_value:type_name -> google.protobuf.DoubleValue
+	11, // 37: models.Position.lp:type_name -> models.LPPosition
+	17, // 38: models.LPPosition.token0:type_name -> models.Asset
+	17, // 39: models.LPPosition.token1:type_name -> models.Asset
+	17, // 40: models.Balance.asset:type_name -> models.Asset
+	3,  // 41: models.TradeCapture.side:type_name -> models.Side
+	2,  // 42: models.TradeCapture.type:type_name -> models.TradeType
+	17, // 43: models.TradeCapture.commission_asset:type_name -> models.Asset
+	7,  // 44: models.TradeCapture.instrument:type_name -> models.Instrument
+	16, // 45: models.TradeCapture.trade_LinkID:type_name -> google.protobuf.StringValue
+	16, // 46: models.TradeCapture.orderID:type_name -> google.protobuf.StringValue
+	16, // 47: models.TradeCapture.client_orderID:type_name -> google.protobuf.StringValue
+	19, // 48: models.TradeCapture.transaction_time:type_name -> google.protobuf.Timestamp
+	49, // [49:49] is the sub-list for method output_type
+	49, // [49:49] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_security_data_proto_init() }
@@ -1618,7 +1810,7 @@ func file_security_data_proto_init() {
 			}
 		}
 		file_security_data_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Balance); i {
+			switch v := v.(*LPPosition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1630,6 +1822,18 @@ func file_security_data_proto_init() {
 			}
 		}
 		file_security_data_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Balance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_security_data_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TradeCapture); i {
 			case 0:
 				return &v.state
@@ -1648,7 +1852,7 @@ func file_security_data_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_security_data_proto_rawDesc,
 			NumEnums:      7,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
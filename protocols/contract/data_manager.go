@@ -0,0 +1,187 @@
+package contract
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/log"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	models2 "gitlab.com/alphaticks/xchanger/models"
+)
+
+// The contract data manager spawns a contract listener and multiplex its events to
+// the actors who subscribed. The subscribers are kept across the restarts of the listener.
+
+type DataManager struct {
+	chain       *models2.Chain
+	address     common.Address
+	eabi        *abi.ABI
+	events      []abi.Event
+	subscribers map[uint64]*actor.PID
+	listener    *actor.PID
+	seqNum      uint64
+	logger      *log.Logger
+}
+
+func NewDataManagerProducer(chain *models2.Chain, address common.Address, eabi *abi.ABI, events []abi.Event) actor.Producer {
+	return func() actor.Actor {
+		return NewDataManager(chain, address, eabi, events)
+	}
+}
+
+func NewDataManager(chain *models2.Chain, address common.Address, eabi *abi.ABI, events []abi.Event) actor.Actor {
+	return &DataManager{
+		chain:   chain,
+		address: address,
+		eabi:    eabi,
+		events:  events,
+		logger:  nil,
+	}
+}
+
+func (state *DataManager) Receive(context actor.Context) {
+	switch context.Message().(type) {
+	case *actor.Started:
+		if err := state.Initialize(context); err != nil {
+			state.logger.Error("error initializing", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor started")
+
+	case *actor.Stopping:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error stopping", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor stopping")
+
+	case *actor.Stopped:
+		state.logger.Info("actor stopped")
+
+	case *actor.Restarting:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error restarting", log.Error(err))
+			// Attention, no panic in restarting or infinite loop
+		}
+		state.logger.Info("actor restarting")
+
+	case *messages.ContractEventDataRequest:
+		if err := state.OnContractEventDataRequest(context); err != nil {
+			state.logger.Error("error processing OnContractEventDataRequest", log.Error(err))
+			panic(err)
+		}
+
+	case *messages.ContractEventDataIncrementalRefresh:
+		if err := state.OnContractEventDataIncrementalRefresh(context); err != nil {
+			state.logger.Error("error processing OnContractEventDataIncrementalRefresh", log.Error(err))
+			panic(err)
+		}
+
+	case *actor.Terminated:
+		if err := state.OnTerminated(context); err != nil {
+			state.logger.Error("error processing OnTerminated", log.Error(err))
+			panic(err)
+		}
+	}
+}
+
+func (state *DataManager) Initialize(context actor.Context) error {
+	state.logger = log.New(
+		log.InfoLevel,
+		"",
+		log.String("ID", context.Self().Id),
+		log.String("type", reflect.TypeOf(*state).String()),
+		log.String("contract", state.address.String()))
+
+	state.subscribers = make(map[uint64]*actor.PID)
+	props := actor.PropsFromProducer(NewListenerProducer(state.chain, state.address, state.eabi, state.events))
+	state.listener = context.Spawn(props)
+	context.Watch(state.listener)
+
+	return nil
+}
+
+func (state *DataManager) OnContractEventDataRequest(context actor.Context) error {
+	req := context.Message().(*messages.ContractEventDataRequest)
+	if req.Unsubscribe {
+		if sub, ok := state.subscribers[req.RequestID]; ok {
+			delete(state.subscribers, req.RequestID)
+			state.unwatch(context, sub)
+		}
+		context.Respond(&messages.ContractEventDataResponse{
+			RequestID:  req.RequestID,
+			ResponseID: uint64(time.Now().UnixNano()),
+			Success:    true,
+			SeqNum:     state.seqNum,
+		})
+		if len(state.subscribers) == 0 {
+			context.Stop(context.Self())
+		}
+		return nil
+	}
+	state.subscribers[req.RequestID] = req.Subscriber
+	context.Watch(req.Subscriber)
+	context.Respond(&messages.ContractEventDataResponse{
+		RequestID:  req.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		Success:    true,
+		SeqNum:     state.seqNum,
+	})
+	return nil
+}
+
+// OnContractEventDataIncrementalRefresh forwards the events of the listener, the sequence
+// numbers being the manager's so that they are continuous across the listener restarts
+func (state *DataManager) OnContractEventDataIncrementalRefresh(context actor.Context) error {
+	refresh := context.Message().(*messages.ContractEventDataIncrementalRefresh)
+	state.seqNum += 1
+	for k, v := range state.subscribers {
+		context.Send(v, &messages.ContractEventDataIncrementalRefresh{
+			RequestID:   k,
+			ResponseID:  uint64(time.Now().UnixNano()),
+			SeqNum:      state.seqNum,
+			BlockNumber: refresh.BlockNumber,
+			BlockTime:   refresh.BlockTime,
+			Removed:     refresh.Removed,
+			Events:      refresh.Events,
+		})
+	}
+	return nil
+}
+
+// unwatch stops watching the subscriber if it has no other subscription
+func (state *DataManager) unwatch(context actor.Context, sub *actor.PID) {
+	for _, v := range state.subscribers {
+		if v.String() == sub.String() {
+			return
+		}
+	}
+	context.Unwatch(sub)
+}
+
+func (state *DataManager) Clean(context actor.Context) error {
+	return nil
+}
+
+func (state *DataManager) OnTerminated(context actor.Context) error {
+	msg := context.Message().(*actor.Terminated)
+	if msg.Who.String() == state.listener.String() {
+		// The supervisor gave up on the listener
+		context.Stop(context.Self())
+		return nil
+	}
+	// Handle subscriber krash
+	for k, v := range state.subscribers {
+		if v.String() == msg.Who.String() {
+			delete(state.subscribers, k)
+		}
+	}
+	if len(state.subscribers) == 0 {
+		// Sudoku
+		context.Stop(context.Self())
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package contract
+
+import (
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/ethereum/go-ethereum/common"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/xchanger/models"
+)
+
+func TestDataManagerRestart(t *testing.T) {
+	as := actor.NewActorSystem()
+	defer as.Shutdown()
+	eabi, events, err := ParseEvents(transferABI, []string{"Transfer"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The executor gives back the sequence number of the logs subscription
+	var seqNum uint64
+	listeners := make(chan *actor.PID, 10)
+	_, err = as.Root.SpawnNamed(actor.PropsFromFunc(func(c actor.Context) {
+		if req, ok := c.Message().(*messages.EVMLogsSubscribeRequest); ok {
+			c.Respond(&messages.EVMLogsSubscribeResponse{
+				RequestID: req.RequestID,
+				Success:   true,
+				SeqNum:    seqNum,
+			})
+			listeners <- req.Subscriber
+		}
+	}), "executor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	refreshes := make(chan *messages.ContractEventDataIncrementalRefresh, 10)
+	subscriber := as.Root.Spawn(actor.PropsFromFunc(func(c actor.Context) {
+		if refresh, ok := c.Message().(*messages.ContractEventDataIncrementalRefresh); ok {
+			refreshes <- refresh
+		}
+	}))
+	chain := &models.Chain{ID: 1, Name: "test"}
+	manager := as.Root.Spawn(actor.PropsFromProducer(NewDataManagerProducer(chain, common.Address{1}, eabi, events)))
+	stopped := make(chan struct{})
+	as.Root.Spawn(actor.PropsFromFunc(func(c actor.Context) {
+		switch c.Message().(type) {
+		case *actor.Started:
+			c.Watch(manager)
+		case *actor.Terminated:
+			close(stopped)
+		}
+	}))
+
+	res, err := as.Root.RequestFuture(manager, &messages.ContractEventDataRequest{
+		RequestID:  1,
+		Subscriber: subscriber,
+	}, time.Second).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.(*messages.ContractEventDataResponse).Success {
+		t.Fatalf("was expecting a successful subscription")
+	}
+	waitListener := func() *actor.PID {
+		select {
+		case pid := <-listeners:
+			return pid
+		case <-time.After(3 * time.Second):
+			t.Fatalf("timed-out waiting for the listener")
+		}
+		return nil
+	}
+	waitRefresh := func() *messages.ContractEventDataIncrementalRefresh {
+		select {
+		case refresh := <-refreshes:
+			return refresh
+		case <-time.After(3 * time.Second):
+			t.Fatalf("timed-out waiting for a refresh")
+		}
+		return nil
+	}
+
+	listener := waitListener()
+	as.Root.Send(listener, &messages.EVMLogsSubscribeRefresh{SeqNum: 1, Update: &messages.EVMLogs{BlockNumber: 1}})
+	if refresh := waitRefresh(); refresh.RequestID != 1 || refresh.SeqNum != 1 || refresh.BlockNumber != 1 {
+		t.Fatalf("unexpected refresh %v", refresh)
+	}
+
+	// A gap in the logs restarts the listener, the subscription is kept
+	seqNum = 3
+	as.Root.Send(listener, &messages.EVMLogsSubscribeRefresh{SeqNum: 3})
+	listener = waitListener()
+	as.Root.Send(listener, &messages.EVMLogsSubscribeRefresh{SeqNum: 4, Update: &messages.EVMLogs{BlockNumber: 4}})
+	if refresh := waitRefresh(); refresh.RequestID != 1 || refresh.SeqNum != 2 || refresh.BlockNumber != 4 {
+		t.Fatalf("unexpected refresh after restart %v", refresh)
+	}
+
+	res, err = as.Root.RequestFuture(manager, &messages.ContractEventDataRequest{
+		RequestID:   1,
+		Unsubscribe: true,
+	}, time.Second).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.(*messages.ContractEventDataResponse).Success {
+		t.Fatalf("was expecting a successful unsubscription")
+	}
+	select {
+	case <-stopped:
+	case <-time.After(3 * time.Second):
+		t.Fatalf("was expecting the manager to stop without subscriber")
+	}
+}
@@ -0,0 +1,298 @@
+package contract
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/log"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	models2 "gitlab.com/alphaticks/xchanger/models"
+)
+
+// The contract listener follows the events of a contract, decodes them with its ABI
+// and sends them to its data manager
+
+type checkTimeout struct{}
+
+type Listener struct {
+	chain           *models2.Chain
+	address         common.Address
+	eabi            *abi.ABI
+	events          map[common.Hash]abi.Event
+	executor        *actor.PID
+	seqNum          uint64
+	lastRefreshTime time.Time
+	logger          *log.Logger
+	timeoutTicker   *time.Ticker
+}
+
+func NewListenerProducer(chain *models2.Chain, address common.Address, eabi *abi.ABI, events []abi.Event) actor.Producer {
+	return func() actor.Actor {
+		return NewListener(chain, address, eabi, events)
+	}
+}
+
+func NewListener(chain *models2.Chain, address common.Address, eabi *abi.ABI, events []abi.Event) actor.Actor {
+	evs := make(map[common.Hash]abi.Event)
+	for _, e := range events {
+		evs[e.ID] = e
+	}
+	return &Listener{
+		chain:   chain,
+		address: address,
+		eabi:    eabi,
+		events:  evs,
+		logger:  nil,
+	}
+}
+
+func (state *Listener) Receive(context actor.Context) {
+	switch context.Message().(type) {
+	case *actor.Started:
+		if err := state.Initialize(context); err != nil {
+			state.logger.Error("error initializing", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor started")
+	case *actor.Stopping:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error stopping", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor stopping")
+	case *actor.Stopped:
+		state.logger.Info("actor stopped")
+	case *actor.Restarting:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error restarting", log.Error(err))
+			// No panic or we get an infinite loop
+		}
+		state.logger.Info("actor restarting")
+
+	case *messages.EVMLogsSubscribeRefresh:
+		if err := state.OnEVMLogsSubscribeRefresh(context); err != nil {
+			state.logger.Error("error processing OnEVMLogsSubscribeRefresh", log.Error(err))
+			panic(err)
+		}
+	case *checkTimeout:
+		if err := state.onCheckTimeout(context); err != nil {
+			state.logger.Error("error processing onCheckTimeout", log.Error(err))
+			panic(err)
+		}
+	}
+}
+
+func (state *Listener) Initialize(context actor.Context) error {
+	state.logger = log.New(
+		log.InfoLevel,
+		"",
+		log.String("ID", context.Self().Id),
+		log.String("type", reflect.TypeOf(*state).String()),
+		log.String("contract", state.address.String()),
+		log.String("chain", state.chain.Name),
+	)
+	state.executor = actor.NewPID(context.ActorSystem().Address(), "executor")
+
+	var ids []interface{}
+	for id := range state.events {
+		ids = append(ids, id)
+	}
+	topics, err := abi.MakeTopics(ids)
+	if err != nil {
+		return fmt.Errorf("error making topics: %v", err)
+	}
+	res, err := context.RequestFuture(state.executor, &messages.EVMLogsSubscribeRequest{
+		RequestID: state.subscriptionID(),
+		Chain:     state.chain,
+		Query: ethereum.FilterQuery{
+			Addresses: []common.Address{state.address},
+			Topics:    topics,
+		},
+		Subscriber: context.Self(),
+	}, 10*time.Second).Result()
+	if err != nil {
+		return fmt.Errorf("error subscribing to EVM logs: %v", err)
+	}
+	subRes, ok := res.(*messages.EVMLogsSubscribeResponse)
+	if !ok {
+		return fmt.Errorf("was expecting EVMLogsSubscribeResponse, got %s", reflect.TypeOf(res).String())
+	}
+	if !subRes.Success {
+		return fmt.Errorf("error subscribing to EVM logs: %s", subRes.RejectionReason.String())
+	}
+	state.seqNum = subRes.SeqNum
+
+	state.lastRefreshTime = time.Now()
+	timeoutTicker := time.NewTicker(5 * time.Second)
+	state.timeoutTicker = timeoutTicker
+	go func(pid *actor.PID) {
+		for {
+			select {
+			case <-timeoutTicker.C:
+				context.Send(pid, &checkTimeout{})
+			case <-time.After(15 * time.Second):
+				if state.timeoutTicker != timeoutTicker {
+					// Only stop if socket ticker has changed
+					return
+				}
+			}
+		}
+	}(context.Self())
+
+	return nil
+}
+
+func (state *Listener) OnEVMLogsSubscribeRefresh(context actor.Context) error {
+	refresh := context.Message().(*messages.EVMLogsSubscribeRefresh)
+	if refresh.SeqNum <= state.seqNum {
+		return nil
+	}
+	if refresh.SeqNum != state.seqNum+1 {
+		return fmt.Errorf("out of order sequence")
+	}
+	state.seqNum = refresh.SeqNum
+	state.lastRefreshTime = time.Now()
+
+	var events []*messages.ContractEvent
+	var blockNumber uint64
+	var blockTime time.Time
+	var removed bool
+	if refresh.Update != nil {
+		blockNumber = refresh.Update.BlockNumber
+		blockTime = refresh.Update.BlockTime
+		removed = refresh.Update.Removed
+		for _, l := range refresh.Update.Logs {
+			ev, err := state.decodeLog(l)
+			if err != nil {
+				// Skip it, it would fail again on replay
+				state.logger.Warn("error decoding log", log.Error(err), log.String("tx", l.TxHash.String()))
+				continue
+			}
+			if ev != nil {
+				events = append(events, ev)
+			}
+		}
+	}
+	context.Send(context.Parent(), &messages.ContractEventDataIncrementalRefresh{
+		ResponseID:  uint64(time.Now().UnixNano()),
+		SeqNum:      state.seqNum,
+		BlockNumber: blockNumber,
+		BlockTime:   blockTime,
+		Removed:     removed,
+		Events:      events,
+	})
+	return nil
+}
+
+// subscriptionID returns the request ID of the logs subscription, derived from the contract
+// and its events so that a restarted listener gets back its subscription instead of opening
+// a new one
+func (state *Listener) subscriptionID() uint64 {
+	ids := make([]string, 0, len(state.events))
+	for id := range state.events {
+		ids = append(ids, id.Hex())
+	}
+	sort.Strings(ids)
+	h := fnv.New64a()
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, state.chain.ID)
+	h.Write(b)
+	h.Write(state.address[:])
+	for _, id := range ids {
+		h.Write([]byte(id))
+	}
+	return h.Sum64()
+}
+
+// decodeLog decodes the indexed and non-indexed arguments of the log, nil if the log
+// is not one of the followed events
+func (state *Listener) decodeLog(l types.Log) (*messages.ContractEvent, error) {
+	if len(l.Topics) == 0 {
+		return nil, nil
+	}
+	ev, ok := state.events[l.Topics[0]]
+	if !ok {
+		return nil, nil
+	}
+	values := make(map[string]interface{})
+	if len(l.Data) > 0 {
+		if err := state.eabi.UnpackIntoMap(values, ev.Name, l.Data); err != nil {
+			return nil, fmt.Errorf("error unpacking %s log: %v", ev.Name, err)
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range ev.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(values, indexed, l.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("error parsing %s topics: %v", ev.Name, err)
+	}
+	return &messages.ContractEvent{
+		Name:     ev.Name,
+		Address:  l.Address,
+		TxHash:   l.TxHash,
+		LogIndex: l.Index,
+		Values:   values,
+	}, nil
+}
+
+func (state *Listener) Clean(context actor.Context) error {
+	if state.timeoutTicker != nil {
+		state.timeoutTicker.Stop()
+		state.timeoutTicker = nil
+	}
+	return nil
+}
+
+func (state *Listener) onCheckTimeout(context actor.Context) error {
+	if time.Since(state.lastRefreshTime) > 30*time.Second {
+		return fmt.Errorf("timed-out")
+	}
+	return nil
+}
+
+// ParseEvents parses the ABI and returns the named events, all the events of the ABI when none is named
+func ParseEvents(abiJSON string, names []string) (*abi.ABI, []abi.Event, error) {
+	eabi, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing abi: %v", err)
+	}
+	var events []abi.Event
+	if len(names) == 0 {
+		for _, e := range eabi.Events {
+			if !e.Anonymous {
+				events = append(events, e)
+			}
+		}
+	} else {
+		for _, n := range names {
+			e, ok := eabi.Events[n]
+			if !ok {
+				return nil, nil, fmt.Errorf("unknown event %s", n)
+			}
+			if e.Anonymous {
+				return nil, nil, fmt.Errorf("anonymous event %s", n)
+			}
+			events = append(events, e)
+		}
+	}
+	if len(events) == 0 {
+		return nil, nil, fmt.Errorf("no event to follow")
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Sig < events[j].Sig
+	})
+	return &eabi, events, nil
+}
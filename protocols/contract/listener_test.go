@@ -0,0 +1,89 @@
+package contract
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"gitlab.com/alphaticks/xchanger/models"
+)
+
+const transferABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"name":"owner","type":"address"},{"indexed":true,"name":"spender","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Approval","type":"event"}]`
+
+func TestDecodeLog(t *testing.T) {
+	if _, _, err := ParseEvents(transferABI, []string{"Deposit"}); err == nil {
+		t.Fatalf("was expecting an error on unknown event")
+	}
+	_, events, err := ParseEvents(transferABI, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("was expecting all the events of the abi, got %d", len(events))
+	}
+	eabi, events, err := ParseEvents(transferABI, []string{"Transfer"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := NewListener(nil, common.Address{1}, eabi, events).(*Listener)
+
+	from := common.Address{2}
+	to := common.Address{3}
+	data, err := eabi.Events["Transfer"].Inputs.NonIndexed().Pack(big.NewInt(1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev, err := state.decodeLog(types.Log{
+		Address: common.Address{1},
+		Topics: []common.Hash{
+			eabi.Events["Transfer"].ID,
+			common.BytesToHash(from[:]),
+			common.BytesToHash(to[:]),
+		},
+		Data:  data,
+		Index: 4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev == nil || ev.Name != "Transfer" || ev.LogIndex != 4 {
+		t.Fatalf("unexpected event %v", ev)
+	}
+	if ev.Values["from"].(common.Address) != from || ev.Values["to"].(common.Address) != to {
+		t.Fatalf("unexpected indexed values %v", ev.Values)
+	}
+	if ev.Values["value"].(*big.Int).Int64() != 1000 {
+		t.Fatalf("unexpected value %v", ev.Values["value"])
+	}
+
+	// Not followed
+	ev, err = state.decodeLog(types.Log{
+		Topics: []common.Hash{eabi.Events["Approval"].ID, common.BytesToHash(from[:]), common.BytesToHash(to[:])},
+		Data:   data,
+	})
+	if err != nil || ev != nil {
+		t.Fatalf("was expecting the approval to be ignored")
+	}
+}
+
+func TestSubscriptionID(t *testing.T) {
+	eabi, events, err := ParseEvents(transferABI, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain := &models.Chain{ID: 1}
+	state := NewListener(chain, common.Address{1}, eabi, events).(*Listener)
+	// Stable across restarts, whatever the order of the events
+	reversed := []abi.Event{events[1], events[0]}
+	if id := NewListener(chain, common.Address{1}, eabi, reversed).(*Listener).subscriptionID(); id != state.subscriptionID() {
+		t.Fatalf("was expecting the same subscription ID, got %d and %d", id, state.subscriptionID())
+	}
+	if id := NewListener(chain, common.Address{2}, eabi, events).(*Listener).subscriptionID(); id == state.subscriptionID() {
+		t.Fatalf("was expecting another subscription ID for another contract")
+	}
+	if id := NewListener(chain, common.Address{1}, eabi, events[:1]).(*Listener).subscriptionID(); id == state.subscriptionID() {
+		t.Fatalf("was expecting another subscription ID for other events")
+	}
+}
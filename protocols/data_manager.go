@@ -9,6 +9,7 @@ import (
 	"github.com/asynkron/protoactor-go/actor"
 	"github.com/asynkron/protoactor-go/log"
 	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gorm.io/gorm"
 )
 
 // The market data manager spawns an instrument listener and multiplex its messages
@@ -18,18 +19,20 @@ type DataManager struct {
 	subscribers map[uint64]*actor.PID
 	listener    *actor.PID
 	asset       *models.ProtocolAsset
+	db          *gorm.DB
 	logger      *log.Logger
 }
 
-func NewDataManagerProducer(protocolAsset *models.ProtocolAsset) actor.Producer {
+func NewDataManagerProducer(protocolAsset *models.ProtocolAsset, db *gorm.DB) actor.Producer {
 	return func() actor.Actor {
-		return NewDataManager(protocolAsset)
+		return NewDataManager(protocolAsset, db)
 	}
 }
 
-func NewDataManager(protocolAsset *models.ProtocolAsset) actor.Actor {
+func NewDataManager(protocolAsset *models.ProtocolAsset, db *gorm.DB) actor.Actor {
 	return &DataManager{
 		asset:  protocolAsset,
+		db:     db,
 		logger: nil,
 	}
 }
@@ -94,7 +97,7 @@ func (state *DataManager) Initialize(context actor.Context) error {
 		log.String("type", reflect.TypeOf(*state).String()))
 
 	state.subscribers = make(map[uint64]*actor.PID)
-	producer := NewProtocolAssetListenerProducer(state.asset)
+	producer := NewProtocolAssetListenerProducer(state.asset, state.db)
 	if producer == nil {
 		return fmt.Errorf("error getting asset listener")
 	}
@@ -0,0 +1,77 @@
+package erc1155
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gorderbook "gitlab.com/alphaticks/gorderbook/gorderbook.models"
+	"gitlab.com/alphaticks/xchanger/chains/evm"
+)
+
+// ERC1155ABI is the subset of the ERC-1155 ABI holding the transfer events
+const ERC1155ABI = `[
+	{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"operator","type":"address"},{"indexed":true,"internalType":"address","name":"from","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":false,"internalType":"uint256","name":"id","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"value","type":"uint256"}],"name":"TransferSingle","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"operator","type":"address"},{"indexed":true,"internalType":"address","name":"from","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":false,"internalType":"uint256[]","name":"ids","type":"uint256[]"},{"indexed":false,"internalType":"uint256[]","name":"values","type":"uint256[]"}],"name":"TransferBatch","type":"event"}
+]`
+
+type ERC1155TransferSingle struct {
+	Operator common.Address
+	From     common.Address
+	To       common.Address
+	Id       *big.Int
+	Value    *big.Int
+}
+
+type ERC1155TransferBatch struct {
+	Operator common.Address
+	From     common.Address
+	To       common.Address
+	Ids      []*big.Int
+	Values   []*big.Int
+}
+
+// unpackTransfers returns the transfers of a TransferSingle or TransferBatch log along with
+// their token ids, the value of a transfer being the amount of the token transferred
+func unpackTransfers(eabi *abi.ABI, l types.Log) ([]*gorderbook.AssetTransfer, [][]byte, error) {
+	if len(l.Topics) != 4 {
+		return nil, nil, nil
+	}
+	var from, to common.Address
+	var ids, values []*big.Int
+	switch l.Topics[0] {
+	case eabi.Events["TransferSingle"].ID:
+		event := new(ERC1155TransferSingle)
+		if err := evm.UnpackLog(eabi, event, "TransferSingle", l); err != nil {
+			return nil, nil, fmt.Errorf("error unpacking log: %v", err)
+		}
+		from, to = event.From, event.To
+		ids, values = []*big.Int{event.Id}, []*big.Int{event.Value}
+	case eabi.Events["TransferBatch"].ID:
+		event := new(ERC1155TransferBatch)
+		if err := evm.UnpackLog(eabi, event, "TransferBatch", l); err != nil {
+			return nil, nil, fmt.Errorf("error unpacking log: %v", err)
+		}
+		if len(event.Ids) != len(event.Values) {
+			return nil, nil, fmt.Errorf("mismatched ids and values array length")
+		}
+		from, to = event.From, event.To
+		ids, values = event.Ids, event.Values
+	default:
+		return nil, nil, nil
+	}
+	transfers := make([]*gorderbook.AssetTransfer, len(ids))
+	tokenIDs := make([][]byte, len(ids))
+	for i := range ids {
+		transfers[i] = &gorderbook.AssetTransfer{
+			From:     from[:],
+			To:       to[:],
+			Value:    values[i].Bytes(),
+			Contract: l.Address.Bytes(),
+		}
+		tokenIDs[i] = ids[i].Bytes()
+	}
+	return transfers, tokenIDs, nil
+}
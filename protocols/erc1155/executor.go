@@ -0,0 +1,305 @@
+package erc1155
+
+import (
+	goContext "context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/log"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	extype "gitlab.com/alphaticks/alpha-connect/protocols/types"
+	"gitlab.com/alphaticks/alpha-connect/utils"
+	registry "gitlab.com/alphaticks/alpha-public-registry-grpc"
+	"gitlab.com/alphaticks/xchanger/constants"
+	models2 "gitlab.com/alphaticks/xchanger/models"
+)
+
+type Executor struct {
+	extype.BaseExecutor
+	protocol       *models2.Protocol
+	executor       *actor.PID
+	protocolAssets map[uint64]*models.ProtocolAsset
+	eabi           *abi.ABI
+	logger         *log.Logger
+	registry       registry.StaticClient
+}
+
+func NewExecutor(registry registry.StaticClient, protocol *models2.Protocol) actor.Actor {
+	return &Executor{
+		protocolAssets: nil,
+		logger:         nil,
+		registry:       registry,
+		protocol:       protocol,
+	}
+}
+
+func (state *Executor) Receive(context actor.Context) {
+	extype.ReceiveExecutor(state, context)
+}
+
+func (state *Executor) Initialize(context actor.Context) error {
+	state.logger = log.New(
+		log.InfoLevel,
+		"",
+		log.String("ID", context.Self().Id),
+		log.String("type", reflect.TypeOf(*state).String()))
+	state.executor = actor.NewPID(context.ActorSystem().Address(), "executor")
+	eabi, err := abi.JSON(strings.NewReader(ERC1155ABI))
+	if err != nil {
+		return fmt.Errorf("error getting ethereum abi: %v", err)
+	}
+	state.eabi = &eabi
+
+	return state.UpdateProtocolAssetList(context)
+}
+
+func (state *Executor) UpdateProtocolAssetList(context actor.Context) error {
+	if state.registry == nil {
+		return nil
+	}
+	assets := make([]*models.ProtocolAsset, 0)
+	reg := state.registry
+
+	ctx, cancel := goContext.WithTimeout(goContext.Background(), 10*time.Second)
+	defer cancel()
+	filter := registry.ProtocolAssetFilter{
+		ProtocolId: []uint32{state.protocol.ID},
+	}
+	in := registry.ProtocolAssetsRequest{
+		Filter: &filter,
+	}
+	res, err := reg.ProtocolAssets(ctx, &in)
+	if err != nil {
+		return fmt.Errorf("error updating protocol asset list: %v", err)
+	}
+	response := res.ProtocolAssets
+	for _, protocolAsset := range response {
+		if protocolAsset.ContractAddress == nil || len(protocolAsset.ContractAddress.Value) < 2 {
+			state.logger.Warn("invalid protocol asset address")
+			continue
+		}
+		_, ok := big.NewInt(1).SetString(protocolAsset.ContractAddress.Value[2:], 16)
+		if !ok {
+			state.logger.Warn("invalid protocol asset address")
+			continue
+		}
+		as, ok := constants.GetAssetByID(protocolAsset.AssetId)
+		if !ok {
+			state.logger.Warn(fmt.Sprintf("error getting asset with id %d", protocolAsset.AssetId))
+			continue
+		}
+		ch, ok := constants.GetChainByID(protocolAsset.ChainId)
+		if !ok {
+			state.logger.Warn(fmt.Sprintf("error getting chain with id %d", protocolAsset.ChainId))
+			continue
+		}
+		if ch.Type != "EVM" && ch.Type != "ZKEVM" {
+			// multi-token contracts are only followed on EVM chains
+			continue
+		}
+		assets = append(
+			assets,
+			&models.ProtocolAsset{
+				ProtocolAssetID: protocolAsset.ProtocolAssetId,
+				Protocol: &models2.Protocol{
+					ID:   state.protocol.ID,
+					Name: state.protocol.Name,
+				},
+				Asset: &models2.Asset{
+					Name:   as.Name,
+					Symbol: as.Symbol,
+					ID:     as.ID,
+				},
+				Chain: &models2.Chain{
+					ID:   ch.ID,
+					Name: ch.Name,
+					Type: ch.Type,
+				},
+				CreationDate:    protocolAsset.CreationDate,
+				CreationBlock:   protocolAsset.CreationBlock,
+				ContractAddress: protocolAsset.ContractAddress,
+				Decimals:        protocolAsset.Decimals,
+			})
+	}
+	state.protocolAssets = make(map[uint64]*models.ProtocolAsset)
+	for _, a := range assets {
+		state.protocolAssets[a.ProtocolAssetID] = a
+	}
+	context.Send(context.Parent(), &messages.ProtocolAssetList{
+		ResponseID:     uint64(time.Now().UnixNano()),
+		ProtocolAssets: assets,
+		Success:        true,
+	})
+
+	return nil
+}
+
+func (state *Executor) OnProtocolAssetListRequest(context actor.Context) error {
+	req := context.Message().(*messages.ProtocolAssetListRequest)
+	passets := make([]*models.ProtocolAsset, len(state.protocolAssets))
+	i := 0
+	for _, v := range state.protocolAssets {
+		passets[i] = v
+		i += 1
+	}
+	context.Respond(&messages.ProtocolAssetList{
+		RequestID:      req.RequestID,
+		ResponseID:     uint64(time.Now().UnixNano()),
+		Success:        true,
+		ProtocolAssets: passets,
+	})
+	return nil
+}
+
+func (state *Executor) OnHistoricalProtocolAssetTransferRequest(context actor.Context) error {
+	req := context.Message().(*messages.HistoricalProtocolAssetTransferRequest)
+	msg := &messages.HistoricalProtocolAssetTransferResponse{
+		RequestID:  req.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		Success:    false,
+	}
+	var pa *models.ProtocolAsset
+	chain, ok := constants.GetChainByID(req.ChainID)
+	if !ok {
+		msg.RejectionReason = messages.RejectionReason_UnknownChain
+		context.Respond(msg)
+		return nil
+	}
+	if chain.Type != "EVM" && chain.Type != "ZKEVM" {
+		msg.RejectionReason = messages.RejectionReason_UnsupportedRequest
+		context.Respond(msg)
+		return nil
+	}
+	if req.AssetID != nil {
+		var ok bool
+		asset, ok := constants.GetAssetByID(req.AssetID.Value)
+		if !ok {
+			msg.RejectionReason = messages.RejectionReason_UnknownAsset
+			context.Respond(msg)
+			return nil
+		}
+		id := utils.GetProtocolAssetID(asset, state.protocol, chain)
+		pa, ok = state.protocolAssets[id]
+		if !ok {
+			msg.RejectionReason = messages.RejectionReason_UnknownProtocolAsset
+			context.Respond(msg)
+			return nil
+		}
+	}
+	topics := [][]common.Hash{{
+		state.eabi.Events["TransferSingle"].ID,
+		state.eabi.Events["TransferBatch"].ID,
+	}}
+	r := &messages.EVMLogsQueryRequest{
+		RequestID: uint64(time.Now().UnixNano()),
+		Chain:     chain,
+		Query: ethereum.FilterQuery{
+			FromBlock: big.NewInt(1).SetUint64(req.Start),
+			ToBlock:   big.NewInt(1).SetUint64(req.Stop),
+			Topics:    topics,
+		},
+	}
+	if pa != nil {
+		a := common.HexToAddress(pa.ContractAddress.Value)
+		r.Query.Addresses = []common.Address{a}
+	}
+	future := context.RequestFuture(state.executor, r, 20*time.Second)
+	context.ReenterAfter(future, func(res interface{}, err error) {
+		if err != nil {
+			state.logger.Warn("error at rpc server", log.Error(err))
+			switch err.Error() {
+			case "future: timeout":
+				msg.RejectionReason = messages.RejectionReason_RPCTimeout
+			default:
+				msg.RejectionReason = messages.RejectionReason_RPCError
+			}
+			context.Respond(msg)
+			return
+		}
+		resp, ok := res.(*messages.EVMLogsQueryResponse)
+		if !ok {
+			state.logger.Warn("incorrect type, expected EVMLogsQueryResponse", log.String("type", reflect.TypeOf(res).String()))
+			msg.RejectionReason = messages.RejectionReason_Other
+			context.Respond(msg)
+			return
+		}
+		if !resp.Success {
+			state.logger.Warn("error at eth rpc server", log.String("rejection reason", resp.RejectionReason.String()))
+			msg.RejectionReason = messages.RejectionReason_RPCError
+			context.Respond(msg)
+			return
+		}
+		if len(resp.Times) != len(resp.Logs) {
+			state.logger.Warn("mismatched logs and times array length", log.Int("times length", len(resp.Times)), log.Int("events length", len(resp.Logs)))
+			msg.RejectionReason = messages.RejectionReason_Other
+			context.Respond(msg)
+			return
+		}
+		type timedLog struct {
+			log  types.Log
+			time uint64
+		}
+		logs := make([]timedLog, len(resp.Logs))
+		for i, l := range resp.Logs {
+			logs[i] = timedLog{log: l, time: resp.Times[i]}
+		}
+		sort.Slice(logs, func(i, j int) bool {
+			if logs[i].log.BlockNumber == logs[j].log.BlockNumber {
+				return logs[i].log.Index < logs[j].log.Index
+			}
+			return logs[i].log.BlockNumber < logs[j].log.BlockNumber
+		})
+
+		events := make([]*models.ProtocolAssetUpdate, 0)
+		var update *models.ProtocolAssetUpdate
+		for _, l := range logs {
+			transfers, tokenIDs, err := unpackTransfers(state.eabi, l.log)
+			if err != nil {
+				state.logger.Warn("error unpacking eth log", log.Error(err))
+				msg.RejectionReason = messages.RejectionReason_RPCError
+				context.Respond(msg)
+				return
+			}
+			if len(transfers) == 0 {
+				continue
+			}
+			if update == nil || update.BlockNumber != l.log.BlockNumber {
+				if update != nil {
+					events = append(events, update)
+				}
+				update = &models.ProtocolAssetUpdate{
+					BlockNumber: l.log.BlockNumber,
+					BlockTime:   utils.SecondToTimestamp(l.time),
+				}
+			}
+			update.Transfers = append(update.Transfers, transfers...)
+			update.TokenIds = append(update.TokenIds, tokenIDs...)
+		}
+		if update != nil {
+			events = append(events, update)
+		}
+		msg.Update = events
+		msg.Success = true
+		msg.SeqNum = uint64(time.Now().UnixNano())
+		context.Respond(msg)
+	})
+	return nil
+}
+
+func (state *Executor) Clean(context actor.Context) error {
+	return nil
+}
+
+func (state *Executor) GetLogger() *log.Logger {
+	return state.logger
+}
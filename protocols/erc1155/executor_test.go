@@ -0,0 +1,133 @@
+package erc1155_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/alphaticks/alpha-connect/config"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	exTests "gitlab.com/alphaticks/alpha-connect/tests"
+	"gitlab.com/alphaticks/xchanger/constants"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestExecutorEVM(t *testing.T) {
+	exTests.LoadStatics(t)
+	testExecutor(t, 1, 15000000, 15001000)
+}
+
+func TestExecutorZKEVM(t *testing.T) {
+	exTests.LoadStatics(t)
+	testExecutor(t, 6, 3000, 4200)
+}
+
+func testExecutor(t *testing.T, chainID uint32, start, stop uint64) {
+	cfg, err := config.LoadConfig()
+	if !assert.Nil(t, err, "LoadConfig err: %v", err) {
+		t.Fatal()
+	}
+	cfg.RegistryAddress = "127.0.0.1:8001"
+	cfg.Protocols = []string{"ERC-1155"}
+	as, executor, clean := exTests.StartExecutor(t, cfg)
+	defer clean()
+
+	chain, ok := constants.GetChainByID(chainID)
+	if !assert.True(t, ok, "missing chain") {
+		t.Fatal()
+	}
+	res, err := as.Root.RequestFuture(executor, &messages.ProtocolAssetListRequest{}, 20*time.Second).Result()
+	if !assert.Nil(t, err, "RequestFuture ProtocolAssetList err: %v", err) {
+		t.Fatal()
+	}
+	assets, ok := res.(*messages.ProtocolAssetList)
+	if !assert.True(t, ok, "incorrect type assertion") {
+		t.Fatal()
+	}
+	var coll *models.ProtocolAsset
+	for _, asset := range assets.ProtocolAssets {
+		if asset.Chain.ID == chain.ID {
+			coll = asset
+			break
+		}
+	}
+	if !assert.NotNil(t, coll, "missing collection") {
+		t.Fatal()
+	}
+
+	var step uint64 = 99
+	var updates []*models.ProtocolAssetUpdate
+	for end := start; end < stop; start += step + 1 {
+		end = start + step
+		if end > stop {
+			end = stop
+		}
+		resp, err := as.Root.RequestFuture(
+			executor,
+			&messages.HistoricalProtocolAssetTransferRequest{
+				RequestID:  uint64(time.Now().UnixNano()),
+				ProtocolID: coll.Protocol.ID,
+				ChainID:    chain.ID,
+				Start:      start,
+				Stop:       end,
+			},
+			30*time.Second,
+		).Result()
+		if !assert.Nil(t, err, "RequestFuture HistoricalProtocolAssetTransferRequest err: %v", err) {
+			t.Fatal()
+		}
+		response, ok := resp.(*messages.HistoricalProtocolAssetTransferResponse)
+		if !assert.True(t, ok, "expected HistoricalProtocolAssetTransferResponse, got %s", reflect.TypeOf(resp).String()) {
+			t.Fatal()
+		}
+		if !assert.True(t, response.Success, "request failed with %s", response.RejectionReason.String()) {
+			t.Fatal()
+		}
+		updates = append(updates, response.Update...)
+	}
+	if !assert.Greater(t, len(updates), 0, "expected transfers") {
+		t.Fatal()
+	}
+	for i, u := range updates {
+		if !assert.Equal(t, len(u.Transfers), len(u.TokenIds), "expected a token id per transfer") {
+			t.Fatal()
+		}
+		if i > 0 && !assert.Greater(t, u.BlockNumber, updates[i-1].BlockNumber, "expected increasing blocks") {
+			t.Fatal()
+		}
+	}
+
+	// The transfers of a specific collection
+	resp, err := as.Root.RequestFuture(
+		executor,
+		&messages.HistoricalProtocolAssetTransferRequest{
+			RequestID:  uint64(time.Now().UnixNano()),
+			ProtocolID: coll.Protocol.ID,
+			ChainID:    chain.ID,
+			AssetID:    &wrapperspb.UInt32Value{Value: coll.Asset.ID},
+			Start:      stop - step,
+			Stop:       stop,
+		},
+		30*time.Second,
+	).Result()
+	if !assert.Nil(t, err, "RequestFuture HistoricalProtocolAssetTransferRequest err: %v", err) {
+		t.Fatal()
+	}
+	response, ok := resp.(*messages.HistoricalProtocolAssetTransferResponse)
+	if !assert.True(t, ok, "expected HistoricalProtocolAssetTransferResponse, got %s", reflect.TypeOf(resp).String()) {
+		t.Fatal()
+	}
+	if !assert.True(t, response.Success, "request failed with %s", response.RejectionReason.String()) {
+		t.Fatal()
+	}
+	for _, u := range response.Update {
+		for _, tx := range u.Transfers {
+			if !assert.Equal(t, coll.ContractAddress.Value, common.BytesToAddress(tx.Contract).String(), "contract address error") {
+				t.Fatal()
+			}
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package erc1155
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/log"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/alpha-connect/protocols/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type checkTimeout struct{}
+
+type Listener struct {
+	types.BaseListener
+	executor        *actor.PID
+	protocolAsset   *models.ProtocolAsset
+	eabi            *abi.ABI
+	seqNum          uint64
+	lastRefreshTime time.Time
+	logger          *log.Logger
+	timeoutTicker   *time.Ticker
+}
+
+func NewListenerProducer(protocolAsset *models.ProtocolAsset) actor.Producer {
+	return func() actor.Actor {
+		return NewListener(protocolAsset)
+	}
+}
+
+func NewListener(protocolAsset *models.ProtocolAsset) actor.Actor {
+	return &Listener{
+		protocolAsset: protocolAsset,
+		logger:        nil,
+	}
+}
+
+func (state *Listener) Receive(context actor.Context) {
+	switch context.Message().(type) {
+	case *actor.Started:
+		if err := state.Initialize(context); err != nil {
+			state.logger.Error("error initializing", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor started")
+	case *actor.Stopping:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error stopping", log.Error(err))
+			panic(err)
+		}
+		state.logger.Info("actor stopped")
+	case *actor.Stopped:
+		state.logger.Info("actor stopped")
+	case *actor.Restarting:
+		if err := state.Clean(context); err != nil {
+			state.logger.Error("error restarting", log.Error(err))
+			// No panic or we get an infinite loop
+		}
+		state.logger.Info("actor restarting")
+
+	case *messages.EVMLogsSubscribeRefresh:
+		if err := state.OnEVMLogsSubscribeRefresh(context); err != nil {
+			state.logger.Error("error processing OnEVMLogsSubscribeRefresh", log.Error(err))
+			panic(err)
+		}
+	case *messages.ProtocolAssetDataRequest:
+		if err := state.OnProtocolAssetDataRequest(context); err != nil {
+			state.logger.Error("error processing OnProtocolAssetDataRequest", log.Error(err))
+			panic(err)
+		}
+	case *checkTimeout:
+		if err := state.onCheckTimeout(context); err != nil {
+			state.logger.Error("error processing onCheckTimeout", log.Error(err))
+			panic(err)
+		}
+	}
+}
+
+func (state *Listener) Initialize(context actor.Context) error {
+	state.logger = log.New(
+		log.InfoLevel,
+		"",
+		log.String("ID", context.Self().Id),
+		log.String("type", reflect.TypeOf(*state).String()),
+		log.String("protocol", "ERC-1155"),
+		log.String("chain", state.protocolAsset.Chain.Type),
+	)
+	state.executor = actor.NewPID(context.ActorSystem().Address(), "executor")
+	switch state.protocolAsset.Chain.Type {
+	case "ZKEVM",
+		"EVM":
+		if err := state.subscribeEVMLogs(context); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported chain type %s", state.protocolAsset.Chain.Type)
+	}
+
+	state.lastRefreshTime = time.Now()
+	timeoutTicker := time.NewTicker(5 * time.Second)
+	state.timeoutTicker = timeoutTicker
+	go func(pid *actor.PID) {
+		for {
+			select {
+			case <-timeoutTicker.C:
+				context.Send(pid, &checkTimeout{})
+			case <-time.After(15 * time.Second):
+				if state.timeoutTicker != timeoutTicker {
+					// Only stop if socket ticker has changed
+					return
+				}
+			}
+		}
+	}(context.Self())
+
+	return nil
+}
+
+func (state *Listener) subscribeEVMLogs(context actor.Context) error {
+	eabi, err := abi.JSON(strings.NewReader(ERC1155ABI))
+	if err != nil {
+		return fmt.Errorf("error getting eth abi: %v", err)
+	}
+	state.eabi = &eabi
+
+	topicsv := [][]interface{}{{
+		state.eabi.Events["TransferSingle"].ID,
+		state.eabi.Events["TransferBatch"].ID,
+	}}
+	topics, err := abi.MakeTopics(topicsv...)
+	if err != nil {
+		return fmt.Errorf("error making topics: %v", err)
+	}
+	query := ethereum.FilterQuery{
+		Topics: topics,
+	}
+	if state.protocolAsset.Asset != nil {
+		a := common.HexToAddress(state.protocolAsset.ContractAddress.Value)
+		query.Addresses = []common.Address{a}
+	}
+
+	res, err := context.RequestFuture(state.executor, &messages.EVMLogsSubscribeRequest{
+		RequestID:  state.protocolAsset.ProtocolAssetID,
+		Chain:      state.protocolAsset.Chain,
+		Query:      query,
+		Subscriber: context.Self(),
+	}, 10*time.Second).Result()
+
+	if err != nil {
+		return fmt.Errorf("error subscribing to EVM logs: %v", err)
+	}
+	subRes, ok := res.(*messages.EVMLogsSubscribeResponse)
+	if !ok {
+		return fmt.Errorf("was expecting EVMLogsSubscribeResponse, got %s", reflect.TypeOf(res).String())
+	}
+	if !subRes.Success {
+		return fmt.Errorf("error subscribing to EVM logs: %s", subRes.RejectionReason.String())
+	}
+	state.seqNum = subRes.SeqNum
+	return nil
+}
+
+func (state *Listener) OnProtocolAssetDataRequest(context actor.Context) error {
+	req := context.Message().(*messages.ProtocolAssetDataRequest)
+	context.Respond(&messages.ProtocolAssetDataResponse{
+		RequestID:  req.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+		Success:    true,
+		SeqNum:     state.seqNum,
+	})
+	return nil
+}
+
+func (state *Listener) OnEVMLogsSubscribeRefresh(context actor.Context) error {
+	refresh := context.Message().(*messages.EVMLogsSubscribeRefresh)
+	if refresh.SeqNum <= state.seqNum {
+		return nil
+	}
+	if refresh.SeqNum != state.seqNum+1 {
+		return fmt.Errorf("out of order sequence")
+	}
+
+	state.seqNum = refresh.SeqNum
+	state.lastRefreshTime = time.Now()
+	var update *models.ProtocolAssetUpdate
+	if refresh.Update != nil {
+		update = &models.ProtocolAssetUpdate{
+			BlockNumber: refresh.Update.BlockNumber,
+			BlockTime:   timestamppb.New(refresh.Update.BlockTime),
+			Removed:     refresh.Update.Removed,
+		}
+		for _, l := range refresh.Update.Logs {
+			transfers, tokenIDs, err := unpackTransfers(state.eabi, l)
+			if err != nil {
+				return err
+			}
+			update.Transfers = append(update.Transfers, transfers...)
+			update.TokenIds = append(update.TokenIds, tokenIDs...)
+		}
+	}
+	context.Send(context.Parent(), &messages.ProtocolAssetDataIncrementalRefresh{
+		Update: update,
+		SeqNum: state.seqNum,
+	})
+	return nil
+}
+
+func (state *Listener) Clean(context actor.Context) error {
+	if state.timeoutTicker != nil {
+		state.timeoutTicker.Stop()
+		state.timeoutTicker = nil
+	}
+	return nil
+}
+
+func (state *Listener) onCheckTimeout(context actor.Context) error {
+	if time.Since(state.lastRefreshTime) > 30*time.Second {
+		return fmt.Errorf("timed-out")
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package erc1155_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/alphaticks/alpha-connect/config"
+	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	"gitlab.com/alphaticks/alpha-connect/protocols/tests"
+	exTests "gitlab.com/alphaticks/alpha-connect/tests"
+	"gitlab.com/alphaticks/xchanger/constants"
+)
+
+func TestListenerEVM(t *testing.T) {
+	exTests.LoadStatics(t)
+	chain, ok := constants.GetChainByID(1)
+	if !assert.True(t, ok, "missing chain") {
+		t.Fatal()
+	}
+	testListener(t, chain.ID)
+}
+
+func TestListenerZKEVM(t *testing.T) {
+	exTests.LoadStatics(t)
+	chain, ok := constants.GetChainByID(6)
+	if !assert.True(t, ok, "missing chain") {
+		t.Fatal()
+	}
+	testListener(t, chain.ID)
+}
+
+func testListener(t *testing.T, chainID uint32) {
+	registryAddress := "127.0.0.1:8001"
+	cfg, err := config.LoadConfig()
+	if !assert.Nil(t, err, "LoadConfig err: %v", err) {
+		t.Fatal()
+	}
+	cfg.RegistryAddress = registryAddress
+	cfg.Protocols = []string{"ERC-1155"}
+	as, executor, clean := exTests.StartExecutor(t, cfg)
+	defer clean()
+
+	res, err := as.Root.RequestFuture(executor, &messages.ProtocolAssetListRequest{
+		RequestID: uint64(time.Now().UnixNano()),
+		Subscribe: false,
+	}, 20*time.Second).Result()
+	if !assert.Nil(t, err, "Request future ProtocolListRequest err: %v", err) {
+		t.Fatal()
+	}
+	response, ok := res.(*messages.ProtocolAssetList)
+	if !assert.True(t, ok, "expected *messages.ProtocolAssetList, got %s", reflect.TypeOf(res).String()) {
+		t.Fatal()
+	}
+	var asset *models.ProtocolAsset
+	for _, a := range response.ProtocolAssets {
+		if a.Chain.ID == chainID {
+			asset = a
+			break
+		}
+	}
+	if !assert.NotNil(t, asset, "asset not found") {
+		t.Fatal()
+	}
+	s := asset.Asset
+
+	// Listen on all protocol assets updates
+	asset.Asset = nil
+	props := actor.PropsFromProducer(tests.NewProtocolCheckerProducer(asset))
+	checker := as.Root.Spawn(props)
+	defer as.Root.PoisonFuture(checker)
+	time.Sleep(2 * time.Minute)
+	resp, err := as.Root.RequestFuture(checker, &tests.GetDataRequest{}, 10*time.Second).Result()
+	if !assert.Nil(t, err, "Request future GetDataRequest err: %v", err) {
+		t.Fatal()
+	}
+	d, ok := resp.(*tests.GetDataResponse)
+	if !assert.True(t, ok, "expected *tests.GetDataResponse, got %s", reflect.TypeOf(resp).String()) {
+		t.Fatal()
+	}
+	if !assert.Nil(t, d.Err, "listener error: %v", d.Err) {
+		t.Fatal()
+	}
+	for _, u := range d.Updates {
+		if !assert.Equal(t, len(u.Transfers), len(u.TokenIds), "expected a token id per transfer") {
+			t.Fatal()
+		}
+	}
+
+	// Listen on a specific protocol asset updates
+	asset.Asset = s
+	props = actor.PropsFromProducer(tests.NewProtocolCheckerProducer(asset))
+	c := as.Root.Spawn(props)
+	defer as.Root.PoisonFuture(c)
+	time.Sleep(2 * time.Minute)
+	resp, err = as.Root.RequestFuture(c, &tests.GetDataRequest{}, 10*time.Second).Result()
+	if !assert.Nil(t, err, "RequestFuture GetData err: %v", err) {
+		t.Fatal()
+	}
+	d, ok = resp.(*tests.GetDataResponse)
+	if !assert.True(t, ok, "expected *tests.GetDataResponse, got %s", reflect.TypeOf(resp).String()) {
+		t.Fatal()
+	}
+	if !assert.Nil(t, d.Err, "listener error: %v", d.Err) {
+		t.Fatal()
+	}
+	// check the contract address is what we expect for all transfers
+	for _, u := range d.Updates {
+		for _, tx := range u.Transfers {
+			if !assert.Equal(t, asset.ContractAddress.Value, common.BytesToAddress(tx.Contract).String()) {
+				t.Fatal()
+			}
+		}
+	}
+}
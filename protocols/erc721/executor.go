@@ -430,6 +430,7 @@ func (state *Executor) OnHistoricalProtocolAssetTransferRequest(context actor.Co
 				Value:    tok,
 				Contract: contract,
 			})
+			update.TokenIds = append(update.TokenIds, tok)
 		}
 		if update != nil {
 			events = append(events, update)
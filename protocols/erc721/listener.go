@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gorm.io/gorm"
 	"reflect"
 	"time"
 
@@ -23,28 +24,33 @@ import (
 type checkTimeout struct{}
 type updateRequest struct{}
 
+// Max number of blocks pulled per SVM events query while catching up
+const svmMaxBatch = 100
+
 type Listener struct {
 	types.BaseListener
 	executor        *actor.PID
 	protocolAsset   *models.ProtocolAsset
 	eabi            *abi.ABI
 	seqNum          uint64
-	lastBlock       uint64
+	catchUp         *types.BlockCatchUp
 	lastRefreshTime time.Time
 	logger          *log.Logger
+	db              *gorm.DB
 	updateTicker    *time.Ticker
 	timeoutTicker   *time.Ticker
 }
 
-func NewListenerProducer(protocolAsset *models.ProtocolAsset) actor.Producer {
+func NewListenerProducer(protocolAsset *models.ProtocolAsset, db *gorm.DB) actor.Producer {
 	return func() actor.Actor {
-		return NewListener(protocolAsset)
+		return NewListener(protocolAsset, db)
 	}
 }
 
-func NewListener(protocolAsset *models.ProtocolAsset) actor.Actor {
+func NewListener(protocolAsset *models.ProtocolAsset, db *gorm.DB) actor.Actor {
 	return &Listener{
 		protocolAsset: protocolAsset,
+		db:            db,
 		logger:        nil,
 	}
 }
@@ -171,7 +177,7 @@ func (state *Listener) subscribeEVMLogs(context actor.Context) error {
 	}
 	subRes, ok := res.(*messages.EVMLogsSubscribeResponse)
 	if !ok {
-		return fmt.Errorf("was expecting EVMLogsSubscribeResponse, got %s", reflect.TypeOf(subRes).String())
+		return fmt.Errorf("was expecting EVMLogsSubscribeResponse, got %s", reflect.TypeOf(res).String())
 	}
 	if !subRes.Success {
 		return fmt.Errorf("error subscribing to EVM logs: %s", subRes.RejectionReason.String())
@@ -197,7 +203,16 @@ func (state *Listener) subscribeSVMEvents(context actor.Context) error {
 	}
 
 	state.seqNum = b.ResponseID
-	state.lastBlock = b.BlockNumber - 5
+	var store types.CheckpointStore
+	if state.db != nil {
+		// Resume from the last processed block
+		store = types.DBCheckpointStore{DB: state.db}
+	}
+	catchUp, err := types.NewBlockCatchUp(state.protocolAsset.ProtocolAssetID, b.BlockNumber-5, svmMaxBatch, store)
+	if err != nil {
+		return err
+	}
+	state.catchUp = catchUp
 
 	ticker := time.NewTicker(20 * time.Second)
 	state.updateTicker = ticker
@@ -243,6 +258,7 @@ func (state *Listener) OnEVMLogsSubscribeRefresh(context actor.Context) error {
 		update = &models.ProtocolAssetUpdate{
 			BlockNumber: refresh.Update.BlockNumber,
 			BlockTime:   timestamppb.New(refresh.Update.BlockTime),
+			Removed:     refresh.Update.Removed,
 		}
 		for _, l := range refresh.Update.Logs {
 			if len(l.Topics) != 4 {
@@ -260,6 +276,7 @@ func (state *Listener) OnEVMLogsSubscribeRefresh(context actor.Context) error {
 					Value:    event.TokenId.Bytes(),
 					Contract: l.Address.Bytes(),
 				})
+				update.TokenIds = append(update.TokenIds, event.TokenId.Bytes())
 			}
 		}
 	}
@@ -286,20 +303,27 @@ func (state *Listener) OnSVMUpdateRequest(context actor.Context) error {
 		return fmt.Errorf("error fetching block number: %s", b.RejectionReason.String())
 	}
 
-	var update *models.ProtocolAssetUpdate
-	if b.BlockNumber >= state.lastBlock {
+	var updates []*models.ProtocolAssetUpdate
+	// Pull up to a batch of blocks while lagging behind the head
+	start, stop, lagging, pull := state.catchUp.Range(b.BlockNumber)
+	if pull {
 		q := &messages.HistoricalProtocolAssetTransferRequest{
 			RequestID:  uint64(time.Now().UnixNano()),
 			ProtocolID: state.protocolAsset.Protocol.ID,
 			ChainID:    state.protocolAsset.Chain.ID,
-			Start:      state.lastBlock,
-			Stop:       state.lastBlock,
+			Start:      start,
+			Stop:       stop,
 		}
 		if state.protocolAsset.Asset != nil {
 			q.AssetID = &wrapperspb.UInt32Value{Value: state.protocolAsset.Asset.ID}
 		}
 		resp, err := context.RequestFuture(state.executor, q, 1*time.Minute).Result()
 		if err != nil {
+			if err == actor.ErrTimeout && state.catchUp.Shrink() {
+				// Range too large for the node, retry with a smaller one on next tick
+				state.logger.Warn("svm events query timed out, reducing batch size", log.Uint64("batch size", state.catchUp.BatchSize))
+				return nil
+			}
 			return fmt.Errorf("error fetching svm events: %v", err)
 		}
 		evs, ok := resp.(*messages.HistoricalProtocolAssetTransferResponse)
@@ -307,27 +331,48 @@ func (state *Listener) OnSVMUpdateRequest(context actor.Context) error {
 			return fmt.Errorf("expected *messages.HistoricalProtocolAssetTransferResponse, got %s", reflect.TypeOf(resp).String())
 		}
 		if !evs.Success {
+			if evs.RejectionReason == messages.RejectionReason_RPCTimeout && state.catchUp.Shrink() {
+				state.logger.Warn("svm events query timed out, reducing batch size", log.Uint64("batch size", state.catchUp.BatchSize))
+				return nil
+			}
 			return fmt.Errorf("error fetching svm events, got %s", evs.RejectionReason)
 		}
-		if len(evs.Update) > 1 {
-			return fmt.Errorf("fetched more than one block")
-		}
-		if len(evs.Update) == 1 {
-			update = &models.ProtocolAssetUpdate{
-				Transfers:   evs.Update[0].Transfers,
-				BlockNumber: evs.Update[0].BlockNumber,
-				BlockTime:   evs.Update[0].BlockTime,
+		for _, u := range evs.Update {
+			if u.BlockNumber < start || u.BlockNumber > stop {
+				return fmt.Errorf("fetched block %d out of range [%d, %d]", u.BlockNumber, start, stop)
 			}
+			updates = append(updates, &models.ProtocolAssetUpdate{
+				Transfers:   u.Transfers,
+				TokenIds:    u.TokenIds,
+				BlockNumber: u.BlockNumber,
+				BlockTime:   u.BlockTime,
+			})
 		}
-		state.lastBlock += 1
+		state.catchUp.Advance(stop, lagging)
 	}
 
-	context.Send(context.Parent(), &messages.ProtocolAssetDataIncrementalRefresh{
-		Update: update,
-		SeqNum: state.seqNum + 1,
-	})
-	state.seqNum += 1
+	if len(updates) == 0 {
+		// Heartbeat
+		updates = append(updates, nil)
+	}
+	for _, u := range updates {
+		context.Send(context.Parent(), &messages.ProtocolAssetDataIncrementalRefresh{
+			Update: u,
+			SeqNum: state.seqNum + 1,
+		})
+		state.seqNum += 1
+	}
+	if pull {
+		// Only checkpoint once the updates were sent, so that none are skipped on restart
+		if err := state.catchUp.Checkpoint(); err != nil {
+			state.logger.Warn("error saving checkpoint", log.Error(err))
+		}
+	}
 	state.lastRefreshTime = time.Now()
+	if lagging {
+		// Keep catching up without waiting for the next tick
+		context.Send(context.Self(), &updateRequest{})
+	}
 	return nil
 }
 
@@ -114,6 +114,9 @@ func TestListenerEVM(t *testing.T) {
 	}
 	// check the contract address is what we expect for all transactions
 	for _, u := range d.Updates {
+		if !assert.Equal(t, len(u.Transfers), len(u.TokenIds), "expected a token id per transfer") {
+			t.Fatal()
+		}
 		for _, tx := range u.Transfers {
 			if !assert.Equal(t, asset.ContractAddress.Value, common.BytesToAddress(tx.Contract).String()) {
 				t.Fatal()
@@ -4,9 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"gitlab.com/alphaticks/alpha-connect/config"
+	"gitlab.com/alphaticks/alpha-connect/protocols/contract"
+	"gitlab.com/alphaticks/alpha-connect/protocols/types"
 	registry "gitlab.com/alphaticks/alpha-public-registry-grpc"
 	"gitlab.com/alphaticks/xchanger/constants"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
@@ -14,6 +17,8 @@ import (
 	"gitlab.com/alphaticks/alpha-connect/models"
 	"gitlab.com/alphaticks/alpha-connect/models/messages"
 	"gitlab.com/alphaticks/alpha-connect/utils"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
 )
 
 // The executor routes all the request to the underlying exchange executor & listeners
@@ -26,6 +31,8 @@ type Executor struct {
 	protocolAssets map[uint64]*models.ProtocolAsset
 	alSubscribers  map[uint64]*actor.PID // A map from request ID to asset list subscriber
 	dataManagers   map[uint64]*actor.PID // A map from protocol asset ID to data manager
+	contracts      map[string]*actor.PID // A map from contract events key to contract listener
+	db             *gorm.DB              // Listener checkpoints, nil if no database is configured
 	logger         *log.Logger
 	strict         bool
 }
@@ -90,6 +97,11 @@ func (state *Executor) Receive(context actor.Context) {
 			state.logger.Error("error processing OnProtocolAssetDefinition", log.Error(err))
 			panic(err)
 		}
+	case *messages.ContractEventDataRequest:
+		if err := state.OnContractEventDataRequest(context); err != nil {
+			state.logger.Error("error processing OnContractEventDataRequest", log.Error(err))
+			panic(err)
+		}
 	case *actor.Terminated:
 		if err := state.OnTerminated(context); err != nil {
 			state.logger.Error("error processing OnTerminated", log.Error(err))
@@ -108,6 +120,20 @@ func (state *Executor) Initialize(context actor.Context) error {
 	state.protocolAssets = make(map[uint64]*models.ProtocolAsset)
 	state.executors = make(map[uint32]*actor.PID)
 	state.dataManagers = make(map[uint64]*actor.PID)
+	state.contracts = make(map[string]*actor.PID)
+
+	if state.DB != nil {
+		sql, err := gorm.Open(postgres.Open(state.DB.DSN()), &gorm.Config{})
+		if err != nil {
+			return fmt.Errorf("error connecting to database: %v", err)
+		}
+		if state.DB.Migrate {
+			if err := sql.AutoMigrate(&types.Checkpoint{}); err != nil {
+				return fmt.Errorf("error migrating checkpoint type: %v", err)
+			}
+		}
+		state.db = sql
+	}
 
 	// Spawn all exchange executors
 	for _, protocolStr := range state.Config.Protocols {
@@ -258,7 +284,7 @@ func (state *Executor) OnProtocolAssetDataRequest(context actor.Context) error {
 	if pid, ok := state.dataManagers[passet.ProtocolAssetID]; ok {
 		context.Forward(pid)
 	} else {
-		props := actor.PropsFromProducer(NewDataManagerProducer(passet), actor.WithSupervisor(
+		props := actor.PropsFromProducer(NewDataManagerProducer(passet, state.db), actor.WithSupervisor(
 			utils.NewExponentialBackoffStrategy(100*time.Second, time.Second, time.Second)))
 		pid := context.Spawn(props)
 		state.dataManagers[passet.ProtocolAssetID] = pid
@@ -301,6 +327,59 @@ func (state *Executor) OnProtocolAssetDefinition(context actor.Context) error {
 	return nil
 }
 
+func (state *Executor) OnContractEventDataRequest(context actor.Context) error {
+	req := context.Message().(*messages.ContractEventDataRequest)
+	res := &messages.ContractEventDataResponse{
+		RequestID:  req.RequestID,
+		ResponseID: uint64(time.Now().UnixNano()),
+	}
+	if !req.Unsubscribe && req.Subscriber == nil {
+		res.RejectionReason = messages.RejectionReason_InvalidRequest
+		context.Respond(res)
+		return nil
+	}
+	chain, ok := constants.GetChainByID(req.ChainID)
+	if !ok {
+		res.RejectionReason = messages.RejectionReason_UnknownChain
+		context.Respond(res)
+		return nil
+	}
+	if chain.Type != "EVM" && chain.Type != "ZKEVM" {
+		res.RejectionReason = messages.RejectionReason_UnsupportedRequest
+		context.Respond(res)
+		return nil
+	}
+	eabi, events, err := contract.ParseEvents(req.ABI, req.Events)
+	if err != nil {
+		state.logger.Warn("invalid contract events request", log.Error(err))
+		res.RejectionReason = messages.RejectionReason_ABIError
+		context.Respond(res)
+		return nil
+	}
+	// One listener per contract and set of events
+	sigs := make([]string, len(events))
+	for i, e := range events {
+		sigs[i] = e.Sig
+	}
+	key := fmt.Sprintf("%d:%s:%s", chain.ID, req.Address.String(), strings.Join(sigs, ","))
+	pid, ok := state.contracts[key]
+	if !ok && req.Unsubscribe {
+		// Nothing to unsubscribe from
+		res.Success = true
+		context.Respond(res)
+		return nil
+	}
+	if !ok {
+		props := actor.PropsFromProducer(contract.NewDataManagerProducer(chain, req.Address, eabi, events), actor.WithSupervisor(
+			utils.NewExponentialBackoffStrategy(100*time.Second, time.Second, time.Second)))
+		pid = context.Spawn(props)
+		context.Watch(pid)
+		state.contracts[key] = pid
+	}
+	context.Forward(pid)
+	return nil
+}
+
 func (state *Executor) getProtocolAsset(asset *models.ProtocolAsset) (*models.ProtocolAsset, *messages.RejectionReason) {
 	if asset == nil {
 		rej := messages.RejectionReason_MissingProtocolAsset
@@ -339,5 +418,11 @@ func (state *Executor) OnTerminated(context actor.Context) error {
 		}
 	}
 
+	for k, v := range state.contracts {
+		if v.Id == req.Who.Id {
+			delete(state.contracts, k)
+		}
+	}
+
 	return nil
 }
@@ -3,11 +3,13 @@ package protocols
 import (
 	"github.com/asynkron/protoactor-go/actor"
 	"gitlab.com/alphaticks/alpha-connect/models"
+	"gitlab.com/alphaticks/alpha-connect/protocols/erc1155"
 	"gitlab.com/alphaticks/alpha-connect/protocols/erc20"
 	"gitlab.com/alphaticks/alpha-connect/protocols/erc721"
 	registry "gitlab.com/alphaticks/alpha-public-registry-grpc"
 	"gitlab.com/alphaticks/xchanger/constants"
 	models2 "gitlab.com/alphaticks/xchanger/models"
+	"gorm.io/gorm"
 )
 
 func NewProtocolExecutorProducer(protocol *models2.Protocol, registry registry.StaticClient) actor.Producer {
@@ -16,17 +18,21 @@ func NewProtocolExecutorProducer(protocol *models2.Protocol, registry registry.S
 		return func() actor.Actor { return erc20.NewExecutor(registry, protocol) }
 	case constants.ERC721.ID:
 		return func() actor.Actor { return erc721.NewExecutor(registry, protocol) }
+	case constants.ERC1155.ID:
+		return func() actor.Actor { return erc1155.NewExecutor(registry, protocol) }
 	default:
 		return nil
 	}
 }
 
-func NewProtocolAssetListenerProducer(protocolAsset *models.ProtocolAsset) actor.Producer {
+func NewProtocolAssetListenerProducer(protocolAsset *models.ProtocolAsset, db *gorm.DB) actor.Producer {
 	switch protocolAsset.Protocol.ID {
 	case constants.ERC20.ID:
-		return func() actor.Actor { return erc20.NewListener(protocolAsset) }
+		return func() actor.Actor { return erc20.NewListener(protocolAsset, db) }
 	case constants.ERC721.ID:
-		return func() actor.Actor { return erc721.NewListener(protocolAsset) }
+		return func() actor.Actor { return erc721.NewListener(protocolAsset, db) }
+	case constants.ERC1155.ID:
+		return func() actor.Actor { return erc1155.NewListener(protocolAsset) }
 	}
 	return nil
 }
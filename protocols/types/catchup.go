@@ -0,0 +1,79 @@
+package types
+
+// BlockCatchUp follows the next block a listener pulling the events of the chain by
+// batches has to process. The batch is halved when the node times out on it, and doubled
+// back up to the max batch while lagging behind the head. The next block is resumed
+// from the checkpoint of the protocol asset, if any, and checkpointed once processed.
+type BlockCatchUp struct {
+	ProtocolAssetID uint64
+	Next            uint64
+	BatchSize       uint64
+	MaxBatch        uint64
+	store           CheckpointStore
+}
+
+// NewBlockCatchUp starts from the checkpoint of the protocol asset, or from the start block
+// if none was saved. The store can be nil, in which case no checkpoint is kept.
+func NewBlockCatchUp(protocolAssetID uint64, start uint64, maxBatch uint64, store CheckpointStore) (*BlockCatchUp, error) {
+	c := &BlockCatchUp{
+		ProtocolAssetID: protocolAssetID,
+		Next:            start,
+		BatchSize:       maxBatch,
+		MaxBatch:        maxBatch,
+		store:           store,
+	}
+	if store != nil {
+		next, ok, err := store.LoadCheckpoint(protocolAssetID)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			c.Next = next
+		}
+	}
+	return c, nil
+}
+
+// Range returns the blocks of the next batch up to the head, and whether the batch
+// stops before the head. It returns false when there is no block to pull.
+func (c *BlockCatchUp) Range(head uint64) (uint64, uint64, bool, bool) {
+	if head < c.Next {
+		return 0, 0, false, false
+	}
+	stop := head
+	lagging := false
+	if stop-c.Next+1 > c.BatchSize {
+		stop = c.Next + c.BatchSize - 1
+		lagging = true
+	}
+	return c.Next, stop, lagging, true
+}
+
+// Shrink halves the batch after a timeout, it returns false if it can't be reduced
+func (c *BlockCatchUp) Shrink() bool {
+	if c.BatchSize <= 1 {
+		return false
+	}
+	c.BatchSize /= 2
+	return true
+}
+
+// Advance moves past the batch ending at stop, growing the batch while lagging
+func (c *BlockCatchUp) Advance(stop uint64, lagging bool) {
+	c.Next = stop + 1
+	if lagging && c.BatchSize < c.MaxBatch {
+		c.BatchSize *= 2
+		if c.BatchSize > c.MaxBatch {
+			c.BatchSize = c.MaxBatch
+		}
+	}
+}
+
+// Checkpoint saves the next block, to be called once the updates of the
+// blocks before it were sent
+func (c *BlockCatchUp) Checkpoint() error {
+	if c.store == nil {
+		return nil
+	}
+	return c.store.SaveCheckpoint(c.ProtocolAssetID, c.Next)
+}
@@ -0,0 +1,85 @@
+package types
+
+import "testing"
+
+type memCheckpointStore map[uint64]uint64
+
+func (s memCheckpointStore) LoadCheckpoint(protocolAssetID uint64) (uint64, bool, error) {
+	n, ok := s[protocolAssetID]
+	return n, ok, nil
+}
+
+func (s memCheckpointStore) SaveCheckpoint(protocolAssetID uint64, blockNumber uint64) error {
+	s[protocolAssetID] = blockNumber
+	return nil
+}
+
+func TestBlockCatchUp(t *testing.T) {
+	store := memCheckpointStore{}
+	c, err := NewBlockCatchUp(1, 995, 100, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start, stop, lagging, ok := c.Range(1000)
+	if !ok || start != 995 || stop != 1000 || lagging {
+		t.Fatalf("was expecting blocks 995 to 1000, got %d to %d", start, stop)
+	}
+	if _, _, _, ok := c.Range(994); ok {
+		t.Fatalf("was expecting no block before the start")
+	}
+
+	// Halved on timeouts
+	for c.Shrink() {
+	}
+	if c.BatchSize != 1 {
+		t.Fatalf("was expecting a batch of 1, got %d", c.BatchSize)
+	}
+	start, stop, lagging, _ = c.Range(1000)
+	if start != 995 || stop != 995 || !lagging {
+		t.Fatalf("was expecting block 995 only, got %d to %d", start, stop)
+	}
+
+	// Grown back while lagging, up to the max batch
+	for i := 0; i < 10; i++ {
+		start, stop, lagging, _ = c.Range(2000)
+		if stop-start+1 != c.BatchSize {
+			t.Fatalf("was expecting a batch of %d, got %d", c.BatchSize, stop-start+1)
+		}
+		c.Advance(stop, lagging)
+	}
+	if c.BatchSize != 100 {
+		t.Fatalf("was expecting a batch of 100, got %d", c.BatchSize)
+	}
+	next := c.Next
+	if _, ok := store[1]; ok {
+		t.Fatalf("was expecting no checkpoint before the updates are sent")
+	}
+	if err := c.Checkpoint(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Resumed from the checkpoint on restart
+	c, err = NewBlockCatchUp(1, 2995, 100, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Next != next {
+		t.Fatalf("was expecting to resume at %d, got %d", next, c.Next)
+	}
+	c, err = NewBlockCatchUp(2, 2995, 100, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Next != 2995 {
+		t.Fatalf("was expecting to start at 2995, got %d", c.Next)
+	}
+
+	// No store, no checkpoint
+	c, err = NewBlockCatchUp(1, 2995, 100, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Next != 2995 || c.Checkpoint() != nil {
+		t.Fatalf("was expecting to start at 2995 without checkpoint")
+	}
+}
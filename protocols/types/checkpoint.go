@@ -0,0 +1,65 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Checkpoint is the next block a protocol asset listener has to process,
+// listeners resume from it on restart instead of skipping history
+type Checkpoint struct {
+	ProtocolAssetID uint64 `gorm:"primarykey;autoIncrement:false"`
+	BlockNumber     uint64
+	UpdatedAt       time.Time
+}
+
+// LoadCheckpoint returns the next block to process of the protocol asset, false if none was saved
+func LoadCheckpoint(db *gorm.DB, protocolAssetID uint64) (uint64, bool, error) {
+	var cp Checkpoint
+	tx := db.Where("protocol_asset_id=?", protocolAssetID).First(&cp)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("error loading checkpoint: %v", tx.Error)
+	}
+	return cp.BlockNumber, true, nil
+}
+
+func SaveCheckpoint(db *gorm.DB, protocolAssetID uint64, blockNumber uint64) error {
+	tx := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "protocol_asset_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"block_number", "updated_at"}),
+	}).Create(&Checkpoint{
+		ProtocolAssetID: protocolAssetID,
+		BlockNumber:     blockNumber,
+		UpdatedAt:       time.Now(),
+	})
+	if tx.Error != nil {
+		return fmt.Errorf("error saving checkpoint: %v", tx.Error)
+	}
+	return nil
+}
+
+// CheckpointStore holds the checkpoints of the protocol asset listeners
+type CheckpointStore interface {
+	LoadCheckpoint(protocolAssetID uint64) (uint64, bool, error)
+	SaveCheckpoint(protocolAssetID uint64, blockNumber uint64) error
+}
+
+// DBCheckpointStore holds the checkpoints in the database
+type DBCheckpointStore struct {
+	DB *gorm.DB
+}
+
+func (s DBCheckpointStore) LoadCheckpoint(protocolAssetID uint64) (uint64, bool, error) {
+	return LoadCheckpoint(s.DB, protocolAssetID)
+}
+
+func (s DBCheckpointStore) SaveCheckpoint(protocolAssetID uint64, blockNumber uint64) error {
+	return SaveCheckpoint(s.DB, protocolAssetID, blockNumber)
+}
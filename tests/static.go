@@ -3414,6 +3414,10 @@ var ProtocolsJSON = []byte(`
   "7": {
     "ID": 7,
     "name": "ERC-721"
+  },
+  "8": {
+    "ID": 8,
+    "name": "ERC-1155"
   }
 }
 `)
@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"gitlab.com/alphaticks/alpha-connect/models/messages"
+	xmodels "gitlab.com/alphaticks/xchanger/models"
+)
+
+// Helpers of the actors reading the state of a chain through the chain executor
+
+// EVMCall calls the method of the contract at the given block, and unpacks its output
+func EVMCall(context actor.Context, executor *actor.PID, chain *xmodels.Chain, cabi *abi.ABI, to common.Address, block uint64, method string, args ...interface{}) ([]interface{}, error) {
+	data, err := cabi.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error packing %s call: %v", method, err)
+	}
+	res, err := context.RequestFuture(executor, &messages.EVMContractCallRequest{
+		RequestID: uint64(time.Now().UnixNano()),
+		Chain:     chain,
+		Msg: ethereum.CallMsg{
+			To:   &to,
+			Data: data,
+		},
+		BlockNumber: block,
+	}, 15*time.Second).Result()
+	if err != nil {
+		return nil, fmt.Errorf("error calling %s: %v", method, err)
+	}
+	call, ok := res.(*messages.EVMContractCallResponse)
+	if !ok {
+		return nil, fmt.Errorf("was expecting *messages.EVMContractCallResponse, got %s", reflect.TypeOf(res).String())
+	}
+	if !call.Success {
+		return nil, fmt.Errorf("error calling %s: %s", method, call.RejectionReason.String())
+	}
+	out, err := cabi.Unpack(method, call.Out)
+	if err != nil {
+		return nil, fmt.Errorf("error unpacking %s output: %v", method, err)
+	}
+	return out, nil
+}
+
+// EVMBlockNumber returns the head block of the chain
+func EVMBlockNumber(context actor.Context, executor *actor.PID, chain *xmodels.Chain) (uint64, error) {
+	res, err := context.RequestFuture(executor, &messages.BlockNumberRequest{
+		RequestID: uint64(time.Now().UnixNano()),
+		Chain:     chain,
+	}, 10*time.Second).Result()
+	if err != nil {
+		return 0, fmt.Errorf("error fetching block number: %v", err)
+	}
+	b, ok := res.(*messages.BlockNumberResponse)
+	if !ok {
+		return 0, fmt.Errorf("was expecting *messages.BlockNumberResponse, got %s", reflect.TypeOf(res).String())
+	}
+	if !b.Success {
+		return 0, fmt.Errorf("error fetching block number: %s", b.RejectionReason.String())
+	}
+	return b.BlockNumber, nil
+}
+
+// ToQuantity converts an amount in the smallest unit of a token
+func ToQuantity(amount *big.Int, decimals uint32) float64 {
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	q, _ := new(big.Float).Quo(new(big.Float).SetInt(amount), new(big.Float).SetInt(unit)).Float64()
+	return q
+}
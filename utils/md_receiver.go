@@ -49,6 +49,14 @@ func NewMDReceiver(as *actor.ActorSystem, executor *actor.PID, instrument *model
 			}
 			r.seq = msg.SeqNum
 			ch <- msg
+
+		case *messages.MarketDataResponse:
+			// Conflated snapshots and snapshots of a restarted listener replace the book
+			if msg.Conflated && msg.SeqNum <= r.seq {
+				return
+			}
+			r.seq = msg.SeqNum
+			ch <- msg
 		}
 	}))
 